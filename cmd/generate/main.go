@@ -3,39 +3,94 @@ package main
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"flag"
+	"fmt"
 	"io/ioutil"
 	"os"
+	"strings"
 
 	"github.com/hashicorp/go-hclog"
 	"github.com/hashicorp/terraform-provider-vault/codegen"
+	"github.com/hashicorp/vault/api"
 	"github.com/hashicorp/vault/sdk/framework"
 )
 
-var pathToOpenAPIDoc = flag.String("openapi-doc", "", "path/to/openapi.json")
+var (
+	pathToOpenAPIDoc = flag.String("openapi-doc", "", "path/to/openapi.json")
+	readOnly         = flag.Bool("read-only", false, "generate data sources for every endpoint only supporting GET requests, rather than the endpoints in the registry")
+	useFramework     = flag.Bool("framework", false, "generate code using terraform-plugin-framework rather than terraform-plugin-sdk")
+	pathToManifest   = flag.String("manifest", "", "path/to/manifest.json, to generate every endpoint it lists rather than the endpoints in the registry")
+	fromVault        = flag.Bool("vault", false, "read the OpenAPI doc from the Vault server at VAULT_ADDR using VAULT_TOKEN, rather than from 'openapi-doc'")
+	vaultAddr        = flag.String("vault-addr", "", "address of the Vault server to read the OpenAPI doc from, overriding VAULT_ADDR, e.g. http://127.0.0.1:8200 for a dev server")
+	mountTypes       = flag.String("mount-types", "", "comma separated types of the mounts whose endpoints are read from Vault, e.g. transform,userpass")
+)
 
 func main() {
 	logger := hclog.Default()
 	flag.Parse()
-	if pathToOpenAPIDoc == nil || *pathToOpenAPIDoc == "" {
-		logger.Error("'openapi-doc' is required")
-		os.Exit(1)
-	}
-	doc, err := ioutil.ReadFile(*pathToOpenAPIDoc)
+	oasDoc, err := readOpenAPIDoc()
 	if err != nil {
-		logger.Error("Unable to read file [%s]: %s", *pathToOpenAPIDoc, err.Error())
+		logger.Error(err.Error())
 		os.Exit(1)
 	}
 
-	// Read in Vault's description of all the supported endpoints, their methods, and more.
-	oasDoc := &framework.OASDocument{}
-	if err := json.NewDecoder(bytes.NewBuffer(doc)).Decode(oasDoc); err != nil {
-		logger.Error("Failed to decode JSON from file [%s]: %s", *pathToOpenAPIDoc, err.Error())
-		os.Exit(1)
+	opts := codegen.Options{Framework: *useFramework}
+	if *pathToManifest != "" {
+		manifest, err := codegen.ReadManifest(*pathToManifest)
+		if err != nil {
+			logger.Error("Unable to read manifest [%s]: %s", *pathToManifest, err.Error())
+			os.Exit(1)
+		}
+		if err := codegen.RunManifest(logger, oasDoc.Paths, manifest, opts); err != nil {
+			logger.Error("Failed to generate code: %s", err.Error())
+			os.Exit(1)
+		}
+		return
 	}
 
-	if err := codegen.Run(logger, oasDoc.Paths); err != nil {
+	run := codegen.Run
+	if *readOnly {
+		run = codegen.RunReadOnly
+	}
+	if err := run(logger, oasDoc.Paths, opts); err != nil {
 		logger.Error("Failed to generate code: %s", err.Error())
 		os.Exit(1)
 	}
 }
+
+// readOpenAPIDoc reads in Vault's description of all the supported endpoints,
+// their methods, and more, either from a file or from a running Vault server.
+func readOpenAPIDoc() (*framework.OASDocument, error) {
+	if *fromVault {
+		config := api.DefaultConfig()
+		if *vaultAddr != "" {
+			config.Address = *vaultAddr
+		}
+		client, err := api.NewClient(config)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create the Vault client: %s", err)
+		}
+		var types []string
+		if *mountTypes != "" {
+			types = strings.Split(*mountTypes, ",")
+		}
+		return codegen.FetchOpenAPIDoc(client, types)
+	}
+
+	if pathToOpenAPIDoc == nil || *pathToOpenAPIDoc == "" {
+		return nil, errors.New("'openapi-doc' is required unless 'vault' is set")
+	}
+	if *mountTypes != "" {
+		return nil, errors.New("'mount-types' is only supported with 'vault'")
+	}
+	doc, err := ioutil.ReadFile(*pathToOpenAPIDoc)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read file [%s]: %s", *pathToOpenAPIDoc, err)
+	}
+	oasDoc := &framework.OASDocument{}
+	if err := json.NewDecoder(bytes.NewBuffer(doc)).Decode(oasDoc); err != nil {
+		return nil, fmt.Errorf("failed to decode JSON from file [%s]: %s", *pathToOpenAPIDoc, err)
+	}
+	return oasDoc, nil
+}
@@ -0,0 +1,102 @@
+package codegen
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// These methods use the display attributes and defaults Vault gives its
+// parameters, which are meant for its UI, to generate schemas that don't
+// show secrets in plans or diff with the values Vault fills in.
+
+// IsSensitive returns whether the parameter holds a secret, e.g. a password,
+// which is hidden in Terraform's output.
+func (p templatableParam) IsSensitive() bool {
+	return p.Schema.DisplayAttrs.Sensitive || p.Schema.DisplaySensitive
+}
+
+// DefaultValue returns the Go literal of the default of an optional scalar
+// parameter, e.g. `"cn"`, or "" if the parameter has no default the schema
+// can give it. Zero values are skipped, as they're the default already.
+func (p templatableParam) DefaultValue() string {
+	if p.Required || p.Computed || p.IsPathParam || isZeroDefault(p.Schema.Default) {
+		return ""
+	}
+	switch p.Schema.Type {
+	case "string":
+		if v, ok := p.Schema.Default.(string); ok {
+			return strconv.Quote(v)
+		}
+	case "boolean":
+		if v, ok := p.Schema.Default.(bool); ok {
+			return strconv.FormatBool(v)
+		}
+	case "integer":
+		// Numbers are decoded from the OpenAPI doc as floats.
+		switch v := p.Schema.Default.(type) {
+		case float64:
+			if v == float64(int64(v)) {
+				return strconv.FormatInt(int64(v), 10)
+			}
+		case int:
+			return strconv.Itoa(v)
+		}
+	}
+	return ""
+}
+
+// IsComputed returns whether the parameter of a resource is computed, either
+// because it's only returned by Vault, or because Vault fills in a default
+// the schema can't give it, e.g. a list, which would otherwise always diff
+// when it isn't set.
+func (p templatableParam) IsComputed() bool {
+	if p.Computed {
+		return true
+	}
+	if p.Required || p.IsPathParam || isZeroDefault(p.Schema.Default) {
+		return false
+	}
+	return p.DefaultValue() == ""
+}
+
+// Example returns the sample value Vault displays for the parameter, or "".
+// Vault doesn't use it as a default, so neither do the generated schemas.
+func (p templatableParam) Example() string {
+	if p.Schema.DisplayAttrs.Value == nil {
+		return ""
+	}
+	example := fmt.Sprint(p.Schema.DisplayAttrs.Value)
+	if example == fmt.Sprint(p.Schema.Default) {
+		// It's documented as the default already.
+		return ""
+	}
+	return example
+}
+
+// IsFile returns whether the value of the parameter is usually the contents
+// of a file, e.g. a certificate.
+func (p templatableParam) IsFile() bool {
+	return p.Schema.DisplayAttrs.EditType == "file"
+}
+
+// isZeroDefault returns whether the given default is unset, or is the zero
+// value of its type, which Terraform gives unset fields anyway.
+func isZeroDefault(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case bool:
+		return !v
+	case float64:
+		return v == 0
+	case int:
+		return v == 0
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
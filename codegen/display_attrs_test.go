@@ -0,0 +1,211 @@
+package codegen
+
+import (
+	"encoding/json"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/vault/sdk/framework"
+)
+
+func TestDisplayAttrs(t *testing.T) {
+	testCases := []struct {
+		name              string
+		schema            string
+		required          bool
+		computed          bool
+		expectedSensitive bool
+		expectedDefault   string
+		expectedComputed  bool
+		expectedExample   string
+	}{
+		{
+			name:   "plain",
+			schema: `{"type": "string"}`,
+		},
+		{
+			name:              "sensitive",
+			schema:            `{"type": "string", "x-vault-displayAttrs": {"sensitive": true}}`,
+			expectedSensitive: true,
+		},
+		{
+			name:              "legacy sensitive",
+			schema:            `{"type": "string", "x-vault-displaySensitive": true}`,
+			expectedSensitive: true,
+		},
+		{
+			name:            "string default",
+			schema:          `{"type": "string", "default": "cn", "x-vault-displayAttrs": {"value": "cn"}}`,
+			expectedDefault: `"cn"`,
+		},
+		{
+			name:            "integer default",
+			schema:          `{"type": "integer", "default": 3600}`,
+			expectedDefault: "3600",
+		},
+		{
+			name:            "boolean default",
+			schema:          `{"type": "boolean", "default": true}`,
+			expectedDefault: "true",
+		},
+		{
+			name:   "zero default",
+			schema: `{"type": "boolean", "default": false}`,
+		},
+		{
+			name:     "required with default",
+			schema:   `{"type": "string", "default": "cn"}`,
+			required: true,
+		},
+		{
+			name:             "list default",
+			schema:           `{"type": "array", "items": {"type": "string"}, "default": ["default"], "x-vault-displayAttrs": {"value": "field1,field2"}}`,
+			expectedComputed: true,
+			expectedExample:  "field1,field2",
+		},
+		{
+			name:             "computed",
+			schema:           `{"type": "string", "default": "cn"}`,
+			computed:         true,
+			expectedComputed: true,
+		},
+		{
+			name:            "example without default",
+			schema:          `{"type": "string", "x-vault-displayAttrs": {"value": "admin"}}`,
+			expectedExample: "admin",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			schema := &framework.OASSchema{}
+			if err := json.Unmarshal([]byte(testCase.schema), schema); err != nil {
+				t.Fatal(err)
+			}
+			p := toTemplatableParam(framework.OASParameter{
+				Name:     "param",
+				Schema:   schema,
+				Required: testCase.required,
+			}, false)
+			p.Computed = testCase.computed
+
+			if actual := p.IsSensitive(); actual != testCase.expectedSensitive {
+				t.Fatalf("expected sensitive to be %t", testCase.expectedSensitive)
+			}
+			if actual := p.DefaultValue(); actual != testCase.expectedDefault {
+				t.Fatalf("expected default %q but received %q", testCase.expectedDefault, actual)
+			}
+			if actual := p.IsComputed(); actual != testCase.expectedComputed {
+				t.Fatalf("expected computed to be %t", testCase.expectedComputed)
+			}
+			if actual := p.Example(); actual != testCase.expectedExample {
+				t.Fatalf("expected example %q but received %q", testCase.expectedExample, actual)
+			}
+		})
+	}
+}
+
+func TestTemplateHandlerDisplayAttrs(t *testing.T) {
+	h, err := newTemplateHandler(hclog.Default())
+	if err != nil {
+		t.Fatal(err)
+	}
+	endpointInfo := &framework.OASPathItem{}
+	if err := json.Unmarshal([]byte(`{
+	"parameters": [{
+		"name": "name",
+		"in": "path",
+		"schema": {
+			"type": "string"
+		},
+		"required": true
+	}],
+	"get": {
+		"responses": {
+			"200": {
+				"description": "OK"
+			}
+		}
+	},
+	"post": {
+		"requestBody": {
+			"content": {
+				"application/json": {
+					"schema": {
+						"type": "object",
+						"properties": {
+							"bindpass": {
+								"type": "string",
+								"description": "Password for the bind DN.",
+								"x-vault-displayAttrs": {"sensitive": true}
+							},
+							"userattr": {
+								"type": "string",
+								"description": "Attribute used for users.",
+								"default": "cn",
+								"x-vault-displayAttrs": {"value": "cn"}
+							},
+							"metadata": {
+								"type": "array",
+								"items": {"type": "string"},
+								"description": "Metadata to add.",
+								"default": ["default"]
+							},
+							"certificate": {
+								"type": "string",
+								"description": "CA certificate.",
+								"x-vault-displayAttrs": {"editType": "file"}
+							}
+						}
+					}
+				}
+			}
+		},
+		"responses": {
+			"200": {
+				"description": "OK"
+			}
+		}
+	}
+}`), endpointInfo); err != nil {
+		t.Fatal(err)
+	}
+	addedInfo := &additionalInfo{
+		Type: tfTypeResource,
+	}
+
+	b := &strings.Builder{}
+	if err := h.Write(b, templateTypeResource, "/foo/role/{name}", endpointInfo, addedInfo); err != nil {
+		t.Fatal(err)
+	}
+	result := b.String()
+	if _, err := parser.ParseFile(token.NewFileSet(), "name.go", result, 0); err != nil {
+		t.Fatalf("generated code doesn't parse: %s\n%s", err, result)
+	}
+	for _, expected := range []string{
+		"Sensitive:   true,",
+		`Default:     "cn",`,
+		"Computed:    true,",
+	} {
+		if !strings.Contains(result, expected) {
+			t.Fatalf("expected %q in result: %s", expected, result)
+		}
+	}
+
+	b = &strings.Builder{}
+	if err := h.Write(b, templateTypeDoc, "/foo/role/{name}", endpointInfo, addedInfo); err != nil {
+		t.Fatal(err)
+	}
+	result = b.String()
+	for _, expected := range []string{
+		"Password for the bind DN. Sensitive, so it's hidden in Terraform's output",
+		"Attribute used for users. Defaults to `cn`.\n",
+		"CA certificate. Usually read from a file",
+	} {
+		if !strings.Contains(result, expected) {
+			t.Fatalf("expected %q in result: %s", expected, result)
+		}
+	}
+}
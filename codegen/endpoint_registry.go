@@ -8,9 +8,6 @@ import "github.com/hashicorp/vault/sdk/framework"
 // IMPORTANT NOTE: To support high quality, only add one
 // endpoint per PR.
 var endpointRegistry = map[string]*additionalInfo{
-	"/transform/alphabet/{name}": {
-		Type: tfTypeResource,
-	},
 	"/transform/decode/{role_name}": {
 		Type: tfTypeDataSource,
 		AdditionalParameters: []templatableParam{
@@ -71,31 +68,6 @@ var endpointRegistry = map[string]*additionalInfo{
 			},
 		},
 	},
-	"/transform/role/{name}": {
-		Type: tfTypeResource,
-	},
-	"/transform/template/{name}": {
-		Type: tfTypeResource,
-	},
-	"/transform/transformation/{name}": {
-		Type: tfTypeResource,
-		AdditionalParameters: []templatableParam{
-			{
-				OASParameter: &framework.OASParameter{
-					Name:        "templates",
-					Description: "Templates configured for transformation.",
-					Schema: &framework.OASSchema{
-						Type: "array",
-						Items: &framework.OASSchema{
-							Type: "string",
-						},
-						DisplayAttrs: &framework.DisplayAttributes{},
-					},
-				},
-				Computed: true,
-			},
-		},
-	},
 }
 
 // tfType is the type of Terraform code to generate.
@@ -133,6 +105,42 @@ func (t tfType) String() string {
 }
 
 type additionalInfo struct {
-	Type                 tfType
+	Type tfType
+	// Name is the name the resource or data source is registered as,
+	// derived from the endpoint when unset.
+	Name string
+	// ReadOnly is set for data sources that read the endpoint rather than
+	// write to it, and List for those listing it.
+	ReadOnly             bool
+	List                 bool
 	AdditionalParameters []templatableParam
 }
+
+// readOnlyEndpoints returns the endpoints of the given paths that only
+// support being read or listed, along with the data sources to generate
+// for them.
+func readOnlyEndpoints(paths map[string]*framework.OASPathItem) map[string]*additionalInfo {
+	result := make(map[string]*additionalInfo)
+	for endpoint, endpointInfo := range paths {
+		if endpointInfo.Get == nil || endpointInfo.Post != nil || endpointInfo.Delete != nil {
+			continue
+		}
+		result[endpoint] = &additionalInfo{
+			Type:     tfTypeDataSource,
+			ReadOnly: true,
+			List:     isListOperation(endpointInfo.Get),
+		}
+	}
+	return result
+}
+
+// isListOperation returns whether the operation lists keys, which Vault
+// describes with a "list" query parameter.
+func isListOperation(op *framework.OASOperation) bool {
+	for _, param := range op.Parameters {
+		if param.Name == "list" && param.In == "query" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,172 @@
+package codegen
+
+import (
+	"strings"
+)
+
+// These methods support the templates generating code for
+// terraform-plugin-framework, which models values with types of its own
+// rather than with interface{}.
+
+// FieldName returns the name of the field of the generated model holding
+// the parameter, e.g. "RoleName" for "role_name".
+func (p templatableParam) FieldName() string {
+	return strings.Title(format(p.Name))
+}
+
+// FrameworkType returns the name of the framework type representing the
+// parameter, shared by its attribute, e.g. schema.StringAttribute, and its
+// value, e.g. types.String. Objects that aren't maps are represented as
+// JSON encoded strings.
+func (p templatableParam) FrameworkType() string {
+	switch p.Schema.Type {
+	case "boolean":
+		return "Bool"
+	case "integer":
+		return "Int64"
+	case "array":
+		return "List"
+	case "object":
+		if p.IsMap() {
+			return "Map"
+		}
+	}
+	return "String"
+}
+
+// FrameworkElementType returns the type of the elements of a list or map
+// parameter, or "" for other parameters.
+func (p templatableParam) FrameworkElementType() string {
+	switch p.FrameworkType() {
+	case "List":
+		if p.Schema.Items != nil && p.Schema.Items.Type == "object" {
+			return "types.MapType{ElemType: types.StringType}"
+		}
+		return "types.StringType"
+	case "Map":
+		return "types.StringType"
+	}
+	return ""
+}
+
+// IsFrameworkJSON returns whether the parameter is represented as a JSON
+// encoded string in the generated framework code.
+func (p templatableParam) IsFrameworkJSON() bool {
+	return p.Schema.Type == "object" && !p.IsMap()
+}
+
+// FrameworkVaultPath returns an expression building the path of the
+// endpoint in Vault from the fields of the given model, e.g.
+// `"/" + strings.Trim(m.Path.ValueString(), "/") + "/role/" + m.Name.ValueString()`.
+func (e *templatableEndpoint) FrameworkVaultPath(model string) string {
+	fields := strings.Split(strings.TrimPrefix(e.Endpoint, "/"), "/")
+	prefix := "/"
+	if e.IsAuthMount {
+		prefix = "/auth/"
+		fields = fields[1:]
+	}
+
+	parts := []string{`"` + prefix + `"`, `strings.Trim(` + model + `.Path.ValueString(), "/")`}
+	literal := ""
+	for _, field := range fields[1:] {
+		if !strings.HasPrefix(field, "{") {
+			literal += "/" + field
+			continue
+		}
+		parts = append(parts, `"`+literal+`/"`)
+		literal = ""
+
+		name := stripCurlyBraces(field)
+		value := model + "." + strings.Title(format(name)) + ".String()"
+		for _, param := range e.Parameters {
+			if param.Name == name && param.FrameworkType() == "String" {
+				value = model + "." + param.FieldName() + ".ValueString()"
+			}
+		}
+		parts = append(parts, value)
+	}
+	if literal != "" {
+		parts = append(parts, `"`+literal+`"`)
+	}
+	return strings.Join(parts, " + ")
+}
+
+// FrameworkPathParams returns the path parameters of the endpoint that are
+// strings, which are parsed from the path of the resource in Vault.
+func (e *templatableEndpoint) FrameworkPathParams() []templatableParam {
+	var result []templatableParam
+	for _, param := range e.Parameters {
+		if param.IsPathParam && param.FrameworkType() == "String" {
+			result = append(result, param)
+		}
+	}
+	return result
+}
+
+// validateFramework returns errUnsupported if the parameters of the endpoint
+// can't be part of the model of the generated framework code, because they
+// collide with each other or with the fields added to each model.
+func (e *templatableEndpoint) validateFramework() error {
+	names := map[string]bool{"id": true, "path": true, "keys": true, "data_json": true}
+	fieldNames := map[string]bool{"ID": true, "Path": true, "Keys": true, "DataJSON": true}
+	for _, param := range e.Parameters {
+		if names[param.Name] || fieldNames[param.FieldName()] {
+			return errUnsupported
+		}
+		names[param.Name] = true
+		fieldNames[param.FieldName()] = true
+	}
+	return nil
+}
+
+// FrameworkImports returns the packages imported by the generated framework
+// code of the given kind, "resource" or "datasource", with an empty string
+// separating the standard library from the others.
+func (e *templatableEndpoint) FrameworkImports(kind string) []string {
+	hasInts, hasJSON := false, e.ReadOnly && !e.List && kind == "datasource"
+	for _, param := range e.Parameters {
+		// Integers are only parsed from the data read, which is the
+		// computed parameters for data sources, and all but the path
+		// parameters for resources.
+		readFromData := param.Computed || (kind == "resource" && !param.IsPathParam)
+		if param.FrameworkType() == "Int64" && readFromData {
+			hasInts = true
+		}
+		if param.IsFrameworkJSON() {
+			hasJSON = true
+		}
+	}
+
+	result := []string{"context"}
+	if hasJSON {
+		result = append(result, "encoding/json")
+	}
+	result = append(result, "fmt")
+	if hasInts {
+		result = append(result, "strconv")
+	}
+	result = append(result, "strings", "")
+
+	if kind == "datasource" {
+		result = append(result,
+			"github.com/hashicorp/terraform-plugin-framework/datasource",
+			"github.com/hashicorp/terraform-plugin-framework/datasource/schema",
+			"github.com/hashicorp/terraform-plugin-framework/diag",
+			"github.com/hashicorp/terraform-plugin-framework/types",
+			"github.com/hashicorp/vault/api",
+		)
+		return result
+	}
+	result = append(result,
+		"github.com/hashicorp/terraform-plugin-framework/diag",
+		"github.com/hashicorp/terraform-plugin-framework/path",
+		"github.com/hashicorp/terraform-plugin-framework/resource",
+		"github.com/hashicorp/terraform-plugin-framework/resource/schema",
+		"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier",
+		"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier",
+		"github.com/hashicorp/terraform-plugin-framework/types",
+		"github.com/hashicorp/vault/api",
+		"github.com/hashicorp/terraform-provider-vault/util",
+	)
+	return result
+}
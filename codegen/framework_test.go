@@ -0,0 +1,162 @@
+package codegen
+
+import (
+	"encoding/json"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/vault/sdk/framework"
+)
+
+func TestFrameworkVaultPath(t *testing.T) {
+	testCases := []struct {
+		endpoint    string
+		isAuthMount bool
+		expected    string
+	}{
+		{
+			endpoint: "/transform/role/{name}",
+			expected: `"/" + strings.Trim(m.Path.ValueString(), "/") + "/role/" + m.Name.ValueString()`,
+		},
+		{
+			endpoint:    "/auth/userpass/users/{username}/password",
+			isAuthMount: true,
+			expected:    `"/auth/" + strings.Trim(m.Path.ValueString(), "/") + "/users/" + m.Username.ValueString() + "/password"`,
+		},
+		{
+			endpoint: "/transit/cache-config",
+			expected: `"/" + strings.Trim(m.Path.ValueString(), "/") + "/cache-config"`,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.endpoint, func(t *testing.T) {
+			e := &templatableEndpoint{
+				Endpoint:    testCase.endpoint,
+				IsAuthMount: testCase.isAuthMount,
+				Parameters: []templatableParam{
+					toTemplatableParam(framework.OASParameter{Name: "name", Schema: &framework.OASSchema{Type: "string"}}, true),
+					toTemplatableParam(framework.OASParameter{Name: "username", Schema: &framework.OASSchema{Type: "string"}}, true),
+				},
+			}
+			if actual := e.FrameworkVaultPath("m"); actual != testCase.expected {
+				t.Fatalf("expected %s but received %s", testCase.expected, actual)
+			}
+		})
+	}
+}
+
+func TestValidateFramework(t *testing.T) {
+	testCases := []struct {
+		names     []string
+		expectErr bool
+	}{
+		{names: []string{"name", "ttl"}},
+		{names: []string{"name", "id"}, expectErr: true},
+		{names: []string{"sha256", "sha_256"}, expectErr: true},
+	}
+	for _, testCase := range testCases {
+		t.Run(strings.Join(testCase.names, ","), func(t *testing.T) {
+			e := &templatableEndpoint{}
+			for _, name := range testCase.names {
+				e.Parameters = append(e.Parameters, toTemplatableParam(framework.OASParameter{Name: name}, false))
+			}
+			err := e.validateFramework()
+			if testCase.expectErr && err != errUnsupported {
+				t.Fatalf("expected errUnsupported, got %v", err)
+			}
+			if !testCase.expectErr && err != nil {
+				t.Fatalf("no error expected, got: %s", err)
+			}
+		})
+	}
+}
+
+func TestTemplateHandlerFramework(t *testing.T) {
+	h, err := newTemplateHandler(hclog.Default())
+	if err != nil {
+		t.Fatal(err)
+	}
+	endpointInfo := &framework.OASPathItem{}
+	if err := json.Unmarshal([]byte(`{
+	"parameters": [{
+		"name": "name",
+		"in": "path",
+		"schema": {
+			"type": "string"
+		},
+		"required": true
+	}],
+	"get": {
+		"responses": {
+			"200": {
+				"description": "OK"
+			}
+		}
+	},
+	"post": {
+		"requestBody": {
+			"content": {
+				"application/json": {
+					"schema": {
+						"type": "object",
+						"properties": {
+							"transformations": {
+								"type": "array",
+								"items": {
+									"type": "string"
+								}
+							},
+							"ttl": {
+								"type": "integer"
+							}
+						}
+					}
+				}
+			}
+		},
+		"responses": {
+			"200": {
+				"description": "OK"
+			}
+		}
+	},
+	"delete": {
+		"responses": {
+			"204": {
+				"description": "empty body"
+			}
+		}
+	}
+}`), endpointInfo); err != nil {
+		t.Fatal(err)
+	}
+	b := &strings.Builder{}
+	if err := h.Write(b, templateTypeFrameworkResource, "/transform/role/{name}", endpointInfo, &additionalInfo{
+		Type: tfTypeResource,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	result := b.String()
+
+	if _, err := parser.ParseFile(token.NewFileSet(), "name.go", result, 0); err != nil {
+		t.Fatalf("invalid code generated: %s\n%s", err, result)
+	}
+	for _, expected := range []string{
+		"func NewNameResource() resource.Resource",
+		"Transformations types.List `tfsdk:\"transformations\"`",
+		"Ttl types.Int64 `tfsdk:\"ttl\"`",
+		`"ttl": schema.Int64Attribute{`,
+		"stringplanmodifier.RequiresReplace()",
+		"func (r *nameResource) ValidateConfig(",
+		"r.client.Logical().Delete(vaultPath)",
+		"util.ParseImportID(nameEndpoint, req.ID)",
+		`resp.State.SetAttribute(ctx, path.Root("name"), pathParams["name"])`,
+	} {
+		if !strings.Contains(result, expected) {
+			t.Fatalf("expected %q in result: %s", expected, result)
+		}
+	}
+}
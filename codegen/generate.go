@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/go-hclog"
@@ -18,9 +19,97 @@ const generatedDirPerms os.FileMode = 0775
 
 var errUnsupported = errors.New("code and doc generation for this item is unsupported")
 
+// Options configures the code generated by Run, RunReadOnly and RunManifest.
+type Options struct {
+	// Framework generates resources and data sources using
+	// terraform-plugin-framework rather than terraform-plugin-sdk.
+	Framework bool
+}
+
 // Run accepts a map of endpoint paths and generates both code and documentation
 // for NEW endpoints in the endpoint registry.
-func Run(logger hclog.Logger, paths map[string]*framework.OASPathItem) error {
+func Run(logger hclog.Logger, paths map[string]*framework.OASPathItem, opts Options) error {
+	return generate(logger, paths, sortedEndpoints(endpointRegistry), opts)
+}
+
+// RunReadOnly accepts a map of endpoint paths and generates both code and
+// documentation for data sources reading or listing each of the endpoints
+// that only support GET requests.
+func RunReadOnly(logger hclog.Logger, paths map[string]*framework.OASPathItem, opts Options) error {
+	return generate(logger, paths, sortedEndpoints(readOnlyEndpoints(paths)), opts)
+}
+
+// RunManifest accepts a map of endpoint paths and generates code for every
+// endpoint listed in the manifest, along with the documentation and tests
+// that don't exist yet. Unless code is generated for
+// terraform-plugin-framework, it also generates the registry of the
+// resources and data sources listed, under the names given in the manifest.
+func RunManifest(logger hclog.Logger, paths map[string]*framework.OASPathItem, manifest *Manifest, opts Options) error {
+	var endpoints []endpointToGenerate
+	for _, endpoint := range manifest.sortedEndpoints() {
+		if _, ok := paths[endpoint.Path]; !ok {
+			return fmt.Errorf("%s is listed in the manifest but isn't in the OpenAPI doc", endpoint.Path)
+		}
+		endpoints = append(endpoints, endpointToGenerate{
+			endpoint:  endpoint.Path,
+			addedInfo: endpoint.additionalInfo(),
+		})
+	}
+	if err := generate(logger, paths, endpoints, opts); err != nil {
+		return err
+	}
+	if opts.Framework {
+		return nil
+	}
+
+	h, err := newTemplateHandler(logger)
+	if err != nil {
+		return err
+	}
+	pathToFile, err := registryFilePath()
+	if err != nil {
+		return err
+	}
+	fCreator := &fileCreator{
+		logger:          logger,
+		templateHandler: h,
+	}
+	wr, closer, err := fCreator.createFileWriter(pathToFile)
+	if err != nil {
+		return err
+	}
+	defer closer()
+	if err := h.WriteRegistry(wr, manifest); err != nil {
+		return err
+	}
+	logger.Info(fmt.Sprintf("generated the registry of %d endpoints", len(manifest.Endpoints)))
+	return nil
+}
+
+// endpointToGenerate is an endpoint along with the information needed to
+// generate code for it.
+type endpointToGenerate struct {
+	endpoint  string
+	addedInfo *additionalInfo
+}
+
+// sortedEndpoints returns the endpoints of a registry sorted by path, so
+// they're always generated in the same order.
+func sortedEndpoints(registry map[string]*additionalInfo) []endpointToGenerate {
+	result := make([]endpointToGenerate, 0, len(registry))
+	for endpoint, addedInfo := range registry {
+		result = append(result, endpointToGenerate{
+			endpoint:  endpoint,
+			addedInfo: addedInfo,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].endpoint < result[j].endpoint
+	})
+	return result
+}
+
+func generate(logger hclog.Logger, paths map[string]*framework.OASPathItem, endpoints []endpointToGenerate, opts Options) error {
 	// Read in the templates we'll be using.
 	h, err := newTemplateHandler(logger)
 	if err != nil {
@@ -31,10 +120,12 @@ func Run(logger hclog.Logger, paths map[string]*framework.OASPathItem) error {
 	fCreator := &fileCreator{
 		logger:          logger,
 		templateHandler: h,
+		framework:       opts.Framework,
 	}
 	createdCount := 0
 	skippedCount := 0
-	for endpoint, addedInfo := range endpointRegistry {
+	for _, e := range endpoints {
+		endpoint, addedInfo := e.endpoint, e.addedInfo
 		if err := fCreator.GenerateCode(endpoint, paths[endpoint], addedInfo); err != nil {
 			if err == errUnsupported {
 				logger.Warn(fmt.Sprintf("couldn't generate %s, continuing", endpoint))
@@ -45,7 +136,16 @@ func Run(logger hclog.Logger, paths map[string]*framework.OASPathItem) error {
 		logger.Info(fmt.Sprintf("generated %s for %s", addedInfo.Type.String(), endpoint))
 		createdCount++
 
-		created, err := fCreator.GenerateDoc(endpoint, paths[endpoint], addedInfo)
+		created, err := fCreator.GenerateTest(endpoint, paths[endpoint], addedInfo)
+		if err != nil {
+			return err
+		}
+		if created {
+			logger.Info(fmt.Sprintf("generated test for %s", endpoint))
+			createdCount++
+		}
+
+		created, err = fCreator.GenerateDoc(endpoint, paths[endpoint], addedInfo)
 		if err != nil {
 			return err
 		}
@@ -64,12 +164,25 @@ func Run(logger hclog.Logger, paths map[string]*framework.OASPathItem) error {
 type fileCreator struct {
 	logger          hclog.Logger
 	templateHandler *templateHandler
+	framework       bool
 }
 
 // GenerateCode is exported because it's the only method intended to be used by
 // other objects. Unexported methods may be available to other code in this package,
 // but they're not intended to be used by anything but the fileCreator.
 func (c *fileCreator) GenerateCode(endpoint string, endpointInfo *framework.OASPathItem, addedInfo *additionalInfo) error {
+	if c.framework {
+		pathToFile, err := frameworkCodeFilePath(addedInfo.Type, endpoint)
+		if err != nil {
+			return err
+		}
+		tmplType := templateTypeFrameworkResource
+		if addedInfo.Type == tfTypeDataSource {
+			tmplType = templateTypeFrameworkDataSource
+		}
+		return c.writeFile(pathToFile, tmplType, endpoint, endpointInfo, addedInfo)
+	}
+
 	pathToFile, err := codeFilePath(addedInfo.Type, endpoint)
 	if err != nil {
 		return err
@@ -100,6 +213,29 @@ func (c *fileCreator) GenerateDoc(endpoint string, endpointInfo *framework.OASPa
 	return true, c.writeFile(pathToFile, templateTypeDoc, endpoint, endpointInfo, addedInfo)
 }
 
+// GenerateTest is exported to indicate it's intended to be directly used.
+// It generates an acceptance test for resources that can be written, and
+// like GenerateDoc, it doesn't overwrite a test that already exists since
+// they get hand-edited after being first created. It will return:
+//   - true, nil: if a new test is generated
+//   - false, nil: if no test is generated
+//   - false, err: in error conditions
+func (c *fileCreator) GenerateTest(endpoint string, endpointInfo *framework.OASPathItem, addedInfo *additionalInfo) (bool, error) {
+	if c.framework || addedInfo.Type != tfTypeResource || endpointInfo.Post == nil {
+		// Tests are only generated for terraform-plugin-sdk resources.
+		return false, nil
+	}
+	pathToFile, err := testFilePath(addedInfo.Type, endpoint)
+	if err != nil {
+		return false, err
+	}
+	if _, err := os.Stat(pathToFile); err == nil {
+		// The file already exists, nothing further to do here.
+		return false, nil
+	}
+	return true, c.writeFile(pathToFile, templateTypeTest, endpoint, endpointInfo, addedInfo)
+}
+
 func (c *fileCreator) writeFile(pathToFile string, tmplTp templateType, endpoint string, endpointInfo *framework.OASPathItem, addedInfo *additionalInfo) error {
 	wr, closer, err := c.createFileWriter(pathToFile)
 	if err != nil {
@@ -180,6 +316,41 @@ func codeFilePath(tfTp tfType, endpoint string) (string, error) {
 	return stripCurlyBraces(path), nil
 }
 
+// registryFilePath returns the path to the registry of the generated
+// resources and data sources.
+func registryFilePath() (string, error) {
+	homeDirPath, err := pathToHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(homeDirPath, "generated", "terraform_registry.go"), nil
+}
+
+// frameworkCodeFilePath is like codeFilePath, but for the code using
+// terraform-plugin-framework, which is generated inside the
+// "generated/framework" folder so it doesn't collide with the other, e.g.
+// "generated/framework/resources/transform/role/name.go".
+func frameworkCodeFilePath(tfTp tfType, endpoint string) (string, error) {
+	filename := fmt.Sprintf("%ss%s.go", tfTp.String(), endpoint)
+	homeDirPath, err := pathToHomeDir()
+	if err != nil {
+		return "", err
+	}
+	path := filepath.Join(homeDirPath, "generated", "framework", filename)
+	return stripCurlyBraces(path), nil
+}
+
+// testFilePath returns the path to the acceptance test of the code
+// generated for an endpoint, which sits next to it, e.g.
+// "generated/resources/transform/role/name_test.go".
+func testFilePath(tfTp tfType, endpoint string) (string, error) {
+	pathToCode, err := codeFilePath(tfTp, endpoint)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(pathToCode, ".go") + "_test.go", nil
+}
+
 /*
 docFilePath creates a directory structure inside the "website/docs/generated" folder
 that's intended to make it easy to find the file for each endpoint in Vault, even if
@@ -2,6 +2,8 @@ package codegen
 
 import (
 	"testing"
+
+	"github.com/hashicorp/vault/sdk/framework"
 )
 
 func TestCodeFilePath(t *testing.T) {
@@ -108,6 +110,21 @@ func TestDocFilePath(t *testing.T) {
 	}
 }
 
+func TestTestFilePath(t *testing.T) {
+	homeDirPath, err := pathToHomeDir()
+	if err != nil {
+		t.Fatal(err)
+	}
+	actual, err := testFilePath(tfTypeResource, "/transform/role/{name}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := homeDirPath + "/generated/resources/transform/role/name_test.go"
+	if actual != expected {
+		t.Fatalf("expected %q but received %q", expected, actual)
+	}
+}
+
 func TestStripCurlyBraces(t *testing.T) {
 	testCases := []struct {
 		input    string
@@ -139,3 +156,36 @@ func TestStripCurlyBraces(t *testing.T) {
 		})
 	}
 }
+
+func TestReadOnlyEndpoints(t *testing.T) {
+	paths := map[string]*framework.OASPathItem{
+		"/foo/roles": {
+			Get: &framework.OASOperation{
+				Parameters: []framework.OASParameter{
+					{Name: "list", In: "query"},
+				},
+			},
+		},
+		"/foo/creds/{name}": {
+			Get: &framework.OASOperation{},
+		},
+		"/foo/roles/{name}": {
+			Get:  &framework.OASOperation{},
+			Post: &framework.OASOperation{},
+		},
+		"/foo/config": {
+			Get:    &framework.OASOperation{},
+			Delete: &framework.OASOperation{},
+		},
+	}
+	endpoints := readOnlyEndpoints(paths)
+	if len(endpoints) != 2 {
+		t.Fatalf("expected 2 read-only endpoints, received %d", len(endpoints))
+	}
+	if info := endpoints["/foo/roles"]; info == nil || info.Type != tfTypeDataSource || !info.ReadOnly || !info.List {
+		t.Fatalf("expected a list data source for /foo/roles, received %#v", info)
+	}
+	if info := endpoints["/foo/creds/{name}"]; info == nil || info.Type != tfTypeDataSource || !info.ReadOnly || info.List {
+		t.Fatalf("expected a read data source for /foo/creds/{name}, received %#v", info)
+	}
+}
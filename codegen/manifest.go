@@ -0,0 +1,204 @@
+package codegen
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+// Manifest lists the endpoints to generate code for, and the names of the
+// resources and data sources generated for them, so all of them can be
+// regenerated at once when Vault's OpenAPI doc is updated.
+type Manifest struct {
+	Endpoints []ManifestEndpoint `json:"endpoints"`
+}
+
+// ManifestEndpoint is an endpoint listed in a manifest.
+type ManifestEndpoint struct {
+	// Path is the path of the endpoint in the OpenAPI doc, e.g.
+	// "/transform/role/{name}".
+	Path string `json:"path"`
+	// Type is the type of code to generate, "resource" or "datasource".
+	Type string `json:"type"`
+	// Name is the name the resource or data source is registered as, e.g.
+	// "vault_transform_role".
+	Name string `json:"name"`
+	// ReadOnly and List generate a data source reading or listing the
+	// endpoint rather than writing to it.
+	ReadOnly bool `json:"read_only,omitempty"`
+	List     bool `json:"list,omitempty"`
+}
+
+// ReadManifest reads and validates the manifest at the given path.
+func ReadManifest(pathToFile string) (*Manifest, error) {
+	f, err := os.Open(pathToFile)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	manifest := &Manifest{}
+	decoder := json.NewDecoder(f)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(manifest); err != nil {
+		return nil, fmt.Errorf("error decoding manifest %s: %s", pathToFile, err)
+	}
+	if err := manifest.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid manifest %s: %s", pathToFile, err)
+	}
+	return manifest, nil
+}
+
+func (m *Manifest) Validate() error {
+	var errs error
+	names := make(map[string]bool)
+	endpoints := make(map[string]bool)
+	for _, endpoint := range m.Endpoints {
+		if endpoint.Path == "" {
+			errs = multierror.Append(errs, fmt.Errorf("path cannot be blank for %q", endpoint.Name))
+		}
+		if endpoint.Type != tfTypeDataSource.String() && endpoint.Type != tfTypeResource.String() {
+			errs = multierror.Append(errs, fmt.Errorf("unsupported type %q for %s", endpoint.Type, endpoint.Path))
+		}
+		if !strings.HasPrefix(endpoint.Name, "vault_") {
+			errs = multierror.Append(errs, fmt.Errorf("name %q of %s must start with \"vault_\"", endpoint.Name, endpoint.Path))
+		}
+		if (endpoint.ReadOnly || endpoint.List) && endpoint.Type != tfTypeDataSource.String() {
+			errs = multierror.Append(errs, fmt.Errorf("only data sources can be read-only, %s is a %s", endpoint.Path, endpoint.Type))
+		}
+
+		key := endpoint.Type + " " + endpoint.Name
+		if names[key] {
+			errs = multierror.Append(errs, fmt.Errorf("the %s name %q is used more than once", endpoint.Type, endpoint.Name))
+		}
+		names[key] = true
+		key = endpoint.Type + " " + endpoint.Path
+		if endpoints[key] {
+			errs = multierror.Append(errs, fmt.Errorf("%s is listed more than once as a %s", endpoint.Path, endpoint.Type))
+		}
+		endpoints[key] = true
+	}
+	return errs
+}
+
+// sortedEndpoints returns the endpoints of the manifest sorted by type and
+// path, so they're always generated in the same order.
+func (m *Manifest) sortedEndpoints() []ManifestEndpoint {
+	result := make([]ManifestEndpoint, len(m.Endpoints))
+	copy(result, m.Endpoints)
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Type != result[j].Type {
+			return result[i].Type < result[j].Type
+		}
+		return result[i].Path < result[j].Path
+	})
+	return result
+}
+
+// additionalInfo returns the additional info used to generate the code for
+// the endpoint. The additional parameters of the endpoint in the endpoint
+// registry, if any, are kept.
+func (e ManifestEndpoint) additionalInfo() *additionalInfo {
+	tfTp := tfTypeResource
+	if e.Type == tfTypeDataSource.String() {
+		tfTp = tfTypeDataSource
+	}
+	result := &additionalInfo{
+		Type:     tfTp,
+		Name:     e.Name,
+		ReadOnly: e.ReadOnly,
+		List:     e.List,
+	}
+	if registered, ok := endpointRegistry[e.Path]; ok && registered.Type == tfTp {
+		result.AdditionalParameters = registered.AdditionalParameters
+	}
+	return result
+}
+
+// templatableRegistry is the data used to generate the registry of all the
+// resources and data sources listed in a manifest.
+type templatableRegistry struct {
+	Imports     []registryImport
+	DataSources []registryEntry
+	Resources   []registryEntry
+}
+
+type registryImport struct {
+	// Alias is set when the name of the package collides with another.
+	Alias string
+	Path  string
+}
+
+type registryEntry struct {
+	Name        string
+	Package     string
+	Constructor string
+}
+
+// toTemplatableRegistry returns the registry of the code generated for the
+// manifest, sorted by name.
+func (m *Manifest) toTemplatableRegistry() *templatableRegistry {
+	result := &templatableRegistry{}
+
+	// Find the package of each endpoint, and the number of packages with
+	// the same name, which have to be aliased.
+	importPaths := make(map[string]string)
+	packageNames := make(map[string]map[string]bool)
+	for _, endpoint := range m.Endpoints {
+		dir := stripCurlyBraces(path.Dir(endpoint.Path))
+		importPath := fmt.Sprintf("github.com/hashicorp/terraform-provider-vault/generated/%ss%s", endpoint.Type, dir)
+		importPaths[endpoint.Type+" "+endpoint.Path] = importPath
+
+		packageName := format(path.Base(dir))
+		if packageNames[packageName] == nil {
+			packageNames[packageName] = make(map[string]bool)
+		}
+		packageNames[packageName][importPath] = true
+	}
+
+	aliases := make(map[string]string)
+	for packageName, paths := range packageNames {
+		for importPath := range paths {
+			if len(paths) == 1 {
+				aliases[importPath] = packageName
+				result.Imports = append(result.Imports, registryImport{Path: importPath})
+				continue
+			}
+			// e.g. "transformDecode" for "generated/datasources/transform/decode"
+			rel := strings.TrimPrefix(importPath, "github.com/hashicorp/terraform-provider-vault/generated/")
+			alias := format(strings.ReplaceAll(rel, "/", "_"))
+			aliases[importPath] = alias
+			result.Imports = append(result.Imports, registryImport{Alias: alias, Path: importPath})
+		}
+	}
+	sort.Slice(result.Imports, func(i, j int) bool {
+		return result.Imports[i].Path < result.Imports[j].Path
+	})
+
+	for _, endpoint := range m.Endpoints {
+		entry := registryEntry{
+			Name:    endpoint.Name,
+			Package: aliases[importPaths[endpoint.Type+" "+endpoint.Path]],
+		}
+		differentiator := strings.Title(format(path.Base(endpoint.Path)))
+		if endpoint.Type == tfTypeDataSource.String() {
+			entry.Constructor = differentiator + "DataSource"
+			result.DataSources = append(result.DataSources, entry)
+		} else {
+			entry.Constructor = differentiator + "Resource"
+			result.Resources = append(result.Resources, entry)
+		}
+	}
+	sort.Slice(result.DataSources, func(i, j int) bool {
+		return result.DataSources[i].Name < result.DataSources[j].Name
+	})
+	sort.Slice(result.Resources, func(i, j int) bool {
+		return result.Resources[i].Name < result.Resources[j].Name
+	})
+	return result
+}
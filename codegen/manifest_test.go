@@ -0,0 +1,171 @@
+package codegen
+
+import (
+	"bytes"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+func TestManifestValidate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		endpoints []ManifestEndpoint
+		expectErr bool
+	}{
+		{
+			name: "valid",
+			endpoints: []ManifestEndpoint{
+				{Path: "/transform/role/{name}", Type: "resource", Name: "vault_transform_role"},
+				{Path: "/transform/role/{name}", Type: "datasource", Name: "vault_transform_role"},
+				{Path: "/transform/role", Type: "datasource", Name: "vault_transform_roles", ReadOnly: true, List: true},
+			},
+		},
+		{
+			name:      "blank path",
+			endpoints: []ManifestEndpoint{{Type: "resource", Name: "vault_transform_role"}},
+			expectErr: true,
+		},
+		{
+			name:      "unsupported type",
+			endpoints: []ManifestEndpoint{{Path: "/transform/role/{name}", Type: "provider", Name: "vault_transform_role"}},
+			expectErr: true,
+		},
+		{
+			name:      "name without prefix",
+			endpoints: []ManifestEndpoint{{Path: "/transform/role/{name}", Type: "resource", Name: "transform_role"}},
+			expectErr: true,
+		},
+		{
+			name:      "read-only resource",
+			endpoints: []ManifestEndpoint{{Path: "/transform/role/{name}", Type: "resource", Name: "vault_transform_role", ReadOnly: true}},
+			expectErr: true,
+		},
+		{
+			name: "duplicate name",
+			endpoints: []ManifestEndpoint{
+				{Path: "/transform/role/{name}", Type: "resource", Name: "vault_transform_role"},
+				{Path: "/transform/alphabet/{name}", Type: "resource", Name: "vault_transform_role"},
+			},
+			expectErr: true,
+		},
+		{
+			name: "duplicate path",
+			endpoints: []ManifestEndpoint{
+				{Path: "/transform/role/{name}", Type: "resource", Name: "vault_transform_role"},
+				{Path: "/transform/role/{name}", Type: "resource", Name: "vault_transform_other_role"},
+			},
+			expectErr: true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			m := &Manifest{Endpoints: testCase.endpoints}
+			err := m.Validate()
+			if testCase.expectErr && err == nil {
+				t.Fatal("expected an error")
+			}
+			if !testCase.expectErr && err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestReadManifest(t *testing.T) {
+	dir, err := ioutil.TempDir("", "manifest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	valid := filepath.Join(dir, "valid.json")
+	if err := ioutil.WriteFile(valid, []byte(`{"endpoints": [{"path": "/transform/role/{name}", "type": "resource", "name": "vault_transform_role"}]}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	m, err := ReadManifest(valid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []ManifestEndpoint{{Path: "/transform/role/{name}", Type: "resource", Name: "vault_transform_role"}}
+	if !reflect.DeepEqual(m.Endpoints, expected) {
+		t.Fatalf("expected %+v but received %+v", expected, m.Endpoints)
+	}
+
+	unknownField := filepath.Join(dir, "unknown.json")
+	if err := ioutil.WriteFile(unknownField, []byte(`{"endpoints": [{"path": "/transform/role/{name}", "kind": "resource"}]}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ReadManifest(unknownField); err == nil {
+		t.Fatal("expected an error for an unknown field")
+	}
+}
+
+// TestRepoManifest checks the manifest checked in alongside the code is valid.
+func TestRepoManifest(t *testing.T) {
+	if _, err := ReadManifest("manifest.json"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestToTemplatableRegistry(t *testing.T) {
+	m := &Manifest{Endpoints: []ManifestEndpoint{
+		{Path: "/transform/role/{name}", Type: "resource", Name: "vault_transform_role"},
+		{Path: "/transform/encode/{role_name}", Type: "datasource", Name: "vault_transform_encode"},
+		{Path: "/transform/decode/{role_name}", Type: "datasource", Name: "vault_transform_decode"},
+		{Path: "/transit/decode/{role_name}", Type: "datasource", Name: "vault_transit_decode"},
+	}}
+	expected := &templatableRegistry{
+		Imports: []registryImport{
+			{Alias: "datasourcesTransformDecode", Path: "github.com/hashicorp/terraform-provider-vault/generated/datasources/transform/decode"},
+			{Path: "github.com/hashicorp/terraform-provider-vault/generated/datasources/transform/encode"},
+			{Alias: "datasourcesTransitDecode", Path: "github.com/hashicorp/terraform-provider-vault/generated/datasources/transit/decode"},
+			{Path: "github.com/hashicorp/terraform-provider-vault/generated/resources/transform/role"},
+		},
+		DataSources: []registryEntry{
+			{Name: "vault_transform_decode", Package: "datasourcesTransformDecode", Constructor: "RoleNameDataSource"},
+			{Name: "vault_transform_encode", Package: "encode", Constructor: "RoleNameDataSource"},
+			{Name: "vault_transit_decode", Package: "datasourcesTransitDecode", Constructor: "RoleNameDataSource"},
+		},
+		Resources: []registryEntry{
+			{Name: "vault_transform_role", Package: "role", Constructor: "NameResource"},
+		},
+	}
+	if actual := m.toTemplatableRegistry(); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but received %+v", expected, actual)
+	}
+}
+
+func TestTemplateHandlerRegistry(t *testing.T) {
+	h, err := newTemplateHandler(hclog.Default())
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := &Manifest{Endpoints: []ManifestEndpoint{
+		{Path: "/transform/decode/{role_name}", Type: "datasource", Name: "vault_transform_decode"},
+		{Path: "/transit/decode/{role_name}", Type: "datasource", Name: "vault_transit_decode"},
+	}}
+	buf := &bytes.Buffer{}
+	if err := h.WriteRegistry(buf, m); err != nil {
+		t.Fatal(err)
+	}
+	result := buf.String()
+	if _, err := parser.ParseFile(token.NewFileSet(), "terraform_registry.go", result, 0); err != nil {
+		t.Fatalf("generated registry doesn't parse: %s\n%s", err, result)
+	}
+	for _, expected := range []string{
+		`datasourcesTransformDecode "github.com/hashicorp/terraform-provider-vault/generated/datasources/transform/decode"`,
+		`"vault_transit_decode": datasourcesTransitDecode.RoleNameDataSource(),`,
+	} {
+		if !strings.Contains(result, expected) {
+			t.Fatalf("expected %q in %s", expected, result)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package codegen
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/vault/api"
+	"github.com/hashicorp/vault/sdk/framework"
+)
+
+// openAPIPath is where Vault serves the OpenAPI doc describing the endpoints
+// of every secret engine and auth method mounted on it.
+const openAPIPath = "sys/internal/specs/openapi"
+
+// FetchOpenAPIDoc reads the OpenAPI doc from the Vault server the client is
+// configured for, rather than from a file exported beforehand. If mount
+// types are given, e.g. "transform" or "userpass", only the paths of the
+// secret engines and auth methods of those types are kept.
+func FetchOpenAPIDoc(client *api.Client, mountTypes []string) (*framework.OASDocument, error) {
+	r := client.NewRequest("GET", "/v1/"+openAPIPath)
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	defer cancelFunc()
+	resp, err := client.RawRequestWithContext(ctx, r)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s: %s", openAPIPath, err)
+	}
+
+	doc := &framework.OASDocument{}
+	if err := json.NewDecoder(resp.Body).Decode(doc); err != nil {
+		return nil, fmt.Errorf("error decoding %s: %s", openAPIPath, err)
+	}
+	if len(mountTypes) == 0 {
+		return doc, nil
+	}
+
+	mounts, err := listMountTypes(client)
+	if err != nil {
+		return nil, err
+	}
+	doc.Paths, err = filterPathsByMountType(doc.Paths, mounts, mountTypes)
+	if err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// listMountTypes returns the type of each secret engine and auth method
+// mounted, keyed by the prefix of its paths in the OpenAPI doc, e.g.
+// "/transform/" or "/auth/userpass/".
+func listMountTypes(client *api.Client) (map[string]string, error) {
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return nil, fmt.Errorf("error listing mounts: %s", err)
+	}
+	auths, err := client.Sys().ListAuth()
+	if err != nil {
+		return nil, fmt.Errorf("error listing auth methods: %s", err)
+	}
+
+	result := make(map[string]string)
+	for mountPath, mount := range mounts {
+		result["/"+mountPath] = mount.Type
+	}
+	for authPath, auth := range auths {
+		result["/auth/"+authPath] = auth.Type
+	}
+	return result, nil
+}
+
+// filterPathsByMountType returns the paths under the prefixes of the mounts
+// of the given types, and errors if none of the mounts are of one of the
+// types, which is likely because it wasn't enabled before generating.
+func filterPathsByMountType(paths map[string]*framework.OASPathItem, mounts map[string]string, mountTypes []string) (map[string]*framework.OASPathItem, error) {
+	var prefixes []string
+	for _, mountType := range mountTypes {
+		found := false
+		for prefix, tp := range mounts {
+			if tp == mountType {
+				prefixes = append(prefixes, prefix)
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("no secret engine or auth method of type %q is mounted", mountType)
+		}
+	}
+
+	result := make(map[string]*framework.OASPathItem)
+	for endpoint, item := range paths {
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(endpoint, prefix) || endpoint == strings.TrimSuffix(prefix, "/") {
+				result[endpoint] = item
+				break
+			}
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,118 @@
+package codegen
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/vault/api"
+	"github.com/hashicorp/vault/sdk/framework"
+)
+
+func TestFilterPathsByMountType(t *testing.T) {
+	paths := map[string]*framework.OASPathItem{
+		"/transform/role/{name}":        {},
+		"/transform-2/role/{name}":      {},
+		"/transformer/role/{name}":      {},
+		"/auth/userpass/users/{name}":   {},
+		"/auth/userpass2/users/{name}":  {},
+		"/secret/data/{path}":           {},
+		"/sys/internal/specs/openapi":   {},
+		"/auth/token/roles/{role_name}": {},
+	}
+	mounts := map[string]string{
+		"/transform/":      "transform",
+		"/transform-2/":    "transform",
+		"/transformer/":    "kv",
+		"/secret/":         "kv",
+		"/sys/":            "system",
+		"/auth/userpass/":  "userpass",
+		"/auth/userpass2/": "ldap",
+		"/auth/token/":     "token",
+	}
+	testCases := []struct {
+		mountTypes []string
+		expected   []string
+		expectErr  bool
+	}{
+		{
+			mountTypes: []string{"transform"},
+			expected:   []string{"/transform-2/role/{name}", "/transform/role/{name}"},
+		},
+		{
+			mountTypes: []string{"transform", "userpass"},
+			expected:   []string{"/auth/userpass/users/{name}", "/transform-2/role/{name}", "/transform/role/{name}"},
+		},
+		{
+			mountTypes: []string{"transit"},
+			expectErr:  true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(fmt.Sprint(testCase.mountTypes), func(t *testing.T) {
+			result, err := filterPathsByMountType(paths, mounts, testCase.mountTypes)
+			if testCase.expectErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			var actual []string
+			for endpoint := range result {
+				actual = append(actual, endpoint)
+			}
+			sort.Strings(actual)
+			if !reflect.DeepEqual(actual, testCase.expected) {
+				t.Fatalf("expected %v but received %v", testCase.expected, actual)
+			}
+		})
+	}
+}
+
+func TestFetchOpenAPIDoc(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/sys/internal/specs/openapi":
+			fmt.Fprint(w, `{"openapi": "3.0.2", "paths": {"/transform/role/{name}": {"description": "Roles."}, "/secret/data/{path}": {}}}`)
+		case "/v1/sys/mounts":
+			fmt.Fprint(w, `{"data": {"transform/": {"type": "transform"}, "secret/": {"type": "kv"}}}`)
+		case "/v1/sys/auth":
+			fmt.Fprint(w, `{"data": {"token/": {"type": "token"}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	config := api.DefaultConfig()
+	config.Address = server.URL
+	client, err := api.NewClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	doc, err := FetchOpenAPIDoc(client, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(doc.Paths) != 2 {
+		t.Fatalf("expected 2 paths but received %d", len(doc.Paths))
+	}
+
+	doc, err = FetchOpenAPIDoc(client, []string{"transform"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(doc.Paths) != 1 || doc.Paths["/transform/role/{name}"] == nil {
+		t.Fatalf("expected only the transform paths but received %v", doc.Paths)
+	}
+	if doc.Paths["/transform/role/{name}"].Description != "Roles." {
+		t.Fatalf("unexpected description %q", doc.Paths["/transform/role/{name}"].Description)
+	}
+}
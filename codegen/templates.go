@@ -22,14 +22,24 @@ var (
 		templateTypeDataSource: "/codegen/templates/datasource.go.tpl",
 		templateTypeDoc:        "/codegen/templates/doc.go.tpl",
 		templateTypeResource:   "/codegen/templates/resource.go.tpl",
+		templateTypeTest:       "/codegen/templates/resource_test.go.tpl",
+
+		templateTypeFrameworkDataSource: "/codegen/templates/framework/datasource.go.tpl",
+		templateTypeFrameworkResource:   "/codegen/templates/framework/resource.go.tpl",
+		templateTypeRegistry:            "/codegen/templates/registry.go.tpl",
 	}
 
+	// templatePartials holds the templates shared by all the types of
+	// files, they are parsed along with each of them.
+	templatePartials = "/codegen/templates/partials.go.tpl"
+
 	// These are the types of fields that OpenAPI 3 has that we support
 	// converting into Terraform fields.
 	supportedParamTypes = []string{
 		"array",
 		"boolean",
 		"integer",
+		"object",
 		"string",
 	}
 )
@@ -40,6 +50,12 @@ func newTemplateHandler(logger hclog.Logger) (*templateHandler, error) {
 		return nil, err
 	}
 
+	pathToPartials := filepath.Join(homeDirPath, templatePartials)
+	partialsBytes, err := ioutil.ReadFile(pathToPartials)
+	if err != nil {
+		return nil, errwrap.Wrapf("error reading "+pathToPartials+": {{err}}", err)
+	}
+
 	// Read in the template for each template type in the registry and
 	// cache them to be used repeatedly.
 	templates := make(map[templateType]*template.Template, len(templateRegistry))
@@ -53,6 +69,9 @@ func newTemplateHandler(logger hclog.Logger) (*templateHandler, error) {
 		if err != nil {
 			return nil, errwrap.Wrapf("error parsing "+tmplType.String()+": {{err}}", err)
 		}
+		if _, err := t.Parse(string(partialsBytes)); err != nil {
+			return nil, errwrap.Wrapf("error parsing the partials of "+tmplType.String()+": {{err}}", err)
+		}
 		templates[tmplType] = t
 	}
 	return &templateHandler{
@@ -72,7 +91,10 @@ type templateHandler struct {
 // for it. This template is written to the given writer. It's exported
 // because it's the only method intended to be called by external callers.
 func (h *templateHandler) Write(wr io.Writer, tmplTp templateType, endpoint string, endpointInfo *framework.OASPathItem, addedInfo *additionalInfo) error {
-	templatable, ok := h.templatableEndpoints[endpoint]
+	// An endpoint may have both a resource and a data source generated
+	// for it.
+	cacheKey := addedInfo.Type.String() + endpoint
+	templatable, ok := h.templatableEndpoints[cacheKey]
 	if !ok {
 		// Since each endpoint will have a code file and a doc file, let's cache
 		// the template-friendly version of the endpoint so it doesn't have to be
@@ -82,11 +104,22 @@ func (h *templateHandler) Write(wr io.Writer, tmplTp templateType, endpoint stri
 		if err != nil {
 			return err
 		}
-		h.templatableEndpoints[endpoint] = templatable
+		h.templatableEndpoints[cacheKey] = templatable
+	}
+	if tmplTp == templateTypeFrameworkDataSource || tmplTp == templateTypeFrameworkResource {
+		if err := templatable.validateFramework(); err != nil {
+			return err
+		}
 	}
 	return h.templates[tmplTp].Execute(wr, templatable)
 }
 
+// WriteRegistry uses a template to generate the registry of the resources
+// and data sources listed in a manifest, and writes it to the given writer.
+func (h *templateHandler) WriteRegistry(wr io.Writer, manifest *Manifest) error {
+	return h.templates[templateTypeRegistry].Execute(wr, manifest.toTemplatableRegistry())
+}
+
 // toTemplatable does a bunch of work to format the given data into a
 // struct that has fields that will be idiomatic to use with Go's templating
 // language.
@@ -103,11 +136,27 @@ func (h *templateHandler) toTemplatable(endpoint string, endpointInfo *framework
 		DirName:                 format(path.Base(filepath.Dir(endpoint))),
 		UpperCaseDifferentiator: strings.Title(tmplName),
 		LowerCaseDifferentiator: tmplName,
+		TerraformName:           addedInfo.Name,
 		Parameters:              parameters,
 		SupportsRead:            endpointInfo.Get != nil,
 		SupportsWrite:           endpointInfo.Post != nil,
 		SupportsDelete:          endpointInfo.Delete != nil,
+		ReadOnly:                addedInfo.ReadOnly,
+		List:                    addedInfo.List,
+		IsResource:              addedInfo.Type == tfTypeResource,
+	}
+	// The first field of the endpoint, or the one following "auth", is the
+	// type of the backend it belongs to.
+	fields := strings.Split(strings.TrimPrefix(endpoint, "/"), "/")
+	if fields[0] == "auth" && len(fields) > 1 {
+		t.IsAuthMount = true
+		fields = fields[1:]
 	}
+	t.MountType = fields[0]
+	if t.TerraformName == "" {
+		t.TerraformName = "vault_" + normalizeDocEndpoint(endpoint)
+	}
+
 	if err := t.Validate(); err != nil {
 		return nil, errwrap.Wrapf("failed to validate templatable data for "+endpoint+": {{err}}", err)
 	}
@@ -195,6 +244,72 @@ func toTemplatableParam(param framework.OASParameter, isPathParameter bool) temp
 	}
 }
 
+// IsMap returns whether the parameter is an object with no known properties,
+// e.g. a map of key-value pairs, which is represented as a map of strings.
+func (p templatableParam) IsMap() bool {
+	return p.Schema.Type == "object" && len(p.Schema.Properties) == 0
+}
+
+// IsBlock returns whether the parameter is an object whose properties are
+// all known and can be fields of a nested block.
+func (p templatableParam) IsBlock() bool {
+	if p.Schema.Type != "object" || len(p.Schema.Properties) == 0 {
+		return false
+	}
+	for _, property := range p.Schema.Properties {
+		if !isBlockField(property) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsJSON returns whether the parameter is an object that can't be
+// represented as a map or a nested block, e.g. one with nested objects. It
+// falls back to a JSON encoded string.
+func (p templatableParam) IsJSON() bool {
+	return p.Schema.Type == "object" && !p.IsMap() && !p.IsBlock()
+}
+
+// BlockParams returns the fields of the nested block representing the
+// parameter, sorted by name.
+func (p templatableParam) BlockParams() []templatableParam {
+	if !p.IsBlock() {
+		return nil
+	}
+	required := make(map[string]bool, len(p.Schema.Required))
+	for _, name := range p.Schema.Required {
+		required[name] = true
+	}
+
+	result := make([]templatableParam, 0, len(p.Schema.Properties))
+	for name, property := range p.Schema.Properties {
+		result = append(result, toTemplatableParam(framework.OASParameter{
+			Name:        name,
+			Description: property.Description,
+			In:          "post",
+			Schema:      property,
+			Required:    required[name],
+		}, false))
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+// isBlockField returns whether a property of an object can be a field of the
+// nested block representing it.
+func isBlockField(schema *framework.OASSchema) bool {
+	switch schema.Type {
+	case "boolean", "integer", "string":
+		return true
+	case "array":
+		return schema.Items != nil && schema.Items.Type == "string"
+	}
+	return false
+}
+
 // templatableEndpoint is a convenience struct that plays nicely with Go's
 // template package. It is used to keep as much logic as possible in Go
 // rather than in Go's templating language, because most folks are more
@@ -204,10 +319,49 @@ type templatableEndpoint struct {
 	DirName                 string
 	UpperCaseDifferentiator string
 	LowerCaseDifferentiator string
-	Parameters              []templatableParam
-	SupportsRead            bool
-	SupportsWrite           bool
-	SupportsDelete          bool
+	// TerraformName is the name the resource or data source is expected
+	// to be registered as, e.g. "vault_transform_role".
+	TerraformName  string
+	Parameters     []templatableParam
+	SupportsRead   bool
+	SupportsWrite  bool
+	SupportsDelete bool
+	// ReadOnly and List are set for data sources reading or listing the
+	// endpoint rather than writing to it.
+	ReadOnly bool
+	List     bool
+	// MountType is the type of the backend the endpoint belongs to, and
+	// IsAuthMount whether it's an auth backend.
+	MountType   string
+	IsAuthMount bool
+	// IsResource is set for resources, which can be imported, rather than
+	// data sources.
+	IsResource bool
+}
+
+// HasJSONParams returns whether any of the parameters of the endpoint falls
+// back to a JSON encoded string, which the generated code has to encode and
+// decode.
+func (e *templatableEndpoint) HasJSONParams() bool {
+	for _, parameter := range e.Parameters {
+		if parameter.IsJSON() {
+			return true
+		}
+	}
+	return false
+}
+
+// ExampleImportID returns an example of the ID a resource is imported with,
+// which is its path in Vault, e.g. "transform/role/example" for
+// "/transform/role/{name}".
+func (e *templatableEndpoint) ExampleImportID() string {
+	fields := strings.Split(strings.TrimPrefix(e.Endpoint, "/"), "/")
+	for i, field := range fields {
+		if strings.HasPrefix(field, "{") {
+			fields[i] = "example"
+		}
+	}
+	return strings.Join(fields, "/")
 }
 
 func (e *templatableEndpoint) Validate() error {
@@ -241,7 +395,8 @@ func validateParameter(parameter templatableParam) error {
 			if parameter.Schema.Type != "array" {
 				// We have a match, and if the type isn't an array, we don't
 				// need to look into its element types to see if they're
-				// supported.
+				// supported. Objects that can't be represented as maps or
+				// nested blocks fall back to JSON encoded strings.
 				return nil
 			}
 			if parameter.Schema.Items.Type == "string" || parameter.Schema.Items.Type == "object" {
@@ -281,6 +436,10 @@ const (
 	templateTypeDataSource
 	templateTypeResource
 	templateTypeDoc
+	templateTypeTest
+	templateTypeFrameworkDataSource
+	templateTypeFrameworkResource
+	templateTypeRegistry
 )
 
 func (t templateType) String() string {
@@ -291,6 +450,14 @@ func (t templateType) String() string {
 		return "resource"
 	case templateTypeDoc:
 		return "doc"
+	case templateTypeTest:
+		return "test"
+	case templateTypeFrameworkDataSource:
+		return "framework datasource"
+	case templateTypeFrameworkResource:
+		return "framework resource"
+	case templateTypeRegistry:
+		return "registry"
 	}
 	return "unset"
 }
@@ -4,6 +4,9 @@ package {{ .DirName }}
 // This code is generated.
 
 import (
+	{{- if or .HasJSONParams (and .ReadOnly (not .List)) }}
+	"encoding/json"
+	{{- end }}
 	"fmt"
 	"log"
 	"strings"
@@ -30,24 +33,7 @@ func {{ .UpperCaseDifferentiator }}DataSource() *schema.Resource {
 			},
 			{{- range .Parameters }}
 			"{{ .Name }}": {
-				{{- if (eq .Schema.Type "string") }}
-				Type:        schema.TypeString,
-				{{- end }}
-				{{- if (eq .Schema.Type "boolean") }}
-				Type:        schema.TypeBool,
-				{{- end }}
-				{{- if (eq .Schema.Type "integer") }}
-				Type:        schema.TypeInt,
-				{{- end }}
-				{{- if (eq .Schema.Type "array") }}
-                Type:        schema.TypeList,
-                {{- if (eq .Schema.Items.Type "string") }}
-                Elem:        &schema.Schema{Type: schema.TypeString},
-                {{- end }} {{/* end if item type string */}}
-                {{- if (eq .Schema.Items.Type "object") }}
-                Elem:        &schema.Schema{Type: schema.TypeMap},
-                {{- end }} {{/* end if item type object */}}
-                {{- end }} {{/* end if array */}}
+				{{- template "schemaType" . }}
 				{{- if .Required }}
 				Required:    true,
 				{{- else }}
@@ -59,7 +45,27 @@ func {{ .UpperCaseDifferentiator }}DataSource() *schema.Resource {
                 {{- if .Computed }}
                 Computed:    true,
                 {{- end }}
-				Description: "{{ .Description }}",
+				{{- with .DefaultValue }}
+				Default:     {{ . }},
+				{{- end }}
+				{{- if .IsSensitive }}
+				Sensitive:   true,
+				{{- end }}
+				Description: `{{ .Description }}`,
+			},
+			{{- end }}
+			{{- if .List }}
+			"keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The keys listed at the endpoint.",
+			},
+			{{- else if .ReadOnly }}
+			"data_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The data read from the endpoint, JSON encoded.",
 			},
 			{{- end }}
 		},
@@ -70,14 +76,31 @@ func read{{ .UpperCaseDifferentiator }}Resource(d *schema.ResourceData, meta int
 	client := meta.(*api.Client)
     path := d.Get("path").(string)
     vaultPath := util.ParsePath(path, {{ .LowerCaseDifferentiator }}Endpoint, d)
+    {{- if .List }}
+    log.Printf("[DEBUG] Listing %q", vaultPath)
+    resp, err := client.Logical().List(vaultPath)
+    if err != nil {
+        return fmt.Errorf("error listing %q: %s", vaultPath, err)
+    }
+    {{- else if .ReadOnly }}
+    log.Printf("[DEBUG] Reading %q", vaultPath)
+    resp, err := client.Logical().Read(vaultPath)
+    if err != nil {
+        return fmt.Errorf("error reading %q: %s", vaultPath, err)
+    }
+    {{- else }}
     log.Printf("[DEBUG] Writing %q", vaultPath)
 
     data := make(map[string]interface{})
     {{- range .Parameters }}
     {{- if not .Computed }}
+      {{- if (eq .Schema.Type "object") }}
+    {{- template "writeObject" . }}
+      {{- else }}
     if val, ok := d.GetOkExists("{{ .Name }}"); ok {
         data["{{ .Name }}"] = val
     }
+      {{- end }}
     {{- end }}
     {{- end }}
     log.Printf("[DEBUG] Writing %q", vaultPath)
@@ -85,17 +108,35 @@ func read{{ .UpperCaseDifferentiator }}Resource(d *schema.ResourceData, meta int
     if err != nil {
         return fmt.Errorf("error writing %q: %s", vaultPath, err)
     }
+    {{- end }}
     if resp == nil {
         d.SetId("")
         return nil
     }
     d.SetId(vaultPath)
+    {{- if .List }}
+    if err := d.Set("keys", resp.Data["keys"]); err != nil {
+        return err
+    }
+    {{- else if .ReadOnly }}
+    encoded, err := json.Marshal(resp.Data)
+    if err != nil {
+        return fmt.Errorf("error encoding the data read from %q: %s", vaultPath, err)
+    }
+    if err := d.Set("data_json", string(encoded)); err != nil {
+        return err
+    }
+    {{- end }}
 
     {{- range .Parameters }}
     {{- if .Computed }}
+      {{- if (eq .Schema.Type "object") }}
+    {{- template "readObject" . }}
+      {{- else }}
     if err := d.Set("{{ .Name }}", resp.Data["{{ .Name }}"]); err != nil {
         return err
     }
+      {{- end }}
     {{- end }}
     {{- end }}
     return nil
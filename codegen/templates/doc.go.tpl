@@ -28,4 +28,34 @@ The following arguments are supported:
 * `path` - (Required) Path to where the back-end is mounted within Vault.
 {{- range .Parameters }}
 * `{{ .Name }}` - {{ if .Required }}(Required){{ else }}(Optional){{ end }} {{ .Description }}
+{{- template "docNotes" . }}
+{{- if .IsJSON }} A JSON encoded string.{{ end }}
+{{- if .IsMap }} A map of strings.{{ end }}
+{{- if .IsBlock }} A block supporting the following arguments:
+{{- range .BlockParams }}
+  * `{{ .Name }}` - {{ if .Required }}(Required){{ else }}(Optional){{ end }} {{ .Description }}
+{{- template "docNotes" . }}
+{{- end }}
+{{- end }}
+{{- end }}
+{{- if .ReadOnly }}
+
+## Attributes Reference
+
+In addition to the arguments above, the following attributes are exported:
+{{- if .List }}
+* `keys` - The keys listed at the endpoint.
+{{- else }}
+* `data_json` - The data read from the endpoint, JSON encoded.
+{{- end }}
+{{- end }}
+{{- if .IsResource }}
+
+## Import
+
+This resource can be imported using its Vault path, e.g.
+
+```
+$ terraform import {{ .TerraformName }}.example {{ .ExampleImportID }}
+```
 {{- end }}
@@ -0,0 +1,181 @@
+package {{ .DirName }}
+
+// DO NOT EDIT
+// This code is generated.
+
+import (
+	{{- range .FrameworkImports "datasource" }}
+	{{- if . }}
+	"{{ . }}"
+	{{- else }}{{ "\n" }}
+	{{- end }}
+	{{- end }}
+)
+
+const {{ .LowerCaseDifferentiator }}Endpoint = "{{ .Endpoint }}"
+
+var (
+	_ datasource.DataSource              = &{{ .LowerCaseDifferentiator }}DataSource{}
+	_ datasource.DataSourceWithConfigure = &{{ .LowerCaseDifferentiator }}DataSource{}
+)
+
+// New{{ .UpperCaseDifferentiator }}DataSource returns the data source for "{{ .Endpoint }}".
+func New{{ .UpperCaseDifferentiator }}DataSource() datasource.DataSource {
+	return &{{ .LowerCaseDifferentiator }}DataSource{}
+}
+
+type {{ .LowerCaseDifferentiator }}DataSource struct {
+	client *api.Client
+}
+
+type {{ .LowerCaseDifferentiator }}DataSourceModel struct {
+	ID   types.String `tfsdk:"id"`
+	Path types.String `tfsdk:"path"`
+	{{- range .Parameters }}
+	{{ .FieldName }} types.{{ .FrameworkType }} `tfsdk:"{{ .Name }}"`
+	{{- end }}
+	{{- if .List }}
+	Keys types.List `tfsdk:"keys"`
+	{{- else if .ReadOnly }}
+	DataJSON types.String `tfsdk:"data_json"`
+	{{- end }}
+}
+
+func (d *{{ .LowerCaseDifferentiator }}DataSource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "{{ .TerraformName }}"
+}
+
+func (d *{{ .LowerCaseDifferentiator }}DataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The path of the data source in Vault.",
+			},
+			"path": schema.StringAttribute{
+				Required:    true,
+				Description: "Path to backend from which to retrieve data.",
+			},
+			{{- range .Parameters }}
+			"{{ .Name }}": schema.{{ .FrameworkType }}Attribute{
+				{{- if .Required }}
+				Required:    true,
+				{{- else if .Computed }}
+				Computed:    true,
+				{{- else }}
+				Optional:    true,
+				{{- end }}
+				{{- template "frameworkAttributeFields" . }}
+			},
+			{{- end }}
+			{{- if .List }}
+			"keys": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The keys listed at the endpoint.",
+			},
+			{{- else if .ReadOnly }}
+			"data_json": schema.StringAttribute{
+				Computed:    true,
+				Description: "The data read from the endpoint, JSON encoded.",
+			},
+			{{- end }}
+		},
+	}
+}
+
+func (d *{{ .LowerCaseDifferentiator }}DataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data", fmt.Sprintf("expected *api.Client, got %T", req.ProviderData))
+		return
+	}
+	d.client = client
+}
+
+func (d *{{ .LowerCaseDifferentiator }}DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config {{ .LowerCaseDifferentiator }}DataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vaultPath := config.vaultPath()
+	{{- if .List }}
+	secret, err := d.client.Logical().List(vaultPath)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error listing %q", vaultPath), err.Error())
+		return
+	}
+	{{- else if .ReadOnly }}
+	secret, err := d.client.Logical().Read(vaultPath)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error reading %q", vaultPath), err.Error())
+		return
+	}
+	{{- else }}
+	data := config.toData(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	secret, err := d.client.Logical().Write(vaultPath, data)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error writing %q", vaultPath), err.Error())
+		return
+	}
+	{{- end }}
+	if secret == nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("No data returned by %q", vaultPath), "")
+		return
+	}
+	config.ID = types.StringValue(vaultPath)
+
+	{{- if .List }}
+	keys := make([]string, 0)
+	if v, ok := secret.Data["keys"].([]interface{}); ok {
+		for _, key := range v {
+			keys = append(keys, fmt.Sprint(key))
+		}
+	}
+	value, diags := types.ListValueFrom(ctx, types.StringType, keys)
+	resp.Diagnostics.Append(diags...)
+	config.Keys = value
+	{{- else if .ReadOnly }}
+	encoded, err := json.Marshal(secret.Data)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error encoding the data read from %q", vaultPath), err.Error())
+		return
+	}
+	config.DataJSON = types.StringValue(string(encoded))
+	{{- end }}
+	config.fromData(ctx, secret.Data, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+func (m *{{ .LowerCaseDifferentiator }}DataSourceModel) vaultPath() string {
+	return {{ .FrameworkVaultPath "m" }}
+}
+
+{{- if not .ReadOnly }}
+
+func (m *{{ .LowerCaseDifferentiator }}DataSourceModel) toData(ctx context.Context, diags *diag.Diagnostics) map[string]interface{} {
+	data := map[string]interface{}{}
+	{{- range .Parameters }}
+	{{- if not .Computed }}
+	{{- template "frameworkToData" . }}
+	{{- end }}
+	{{- end }}
+	return data
+}
+{{- end }}
+
+func (m *{{ .LowerCaseDifferentiator }}DataSourceModel) fromData(ctx context.Context, data map[string]interface{}, diags *diag.Diagnostics) {
+	{{- range .Parameters }}
+	{{- if .Computed }}
+	{{- template "frameworkFromData" . }}
+	{{- end }}
+	{{- end }}
+}
@@ -0,0 +1,280 @@
+package {{ .DirName }}
+
+// DO NOT EDIT
+// This code is generated.
+
+import (
+	{{- range .FrameworkImports "resource" }}
+	{{- if . }}
+	"{{ . }}"
+	{{- else }}{{ "\n" }}
+	{{- end }}
+	{{- end }}
+)
+
+const {{ .LowerCaseDifferentiator }}Endpoint = "{{ .Endpoint }}"
+
+var (
+	_ resource.Resource                   = &{{ .LowerCaseDifferentiator }}Resource{}
+	_ resource.ResourceWithConfigure      = &{{ .LowerCaseDifferentiator }}Resource{}
+	_ resource.ResourceWithImportState    = &{{ .LowerCaseDifferentiator }}Resource{}
+	_ resource.ResourceWithValidateConfig = &{{ .LowerCaseDifferentiator }}Resource{}
+)
+
+// New{{ .UpperCaseDifferentiator }}Resource returns the resource for "{{ .Endpoint }}".
+func New{{ .UpperCaseDifferentiator }}Resource() resource.Resource {
+	return &{{ .LowerCaseDifferentiator }}Resource{}
+}
+
+type {{ .LowerCaseDifferentiator }}Resource struct {
+	client *api.Client
+}
+
+type {{ .LowerCaseDifferentiator }}ResourceModel struct {
+	ID   types.String `tfsdk:"id"`
+	Path types.String `tfsdk:"path"`
+	{{- range .Parameters }}
+	{{ .FieldName }} types.{{ .FrameworkType }} `tfsdk:"{{ .Name }}"`
+	{{- end }}
+}
+
+func (r *{{ .LowerCaseDifferentiator }}Resource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "{{ .TerraformName }}"
+}
+
+func (r *{{ .LowerCaseDifferentiator }}Resource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The path of the resource in Vault.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Required:    true,
+				Description: `The mount path for a back-end, for example, the path given in "$ vault auth enable -path=my-aws aws".`,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			{{- range .Parameters }}
+			"{{ .Name }}": schema.{{ .FrameworkType }}Attribute{
+				{{- if .Required }}
+				Required:    true,
+				{{- else if .Computed }}
+				Computed:    true,
+				{{- else }}
+				Optional:    true,
+				Computed:    true,
+				{{- end }}
+				{{- template "frameworkAttributeFields" . }}
+				{{- if and .IsPathParam (eq .FrameworkType "String") }}
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				{{- end }}
+			},
+			{{- end }}
+		},
+	}
+}
+
+func (r *{{ .LowerCaseDifferentiator }}Resource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*api.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data", fmt.Sprintf("expected *api.Client, got %T", req.ProviderData))
+		return
+	}
+	r.client = client
+}
+
+// ValidateConfig checks that the path parameters can't change the endpoint
+// written to.
+func (r *{{ .LowerCaseDifferentiator }}Resource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config {{ .LowerCaseDifferentiator }}ResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	{{- range .FrameworkPathParams }}
+	if !config.{{ .FieldName }}.IsNull() && !config.{{ .FieldName }}.IsUnknown() && strings.Contains(config.{{ .FieldName }}.ValueString(), "/") {
+		resp.Diagnostics.AddAttributeError(path.Root("{{ .Name }}"), "Invalid {{ .Name }}", `"{{ .Name }}" can't contain "/"`)
+	}
+	{{- end }}
+}
+
+func (r *{{ .LowerCaseDifferentiator }}Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	{{- if .SupportsWrite }}
+	var plan {{ .LowerCaseDifferentiator }}ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vaultPath := plan.vaultPath()
+	data := plan.toData(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if _, err := r.client.Logical().Write(vaultPath, data); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error writing %q", vaultPath), err.Error())
+		return
+	}
+	plan.ID = types.StringValue(vaultPath)
+
+	{{- if .SupportsRead }}
+	r.read(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	{{- else }}
+	plan.fromData(ctx, nil, &resp.Diagnostics)
+	{{- end }}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	{{- else }}
+	resp.Diagnostics.AddError("Unsupported operation", fmt.Sprintf("%q can't be written to", {{ .LowerCaseDifferentiator }}Endpoint))
+	{{- end }}
+}
+
+func (r *{{ .LowerCaseDifferentiator }}Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state {{ .LowerCaseDifferentiator }}ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	{{- if .SupportsRead }}
+	if found := r.read(ctx, &state, &resp.Diagnostics); !found {
+		if !resp.Diagnostics.HasError() {
+			resp.State.RemoveResource(ctx)
+		}
+		return
+	}
+	{{- end }}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *{{ .LowerCaseDifferentiator }}Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	{{- if .SupportsWrite }}
+	var plan {{ .LowerCaseDifferentiator }}ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vaultPath := plan.ID.ValueString()
+	data := plan.toData(ctx, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if _, err := r.client.Logical().Write(vaultPath, data); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error updating %q", vaultPath), err.Error())
+		return
+	}
+
+	{{- if .SupportsRead }}
+	r.read(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	{{- else }}
+	plan.fromData(ctx, nil, &resp.Diagnostics)
+	{{- end }}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	{{- else }}
+	resp.Diagnostics.AddError("Unsupported operation", fmt.Sprintf("%q can't be written to", {{ .LowerCaseDifferentiator }}Endpoint))
+	{{- end }}
+}
+
+func (r *{{ .LowerCaseDifferentiator }}Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	{{- if .SupportsDelete }}
+	var state {{ .LowerCaseDifferentiator }}ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vaultPath := state.ID.ValueString()
+	if _, err := r.client.Logical().Delete(vaultPath); err != nil && !util.Is404(err) {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error deleting %q", vaultPath), err.Error())
+	}
+	{{- else }}
+	// Terraform requires the delete is implemented, but this endpoint
+	// doesn't support delete. Thus, the resource is only removed from the
+	// state.
+	{{- end }}
+}
+
+// ImportState sets the mount path and path parameters of the imported
+// resource from its ID, which is its path in Vault.
+func (r *{{ .LowerCaseDifferentiator }}Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	vaultPath, pathParams, err := util.ParseImportID({{ .LowerCaseDifferentiator }}Endpoint, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), vaultPath)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("path"), pathParams["path"])...)
+	{{- range .FrameworkPathParams }}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("{{ .Name }}"), pathParams["{{ .Name }}"])...)
+	{{- end }}
+}
+
+{{- if .SupportsRead }}
+
+// read refreshes the model from Vault, returning false if it wasn't found.
+func (r *{{ .LowerCaseDifferentiator }}Resource) read(ctx context.Context, m *{{ .LowerCaseDifferentiator }}ResourceModel, diags *diag.Diagnostics) bool {
+	vaultPath := m.ID.ValueString()
+	resp, err := r.client.Logical().Read(vaultPath)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error reading %q", vaultPath), err.Error())
+		return false
+	}
+	if resp == nil {
+		return false
+	}
+
+	{{- if .FrameworkPathParams }}
+
+	pathParams, err := util.PathParameters({{ .LowerCaseDifferentiator }}Endpoint, vaultPath)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Error parsing %q", vaultPath), err.Error())
+		return false
+	}
+	{{- range .FrameworkPathParams }}
+	if v, ok := pathParams["{{ .Name }}"]; ok {
+		m.{{ .FieldName }} = types.StringValue(v)
+	}
+	{{- end }}
+	{{- end }}
+	m.fromData(ctx, resp.Data, diags)
+	return !diags.HasError()
+}
+{{- end }}
+
+func (m *{{ .LowerCaseDifferentiator }}ResourceModel) vaultPath() string {
+	return {{ .FrameworkVaultPath "m" }}
+}
+
+func (m *{{ .LowerCaseDifferentiator }}ResourceModel) toData(ctx context.Context, diags *diag.Diagnostics) map[string]interface{} {
+	data := map[string]interface{}{}
+	{{- range .Parameters }}
+	{{- if not .Computed }}
+	{{- template "frameworkToData" . }}
+	{{- end }}
+	{{- end }}
+	return data
+}
+
+func (m *{{ .LowerCaseDifferentiator }}ResourceModel) fromData(ctx context.Context, data map[string]interface{}, diags *diag.Diagnostics) {
+	{{- range .Parameters }}
+	{{- if not .IsPathParam }}
+	{{- template "frameworkFromData" . }}
+	{{- end }}
+	{{- end }}
+}
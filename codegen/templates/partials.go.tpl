@@ -0,0 +1,245 @@
+{{- /*
+These templates are shared by all the types of files.
+*/ -}}
+
+{{- define "schemaType" }}
+			{{- if (eq .Schema.Type "string") }}
+			Type:        schema.TypeString,
+			{{- end }}
+			{{- if (eq .Schema.Type "boolean") }}
+			Type:        schema.TypeBool,
+			{{- end }}
+			{{- if (eq .Schema.Type "integer") }}
+			Type:        schema.TypeInt,
+			{{- end }}
+			{{- if (eq .Schema.Type "array") }}
+			Type:        schema.TypeList,
+			{{- if (eq .Schema.Items.Type "string") }}
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			{{- end }} {{/* end if item type string */}}
+			{{- if (eq .Schema.Items.Type "object") }}
+			Elem:        &schema.Schema{Type: schema.TypeMap},
+			{{- end }} {{/* end if item type object */}}
+			{{- end }} {{/* end if array */}}
+			{{- if .IsMap }}
+			Type:        schema.TypeMap,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			{{- end }}
+			{{- if .IsBlock }}
+			Type:        schema.TypeList,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					{{- range .BlockParams }}
+					"{{ .Name }}": {
+						{{- template "schemaType" . }}
+						{{- if .Required }}
+						Required:    true,
+						{{- else }}
+						Optional:    true,
+						{{- end }}
+						{{- with .DefaultValue }}
+						Default:     {{ . }},
+						{{- end }}
+						{{- if .IsSensitive }}
+						Sensitive:   true,
+						{{- end }}
+						Description: `{{ .Description }}`,
+					},
+					{{- end }}
+				},
+			},
+			{{- end }}
+			{{- if .IsJSON }}
+			Type:             schema.TypeString,
+			DiffSuppressFunc: util.JsonDiffSuppress,
+			{{- end }}
+{{- end }}
+
+{{- /*
+writeObject adds an object parameter, represented as a map, a nested block
+or a JSON encoded string, to the data written to Vault.
+*/ -}}
+{{- define "writeObject" }}
+	{{- if .IsMap }}
+	if v, ok := d.GetOk("{{ .Name }}"); ok {
+		data["{{ .Name }}"] = v
+	}
+	{{- end }}
+	{{- if .IsBlock }}
+	if v, ok := d.GetOk("{{ .Name }}"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		data["{{ .Name }}"] = v.([]interface{})[0]
+	}
+	{{- end }}
+	{{- if .IsJSON }}
+	if v, ok := d.GetOk("{{ .Name }}"); ok {
+		var obj map[string]interface{}
+		if err := json.Unmarshal([]byte(v.(string)), &obj); err != nil {
+			return fmt.Errorf("error decoding '{{ .Name }}': %s", err)
+		}
+		data["{{ .Name }}"] = obj
+	}
+	{{- end }}
+{{- end }}
+
+{{- /*
+readObject sets an object parameter read from Vault in the state.
+*/ -}}
+{{- define "readObject" }}
+	{{- if .IsMap }}
+	if val, ok := resp.Data["{{ .Name }}"]; ok {
+		if err := d.Set("{{ .Name }}", val); err != nil {
+			return fmt.Errorf("error setting state key '{{ .Name }}': %s", err)
+		}
+	}
+	{{- end }}
+	{{- if .IsBlock }}
+	if val, ok := resp.Data["{{ .Name }}"].(map[string]interface{}); ok {
+		block := map[string]interface{}{}
+		{{- range .BlockParams }}
+		block["{{ .Name }}"] = val["{{ .Name }}"]
+		{{- end }}
+		if err := d.Set("{{ .Name }}", []interface{}{block}); err != nil {
+			return fmt.Errorf("error setting state key '{{ .Name }}': %s", err)
+		}
+	}
+	{{- end }}
+	{{- if .IsJSON }}
+	if val, ok := resp.Data["{{ .Name }}"]; ok && val != nil {
+		encoded, err := json.Marshal(val)
+		if err != nil {
+			return fmt.Errorf("error encoding '{{ .Name }}': %s", err)
+		}
+		if err := d.Set("{{ .Name }}", string(encoded)); err != nil {
+			return fmt.Errorf("error setting state key '{{ .Name }}': %s", err)
+		}
+	}
+	{{- end }}
+{{- end }}
+
+{{- /*
+docNotes describes what the display attributes and default of a parameter
+mean for its users, after its description in the docs.
+*/ -}}
+{{- define "docNotes" }}
+{{- if .DefaultValue }} Defaults to `{{ .Schema.Default }}`.{{ end }}
+{{- with .Example }} For example, `{{ . }}`.{{ end }}
+{{- if .IsFile }} Usually read from a file, e.g. with `file()`.{{ end }}
+{{- if .IsSensitive }} Sensitive, so it's hidden in Terraform's output, but it's stored in the state in plain text.{{ end }}
+{{- end }}
+
+{{- /*
+These templates are shared by the terraform-plugin-framework resources and
+data sources.
+frameworkAttributeFields are the fields of the attribute of a parameter that
+don't depend on whether it's part of a resource or a data source.
+*/ -}}
+{{- define "frameworkAttributeFields" }}
+				{{- if .FrameworkElementType }}
+				ElementType: {{ .FrameworkElementType }},
+				{{- end }}
+				{{- if .IsSensitive }}
+				Sensitive:   true,
+				{{- end }}
+				Description: `{{ .Description }}`,
+{{- end }}
+
+{{- /*
+frameworkToData adds a parameter of the model "m" to the data written to Vault.
+*/ -}}
+{{- define "frameworkToData" }}
+	if !m.{{ .FieldName }}.IsNull() && !m.{{ .FieldName }}.IsUnknown() {
+		{{- if .IsFrameworkJSON }}
+		var v map[string]interface{}
+		if err := json.Unmarshal([]byte(m.{{ .FieldName }}.ValueString()), &v); err != nil {
+			diags.AddError("Error decoding {{ .Name }}", err.Error())
+			return nil
+		}
+		data["{{ .Name }}"] = v
+		{{- else if (eq .FrameworkType "List") }}
+		{{- if (eq .Schema.Items.Type "object") }}
+		var v []map[string]string
+		{{- else }}
+		var v []string
+		{{- end }}
+		diags.Append(m.{{ .FieldName }}.ElementsAs(ctx, &v, false)...)
+		data["{{ .Name }}"] = v
+		{{- else if (eq .FrameworkType "Map") }}
+		var v map[string]string
+		diags.Append(m.{{ .FieldName }}.ElementsAs(ctx, &v, false)...)
+		data["{{ .Name }}"] = v
+		{{- else }}
+		data["{{ .Name }}"] = m.{{ .FieldName }}.Value{{ .FrameworkType }}()
+		{{- end }}
+	}
+{{- end }}
+
+{{- /*
+frameworkFromData sets a parameter of the model "m" from the data read from
+Vault, or to null if it wasn't read and its value is still unknown.
+*/ -}}
+{{- define "frameworkFromData" }}
+	{{- if .IsFrameworkJSON }}
+	if v, ok := data["{{ .Name }}"]; ok && v != nil {
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			diags.AddError("Error encoding {{ .Name }}", err.Error())
+			return
+		}
+		m.{{ .FieldName }} = types.StringValue(string(encoded))
+	}
+	{{- else if (eq .FrameworkType "List") }}
+	if v, ok := data["{{ .Name }}"].([]interface{}); ok {
+		{{- if (eq .Schema.Items.Type "object") }}
+		elems := make([]map[string]string, 0, len(v))
+		for _, e := range v {
+			elem := map[string]string{}
+			if obj, ok := e.(map[string]interface{}); ok {
+				for key, val := range obj {
+					elem[key] = fmt.Sprint(val)
+				}
+			}
+			elems = append(elems, elem)
+		}
+		{{- else }}
+		elems := make([]string, 0, len(v))
+		for _, e := range v {
+			elems = append(elems, fmt.Sprint(e))
+		}
+		{{- end }}
+		value, d := types.ListValueFrom(ctx, {{ .FrameworkElementType }}, elems)
+		diags.Append(d...)
+		m.{{ .FieldName }} = value
+	}
+	{{- else if (eq .FrameworkType "Map") }}
+	if v, ok := data["{{ .Name }}"].(map[string]interface{}); ok {
+		elems := make(map[string]string, len(v))
+		for key, e := range v {
+			elems[key] = fmt.Sprint(e)
+		}
+		value, d := types.MapValueFrom(ctx, {{ .FrameworkElementType }}, elems)
+		diags.Append(d...)
+		m.{{ .FieldName }} = value
+	}
+	{{- else if (eq .FrameworkType "Int64") }}
+	if v, ok := data["{{ .Name }}"]; ok && v != nil {
+		i, err := strconv.ParseInt(fmt.Sprint(v), 10, 64)
+		if err != nil {
+			diags.AddError("Error reading {{ .Name }}", err.Error())
+			return
+		}
+		m.{{ .FieldName }} = types.Int64Value(i)
+	}
+	{{- else if (eq .FrameworkType "Bool") }}
+	if v, ok := data["{{ .Name }}"].(bool); ok {
+		m.{{ .FieldName }} = types.BoolValue(v)
+	}
+	{{- else }}
+	if v, ok := data["{{ .Name }}"].(string); ok {
+		m.{{ .FieldName }} = types.StringValue(v)
+	}
+	{{- end }}
+	if m.{{ .FieldName }}.IsUnknown() {
+		m.{{ .FieldName }} = types.{{ .FrameworkType }}Null({{ .FrameworkElementType }})
+	}
+{{- end }}
@@ -0,0 +1,23 @@
+package generated
+
+// DO NOT EDIT
+// This code is generated from the manifest of the endpoints to generate.
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	{{- range .Imports }}
+	{{ if .Alias }}{{ .Alias }} {{ end }}"{{ .Path }}"
+	{{- end }}
+)
+
+var DataSourceRegistry = map[string]*schema.Resource{
+	{{- range .DataSources }}
+	"{{ .Name }}": {{ .Package }}.{{ .Constructor }}(),
+	{{- end }}
+}
+
+var ResourceRegistry = map[string]*schema.Resource{
+	{{- range .Resources }}
+	"{{ .Name }}": {{ .Package }}.{{ .Constructor }}(),
+	{{- end }}
+}
@@ -4,6 +4,9 @@ package {{ .DirName }}
 // This code is generated.
 
 import (
+	{{- if .HasJSONParams }}
+	"encoding/json"
+	{{- end }}
 	"fmt"
 	"log"
 	"strings"
@@ -32,33 +35,19 @@ func {{ .UpperCaseDifferentiator }}Resource() *schema.Resource {
 		},
 		{{- range .Parameters }}
 		"{{ .Name }}": {
-			{{- if (eq .Schema.Type "string") }}
-			Type:        schema.TypeString,
-			{{- end }}
-			{{- if (eq .Schema.Type "boolean") }}
-			Type:        schema.TypeBool,
-			{{- end }}
-			{{- if (eq .Schema.Type "integer") }}
-			Type:        schema.TypeInt,
-			{{- end }}
-			{{- if (eq .Schema.Type "array") }}
-			Type:        schema.TypeList,
-			{{- if (eq .Schema.Items.Type "string") }}
-			Elem:        &schema.Schema{Type: schema.TypeString},
-			{{- end }} {{/* end if item type string */}}
-			{{- if (eq .Schema.Items.Type "object") }}
-            Elem:        &schema.Schema{Type: schema.TypeMap},
-            {{- end }} {{/* end if item type object */}}
-			{{- end }} {{/* end if array */}}
+			{{- template "schemaType" . }}
 			{{- if .Required }}
 			Required:    true,
 			{{- else }}
 			Optional:    true,
 			{{- end }}
-			{{- if .Computed }}
-            Computed:    true,
-            {{- end }}
-			{{- if .Schema.DisplayAttrs.Sensitive }}
+			{{- if .IsComputed }}
+			Computed:    true,
+			{{- end }}
+			{{- with .DefaultValue }}
+			Default:     {{ . }},
+			{{- end }}
+			{{- if .IsSensitive }}
 			Sensitive:   true,
 			{{- end }}
 			Description: `{{ .Description }}`,
@@ -81,7 +70,11 @@ func {{ .UpperCaseDifferentiator }}Resource() *schema.Resource {
 		Delete: delete{{ .UpperCaseDifferentiator }}Resource,
 		{{- end }}
 		Importer: &schema.ResourceImporter{
+			{{- if or .SupportsRead .SupportsWrite }}
+			State: import{{ .UpperCaseDifferentiator }}Resource,
+			{{- else }}
 			State: schema.ImportStatePassthrough,
+			{{- end }}
 		},
 		Schema: fields,
 	}
@@ -103,9 +96,13 @@ func create{{ .UpperCaseDifferentiator }}Resource(d *schema.ResourceData, meta i
 	{{- end }}
 	{{- if not .IsPathParam }}
 	  {{- if not .Computed }}
+	    {{- if (eq .Schema.Type "object") }}
+	{{- template "writeObject" . }}
+	    {{- else }}
     if v, ok := d.GetOkExists("{{ .Name }}"); ok {
         data["{{ .Name }}"] = v
     }
+	    {{- end }}
 	  {{- end }}
 	{{- end }}
 	{{- end }}
@@ -147,11 +144,15 @@ func read{{ .UpperCaseDifferentiator }}Resource(d *schema.ResourceData, meta int
     }
 	{{- range .Parameters }}
 	{{- if not .IsPathParam }}
+	  {{- if (eq .Schema.Type "object") }}
+	{{- template "readObject" . }}
+	  {{- else }}
 	if val, ok := resp.Data["{{ .Name }}"]; ok {
         if err := d.Set("{{ .Name }}", val); err != nil {
             return fmt.Errorf("error setting state key '{{ .Name }}': %s", err)
         }
     }
+	  {{- end }}
     {{- end }}
 	{{- end }}
 	return nil
@@ -168,9 +169,13 @@ func update{{ .UpperCaseDifferentiator }}Resource(d *schema.ResourceData, meta i
 	{{- range .Parameters }}
 	{{- if not .IsPathParam }}
 	  {{- if not .Computed }}
+	    {{- if (eq .Schema.Type "object") }}
+	{{- template "writeObject" . }}
+	    {{- else }}
 	if raw, ok := d.GetOk("{{ .Name }}"); ok {
 		data["{{ .Name }}"] = raw
 	}
+	    {{- end }}
 	  {{- end }}
 	{{- end }}
 	{{- end }}
@@ -207,6 +212,24 @@ func delete{{ .UpperCaseDifferentiator }}Resource(_ *schema.ResourceData, _ inte
 }
 {{ end }}
 
+{{- if or .SupportsRead .SupportsWrite }}
+// import{{ .UpperCaseDifferentiator }}Resource sets the mount path and path parameters of
+// the imported resource from its ID, which is its path in Vault.
+func import{{ .UpperCaseDifferentiator }}Resource(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	vaultPath, pathParams, err := util.ParseImportID({{ .LowerCaseDifferentiator }}Endpoint, d.Id())
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(vaultPath)
+	for paramName, paramVal := range pathParams {
+		if err := d.Set(paramName, paramVal); err != nil {
+			return nil, fmt.Errorf("error setting state %q, %q: %s", paramName, paramVal, err)
+		}
+	}
+	return []*schema.ResourceData{d}, nil
+}
+{{ end }}
+
 {{- if .SupportsRead }}
 func resource{{ .UpperCaseDifferentiator }}Exists(d *schema.ResourceData, meta interface{}) (bool, error) {
 	client := meta.(*api.Client)
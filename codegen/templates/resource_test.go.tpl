@@ -0,0 +1,84 @@
+package {{ .DirName }}
+
+// This test was generated as a starting point, and is expected to be edited
+// so the values in its configuration are meaningful for the endpoint.
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-provider-vault/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/terraform-provider-vault/vault"
+)
+
+var {{ .LowerCaseDifferentiator }}TestProvider = func() *schema.Provider {
+	p := schema.NewProvider(vault.Provider())
+	{{- if .IsAuthMount }}
+	p.RegisterResource("vault_auth_backend", vault.AuthBackendResource())
+	{{- else }}
+	p.RegisterResource("vault_mount", vault.MountResource())
+	{{- end }}
+	p.RegisterResource("{{ .TerraformName }}", {{ .UpperCaseDifferentiator }}Resource())
+	return p
+}()
+
+func Test{{ .UpperCaseDifferentiator }}Basic(t *testing.T) {
+	path := acctest.RandomWithPrefix("{{ .MountType }}")
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { util.TestAccPreCheck(t) },
+		Providers: map[string]terraform.ResourceProvider{
+			"vault": {{ .LowerCaseDifferentiator }}TestProvider.ResourceProvider(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: {{ .LowerCaseDifferentiator }}Config(path, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("{{ .TerraformName }}.test", "path", path),
+					{{- range .Parameters }}
+					{{- if and .IsPathParam (ne .Name "path") }}
+					resource.TestCheckResourceAttr("{{ $.TerraformName }}.test", "{{ .Name }}", "test"),
+					{{- end }}
+					{{- end }}
+					{{- with .UpdatableParam }}
+					resource.TestCheckResourceAttr("{{ $.TerraformName }}.test", "{{ .Name }}", "{{ .TestValue false }}"),
+					{{- end }}
+				),
+			},
+			{{- with .UpdatableParam }}
+			{
+				Config: {{ $.LowerCaseDifferentiator }}Config(path, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("{{ $.TerraformName }}.test", "{{ .Name }}", "{{ .TestValue true }}"),
+				),
+			},
+			{{- end }}
+			{{- if .SupportsRead }}
+			{
+				ResourceName:      "{{ .TerraformName }}.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				{{- with .ImportStateVerifyIgnore }}
+				ImportStateVerifyIgnore: []string{
+					{{- range . }}
+					"{{ . }}",
+					{{- end }}
+				},
+				{{- end }}
+			},
+			{{- end }}
+		},
+	})
+}
+
+func {{ .LowerCaseDifferentiator }}Config(path string, updated bool) string {
+	if updated {
+		return fmt.Sprintf(`
+{{ .TestConfig true }}`, path)
+	}
+	return fmt.Sprintf(`
+{{ .TestConfig false }}`, path)
+}
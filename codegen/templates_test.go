@@ -2,6 +2,8 @@ package codegen
 
 import (
 	"encoding/json"
+	"go/parser"
+	"go/token"
 	"reflect"
 	"strings"
 	"testing"
@@ -206,6 +208,26 @@ func TestValidate(t *testing.T) {
 			},
 			expectErr: false,
 		},
+		{
+			testName: "object param",
+			input: &templatableEndpoint{
+				Endpoint:                "foo",
+				DirName:                 "foo",
+				UpperCaseDifferentiator: "foo",
+				LowerCaseDifferentiator: "foo",
+				Parameters: []templatableParam{
+					{
+						OASParameter: &framework.OASParameter{
+							Name: "foo",
+							Schema: &framework.OASSchema{
+								Type: "object",
+							},
+						},
+					},
+				},
+			},
+			expectErr: false,
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.testName, func(t *testing.T) {
@@ -582,3 +604,231 @@ func TestTemplateHandler(t *testing.T) {
 		t.Fatalf("unexpected result: %s", result)
 	}
 }
+
+func TestTemplateHandlerObjectParams(t *testing.T) {
+	h, err := newTemplateHandler(hclog.Default())
+	if err != nil {
+		t.Fatal(err)
+	}
+	endpointInfo := &framework.OASPathItem{}
+	if err := json.Unmarshal([]byte(`{
+	"parameters": [{
+		"name": "name",
+		"in": "path",
+		"schema": {
+			"type": "string"
+		},
+		"required": true
+	}],
+	"get": {
+		"responses": {
+			"200": {
+				"description": "OK"
+			}
+		}
+	},
+	"post": {
+		"requestBody": {
+			"content": {
+				"application/json": {
+					"schema": {
+						"type": "object",
+						"properties": {
+							"labels": {
+								"type": "object",
+								"description": "Labels of the role."
+							},
+							"settings": {
+								"type": "object",
+								"description": "Settings of the role.",
+								"properties": {
+									"ttl": {
+										"type": "integer"
+									},
+									"enabled": {
+										"type": "boolean"
+									}
+								}
+							},
+							"policy": {
+								"type": "object",
+								"description": "Policy of the role.",
+								"properties": {
+									"rules": {
+										"type": "array",
+										"items": {
+											"type": "object"
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		},
+		"responses": {
+			"200": {
+				"description": "OK"
+			}
+		}
+	}
+}`), endpointInfo); err != nil {
+		t.Fatal(err)
+	}
+	b := &strings.Builder{}
+	if err := h.Write(b, templateTypeResource, "/foo/role/{name}", endpointInfo, &additionalInfo{
+		Type: tfTypeResource,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	result := b.String()
+
+	for _, expected := range []string{
+		`"encoding/json"`,
+		"schema.TypeMap",
+		"MaxItems:    1,",
+		`"enabled": {`,
+		"util.JsonDiffSuppress",
+		"json.Unmarshal",
+		"json.Marshal",
+	} {
+		if !strings.Contains(result, expected) {
+			t.Fatalf("expected %q in result: %s", expected, result)
+		}
+	}
+}
+
+func TestTemplateHandlerReadOnly(t *testing.T) {
+	h, err := newTemplateHandler(hclog.Default())
+	if err != nil {
+		t.Fatal(err)
+	}
+	endpointInfo := &framework.OASPathItem{
+		Parameters: []framework.OASParameter{
+			{
+				Name:     "name",
+				In:       "path",
+				Schema:   &framework.OASSchema{Type: "string"},
+				Required: true,
+			},
+		},
+		Get: &framework.OASOperation{},
+	}
+	testCases := []struct {
+		endpoint string
+		info     *additionalInfo
+		expected []string
+	}{
+		{
+			endpoint: "/foo/creds/{name}",
+			info:     &additionalInfo{Type: tfTypeDataSource, ReadOnly: true},
+			expected: []string{`"encoding/json"`, "client.Logical().Read(vaultPath)", `d.Set("data_json"`},
+		},
+		{
+			endpoint: "/foo/roles/{name}",
+			info:     &additionalInfo{Type: tfTypeDataSource, ReadOnly: true, List: true},
+			expected: []string{"client.Logical().List(vaultPath)", `d.Set("keys"`},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.endpoint, func(t *testing.T) {
+			b := &strings.Builder{}
+			if err := h.Write(b, templateTypeDataSource, testCase.endpoint, endpointInfo, testCase.info); err != nil {
+				t.Fatal(err)
+			}
+			result := b.String()
+			if strings.Contains(result, "client.Logical().Write") {
+				t.Fatalf("expected the endpoint not to be written to: %s", result)
+			}
+			for _, expected := range testCase.expected {
+				if !strings.Contains(result, expected) {
+					t.Fatalf("expected %q in result: %s", expected, result)
+				}
+			}
+		})
+	}
+}
+
+func TestExampleImportID(t *testing.T) {
+	testCases := []struct {
+		endpoint string
+		expected string
+	}{
+		{endpoint: "/transform/role/{name}", expected: "transform/role/example"},
+		{endpoint: "/auth/userpass/users/{username}", expected: "auth/userpass/users/example"},
+		{endpoint: "/transit/cache-config", expected: "transit/cache-config"},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.endpoint, func(t *testing.T) {
+			e := &templatableEndpoint{Endpoint: testCase.endpoint}
+			if actual := e.ExampleImportID(); actual != testCase.expected {
+				t.Fatalf("expected %q but received %q", testCase.expected, actual)
+			}
+		})
+	}
+}
+
+func TestTemplateHandlerImporter(t *testing.T) {
+	h, err := newTemplateHandler(hclog.Default())
+	if err != nil {
+		t.Fatal(err)
+	}
+	endpointInfo := &framework.OASPathItem{}
+	if err := json.Unmarshal([]byte(`{
+	"parameters": [{
+		"name": "name",
+		"in": "path",
+		"schema": {
+			"type": "string"
+		},
+		"required": true
+	}],
+	"get": {
+		"responses": {
+			"200": {
+				"description": "OK"
+			}
+		}
+	},
+	"post": {
+		"responses": {
+			"200": {
+				"description": "OK"
+			}
+		}
+	}
+}`), endpointInfo); err != nil {
+		t.Fatal(err)
+	}
+	addedInfo := &additionalInfo{
+		Type: tfTypeResource,
+	}
+
+	b := &strings.Builder{}
+	if err := h.Write(b, templateTypeResource, "/transform/role/{name}", endpointInfo, addedInfo); err != nil {
+		t.Fatal(err)
+	}
+	result := b.String()
+	if _, err := parser.ParseFile(token.NewFileSet(), "name.go", result, 0); err != nil {
+		t.Fatalf("generated code doesn't parse: %s\n%s", err, result)
+	}
+	for _, expected := range []string{
+		"State: importNameResource,",
+		"func importNameResource(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {",
+		"util.ParseImportID(nameEndpoint, d.Id())",
+	} {
+		if !strings.Contains(result, expected) {
+			t.Fatalf("expected %q in result: %s", expected, result)
+		}
+	}
+
+	b = &strings.Builder{}
+	if err := h.Write(b, templateTypeDoc, "/transform/role/{name}", endpointInfo, addedInfo); err != nil {
+		t.Fatal(err)
+	}
+	expected := "$ terraform import vault_transform_role.example transform/role/example"
+	if result := b.String(); !strings.Contains(result, expected) {
+		t.Fatalf("expected %q in result: %s", expected, result)
+	}
+}
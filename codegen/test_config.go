@@ -0,0 +1,135 @@
+package codegen
+
+import (
+	"fmt"
+	"strings"
+)
+
+// testResourceName is the name given to the resource under test in the
+// configurations of generated acceptance tests.
+const testResourceName = "test"
+
+// TestConfig returns the Terraform configuration used by the generated
+// acceptance test of a resource. It mounts the backend the endpoint belongs
+// to at a path given through a single %s verb, and sets every required
+// parameter along with the parameter changed between the steps of the test.
+// When updated is true, that parameter is given a different value.
+func (e *templatableEndpoint) TestConfig(updated bool) string {
+	mountResource := "vault_mount"
+	if e.IsAuthMount {
+		mountResource = "vault_auth_backend"
+	}
+
+	b := &strings.Builder{}
+	fmt.Fprintf(b, "resource %q %q {\n", mountResource, testResourceName)
+	fmt.Fprintf(b, "  path = \"%%s\"\n")
+	fmt.Fprintf(b, "  type = %q\n", e.MountType)
+	fmt.Fprintf(b, "}\n\n")
+
+	fmt.Fprintf(b, "resource %q %q {\n", e.TerraformName, testResourceName)
+	fmt.Fprintf(b, "  path = %s.%s.path\n", mountResource, testResourceName)
+	updatable := e.UpdatableParam()
+	for _, param := range e.Parameters {
+		switch {
+		case param.Computed || param.Name == "path":
+			continue
+		case param.IsPathParam:
+			fmt.Fprintf(b, "  %s = %q\n", param.Name, testResourceName)
+		case updatable != nil && param.Name == updatable.Name:
+			writeTestAttribute(b, "  ", param, updated)
+		case param.Required:
+			writeTestAttribute(b, "  ", param, false)
+		}
+	}
+	fmt.Fprintf(b, "}\n")
+	return b.String()
+}
+
+// UpdatableParam returns the parameter whose value is changed between the
+// steps of the generated acceptance test of a resource, or nil if none of
+// them are suitable.
+func (e *templatableEndpoint) UpdatableParam() *templatableParam {
+	for _, param := range e.Parameters {
+		if param.IsPathParam || param.Computed || param.Required || param.IsSensitive() {
+			continue
+		}
+		if param.IsScalar() {
+			p := param
+			return &p
+		}
+	}
+	return nil
+}
+
+// ImportStateVerifyIgnore returns the fields that can't be verified after
+// importing a resource, which are the sensitive fields that Vault doesn't
+// return. The mount path and path parameters are parsed from the ID.
+func (e *templatableEndpoint) ImportStateVerifyIgnore() []string {
+	var result []string
+	for _, param := range e.Parameters {
+		if param.IsSensitive() {
+			result = append(result, param.Name)
+		}
+	}
+	return result
+}
+
+// IsScalar returns whether the parameter is a string, a boolean or an
+// integer, whose value in the state is the value it was given.
+func (p templatableParam) IsScalar() bool {
+	switch p.Schema.Type {
+	case "boolean", "integer", "string":
+		return true
+	}
+	return false
+}
+
+// TestValue returns the value of a scalar parameter, as it is stored in the
+// state, in the generated acceptance test of a resource.
+func (p templatableParam) TestValue(updated bool) string {
+	switch p.Schema.Type {
+	case "boolean":
+		if updated {
+			return "false"
+		}
+		return "true"
+	case "integer":
+		if updated {
+			return "2"
+		}
+		return "1"
+	}
+	if updated {
+		return "test-updated"
+	}
+	return "test"
+}
+
+// writeTestAttribute writes a parameter of the resource under test, with an
+// arbitrary value of its type, to the configuration of its acceptance test.
+func writeTestAttribute(b *strings.Builder, indent string, param templatableParam, updated bool) {
+	switch {
+	case param.IsBlock():
+		fmt.Fprintf(b, "%s%s {\n", indent, param.Name)
+		for _, field := range param.BlockParams() {
+			if field.Required {
+				writeTestAttribute(b, indent+"  ", field, updated)
+			}
+		}
+		fmt.Fprintf(b, "%s}\n", indent)
+	case param.IsMap():
+		fmt.Fprintf(b, "%s%s = {\n%s  key = %q\n%s}\n", indent, param.Name, indent, param.TestValue(updated), indent)
+	case param.IsJSON():
+		fmt.Fprintf(b, "%s%s = jsonencode({})\n", indent, param.Name)
+	case param.Schema.Type == "array":
+		if param.Schema.Items != nil && param.Schema.Items.Type == "string" {
+			fmt.Fprintf(b, "%s%s = [%q]\n", indent, param.Name, param.TestValue(updated))
+		} else {
+			fmt.Fprintf(b, "%s%s = []\n", indent, param.Name)
+		}
+	case param.Schema.Type == "string":
+		fmt.Fprintf(b, "%s%s = %q\n", indent, param.Name, param.TestValue(updated))
+	default:
+		fmt.Fprintf(b, "%s%s = %s\n", indent, param.Name, param.TestValue(updated))
+	}
+}
@@ -0,0 +1,147 @@
+package codegen
+
+import (
+	"encoding/json"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/vault/sdk/framework"
+)
+
+func TestTestConfig(t *testing.T) {
+	endpoint := &templatableEndpoint{
+		TerraformName: "vault_auth_foo_role",
+		MountType:     "foo",
+		IsAuthMount:   true,
+		Parameters: []templatableParam{
+			{
+				OASParameter: &framework.OASParameter{
+					Name:     "name",
+					Required: true,
+					Schema:   &framework.OASSchema{Type: "string", DisplayAttrs: &framework.DisplayAttributes{}},
+				},
+				IsPathParam: true,
+			},
+			{
+				OASParameter: &framework.OASParameter{
+					Name:   "secret",
+					Schema: &framework.OASSchema{Type: "string", DisplayAttrs: &framework.DisplayAttributes{Sensitive: true}},
+				},
+			},
+			{
+				OASParameter: &framework.OASParameter{
+					Name:   "ttl",
+					Schema: &framework.OASSchema{Type: "integer", DisplayAttrs: &framework.DisplayAttributes{}},
+				},
+			},
+			{
+				OASParameter: &framework.OASParameter{
+					Name:     "policies",
+					Required: true,
+					Schema: &framework.OASSchema{
+						Type:         "array",
+						Items:        &framework.OASSchema{Type: "string"},
+						DisplayAttrs: &framework.DisplayAttributes{},
+					},
+				},
+			},
+		},
+	}
+
+	expected := `resource "vault_auth_backend" "test" {
+  path = "%s"
+  type = "foo"
+}
+
+resource "vault_auth_foo_role" "test" {
+  path = vault_auth_backend.test.path
+  name = "test"
+  ttl = 1
+  policies = ["test"]
+}
+`
+	if actual := endpoint.TestConfig(false); actual != expected {
+		t.Fatalf("expected %q but received %q", expected, actual)
+	}
+	if actual := endpoint.TestConfig(true); !strings.Contains(actual, "ttl = 2\n") {
+		t.Fatalf("expected the updated config to change ttl, received %q", actual)
+	}
+
+	ignored := endpoint.ImportStateVerifyIgnore()
+	if len(ignored) != 1 || ignored[0] != "secret" {
+		t.Fatalf("unexpected fields ignored on import: %v", ignored)
+	}
+}
+
+func TestTemplateHandlerTest(t *testing.T) {
+	h, err := newTemplateHandler(hclog.Default())
+	if err != nil {
+		t.Fatal(err)
+	}
+	endpointInfo := &framework.OASPathItem{}
+	if err := json.Unmarshal([]byte(`{
+	"parameters": [{
+		"name": "name",
+		"in": "path",
+		"schema": {
+			"type": "string"
+		},
+		"required": true
+	}],
+	"get": {
+		"responses": {
+			"200": {
+				"description": "OK"
+			}
+		}
+	},
+	"post": {
+		"requestBody": {
+			"content": {
+				"application/json": {
+					"schema": {
+						"type": "object",
+						"properties": {
+							"template": {
+								"type": "string"
+							}
+						}
+					}
+				}
+			}
+		},
+		"responses": {
+			"200": {
+				"description": "OK"
+			}
+		}
+	}
+}`), endpointInfo); err != nil {
+		t.Fatal(err)
+	}
+	b := &strings.Builder{}
+	if err := h.Write(b, templateTypeTest, "/transform/template/{name}", endpointInfo, &additionalInfo{
+		Type: tfTypeResource,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	result := b.String()
+
+	if _, err := parser.ParseFile(token.NewFileSet(), "name_test.go", result, 0); err != nil {
+		t.Fatalf("invalid test generated: %s\n%s", err, result)
+	}
+	for _, expected := range []string{
+		"func TestNameBasic(t *testing.T)",
+		`acctest.RandomWithPrefix("transform")`,
+		`p.RegisterResource("vault_transform_template", NameResource())`,
+		`resource.TestCheckResourceAttr("vault_transform_template.test", "template", "test-updated")`,
+		"ImportStateVerify: true",
+	} {
+		if !strings.Contains(result, expected) {
+			t.Fatalf("expected %q in result: %s", expected, result)
+		}
+	}
+}
@@ -32,41 +32,41 @@ func RoleNameDataSource() *schema.Resource {
 				Type:        schema.TypeList,
 				Elem:        &schema.Schema{Type: schema.TypeMap},
 				Optional:    true,
-				Description: "Specifies a list of items to be decoded in a single batch. If this parameter is set, the top-level parameters 'value', 'transformation' and 'tweak' will be ignored. Each batch item within the list can specify these parameters instead.",
+				Description: `Specifies a list of items to be decoded in a single batch. If this parameter is set, the top-level parameters 'value', 'transformation' and 'tweak' will be ignored. Each batch item within the list can specify these parameters instead.`,
 			},
 			"batch_results": {
 				Type:        schema.TypeList,
 				Elem:        &schema.Schema{Type: schema.TypeMap},
 				Optional:    true,
 				Computed:    true,
-				Description: "The result of decoding batch_input.",
+				Description: `The result of decoding batch_input.`,
 			},
 			"decoded_value": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Computed:    true,
-				Description: "The result of decoding a value.",
+				Description: `The result of decoding a value.`,
 			},
 			"role_name": {
 				Type:        schema.TypeString,
 				Required:    true,
 				ForceNew:    true,
-				Description: "The name of the role.",
+				Description: `The name of the role.`,
 			},
 			"transformation": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "The transformation to perform. If no value is provided and the role contains a single transformation, this value will be inferred from the role.",
+				Description: `The transformation to perform. If no value is provided and the role contains a single transformation, this value will be inferred from the role.`,
 			},
 			"tweak": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "The tweak value to use. Only applicable for FPE transformations",
+				Description: `The tweak value to use. Only applicable for FPE transformations`,
 			},
 			"value": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "The value in which to decode.",
+				Description: `The value in which to decode.`,
 			},
 		},
 	}
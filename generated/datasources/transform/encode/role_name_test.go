@@ -7,8 +7,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
-	"github.com/hashicorp/terraform-provider-vault/generated/resources/transform/role"
-	"github.com/hashicorp/terraform-provider-vault/generated/resources/transform/transformation"
 	"github.com/hashicorp/terraform-provider-vault/schema"
 	"github.com/hashicorp/terraform-provider-vault/util"
 	"github.com/hashicorp/terraform-provider-vault/vault"
@@ -17,8 +15,6 @@ import (
 var roleNameTestProvider = func() *schema.Provider {
 	p := schema.NewProvider(vault.Provider())
 	p.RegisterResource("vault_mount", vault.MountResource())
-	p.RegisterResource("vault_transform_transformation_name", transformation.NameResource())
-	p.RegisterResource("vault_transform_role_name", role.NameResource())
 	p.RegisterDataSource("vault_transform_encode_role_name", RoleNameDataSource())
 	return p
 }()
@@ -47,7 +43,7 @@ resource "vault_mount" "transform" {
   path = "%s"
   type = "transform"
 }
-resource "vault_transform_transformation_name" "ccn-fpe" {
+resource "vault_transform_transformation" "ccn-fpe" {
   path = vault_mount.transform.path
   name = "ccn-fpe"
   type = "fpe"
@@ -55,13 +51,13 @@ resource "vault_transform_transformation_name" "ccn-fpe" {
   tweak_source = "internal"
   allowed_roles = ["payments"]
 }
-resource "vault_transform_role_name" "payments" {
-  path = vault_transform_transformation_name.ccn-fpe.path
+resource "vault_transform_role" "payments" {
+  path = vault_transform_transformation.ccn-fpe.path
   name = "payments"
   transformations = ["ccn-fpe"]
 }
 data "vault_transform_encode_role_name" "test" {
-    path      = vault_transform_role_name.payments.path
+    path      = vault_transform_role.payments.path
     role_name = "payments"
     value     = "1111-2222-3333-4444"
 }
@@ -93,7 +89,7 @@ resource "vault_mount" "transform" {
   path = "%s"
   type = "transform"
 }
-resource "vault_transform_transformation_name" "ccn-fpe" {
+resource "vault_transform_transformation" "ccn-fpe" {
   path = vault_mount.transform.path
   name = "ccn-fpe"
   type = "fpe"
@@ -101,13 +97,13 @@ resource "vault_transform_transformation_name" "ccn-fpe" {
   tweak_source = "internal"
   allowed_roles = ["payments"]
 }
-resource "vault_transform_role_name" "payments" {
-  path = vault_transform_transformation_name.ccn-fpe.path
+resource "vault_transform_role" "payments" {
+  path = vault_transform_transformation.ccn-fpe.path
   name = "payments"
   transformations = ["ccn-fpe"]
 }
 data "vault_transform_encode_role_name" "test" {
-    path      = vault_transform_role_name.payments.path
+    path      = vault_transform_role.payments.path
     role_name = "payments"
     batch_input = [{"value":"1111-2222-3333-4444"}]
 }
@@ -45,6 +45,33 @@ func Is404(err error) bool {
 	return strings.Contains(err.Error(), "Code: 404")
 }
 
+// Is403 returns whether err is a permission denied error returned by Vault.
+func Is403(err error) bool {
+	return strings.Contains(err.Error(), "Code: 403")
+}
+
+// IsSealed returns whether err was returned by a sealed Vault.
+func IsSealed(err error) bool {
+	return strings.Contains(err.Error(), "Code: 503") && strings.Contains(err.Error(), "Vault is sealed")
+}
+
+var errorStatusCodeRegex = regexp.MustCompile(`Code: (\d{3})\.`)
+
+// ErrorStatusCode returns the HTTP status code of the response an error
+// returned by the Vault API was built from, or 0 if there is none.
+func ErrorStatusCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	m := errorStatusCodeRegex.FindStringSubmatch(err.Error())
+	if m == nil {
+		return 0
+	}
+	var code int
+	fmt.Sscanf(m[1], "%d", &code)
+	return code
+}
+
 func CalculateConflictsWith(self string, group []string) []string {
 	if len(group) < 2 {
 		return []string{}
@@ -136,6 +163,23 @@ func GetTestADCreds(t *testing.T) (string, string, string) {
 	return adBindDN, adBindPass, adURL
 }
 
+func GetTestLDAPCreds(t *testing.T) (string, string, string) {
+	bindDN := os.Getenv("LDAP_BINDDN")
+	bindPass := os.Getenv("LDAP_BINDPASS")
+	url := os.Getenv("LDAP_URL")
+
+	if bindDN == "" {
+		t.Skip("LDAP_BINDDN not set")
+	}
+	if bindPass == "" {
+		t.Skip("LDAP_BINDPASS not set")
+	}
+	if url == "" {
+		t.Skip("LDAP_URL not set")
+	}
+	return bindDN, bindPass, url
+}
+
 func GetTestNomadCreds(t *testing.T) (string, string) {
 	address := os.Getenv("NOMAD_ADDR")
 	token := os.Getenv("NOMAD_TOKEN")
@@ -273,6 +317,46 @@ func ParsePath(userSuppliedPath, endpoint string, d *schema.ResourceData) string
 	return recomprised
 }
 
+// ParseImportID parses the ID a generated resource is imported with, which is
+// its path in Vault, with or without a leading slash. It returns the path
+// the resource's ID is set to, and the path parameters set in its state,
+// e.g. for the endpoint "/transform/role/{name}" and the ID
+// "transform/role/payments", "/transform/role/payments" and a "path" of
+// "transform" and "name" of "payments".
+func ParseImportID(endpoint, id string) (string, map[string]string, error) {
+	vaultPath := "/" + strings.Trim(id, "/")
+	params, err := PathParameters(endpoint, vaultPath)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid import ID %q for %q: %w", id, endpoint, err)
+	}
+	// PathParameters matches paths with anything around them, so ensure the
+	// parameters make up the whole ID.
+	if expanded := expandEndpoint(endpoint, params); expanded != vaultPath {
+		return "", nil, fmt.Errorf("invalid import ID %q for %q, expected a path like %q", id, endpoint, expanded)
+	}
+	return vaultPath, params, nil
+}
+
+// expandEndpoint replaces the mount and parameters of the endpoint with the
+// given path parameters.
+func expandEndpoint(endpoint string, params map[string]string) string {
+	fields := strings.Split(strings.TrimPrefix(endpoint, "/"), "/")
+	mountIndex := 0
+	if fields[0] == "auth" && len(fields) > 1 {
+		mountIndex = 1
+	}
+	for i, field := range fields {
+		if i == mountIndex {
+			fields[i] = params["path"]
+			continue
+		}
+		if strings.HasPrefix(field, "{") && strings.HasSuffix(field, "}") {
+			fields[i] = params[strings.Trim(field, "{}")]
+		}
+	}
+	return "/" + strings.Join(fields, "/")
+}
+
 // PathParameters is just like regexp FindStringSubmatch,
 // but it validates that the match is different from the string passed
 // in, and that there's only one result.
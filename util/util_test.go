@@ -27,6 +27,31 @@ func TestExpiredTokenError(t *testing.T) {
 	}
 }
 
+func TestErrorStatusCode(t *testing.T) {
+	denied := fmt.Errorf("Error making API request.\n\nURL: GET https://vault:8200/v1/secret/foo\nCode: 403. Errors:\n\n* 1 error occurred:\n\t* permission denied\n\n")
+	if code := ErrorStatusCode(denied); code != 403 {
+		t.Errorf("expected 403, got %d", code)
+	}
+	if !Is403(denied) {
+		t.Errorf("Should be permission denied")
+	}
+	if IsSealed(denied) {
+		t.Errorf("Shouldn't be sealed")
+	}
+
+	sealed := fmt.Errorf("Error making API request.\n\nURL: GET https://vault:8200/v1/secret/foo\nCode: 503. Errors:\n\n* Vault is sealed")
+	if !IsSealed(sealed) {
+		t.Errorf("Should be sealed")
+	}
+
+	if code := ErrorStatusCode(fmt.Errorf("Error making request")); code != 0 {
+		t.Errorf("expected 0, got %d", code)
+	}
+	if code := ErrorStatusCode(nil); code != 0 {
+		t.Errorf("expected 0, got %d", code)
+	}
+}
+
 func TestSliceHasElement_scalar(t *testing.T) {
 	slice := []interface{}{1, 2, 3, 4, 5}
 
@@ -254,3 +279,70 @@ func TestPathParameters(t *testing.T) {
 		})
 	}
 }
+
+func TestParseImportID(t *testing.T) {
+	testCases := []struct {
+		endpoint, id      string
+		expectedVaultPath string
+		expectedParams    map[string]string
+		expectErr         bool
+	}{
+		{
+			endpoint:          "/transform/role/{name}",
+			id:                "transform/role/payments",
+			expectedVaultPath: "/transform/role/payments",
+			expectedParams: map[string]string{
+				"path": "transform",
+				"name": "payments",
+			},
+		},
+		{
+			endpoint:          "/transform/role/{name}",
+			id:                "/my/transform/role/payments/",
+			expectedVaultPath: "/my/transform/role/payments",
+			expectedParams: map[string]string{
+				"path": "my/transform",
+				"name": "payments",
+			},
+		},
+		{
+			endpoint:          "/auth/userpass/users/{username}",
+			id:                "auth/my-userpass/users/alice",
+			expectedVaultPath: "/auth/my-userpass/users/alice",
+			expectedParams: map[string]string{
+				"path":     "my-userpass",
+				"username": "alice",
+			},
+		},
+		{
+			endpoint:  "/transform/role/{name}",
+			id:        "payments",
+			expectErr: true,
+		},
+		{
+			endpoint:  "/transit/keys/{name}/config",
+			id:        "transit/keys/my-key/config/extra",
+			expectErr: true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.id, func(t *testing.T) {
+			vaultPath, params, err := ParseImportID(testCase.endpoint, testCase.id)
+			if testCase.expectErr {
+				if err == nil {
+					t.Fatalf("expected an error but received %q, %+v", vaultPath, params)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if vaultPath != testCase.expectedVaultPath {
+				t.Fatalf("expected %q but received %q", testCase.expectedVaultPath, vaultPath)
+			}
+			if !reflect.DeepEqual(params, testCase.expectedParams) {
+				t.Fatalf("expected %+v but received %+v", testCase.expectedParams, params)
+			}
+		})
+	}
+}
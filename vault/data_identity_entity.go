@@ -26,6 +26,7 @@ var (
 	identityEntityAliasFields = []string{
 		"canonical_id",
 		"creation_time",
+		"custom_metadata",
 		"id",
 		"last_update_time",
 		"merged_from_canonical_ids",
@@ -45,6 +46,10 @@ var (
 			Type:     schema.TypeString,
 			Computed: true,
 		},
+		"custom_metadata": {
+			Type:     schema.TypeMap,
+			Computed: true,
+		},
 		"id": {
 			Type:     schema.TypeString,
 			Computed: true,
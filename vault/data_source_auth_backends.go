@@ -0,0 +1,14 @@
+package vault
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func authBackendsDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return mountTableDataSourceRead(d, meta, "sys/auth", "backends")
+		},
+		Schema: mountTableDataSourceSchema("backends", "The auth methods enabled in Vault."),
+	}
+}
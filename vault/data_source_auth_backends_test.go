@@ -0,0 +1,49 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestDataSourceAuthBackends(t *testing.T) {
+	path := acctest.RandomWithPrefix("userpass")
+	dataSourceName := "data.vault_auth_backends.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceAuthBackendsConfig(path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "type", "userpass"),
+					resource.TestCheckResourceAttr(dataSourceName, "paths.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "paths.0", path),
+					resource.TestCheckResourceAttrPair(dataSourceName, "accessors."+path, "vault_auth_backend.test", "accessor"),
+					resource.TestCheckResourceAttr(dataSourceName, "backends.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "backends.0.path", path),
+					resource.TestCheckResourceAttr(dataSourceName, "backends.0.type", "userpass"),
+					resource.TestCheckResourceAttr(dataSourceName, "backends.0.description", "test backend"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceAuthBackendsConfig(path string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "test" {
+  path        = "%s"
+  type        = "userpass"
+  description = "test backend"
+}
+
+data "vault_auth_backends" "test" {
+  type       = "userpass"
+  depends_on = [vault_auth_backend.test]
+}
+`, path)
+}
@@ -0,0 +1,123 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func awsSTSCredentialsDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: awsSTSCredentialsDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "AWS Secret Backend to generate credentials from.",
+			},
+			"role": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "AWS Secret Role to generate credentials for.",
+			},
+			"role_arn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ARN to use if multiple are available in the role. Required if the role has multiple ARNs.",
+			},
+			"role_session_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The role session name attached to the assumed role ARN. Requires Vault 1.15+.",
+			},
+			"ttl": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User specified Time-To-Live for the STS token. Uses the Role defined default_sts_ttl when not specified.",
+			},
+			"mfa_code": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The MFA code for the MFA device configured on the role with mfa_serial. Requires Vault 1.16+.",
+			},
+			"access_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "AWS access key ID generated by Vault.",
+			},
+			"secret_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "AWS secret key generated by Vault.",
+			},
+			"security_token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "AWS security token generated by Vault.",
+			},
+			"arn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ARN of the assumed role or federated user the credentials belong to.",
+			},
+			"lease_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Lease identifier assigned by vault.",
+			},
+			"lease_duration": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Lease duration in seconds relative to the time in lease_start_time.",
+			},
+			"lease_start_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time at which the lease was read, using the clock of the system where Terraform was running",
+			},
+		},
+	}
+}
+
+func awsSTSCredentialsDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := strings.Trim(d.Get("backend").(string), "/") + "/sts/" + d.Get("role").(string)
+
+	data := map[string]interface{}{}
+	for _, k := range []string{"role_arn", "role_session_name", "ttl", "mfa_code"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Generating STS credentials from %q", path)
+	secret, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error generating STS credentials from %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Generated STS credentials from %q", path)
+
+	if secret == nil {
+		return fmt.Errorf("no role found at path %q", path)
+	}
+
+	d.SetId(secret.LeaseID)
+	d.Set("access_key", secret.Data["access_key"])
+	d.Set("secret_key", secret.Data["secret_key"])
+	d.Set("security_token", secret.Data["security_token"])
+	d.Set("arn", secret.Data["arn"])
+	d.Set("lease_id", secret.LeaseID)
+	d.Set("lease_duration", secret.LeaseDuration)
+	d.Set("lease_start_time", time.Now().Format(time.RFC3339))
+
+	return nil
+}
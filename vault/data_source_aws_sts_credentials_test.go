@@ -0,0 +1,55 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataSourceAWSSTSCredentials_basic(t *testing.T) {
+	mountPath := acctest.RandomWithPrefix("tf-test-aws")
+	accessKey, secretKey := getTestAWSCreds(t)
+	region := getTestAWSRegion(t)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAWSSTSCredentialsConfig(mountPath, accessKey, secretKey, region),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vault_aws_sts_credentials.test", "access_key"),
+					resource.TestCheckResourceAttrSet("data.vault_aws_sts_credentials.test", "secret_key"),
+					resource.TestCheckResourceAttrSet("data.vault_aws_sts_credentials.test", "security_token"),
+					resource.TestCheckResourceAttrSet("data.vault_aws_sts_credentials.test", "lease_id"),
+					resource.TestCheckResourceAttr("data.vault_aws_sts_credentials.test", "ttl", "15m"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAWSSTSCredentialsConfig(mountPath, accessKey, secretKey, region string) string {
+	return fmt.Sprintf(`
+resource "vault_aws_secret_backend" "aws" {
+  path       = "%s"
+  access_key = "%s"
+  secret_key = "%s"
+  region     = "%s"
+}
+
+resource "vault_aws_secret_backend_role" "role" {
+  backend         = vault_aws_secret_backend.aws.path
+  name            = "test"
+  credential_type = "federation_token"
+  policy_document = "{\"Version\": \"2012-10-17\", \"Statement\": [{\"Effect\": \"Allow\", \"Action\": \"iam:*\", \"Resource\": \"*\"}]}"
+}
+
+data "vault_aws_sts_credentials" "test" {
+  backend = vault_aws_secret_backend.aws.path
+  role    = vault_aws_secret_backend_role.role.name
+  ttl     = "15m"
+}`, mountPath, accessKey, secretKey, region)
+}
@@ -0,0 +1,83 @@
+package vault
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func gcpkmsDecryptDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: gcpkmsDecryptDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The GCP KMS secret backend the key belongs to.",
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the decryption key to use.",
+			},
+			"ciphertext": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Base64 encoded ciphertext to be decrypted.",
+			},
+			"additional_authenticated_data": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The additional authenticated data that was provided when encrypting.",
+			},
+			"key_version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The version of the key to use for decryption. Required for asymmetric keys.",
+			},
+			"plaintext": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Decrypted plaintext.",
+			},
+		},
+	}
+}
+
+func gcpkmsDecryptDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := strings.Trim(d.Get("backend").(string), "/") + "/decrypt/" + d.Get("key").(string)
+	ciphertext := d.Get("ciphertext").(string)
+
+	payload := map[string]interface{}{
+		"ciphertext": ciphertext,
+	}
+	if v, ok := d.GetOk("additional_authenticated_data"); ok {
+		payload["additional_authenticated_data"] = v
+	}
+	if v, ok := d.GetOk("key_version"); ok {
+		payload["key_version"] = v
+	}
+
+	log.Printf("[DEBUG] Decrypting with GCP KMS key %q", path)
+	resp, err := client.Logical().Write(path, payload)
+	if err != nil {
+		return fmt.Errorf("issue decrypting with key: %s", err)
+	}
+	log.Printf("[DEBUG] Decrypted with GCP KMS key %q", path)
+	if resp == nil {
+		return fmt.Errorf("no response from %q", path)
+	}
+
+	d.SetId(base64.StdEncoding.EncodeToString([]byte(ciphertext)))
+	d.Set("plaintext", resp.Data["plaintext"])
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func gcpkmsEncryptDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: gcpkmsEncryptDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The GCP KMS secret backend the key belongs to.",
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the encryption key to use.",
+			},
+			"plaintext": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Plaintext to be encrypted.",
+			},
+			"additional_authenticated_data": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Optional data that must also be provided when decrypting the ciphertext.",
+			},
+			"key_version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The version of the key to use for encryption. Defaults to the primary version.",
+			},
+			"ciphertext": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Base64 encoded ciphertext.",
+			},
+		},
+	}
+}
+
+func gcpkmsEncryptDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := strings.Trim(d.Get("backend").(string), "/") + "/encrypt/" + d.Get("key").(string)
+
+	payload := map[string]interface{}{
+		"plaintext": d.Get("plaintext").(string),
+	}
+	if v, ok := d.GetOk("additional_authenticated_data"); ok {
+		payload["additional_authenticated_data"] = v
+	}
+	if v, ok := d.GetOk("key_version"); ok {
+		payload["key_version"] = v
+	}
+
+	log.Printf("[DEBUG] Encrypting with GCP KMS key %q", path)
+	resp, err := client.Logical().Write(path, payload)
+	if err != nil {
+		return fmt.Errorf("issue encrypting with key: %s", err)
+	}
+	log.Printf("[DEBUG] Encrypted with GCP KMS key %q", path)
+	if resp == nil {
+		return fmt.Errorf("no response from %q", path)
+	}
+
+	ciphertext, _ := resp.Data["ciphertext"].(string)
+
+	d.SetId(ciphertext)
+	d.Set("ciphertext", ciphertext)
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestDataSourceGCPKMSEncrypt(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-gcpkms")
+	name := acctest.RandomWithPrefix("tf-test-key")
+	credentials, keyRing := getTestGCPKMSKeyRing(t)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceGCPKMSEncryptConfig(backend, credentials, keyRing, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vault_gcpkms_encrypt.test", "ciphertext"),
+					resource.TestCheckResourceAttr("data.vault_gcpkms_decrypt.test", "plaintext", "foo"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceGCPKMSEncryptConfig(backend, credentials, keyRing, name string) string {
+	return fmt.Sprintf(`
+resource "vault_gcpkms_secret_backend" "test" {
+  backend     = "%s"
+  credentials = <<EOT
+%s
+EOT
+}
+
+resource "vault_gcpkms_key" "test" {
+  backend  = vault_gcpkms_secret_backend.test.backend
+  name     = "%s"
+  key_ring = "%s"
+}
+
+data "vault_gcpkms_encrypt" "test" {
+  backend                       = vault_gcpkms_secret_backend.test.backend
+  key                           = vault_gcpkms_key.test.name
+  plaintext                     = "foo"
+  additional_authenticated_data = "bar"
+}
+
+data "vault_gcpkms_decrypt" "test" {
+  backend                       = vault_gcpkms_secret_backend.test.backend
+  key                           = vault_gcpkms_key.test.name
+  ciphertext                    = data.vault_gcpkms_encrypt.test.ciphertext
+  additional_authenticated_data = "bar"
+}
+`, backend, credentials, name, keyRing)
+}
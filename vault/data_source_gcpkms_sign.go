@@ -0,0 +1,72 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func gcpkmsSignDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: gcpkmsSignDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The GCP KMS secret backend the key belongs to.",
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the signing key to use.",
+			},
+			"key_version": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The version of the key to use for signing.",
+			},
+			"digest": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Base64 encoded digest of the data to sign, computed with the hash algorithm of the key.",
+			},
+			"signature": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Base64 encoded signature.",
+			},
+		},
+	}
+}
+
+func gcpkmsSignDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := strings.Trim(d.Get("backend").(string), "/") + "/sign/" + d.Get("key").(string)
+
+	payload := map[string]interface{}{
+		"digest":      d.Get("digest").(string),
+		"key_version": d.Get("key_version").(int),
+	}
+
+	log.Printf("[DEBUG] Signing with GCP KMS key %q", path)
+	resp, err := client.Logical().Write(path, payload)
+	if err != nil {
+		return fmt.Errorf("issue signing with key: %s", err)
+	}
+	log.Printf("[DEBUG] Signed with GCP KMS key %q", path)
+	if resp == nil {
+		return fmt.Errorf("no response from %q", path)
+	}
+
+	signature, _ := resp.Data["signature"].(string)
+
+	d.SetId(signature)
+	d.Set("signature", signature)
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestDataSourceGCPKMSSign(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-gcpkms")
+	name := acctest.RandomWithPrefix("tf-test-key")
+	credentials, keyRing := getTestGCPKMSKeyRing(t)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceGCPKMSSignConfig(backend, credentials, keyRing, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vault_gcpkms_sign.test", "signature"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceGCPKMSSignConfig(backend, credentials, keyRing, name string) string {
+	return fmt.Sprintf(`
+resource "vault_gcpkms_secret_backend" "test" {
+  backend     = "%s"
+  credentials = <<EOT
+%s
+EOT
+}
+
+resource "vault_gcpkms_key" "test" {
+  backend   = vault_gcpkms_secret_backend.test.backend
+  name      = "%s"
+  key_ring  = "%s"
+  purpose   = "asymmetric_sign"
+  algorithm = "ec_sign_p256_sha256"
+}
+
+data "vault_gcpkms_sign" "test" {
+  backend     = vault_gcpkms_secret_backend.test.backend
+  key         = vault_gcpkms_key.test.name
+  key_version = 1
+  digest      = base64sha256("foo")
+}
+`, backend, credentials, name, keyRing)
+}
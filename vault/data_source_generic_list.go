@@ -0,0 +1,80 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func genericListDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: genericListDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Full path of the endpoint to list.",
+			},
+			"keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of keys returned by the endpoint.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"key_info_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "JSON-encoded additional information about each key, for endpoints that return it.",
+			},
+		},
+	}
+}
+
+func genericListDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := strings.Trim(d.Get("path").(string), "/")
+
+	log.Printf("[DEBUG] Listing %q", path)
+	secret, err := client.Logical().List(path)
+	if err != nil {
+		return fmt.Errorf("error listing %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Listed %q", path)
+
+	// an empty or missing path is not an error, it simply has no keys
+	keys := []string{}
+	var keyInfo interface{}
+	if secret != nil {
+		if v, ok := secret.Data["keys"].([]interface{}); ok {
+			for _, k := range v {
+				keys = append(keys, k.(string))
+			}
+		}
+		keyInfo = secret.Data["key_info"]
+	}
+
+	keyInfoJSON := ""
+	if keyInfo != nil {
+		jsonData, err := json.Marshal(keyInfo)
+		if err != nil {
+			return fmt.Errorf("error marshaling key_info JSON for %q: %s", path, err)
+		}
+		keyInfoJSON = string(jsonData)
+	}
+
+	d.SetId(path)
+	if err := d.Set("keys", keys); err != nil {
+		return fmt.Errorf("error setting keys for %q: %s", path, err)
+	}
+	d.Set("key_info_json", keyInfoJSON)
+
+	return nil
+}
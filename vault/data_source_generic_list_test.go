@@ -0,0 +1,72 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestDataSourceGenericList(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-kv")
+	resourceName := "data.vault_generic_list.test"
+	emptyResourceName := "data.vault_generic_list.empty"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceGenericList_config(mount),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "path", mount),
+					resource.TestCheckResourceAttr(resourceName, "keys.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "keys.0", "bar"),
+					resource.TestCheckResourceAttr(resourceName, "keys.1", "foo"),
+					resource.TestCheckResourceAttr(resourceName, "keys.2", "nested/"),
+					resource.TestCheckResourceAttr(resourceName, "key_info_json", ""),
+					resource.TestCheckResourceAttr(emptyResourceName, "keys.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceGenericList_config(mount string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "kv"
+}
+
+resource "vault_generic_secret" "foo" {
+  path      = "${vault_mount.test.path}/foo"
+  data_json = jsonencode({ zip = "zap" })
+}
+
+resource "vault_generic_secret" "bar" {
+  path      = "${vault_mount.test.path}/bar"
+  data_json = jsonencode({ zip = "zap" })
+}
+
+resource "vault_generic_secret" "baz" {
+  path      = "${vault_mount.test.path}/nested/baz"
+  data_json = jsonencode({ zip = "zap" })
+}
+
+data "vault_generic_list" "test" {
+  path = vault_mount.test.path
+
+  depends_on = [
+    vault_generic_secret.foo,
+    vault_generic_secret.bar,
+    vault_generic_secret.baz,
+  ]
+}
+
+data "vault_generic_list" "empty" {
+  path = "${vault_mount.test.path}/missing"
+}
+`, mount)
+}
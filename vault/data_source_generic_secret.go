@@ -22,6 +22,13 @@ func genericSecretDataSource() *schema.Resource {
 				Description: "Full path from which a secret will be read.",
 			},
 
+			"api_path": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Treat a KV v2 path whose first segment after the mount is data/ as the API path of the secret, rather than as part of its name.",
+			},
+
 			"version": {
 				Type:     schema.TypeInt,
 				Required: false,
@@ -78,7 +85,7 @@ func genericSecretDataSourceRead(d *schema.ResourceData, meta interface{}) error
 	secretVersion := d.Get("version").(int)
 	log.Printf("[DEBUG] Reading %s %d from Vault", path, secretVersion)
 
-	secret, err := versionedSecret(secretVersion, path, client)
+	secret, err := versionedSecret(secretVersion, path, d.Get("api_path").(bool), client)
 	if err != nil {
 		return fmt.Errorf("error reading from Vault: %s", err)
 	}
@@ -2,6 +2,7 @@ package vault
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
@@ -45,6 +46,70 @@ func TestV2Secret(t *testing.T) {
 	})
 }
 
+func TestV2SecretDataPath(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-acctest-kv")
+	path := acctest.RandomWithPrefix("foo")
+	r.Test(t, r.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []r.TestStep{
+			{
+				Config: testv2DataSourceGenericSecretDataPath_config(mount, path),
+				Check:  testDataSourceGenericSecret_check,
+			},
+		},
+	})
+}
+
+func TestDataSourceGenericSecret_v1Version(t *testing.T) {
+	r.Test(t, r.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []r.TestStep{
+			{
+				Config:      testDataSourceGenericSecret_config + testDataSourceGenericSecretV1Version_config,
+				ExpectError: regexp.MustCompile("a version can only be requested for KV v2 secrets"),
+			},
+		},
+	})
+}
+
+const testDataSourceGenericSecretV1Version_config = `
+data "vault_generic_secret" "versioned" {
+    path = "${vault_generic_secret.test.path}"
+    version = 1
+}
+`
+
+// testv2DataSourceGenericSecretDataPath_config writes the secret through its
+// data endpoint, with api_path set, and reads it through its logical path.
+func testv2DataSourceGenericSecretDataPath_config(mount, path string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path        = "%s"
+  type        = "kv"
+  options = {
+    "version" = "2"
+  }
+}
+
+resource "vault_generic_secret" "test" {
+    path = "${vault_mount.test.path}/data/%s"
+    api_path = true
+    data_json = <<EOT
+{
+    "zip": "zap"
+}
+EOT
+}
+
+data "vault_generic_secret" "test" {
+    path = "${vault_mount.test.path}/%s"
+    depends_on = [vault_generic_secret.test]
+}
+`, mount, path, path)
+}
+
 func testv2DataSourceGenericSecret_config(mount, path string) string {
 	return fmt.Sprintf(`
 resource "vault_mount" "test" {
@@ -0,0 +1,93 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func healthDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: healthDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"initialized": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether Vault has been initialized.",
+			},
+			"sealed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether Vault is sealed.",
+			},
+			"standby": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the node is a standby.",
+			},
+			"performance_standby": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the node is a performance standby.",
+			},
+			"replication_performance_mode": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Performance replication mode of the cluster, such as primary, secondary or disabled.",
+			},
+			"replication_dr_mode": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Disaster recovery replication mode of the cluster, such as primary, secondary or disabled.",
+			},
+			"server_time_utc": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Server time as a Unix timestamp.",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Version of the Vault server.",
+			},
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the Vault cluster.",
+			},
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the Vault cluster.",
+			},
+		},
+	}
+}
+
+func healthDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	log.Printf("[DEBUG] Reading health")
+	health, err := client.Sys().Health()
+	if err != nil {
+		return fmt.Errorf("error reading health: %s", err)
+	}
+	log.Printf("[DEBUG] Read health")
+
+	d.SetId("sys/health")
+	d.Set("initialized", health.Initialized)
+	d.Set("sealed", health.Sealed)
+	d.Set("standby", health.Standby)
+	d.Set("performance_standby", health.PerformanceStandby)
+	d.Set("replication_performance_mode", health.ReplicationPerformanceMode)
+	d.Set("replication_dr_mode", health.ReplicationDRMode)
+	d.Set("server_time_utc", health.ServerTimeUTC)
+	d.Set("version", health.Version)
+	d.Set("cluster_name", health.ClusterName)
+	d.Set("cluster_id", health.ClusterID)
+
+	return nil
+}
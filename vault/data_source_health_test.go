@@ -0,0 +1,32 @@
+package vault
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestDataSourceHealth(t *testing.T) {
+	resourceName := "data.vault_health.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceHealth_config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "initialized", "true"),
+					resource.TestCheckResourceAttr(resourceName, "sealed", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "version"),
+					resource.TestCheckResourceAttrSet(resourceName, "cluster_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "server_time_utc"),
+				),
+			},
+		},
+	})
+}
+
+const testDataSourceHealth_config = `
+data "vault_health" "test" {}
+`
@@ -0,0 +1,117 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+var identityOidcOpenIDConfigFields = []string{
+	"issuer",
+	"jwks_uri",
+	"authorization_endpoint",
+	"token_endpoint",
+	"userinfo_endpoint",
+	"request_uri_parameter_supported",
+	"id_token_signing_alg_values_supported",
+	"response_types_supported",
+	"scopes_supported",
+	"subject_types_supported",
+	"grant_types_supported",
+	"token_endpoint_auth_methods_supported",
+}
+
+func identityOidcOpenIDConfigDataSource() *schema.Resource {
+	stringList := func(description string) *schema.Schema {
+		return &schema.Schema{
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: description,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		}
+	}
+	computedString := func(description string) *schema.Schema {
+		return &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: description,
+		}
+	}
+
+	return &schema.Resource{
+		Read: identityOidcOpenIDConfigDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the OIDC provider.",
+			},
+			"issuer":                 computedString("The URL of the issuer for the provider."),
+			"jwks_uri":               computedString("The well known keys URI for the provider."),
+			"authorization_endpoint": computedString("The Authorization Endpoint for the provider."),
+			"token_endpoint":         computedString("The Token Endpoint for the provider."),
+			"userinfo_endpoint":      computedString("The User Info Endpoint for the provider."),
+			"request_uri_parameter_supported": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Specifies whether Request URI Parameter is supported by the provider.",
+			},
+			"id_token_signing_alg_values_supported": stringList("The signing algorithms supported by the provider."),
+			"response_types_supported":              stringList("The response types supported by the provider."),
+			"scopes_supported":                      stringList("The scopes supported by the provider."),
+			"subject_types_supported":               stringList("The subject types supported by the provider."),
+			"grant_types_supported":                 stringList("The grant types supported by the provider."),
+			"token_endpoint_auth_methods_supported": stringList("The token endpoint auth methods supported by the provider."),
+		},
+	}
+}
+
+func identityOidcOpenIDConfigDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Get("name").(string)
+	path := identityOidcProviderPath(name) + "/.well-known/openid-configuration"
+
+	log.Printf("[DEBUG] Reading identity OIDC provider config %q", path)
+	config := map[string]interface{}{}
+	if err := identityOidcRawRead(client, path, &config); err != nil {
+		return fmt.Errorf("error reading identity OIDC provider config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read identity OIDC provider config %q", path)
+
+	d.SetId(strings.Trim(name, "/"))
+	for _, k := range identityOidcOpenIDConfigFields {
+		if err := d.Set(k, config[k]); err != nil {
+			return fmt.Errorf("error setting state key %q: %s", k, err)
+		}
+	}
+
+	return nil
+}
+
+// identityOidcRawRead decodes the JSON document at path into v. The OIDC
+// discovery documents aren't wrapped in a secret, so they have to be read
+// with a raw request.
+func identityOidcRawRead(client *api.Client, path string, v interface{}) error {
+	r := client.NewRequest("GET", "/v1/"+path)
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	defer cancelFunc()
+	resp, err := client.RawRequestWithContext(ctx, r)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return err
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
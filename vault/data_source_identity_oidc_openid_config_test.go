@@ -0,0 +1,46 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestDataSourceIdentityOidcOpenIDConfig(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-provider")
+	dataSourceName := "data.vault_identity_oidc_openid_config.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceIdentityOidcOpenIDConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "name", name),
+					resource.TestMatchResourceAttr(dataSourceName, "issuer", regexp.MustCompile("/v1/identity/oidc/provider/"+name+"$")),
+					resource.TestMatchResourceAttr(dataSourceName, "jwks_uri", regexp.MustCompile("/v1/identity/oidc/provider/"+name+"/.well-known/keys$")),
+					resource.TestCheckResourceAttrSet(dataSourceName, "authorization_endpoint"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "token_endpoint"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "userinfo_endpoint"),
+					resource.TestCheckResourceAttr(dataSourceName, "scopes_supported.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceIdentityOidcOpenIDConfig(name string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_oidc_provider" "test" {
+  name = "%s"
+}
+
+data "vault_identity_oidc_openid_config" "test" {
+  name = vault_identity_oidc_provider.test.name
+}
+`, name)
+}
@@ -0,0 +1,104 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+const identityOidcPublicKeysPath = "identity/oidc/.well-known/keys"
+
+func identityOidcPublicKeysDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: identityOidcPublicKeysDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"provider_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the OIDC provider. If unset, the keys used to sign identity tokens are returned.",
+			},
+			"keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The public portion of the keys, in JWK format.",
+				Elem: &schema.Schema{
+					Type: schema.TypeMap,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+			},
+			"key_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The IDs of the keys.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"jwks": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The JSON Web Key Set document.",
+			},
+		},
+	}
+}
+
+func identityOidcPublicKeysDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := identityOidcPublicKeysPath
+	if name, ok := d.GetOk("provider_name"); ok {
+		path = identityOidcProviderPath(name.(string)) + "/.well-known/keys"
+	}
+
+	log.Printf("[DEBUG] Reading identity OIDC public keys %q", path)
+	var jwks struct {
+		Keys []map[string]interface{} `json:"keys"`
+	}
+	if err := identityOidcRawRead(client, path, &jwks); err != nil {
+		return fmt.Errorf("error reading identity OIDC public keys %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read identity OIDC public keys %q", path)
+
+	// Only the scalar members of each JWK can be represented in the keys map,
+	// the complete document is available in jwks.
+	keys := make([]map[string]interface{}, 0, len(jwks.Keys))
+	keyIDs := make([]string, 0, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		key := map[string]interface{}{}
+		for k, v := range jwk {
+			if s, ok := v.(string); ok {
+				key[k] = s
+			}
+		}
+		keys = append(keys, key)
+		if kid, ok := jwk["kid"].(string); ok {
+			keyIDs = append(keyIDs, kid)
+		}
+	}
+
+	doc, err := json.Marshal(jwks)
+	if err != nil {
+		return fmt.Errorf("error encoding identity OIDC public keys %q: %s", path, err)
+	}
+
+	d.SetId(strings.Trim(path, "/"))
+	if err := d.Set("keys", keys); err != nil {
+		return fmt.Errorf("error setting state key %q: %s", "keys", err)
+	}
+	if err := d.Set("key_ids", keyIDs); err != nil {
+		return fmt.Errorf("error setting state key %q: %s", "key_ids", err)
+	}
+	if err := d.Set("jwks", string(doc)); err != nil {
+		return fmt.Errorf("error setting state key %q: %s", "jwks", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestDataSourceIdentityOidcPublicKeys(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-provider")
+	dataSourceName := "data.vault_identity_oidc_public_keys.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceIdentityOidcPublicKeys(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "provider_name", name),
+					resource.TestCheckResourceAttrSet(dataSourceName, "key_ids.#"),
+					resource.TestMatchResourceAttr(dataSourceName, "jwks", regexp.MustCompile(`^\{"keys":\[`)),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceIdentityOidcPublicKeys(name string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_oidc_provider" "test" {
+  name = "%s"
+}
+
+data "vault_identity_oidc_public_keys" "test" {
+  provider_name = vault_identity_oidc_provider.test.name
+}
+`, name)
+}
@@ -0,0 +1,341 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+// importBlocksRoleResource describes how to discover the roles of a backend
+// type and how the matching resource is imported.
+type importBlocksRoleResource struct {
+	resourceType string
+	// listPath and importID are formatted with the mount path, and the
+	// mount path and role name respectively.
+	listPath  string
+	importID  string
+	nameField string
+}
+
+var (
+	importBlocksAuthRoleResources = map[string]importBlocksRoleResource{
+		"approle": {
+			resourceType: "vault_approle_auth_backend_role",
+			listPath:     "auth/%s/role",
+			importID:     "auth/%s/role/%s",
+			nameField:    "role_name",
+		},
+		"jwt": {
+			resourceType: "vault_jwt_auth_backend_role",
+			listPath:     "auth/%s/role",
+			importID:     "auth/%s/role/%s",
+			nameField:    "role_name",
+		},
+		"oidc": {
+			resourceType: "vault_jwt_auth_backend_role",
+			listPath:     "auth/%s/role",
+			importID:     "auth/%s/role/%s",
+			nameField:    "role_name",
+		},
+		"kubernetes": {
+			resourceType: "vault_kubernetes_auth_backend_role",
+			listPath:     "auth/%s/role",
+			importID:     "auth/%s/role/%s",
+			nameField:    "role_name",
+		},
+	}
+
+	importBlocksSecretRoleResources = map[string]importBlocksRoleResource{
+		"aws": {
+			resourceType: "vault_aws_secret_backend_role",
+			listPath:     "%s/roles",
+			importID:     "%s/roles/%s",
+			nameField:    "name",
+		},
+		"database": {
+			resourceType: "vault_database_secret_backend_role",
+			listPath:     "%s/roles",
+			importID:     "%s/roles/%s",
+			nameField:    "name",
+		},
+		"pki": {
+			resourceType: "vault_pki_secret_backend_role",
+			listPath:     "%s/roles",
+			importID:     "%s/roles/%s",
+			nameField:    "name",
+		},
+		"ssh": {
+			resourceType: "vault_ssh_secret_backend_role",
+			listPath:     "%s/roles",
+			importID:     "%s/roles/%s",
+			nameField:    "name",
+		},
+	}
+
+	// importBlocksSystemMounts are mounted by Vault itself and can't be
+	// managed by Terraform.
+	importBlocksSystemMounts = map[string]bool{
+		"sys":       true,
+		"identity":  true,
+		"cubbyhole": true,
+		"token":     true,
+	}
+
+	importBlocksInvalidNameChars = regexp.MustCompile(`[^a-z0-9_-]+`)
+)
+
+// importBlock is a resource found in Vault along with the arguments
+// identifying it in the generated skeleton configuration.
+type importBlock struct {
+	resourceType string
+	resourceName string
+	id           string
+	args         [][2]string
+}
+
+func importBlocksDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: importBlocksDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"include_roles": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Also list the roles of the supported auth and secret backends.",
+			},
+			"skeletons": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Add a skeleton resource block for each import block to hcl.",
+			},
+			"imports": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The resources found in Vault.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the resource.",
+						},
+						"resource_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name given to the resource in the generated configuration.",
+						},
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID used to import the resource.",
+						},
+					},
+				},
+			},
+			"hcl": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Import blocks, and optionally skeleton resource blocks, for the resources found in Vault.",
+			},
+		},
+	}
+}
+
+func importBlocksDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	includeRoles := d.Get("include_roles").(bool)
+
+	var blocks []*importBlock
+
+	log.Printf("[DEBUG] Listing mounts from Vault")
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return fmt.Errorf("error listing mounts from Vault: %s", err)
+	}
+	for _, path := range importBlocksSortedKeys(mounts) {
+		mount := mounts[path]
+		path = strings.TrimSuffix(path, "/")
+		if importBlocksSystemMounts[mount.Type] {
+			continue
+		}
+
+		blocks = append(blocks, &importBlock{
+			resourceType: "vault_mount",
+			resourceName: path,
+			id:           path,
+			args:         [][2]string{{"path", path}, {"type", mount.Type}},
+		})
+
+		if r, ok := importBlocksSecretRoleResources[mount.Type]; ok && includeRoles {
+			roles, err := importBlocksListRoles(client, path, r)
+			if err != nil {
+				return err
+			}
+			blocks = append(blocks, roles...)
+		}
+	}
+
+	log.Printf("[DEBUG] Listing auth backends from Vault")
+	auths, err := client.Sys().ListAuth()
+	if err != nil {
+		return fmt.Errorf("error listing auth backends from Vault: %s", err)
+	}
+	for _, path := range importBlocksSortedKeys(auths) {
+		auth := auths[path]
+		path = strings.TrimSuffix(path, "/")
+		if importBlocksSystemMounts[auth.Type] {
+			continue
+		}
+
+		blocks = append(blocks, &importBlock{
+			resourceType: "vault_auth_backend",
+			resourceName: path,
+			id:           path,
+			args:         [][2]string{{"type", auth.Type}, {"path", path}},
+		})
+
+		if r, ok := importBlocksAuthRoleResources[auth.Type]; ok && includeRoles {
+			roles, err := importBlocksListRoles(client, path, r)
+			if err != nil {
+				return err
+			}
+			blocks = append(blocks, roles...)
+		}
+	}
+
+	log.Printf("[DEBUG] Listing policies from Vault")
+	policies, err := client.Sys().ListPolicies()
+	if err != nil {
+		return fmt.Errorf("error listing policies from Vault: %s", err)
+	}
+	sort.Strings(policies)
+	for _, name := range policies {
+		// the root policy can't be read nor modified
+		if name == "root" {
+			continue
+		}
+		blocks = append(blocks, &importBlock{
+			resourceType: "vault_policy",
+			resourceName: name,
+			id:           name,
+			args:         [][2]string{{"name", name}},
+		})
+	}
+
+	importBlocksAssignNames(blocks)
+
+	imports := make([]map[string]interface{}, 0, len(blocks))
+	for _, b := range blocks {
+		imports = append(imports, map[string]interface{}{
+			"resource_type": b.resourceType,
+			"resource_name": b.resourceName,
+			"id":            b.id,
+		})
+	}
+
+	d.SetId("import-blocks")
+	if err := d.Set("imports", imports); err != nil {
+		return fmt.Errorf("error setting state key %q: %s", "imports", err)
+	}
+	if err := d.Set("hcl", importBlocksHCL(blocks, d.Get("skeletons").(bool))); err != nil {
+		return fmt.Errorf("error setting state key %q: %s", "hcl", err)
+	}
+
+	return nil
+}
+
+func importBlocksListRoles(client *api.Client, path string, r importBlocksRoleResource) ([]*importBlock, error) {
+	listPath := fmt.Sprintf(r.listPath, path)
+
+	log.Printf("[DEBUG] Listing roles at %q", listPath)
+	resp, err := client.Logical().List(listPath)
+	if err != nil {
+		return nil, fmt.Errorf("error listing roles at %q: %s", listPath, err)
+	}
+	if resp == nil {
+		return nil, nil
+	}
+
+	keys, _ := resp.Data["keys"].([]interface{})
+	names := make([]string, 0, len(keys))
+	for _, k := range keys {
+		if name, ok := k.(string); ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	blocks := make([]*importBlock, 0, len(names))
+	for _, name := range names {
+		blocks = append(blocks, &importBlock{
+			resourceType: r.resourceType,
+			resourceName: path + "_" + name,
+			id:           fmt.Sprintf(r.importID, path, name),
+			args:         [][2]string{{"backend", path}, {r.nameField, name}},
+		})
+	}
+
+	return blocks, nil
+}
+
+// importBlocksAssignNames turns the resource names of blocks into valid and
+// unique Terraform identifiers.
+func importBlocksAssignNames(blocks []*importBlock) {
+	seen := map[string]bool{}
+	for _, b := range blocks {
+		name := importBlocksInvalidNameChars.ReplaceAllString(strings.ToLower(b.resourceName), "_")
+		name = strings.Trim(name, "_")
+		if name == "" || (name[0] >= '0' && name[0] <= '9') || name[0] == '-' {
+			name = "_" + name
+		}
+
+		unique := name
+		for i := 2; seen[b.resourceType+"."+unique]; i++ {
+			unique = fmt.Sprintf("%s_%d", name, i)
+		}
+		seen[b.resourceType+"."+unique] = true
+		b.resourceName = unique
+	}
+}
+
+func importBlocksHCL(blocks []*importBlock, skeletons bool) string {
+	var sb strings.Builder
+	for i, b := range blocks {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		fmt.Fprintf(&sb, "import {\n  to = %s.%s\n  id = %s\n}\n", b.resourceType, b.resourceName, strconv.Quote(b.id))
+
+		if !skeletons {
+			continue
+		}
+		fmt.Fprintf(&sb, "\nresource %q %q {\n", b.resourceType, b.resourceName)
+		for _, arg := range b.args {
+			fmt.Fprintf(&sb, "  %s = %s\n", arg[0], strconv.Quote(arg[1]))
+		}
+		sb.WriteString("}\n")
+	}
+
+	return sb.String()
+}
+
+// importBlocksSortedKeys returns the paths of the mounts sorted, so the
+// blocks are always generated in the same order. api.AuthMount is an alias
+// of api.MountOutput, so it sorts the auth methods too.
+func importBlocksSortedKeys(m map[string]*api.MountOutput) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,146 @@
+package vault
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestDataSourceImportBlocks(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-approle")
+	role := acctest.RandomWithPrefix("test-role")
+	policy := acctest.RandomWithPrefix("test-policy")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceImportBlocksConfig(path, role, policy),
+				Check: resource.ComposeTestCheckFunc(
+					testDataSourceImportBlocksCheckID("vault_auth_backend", path),
+					testDataSourceImportBlocksCheckID("vault_approle_auth_backend_role", "auth/"+path+"/role/"+role),
+					testDataSourceImportBlocksCheckID("vault_policy", policy),
+					testDataSourceImportBlocksCheckHCL(fmt.Sprintf("id = %q", "auth/"+path+"/role/"+role)),
+					testDataSourceImportBlocksCheckHCL(fmt.Sprintf("role_name = %q", role)),
+				),
+			},
+		},
+	})
+}
+
+func TestImportBlocksAssignNames(t *testing.T) {
+	blocks := []*importBlock{
+		{resourceType: "vault_mount", resourceName: "kv/team-a"},
+		{resourceType: "vault_mount", resourceName: "kv_team-a"},
+		{resourceType: "vault_auth_backend", resourceName: "kv/team-a"},
+		{resourceType: "vault_policy", resourceName: "1st.Policy"},
+	}
+	importBlocksAssignNames(blocks)
+
+	expected := []string{"kv_team-a", "kv_team-a_2", "kv_team-a", "_1st_policy"}
+	for i, b := range blocks {
+		if b.resourceName != expected[i] {
+			t.Errorf("expected resource name %q for block %d, got %q", expected[i], i, b.resourceName)
+		}
+	}
+}
+
+func TestImportBlocksHCL(t *testing.T) {
+	blocks := []*importBlock{
+		{
+			resourceType: "vault_policy",
+			resourceName: "dev",
+			id:           "dev",
+			args:         [][2]string{{"name", "dev"}},
+		},
+	}
+
+	expected := `import {
+  to = vault_policy.dev
+  id = "dev"
+}
+
+resource "vault_policy" "dev" {
+  name = "dev"
+}
+`
+	if actual := importBlocksHCL(blocks, true); actual != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, actual)
+	}
+
+	expected = `import {
+  to = vault_policy.dev
+  id = "dev"
+}
+`
+	if actual := importBlocksHCL(blocks, false); actual != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, actual)
+	}
+}
+
+func testDataSourceImportBlocksCheckID(resourceType, id string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources["data.vault_import_blocks.test"]
+		if !ok {
+			return fmt.Errorf("resource %q not found in state", "data.vault_import_blocks.test")
+		}
+
+		attrs := rs.Primary.Attributes
+		for k, v := range attrs {
+			if !strings.HasPrefix(k, "imports.") || !strings.HasSuffix(k, ".id") || v != id {
+				continue
+			}
+			typeKey := strings.TrimSuffix(k, ".id") + ".resource_type"
+			if attrs[typeKey] == resourceType {
+				return nil
+			}
+		}
+		return fmt.Errorf("no %s with ID %q found in imports", resourceType, id)
+	}
+}
+
+func testDataSourceImportBlocksCheckHCL(substr string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources["data.vault_import_blocks.test"]
+		if !ok {
+			return fmt.Errorf("resource %q not found in state", "data.vault_import_blocks.test")
+		}
+
+		if !strings.Contains(rs.Primary.Attributes["hcl"], substr) {
+			return fmt.Errorf("expected hcl to contain %q, got:\n%s", substr, rs.Primary.Attributes["hcl"])
+		}
+		return nil
+	}
+}
+
+func testDataSourceImportBlocksConfig(path, role, policy string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "approle" {
+  type = "approle"
+  path = "%s"
+}
+
+resource "vault_approle_auth_backend_role" "role" {
+  backend   = vault_auth_backend.approle.path
+  role_name = "%s"
+}
+
+resource "vault_policy" "test" {
+  name   = "%s"
+  policy = <<EOT
+path "secret/*" {
+  capabilities = ["read"]
+}
+EOT
+}
+
+data "vault_import_blocks" "test" {
+  depends_on = [vault_approle_auth_backend_role.role, vault_policy.test]
+}
+`, path, role, policy)
+}
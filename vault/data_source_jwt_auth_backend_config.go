@@ -0,0 +1,122 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+var jwtAuthBackendConfigDataSourceFields = []string{
+	"oidc_discovery_url",
+	"oidc_discovery_ca_pem",
+	"oidc_client_id",
+	"jwks_url",
+	"jwks_ca_pem",
+	"jwt_validation_pubkeys",
+	"bound_issuer",
+	"jwt_supported_algs",
+	"default_role",
+	"provider_config",
+}
+
+func jwtAuthBackendConfigDataSource() *schema.Resource {
+	computedString := func(description string) *schema.Schema {
+		return &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: description,
+		}
+	}
+	computedList := func(description string) *schema.Schema {
+		return &schema.Schema{
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: description,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		}
+	}
+
+	return &schema.Resource{
+		Read: jwtAuthBackendConfigDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "jwt",
+				Description: "Path of the JWT/OIDC auth backend.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"type":                   computedString("Type of the auth backend, either jwt or oidc."),
+			"accessor":               computedString("The accessor of the auth backend."),
+			"oidc_discovery_url":     computedString("The OIDC Discovery URL, without any .well-known component (base path)."),
+			"oidc_discovery_ca_pem":  computedString("The CA certificate or chain of certificates used to validate connections to the OIDC Discovery URL."),
+			"oidc_client_id":         computedString("The OAuth Client ID configured with the OIDC provider."),
+			"jwks_url":               computedString("JWKS URL to use to authenticate signatures."),
+			"jwks_ca_pem":            computedString("The CA certificate or chain of certificates used to validate connections to the JWKS URL."),
+			"jwt_validation_pubkeys": computedList("A list of PEM-encoded public keys used to authenticate signatures locally."),
+			"bound_issuer":           computedString("The value against which the iss claim in a JWT is matched."),
+			"jwt_supported_algs":     computedList("A list of supported signing algorithms."),
+			"default_role":           computedString("The default role used if none is provided during login."),
+			"provider_config": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Provider specific handling configuration.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"issuer": computedString("The issuer of the JWTs accepted by the backend, the bound issuer if set, otherwise the OIDC Discovery URL."),
+		},
+	}
+}
+
+func jwtAuthBackendConfigDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := strings.Trim(d.Get("path").(string), "/")
+
+	backend, err := getJwtAuthBackendIfPresent(client, path)
+	if err != nil {
+		return fmt.Errorf("unable to check auth backends in Vault for path %s: %s", path, err)
+	}
+	if backend == nil {
+		return fmt.Errorf("no auth backend found at path %q", path)
+	}
+
+	configPath := jwtConfigEndpoint(path)
+	log.Printf("[DEBUG] Reading JWT auth backend config %q", configPath)
+	resp, err := client.Logical().Read(configPath)
+	if err != nil {
+		return fmt.Errorf("error reading JWT auth backend config %q: %s", configPath, err)
+	}
+	log.Printf("[DEBUG] Read JWT auth backend config %q", configPath)
+	if resp == nil {
+		return fmt.Errorf("no config found for JWT auth backend %q", path)
+	}
+
+	d.SetId(path)
+	d.Set("type", backend.Type)
+	d.Set("accessor", backend.Accessor)
+	for _, k := range jwtAuthBackendConfigDataSourceFields {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return fmt.Errorf("error setting state key %q: %s", k, err)
+		}
+	}
+
+	issuer, _ := resp.Data["bound_issuer"].(string)
+	if issuer == "" {
+		issuer, _ = resp.Data["oidc_discovery_url"].(string)
+	}
+	d.Set("issuer", issuer)
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package vault
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestDataSourceJWTAuthBackendConfig(t *testing.T) {
+	path := acctest.RandomWithPrefix("jwt")
+	dataSourceName := "data.vault_jwt_auth_backend_config.config"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testJWTAuthBackend_Destroyed(path),
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceJWTAuthBackendConfig(path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "path", path),
+					resource.TestCheckResourceAttr(dataSourceName, "type", "jwt"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "accessor", "vault_jwt_auth_backend.jwt", "accessor"),
+					resource.TestCheckResourceAttr(dataSourceName, "oidc_discovery_url", "https://myco.auth0.com/"),
+					resource.TestCheckResourceAttr(dataSourceName, "bound_issuer", "api://default"),
+					resource.TestCheckResourceAttr(dataSourceName, "issuer", "api://default"),
+					resource.TestCheckResourceAttr(dataSourceName, "jwt_supported_algs.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "jwt_supported_algs.0", "RS512"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceJWTAuthBackendConfig(path string) string {
+	return testAccJWTAuthBackendConfigFullOIDC(path, "https://myco.auth0.com/", "api://default", "\"RS512\"") + `
+data "vault_jwt_auth_backend_config" "config" {
+  path = vault_jwt_auth_backend.jwt.path
+}
+`
+}
@@ -0,0 +1,71 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+// This test requires a Kubernetes cluster that Vault can reach, along with a
+// service account JWT that is allowed to manage service accounts and tokens.
+func TestAccDataSourceKubernetesServiceAccountToken_basic(t *testing.T) {
+	host := os.Getenv("KUBE_HOST")
+	if host == "" {
+		t.Skip("KUBE_HOST not set")
+	}
+	caCert := os.Getenv("KUBE_CA_CERT")
+	jwt := os.Getenv("KUBE_SERVICE_ACCOUNT_JWT")
+	if jwt == "" {
+		t.Skip("KUBE_SERVICE_ACCOUNT_JWT not set")
+	}
+
+	backend := acctest.RandomWithPrefix("tf-test-kubernetes")
+	dataSourceName := "data.vault_kubernetes_service_account_token.token"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceKubernetesServiceAccountTokenConfig(backend, host, caCert, jwt),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "service_account_namespace", "default"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "service_account_name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "service_account_token"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "lease_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "lease_duration", "600"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceKubernetesServiceAccountTokenConfig(backend, host, caCert, jwt string) string {
+	return fmt.Sprintf(`
+resource "vault_kubernetes_secret_backend" "test" {
+  backend              = "%s"
+  kubernetes_host      = %q
+  kubernetes_ca_cert   = %q
+  service_account_jwt  = %q
+  disable_local_ca_jwt = true
+}
+
+resource "vault_kubernetes_secret_backend_role" "test" {
+  backend                       = vault_kubernetes_secret_backend.test.backend
+  name                          = "test"
+  allowed_kubernetes_namespaces = ["*"]
+  token_max_ttl                 = 1200
+  generated_role_rules          = "rules:\n- apiGroups: [\"\"]\n  resources: [\"pods\"]\n  verbs: [\"list\"]\n"
+}
+
+data "vault_kubernetes_service_account_token" "token" {
+  backend              = vault_kubernetes_secret_backend.test.backend
+  role                 = vault_kubernetes_secret_backend_role.test.name
+  kubernetes_namespace = "default"
+  ttl                  = "600"
+}
+`, backend, host, caCert, jwt)
+}
@@ -0,0 +1,213 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func kvSecretV2MetadataDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: kvSecretV2MetadataDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"mount": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Path where the KV-V2 engine is mounted.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Full name of the secret. For a nested secret, the name is the nested path excluding the mount and data prefix.",
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Full path where the KV-V2 secret metadata was read from.",
+			},
+			"cas_required": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "If true, all keys will require the cas parameter to be set on all write requests.",
+			},
+			"created_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time at which the secret was created.",
+			},
+			"updated_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time at which the secret was last updated.",
+			},
+			"current_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Current version of the secret.",
+			},
+			"oldest_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Oldest version of the secret which has not been permanently destroyed.",
+			},
+			"max_versions": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of versions to keep per key.",
+			},
+			"delete_version_after": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The length of time before a version is deleted.",
+			},
+			"custom_metadata": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Map of custom metadata associated with the secret.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"versions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of all versions of the secret, ordered from oldest to newest.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"version": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Version number of the secret.",
+						},
+						"created_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Time at which the version was created.",
+						},
+						"deletion_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Time at which the version was deleted, empty if the version is not deleted.",
+						},
+						"destroyed": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "True if the version has been permanently destroyed.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func kvSecretV2MetadataDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	mount := strings.Trim(d.Get("mount").(string), "/")
+	name := strings.Trim(d.Get("name").(string), "/")
+	path := kvSecretV2MetadataPath(mount, name)
+
+	log.Printf("[DEBUG] Reading KV-V2 secret metadata from %q", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading KV-V2 secret metadata from %q: %s", path, err)
+	}
+	if secret == nil {
+		return fmt.Errorf("no KV-V2 secret metadata found at %q", path)
+	}
+	log.Printf("[DEBUG] Read KV-V2 secret metadata from %q", path)
+
+	d.SetId(path)
+	d.Set("path", path)
+
+	for _, k := range []string{"cas_required", "created_time", "updated_time", "current_version", "oldest_version", "max_versions", "delete_version_after"} {
+		if err := d.Set(k, secret.Data[k]); err != nil {
+			return fmt.Errorf("error setting %q for %q: %s", k, path, err)
+		}
+	}
+
+	customMetadata := map[string]string{}
+	if v, ok := secret.Data["custom_metadata"].(map[string]interface{}); ok {
+		for k, val := range v {
+			customMetadata[k] = fmt.Sprintf("%v", val)
+		}
+	}
+	if err := d.Set("custom_metadata", customMetadata); err != nil {
+		return fmt.Errorf("error setting custom_metadata for %q: %s", path, err)
+	}
+
+	versions, err := kvSecretV2FlattenVersions(secret.Data["versions"])
+	if err != nil {
+		return fmt.Errorf("error parsing versions for %q: %s", path, err)
+	}
+	if err := d.Set("versions", versions); err != nil {
+		return fmt.Errorf("error setting versions for %q: %s", path, err)
+	}
+
+	return nil
+}
+
+func kvSecretV2MetadataPath(mount, name string) string {
+	return strings.Trim(mount, "/") + "/metadata/" + strings.Trim(name, "/")
+}
+
+// kvSecretV2FlattenVersions converts the map of versions returned by
+// the KV-V2 metadata endpoint into a list ordered by version number.
+func kvSecretV2FlattenVersions(raw interface{}) ([]map[string]interface{}, error) {
+	versionsMap, ok := raw.(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+
+	result := make([]map[string]interface{}, 0, len(versionsMap))
+	for k, v := range versionsMap {
+		version, err := strconv.Atoi(k)
+		if err != nil {
+			return nil, fmt.Errorf("invalid version %q: %s", k, err)
+		}
+
+		versionData, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("unexpected data for version %q: %#v", k, v)
+		}
+
+		var destroyed bool
+		if b, ok := versionData["destroyed"].(bool); ok {
+			destroyed = b
+		}
+
+		result = append(result, map[string]interface{}{
+			"version":       version,
+			"created_time":  kvSecretV2StringValue(versionData["created_time"]),
+			"deletion_time": kvSecretV2StringValue(versionData["deletion_time"]),
+			"destroyed":     destroyed,
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i]["version"].(int) < result[j]["version"].(int)
+	})
+
+	return result, nil
+}
+
+func kvSecretV2StringValue(v interface{}) string {
+	switch s := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return s
+	case json.Number:
+		return s.String()
+	default:
+		return fmt.Sprintf("%v", s)
+	}
+}
@@ -0,0 +1,110 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestDataSourceKVSecretV2Metadata(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-kvv2")
+	name := acctest.RandomWithPrefix("foo")
+	resourceName := "data.vault_kv_secret_v2_metadata.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKVSecretV2Metadata_config(mount, name, "zap"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "path", mount+"/metadata/"+name),
+					resource.TestCheckResourceAttr(resourceName, "current_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "oldest_version", "0"),
+					resource.TestCheckResourceAttr(resourceName, "versions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "versions.0.version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "versions.0.destroyed", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_time"),
+				),
+			},
+			{
+				Config: testDataSourceKVSecretV2Metadata_config(mount, name, "kablamo"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "current_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "versions.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "versions.0.version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "versions.1.version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceKVSecretV2Metadata_config(mount, name, value string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path        = "%s"
+  type        = "kv"
+  options = {
+    "version" = "2"
+  }
+}
+
+resource "vault_generic_secret" "test" {
+  path = "${vault_mount.test.path}/%s"
+  data_json = <<EOT
+{
+  "zip": "%s"
+}
+EOT
+}
+
+data "vault_kv_secret_v2_metadata" "test" {
+  mount = vault_mount.test.path
+  name  = "%[2]s"
+
+  depends_on = [vault_generic_secret.test]
+}
+`, mount, name, value)
+}
+
+func TestKVSecretV2FlattenVersions(t *testing.T) {
+	raw := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(`{
+  "2": {"created_time": "2018-03-22T02:36:43.986212308Z", "deletion_time": "", "destroyed": false},
+  "1": {"created_time": "2018-03-22T02:24:06.945319214Z", "deletion_time": "2018-03-22T02:30:00Z", "destroyed": true}
+}`), &raw); err != nil {
+		t.Fatal(err)
+	}
+
+	actual, err := kvSecretV2FlattenVersions(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"version":       1,
+			"created_time":  "2018-03-22T02:24:06.945319214Z",
+			"deletion_time": "2018-03-22T02:30:00Z",
+			"destroyed":     true,
+		},
+		{
+			"version":       2,
+			"created_time":  "2018-03-22T02:36:43.986212308Z",
+			"deletion_time": "",
+			"destroyed":     false,
+		},
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected %#v, got %#v", expected, actual)
+	}
+
+	if _, err := kvSecretV2FlattenVersions(map[string]interface{}{"foo": map[string]interface{}{}}); err == nil {
+		t.Fatal("expected an error for an invalid version key")
+	}
+}
@@ -0,0 +1,76 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func kvSecretsListV2DataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: kvSecretsListV2DataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"mount": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Path where the KV-V2 engine is mounted.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Full named path of the secret directory to list. For a nested directory, the name is the nested path excluding the mount and metadata prefix.",
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Full path where the KV-V2 secrets were listed from.",
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of all secret names.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func kvSecretsListV2DataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	mount := strings.Trim(d.Get("mount").(string), "/")
+	path := mount + "/metadata"
+	if name := strings.Trim(d.Get("name").(string), "/"); name != "" {
+		path = kvSecretV2MetadataPath(mount, name)
+	}
+
+	log.Printf("[DEBUG] Listing KV-V2 secrets at %q", path)
+	secret, err := client.Logical().List(path)
+	if err != nil {
+		return fmt.Errorf("error listing KV-V2 secrets at %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Listed KV-V2 secrets at %q", path)
+
+	names := []string{}
+	if secret != nil {
+		if keys, ok := secret.Data["keys"].([]interface{}); ok {
+			for _, k := range keys {
+				names = append(names, k.(string))
+			}
+		}
+	}
+
+	d.SetId(path)
+	d.Set("path", path)
+	if err := d.Set("names", names); err != nil {
+		return fmt.Errorf("error setting names for %q: %s", path, err)
+	}
+
+	return nil
+}
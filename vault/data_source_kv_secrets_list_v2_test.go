@@ -0,0 +1,79 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestDataSourceKVSecretsListV2(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-kvv2")
+	resourceName := "data.vault_kv_secrets_list_v2.test"
+	nestedResourceName := "data.vault_kv_secrets_list_v2.nested"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKVSecretsListV2_config(mount),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "path", mount+"/metadata"),
+					resource.TestCheckResourceAttr(resourceName, "names.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "names.0", "bar"),
+					resource.TestCheckResourceAttr(resourceName, "names.1", "foo"),
+					resource.TestCheckResourceAttr(resourceName, "names.2", "nested/"),
+					resource.TestCheckResourceAttr(nestedResourceName, "path", mount+"/metadata/nested"),
+					resource.TestCheckResourceAttr(nestedResourceName, "names.#", "1"),
+					resource.TestCheckResourceAttr(nestedResourceName, "names.0", "baz"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceKVSecretsListV2_config(mount string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path        = "%s"
+  type        = "kv"
+  options = {
+    "version" = "2"
+  }
+}
+
+resource "vault_generic_secret" "foo" {
+  path      = "${vault_mount.test.path}/foo"
+  data_json = jsonencode({ zip = "zap" })
+}
+
+resource "vault_generic_secret" "bar" {
+  path      = "${vault_mount.test.path}/bar"
+  data_json = jsonencode({ zip = "zap" })
+}
+
+resource "vault_generic_secret" "baz" {
+  path      = "${vault_mount.test.path}/nested/baz"
+  data_json = jsonencode({ zip = "zap" })
+}
+
+data "vault_kv_secrets_list_v2" "test" {
+  mount = vault_mount.test.path
+
+  depends_on = [
+    vault_generic_secret.foo,
+    vault_generic_secret.bar,
+    vault_generic_secret.baz,
+  ]
+}
+
+data "vault_kv_secrets_list_v2" "nested" {
+  mount = vault_mount.test.path
+  name  = "nested"
+
+  depends_on = [vault_generic_secret.baz]
+}
+`, mount)
+}
@@ -0,0 +1,69 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func leaderDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: leaderDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"ha_enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether high availability is enabled.",
+			},
+			"is_self": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the node the provider is connected to is the leader.",
+			},
+			"leader_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "API address of the leader.",
+			},
+			"leader_cluster_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Cluster address of the leader.",
+			},
+			"performance_standby": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the node the provider is connected to is a performance standby.",
+			},
+			"performance_standby_last_remote_wal": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Last WAL index received from the leader by a performance standby.",
+			},
+		},
+	}
+}
+
+func leaderDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	log.Printf("[DEBUG] Reading leader")
+	leader, err := client.Sys().Leader()
+	if err != nil {
+		return fmt.Errorf("error reading leader: %s", err)
+	}
+	log.Printf("[DEBUG] Read leader")
+
+	d.SetId("sys/leader")
+	d.Set("ha_enabled", leader.HAEnabled)
+	d.Set("is_self", leader.IsSelf)
+	d.Set("leader_address", leader.LeaderAddress)
+	d.Set("leader_cluster_address", leader.LeaderClusterAddress)
+	d.Set("performance_standby", leader.PerfStandby)
+	d.Set("performance_standby_last_remote_wal", int(leader.PerfStandbyLastRemoteWAL))
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package vault
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestDataSourceLeader(t *testing.T) {
+	resourceName := "data.vault_leader.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceLeader_config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "ha_enabled"),
+					resource.TestCheckResourceAttrSet(resourceName, "is_self"),
+					resource.TestCheckResourceAttr(resourceName, "performance_standby", "false"),
+				),
+			},
+		},
+	})
+}
+
+const testDataSourceLeader_config = `
+data "vault_leader" "test" {}
+`
@@ -0,0 +1,139 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+const licenseStatusPath = "sys/license/status"
+
+func licenseDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: licenseDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"expiry_warning_days": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     30,
+				Description: "Number of days before the license expires from which expires_soon is set.",
+			},
+			"autoloading_used": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the license was autoloaded from the Vault server configuration.",
+			},
+			"license_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the license.",
+			},
+			"customer_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the customer the license was issued to.",
+			},
+			"installation_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the installation the license applies to.",
+			},
+			"issue_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time the license was issued.",
+			},
+			"start_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time the license became valid.",
+			},
+			"expiration_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time the license expires.",
+			},
+			"termination_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time Vault stops working after the license has expired.",
+			},
+			"features": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Features enabled by the license.",
+			},
+			"performance_standby_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of performance standbys allowed by the license.",
+			},
+			"expires_soon": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the license expires within expiry_warning_days.",
+			},
+		},
+	}
+}
+
+func licenseDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	log.Printf("[DEBUG] Reading license status")
+	resp, err := client.Logical().Read(licenseStatusPath)
+	if err != nil {
+		return fmt.Errorf("error reading license status: %s", err)
+	}
+	log.Printf("[DEBUG] Read license status")
+
+	if resp == nil {
+		return fmt.Errorf("no license status returned")
+	}
+
+	license, ok := resp.Data["autoloaded"].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("no license found in license status")
+	}
+
+	d.SetId(licenseStatusPath)
+	d.Set("autoloading_used", resp.Data["autoloading_used"])
+
+	for _, k := range []string{
+		"license_id",
+		"customer_id",
+		"installation_id",
+		"issue_time",
+		"start_time",
+		"expiration_time",
+		"termination_time",
+		"features",
+		"performance_standby_count",
+	} {
+		if err := d.Set(k, license[k]); err != nil {
+			return fmt.Errorf("error setting state key %q: %s", k, err)
+		}
+	}
+
+	expiresSoon := false
+	if v, ok := license["expiration_time"].(string); ok && v != "" {
+		expiration, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return fmt.Errorf("error parsing license expiration time %q: %s", v, err)
+		}
+
+		warningDays := d.Get("expiry_warning_days").(int)
+		if time.Until(expiration) < time.Duration(warningDays)*24*time.Hour {
+			log.Printf("[WARN] Vault license %v expires at %s", license["license_id"], v)
+			expiresSoon = true
+		}
+	}
+	d.Set("expires_soon", expiresSoon)
+
+	return nil
+}
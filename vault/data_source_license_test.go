@@ -0,0 +1,37 @@
+package vault
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestDataSourceLicense(t *testing.T) {
+	isEnterprise := os.Getenv("TF_ACC_ENTERPRISE")
+	if isEnterprise == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+
+	resourceName := "data.vault_license.test"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceLicense_config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "expiry_warning_days", "30"),
+					resource.TestCheckResourceAttrSet(resourceName, "license_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "expiration_time"),
+					resource.TestCheckResourceAttrSet(resourceName, "features.#"),
+					resource.TestCheckResourceAttrSet(resourceName, "expires_soon"),
+				),
+			},
+		},
+	})
+}
+
+const testDataSourceLicense_config = `
+data "vault_license" "test" {}
+`
@@ -0,0 +1,205 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func mountsDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return mountTableDataSourceRead(d, meta, "sys/mounts", "mounts")
+		},
+		Schema: mountTableDataSourceSchema("mounts", "The secret engines mounted in Vault."),
+	}
+}
+
+// mountTableDataSourceSchema returns the schema shared by the data sources
+// listing the secret engine and auth method mount tables, key is the name of
+// the attribute holding the entries of the table.
+func mountTableDataSourceSchema(key, description string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"type": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Only list the mounts of this type.",
+		},
+		"paths": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The paths of the mounts.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"accessors": {
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Description: "Map of the mount paths to their accessors.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		key: {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: description,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"path": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The mount path.",
+					},
+					"type": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The type of the mount.",
+					},
+					"description": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The description of the mount.",
+					},
+					"accessor": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The accessor of the mount.",
+					},
+					"local": {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "Whether the mount is local only.",
+					},
+					"seal_wrap": {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "Whether seal wrapping is enabled for the mount.",
+					},
+					"options": {
+						Type:        schema.TypeMap,
+						Computed:    true,
+						Description: "The options of the mount.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"default_lease_ttl_seconds": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "Default lease duration in seconds.",
+					},
+					"max_lease_ttl_seconds": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "Maximum possible lease duration in seconds.",
+					},
+					"running_plugin_version": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The version of the plugin serving the mount, only reported by Vault 1.12 and later.",
+					},
+				},
+			},
+		},
+	}
+}
+
+// mountTableDataSourceRead reads the mount table at path. The raw response is
+// used rather than the typed api.MountOutput since the latter doesn't carry
+// every field of the mount table, e.g. the running plugin version.
+func mountTableDataSourceRead(d *schema.ResourceData, meta interface{}, path, key string) error {
+	client := meta.(*api.Client)
+
+	log.Printf("[DEBUG] Reading mount table %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading mount table %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read mount table %q", path)
+	if resp == nil {
+		return fmt.Errorf("no mount table found at %q", path)
+	}
+
+	mountType := d.Get("type").(string)
+
+	var paths []string
+	mounts := map[string]map[string]interface{}{}
+	for mountPath, raw := range resp.Data {
+		mount, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if mountType != "" && mount["type"] != mountType {
+			continue
+		}
+
+		mountPath = strings.TrimSuffix(mountPath, "/")
+		paths = append(paths, mountPath)
+		mounts[mountPath] = mount
+	}
+	sort.Strings(paths)
+
+	accessors := map[string]interface{}{}
+	entries := make([]map[string]interface{}, 0, len(paths))
+	for _, mountPath := range paths {
+		mount := mounts[mountPath]
+
+		entry := map[string]interface{}{
+			"path":                   mountPath,
+			"type":                   mount["type"],
+			"description":            mount["description"],
+			"accessor":               mount["accessor"],
+			"local":                  mount["local"],
+			"seal_wrap":              mount["seal_wrap"],
+			"running_plugin_version": mount["running_plugin_version"],
+		}
+
+		options := map[string]interface{}{}
+		if v, ok := mount["options"].(map[string]interface{}); ok {
+			for k, o := range v {
+				options[k] = fmt.Sprintf("%v", o)
+			}
+		}
+		entry["options"] = options
+
+		if config, ok := mount["config"].(map[string]interface{}); ok {
+			for src, dest := range map[string]string{
+				"default_lease_ttl": "default_lease_ttl_seconds",
+				"max_lease_ttl":     "max_lease_ttl_seconds",
+			} {
+				if v, ok := config[src].(json.Number); ok {
+					ttl, err := v.Int64()
+					if err != nil {
+						return fmt.Errorf("unexpected value %q for %s of mount %q: %s", v, src, mountPath, err)
+					}
+					entry[dest] = ttl
+				}
+			}
+		}
+
+		if accessor, ok := mount["accessor"].(string); ok {
+			accessors[mountPath] = accessor
+		}
+		entries = append(entries, entry)
+	}
+
+	d.SetId(path)
+	if err := d.Set("paths", paths); err != nil {
+		return fmt.Errorf("error setting state key %q: %s", "paths", err)
+	}
+	if err := d.Set("accessors", accessors); err != nil {
+		return fmt.Errorf("error setting state key %q: %s", "accessors", err)
+	}
+	if err := d.Set(key, entries); err != nil {
+		return fmt.Errorf("error setting state key %q: %s", key, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestDataSourceMounts(t *testing.T) {
+	path := acctest.RandomWithPrefix("kv")
+	dataSourceName := "data.vault_mounts.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceMountsConfig(path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "type", "nomad"),
+					resource.TestCheckResourceAttr(dataSourceName, "paths.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "paths.0", path),
+					resource.TestCheckResourceAttrPair(dataSourceName, "accessors."+path, "vault_mount.test", "accessor"),
+					resource.TestCheckResourceAttr(dataSourceName, "mounts.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "mounts.0.path", path),
+					resource.TestCheckResourceAttr(dataSourceName, "mounts.0.type", "nomad"),
+					resource.TestCheckResourceAttr(dataSourceName, "mounts.0.default_lease_ttl_seconds", "3600"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceMountsConfig(path string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path                      = "%s"
+  type                      = "nomad"
+  default_lease_ttl_seconds = 3600
+}
+
+data "vault_mounts" "test" {
+  type       = "nomad"
+  depends_on = [vault_mount.test]
+}
+`, path)
+}
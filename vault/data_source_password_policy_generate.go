@@ -0,0 +1,52 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func passwordPolicyGenerateDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: passwordPolicyGenerateDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the password policy to generate a password from.",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The generated password.",
+			},
+		},
+	}
+}
+
+func passwordPolicyGenerateDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Get("name").(string)
+	path := fmt.Sprintf("sys/policies/password/%s/generate", name)
+
+	log.Printf("[DEBUG] Generating password from password policy %q", name)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error generating password from password policy %q: %s", name, err)
+	}
+	log.Printf("[DEBUG] Generated password from password policy %q", name)
+
+	if secret == nil {
+		return fmt.Errorf("no password policy found with name %q", name)
+	}
+
+	d.SetId(name)
+	d.Set("password", secret.Data["password"])
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestDataSourcePasswordPolicyGenerate(t *testing.T) {
+	policyName := acctest.RandomWithPrefix("test-policy")
+	resourceName := "data.vault_password_policy_generate.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourcePasswordPolicyGenerate_config(policyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", policyName),
+					resource.TestMatchResourceAttr(resourceName, "password", regexp.MustCompile("^[abcde]{20}$")),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourcePasswordPolicyGenerate_config(policyName string) string {
+	return fmt.Sprintf(`
+resource "vault_password_policy" "test" {
+  name   = "%s"
+  policy = <<EOT
+length = 20
+rule "charset" {
+  charset = "abcde"
+}
+EOT
+}
+
+data "vault_password_policy_generate" "test" {
+  name = vault_password_policy.test.name
+}
+`, policyName)
+}
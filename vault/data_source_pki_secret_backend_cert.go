@@ -0,0 +1,94 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func pkiSecretBackendCertDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: pkiSecretBackendCertDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The path of the PKI secret backend to look up the certificate in.",
+			},
+			"serial_number": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The serial number of the certificate, in hyphen-separated or colon-separated hexadecimal.",
+			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The PEM encoded certificate.",
+			},
+			"issuer_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the issuer of the certificate. Only set on Vault 1.11+.",
+			},
+			"ca_chain": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The CA chain of the certificate.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"revoked": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the certificate has been revoked.",
+			},
+			"revocation_time": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The time at which the certificate was revoked, in unix epoch format. 0 if not revoked.",
+			},
+			"revocation_time_rfc3339": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time at which the certificate was revoked, in RFC 3339 format.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendCertDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	serial := d.Get("serial_number").(string)
+	path := pkiSecretBackendCertLookupPath(backend, serial)
+
+	log.Printf("[DEBUG] Reading certificate %q from PKI secret backend %q", serial, backend)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading certificate %q from PKI secret backend %q: %s", serial, backend, err)
+	}
+	if resp == nil {
+		return fmt.Errorf("certificate %q not found in PKI secret backend %q", serial, backend)
+	}
+	log.Printf("[DEBUG] Read certificate %q from PKI secret backend %q", serial, backend)
+
+	d.SetId(path)
+	d.Set("certificate", resp.Data["certificate"])
+	d.Set("issuer_id", resp.Data["issuer_id"])
+	d.Set("revocation_time", resp.Data["revocation_time"])
+	d.Set("revocation_time_rfc3339", resp.Data["revocation_time_rfc3339"])
+
+	revoked := d.Get("revocation_time").(int) > 0
+	d.Set("revoked", revoked)
+
+	if v, ok := resp.Data["ca_chain"].([]interface{}); ok {
+		if err := d.Set("ca_chain", v); err != nil {
+			return fmt.Errorf("error setting ca_chain for certificate %q: %s", serial, err)
+		}
+	}
+
+	return nil
+}
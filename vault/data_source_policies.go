@@ -0,0 +1,45 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func policiesDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: policiesDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Names of the ACL policies.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func policiesDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	log.Printf("[DEBUG] Listing policies from Vault")
+	names, err := client.Sys().ListPolicies()
+	if err != nil {
+		return fmt.Errorf("error listing policies from Vault: %s", err)
+	}
+	sort.Strings(names)
+
+	d.SetId("policies")
+	if err := d.Set("names", names); err != nil {
+		return fmt.Errorf("error setting state key %q: %s", "names", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package vault
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestDataSourcePolicies(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-policy")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourcePoliciesConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testDataSourcePoliciesCheckName("data.vault_policies.test", name),
+					testDataSourcePoliciesCheckName("data.vault_policies.test", "default"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourcePoliciesCheckName(resourceName, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %q not found in state", resourceName)
+		}
+
+		for k, v := range rs.Primary.Attributes {
+			if strings.HasPrefix(k, "names.") && k != "names.#" && v == name {
+				return nil
+			}
+		}
+		return fmt.Errorf("policy %q not found in %s", name, resourceName)
+	}
+}
+
+func testDataSourcePoliciesConfig(name string) string {
+	return testResourcePolicy_initialConfig(name) + `
+data "vault_policies" "test" {
+  depends_on = [vault_policy.test]
+}
+`
+}
@@ -0,0 +1,48 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func policyDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: policyDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the policy.",
+			},
+			"policy": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The policy document.",
+			},
+		},
+	}
+}
+
+func policyDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Get("name").(string)
+
+	log.Printf("[DEBUG] Reading policy %s from Vault", name)
+	policy, err := client.Sys().GetPolicy(name)
+	if err != nil {
+		return fmt.Errorf("error reading from Vault: %s", err)
+	}
+	if policy == "" {
+		return fmt.Errorf("no policy found with name %q", name)
+	}
+
+	d.SetId(name)
+	d.Set("policy", policy)
+
+	return nil
+}
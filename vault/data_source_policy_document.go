@@ -3,6 +3,7 @@ package vault
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -24,9 +25,37 @@ type PolicyRule struct {
 	RequiredParameters []string
 	AllowedParameters  map[string][]string
 	DeniedParameters   map[string][]string
+	ControlGroup       *PolicyControlGroup
 }
 
-var allowedCapabilities = []string{"create", "read", "update", "delete", "list", "sudo", "deny"}
+type PolicyControlGroup struct {
+	TTL     string
+	Factors []*PolicyControlGroupFactor
+}
+
+type PolicyControlGroupFactor struct {
+	Name                   string
+	GroupNames             []string
+	Approvals              int
+	ControlledCapabilities []string
+}
+
+var allowedCapabilities = []string{"create", "read", "update", "patch", "delete", "list", "sudo", "deny"}
+
+var (
+	policyTemplateRegex = regexp.MustCompile(`{{\s*([^}]*?)\s*}}`)
+
+	// policyTemplateParamRegexes match the identity parameters that can be
+	// used in templated policy paths.
+	policyTemplateParamRegexes = []*regexp.Regexp{
+		regexp.MustCompile(`^identity\.entity\.(id|name)$`),
+		regexp.MustCompile(`^identity\.entity\.metadata\.[^.]+$`),
+		regexp.MustCompile(`^identity\.entity\.aliases\.[^.]+\.(id|name)$`),
+		regexp.MustCompile(`^identity\.entity\.aliases\.[^.]+\.(metadata|custom_metadata)\.[^.]+$`),
+		regexp.MustCompile(`^identity\.groups\.(ids|names)\.[^.]+\.(id|name)$`),
+		regexp.MustCompile(`^identity\.groups\.(ids|names)\.[^.]+\.metadata\.[^.]+$`),
+	}
+)
 
 func policyDocumentDataSource() *schema.Resource {
 	return &schema.Resource{
@@ -40,8 +69,9 @@ func policyDocumentDataSource() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"path": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: policyPathValidation,
 						},
 
 						"description": {
@@ -97,6 +127,57 @@ func policyDocumentDataSource() *schema.Resource {
 							},
 						},
 
+						"control_group": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Control group requiring authorization before requests to the path are allowed. Requires Vault Enterprise.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ttl": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									"factor": {
+										Type:     schema.TypeList,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+
+												"group_names": {
+													Type:     schema.TypeList,
+													Required: true,
+													Elem: &schema.Schema{
+														Type: schema.TypeString,
+													},
+												},
+
+												"approvals": {
+													Type:     schema.TypeInt,
+													Required: true,
+												},
+
+												"controlled_capabilities": {
+													Type:     schema.TypeList,
+													Optional: true,
+													Elem: &schema.Schema{
+														Type:         schema.TypeString,
+														ValidateFunc: capabilityValidation,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+
 						"denied_parameter": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -171,6 +252,10 @@ func policyDocumentDataSourceRead(d *schema.ResourceData, meta interface{}) erro
 				}
 			}
 
+			if controlGroupIntfs := rawRule["control_group"].([]interface{}); len(controlGroupIntfs) > 0 && controlGroupIntfs[0] != nil {
+				rule.ControlGroup = policyDecodeControlGroup(controlGroupIntfs[0].(map[string]interface{}))
+			}
+
 			log.Printf("[DEBUG] Rule is: %#v", rule)
 
 			rules[i] = rule
@@ -200,6 +285,43 @@ func capabilityValidation(configI interface{}, k string) ([]string, []error) {
 	return nil, []error{fmt.Errorf("invalid capability: \"%s\" in: %s", configI.(string), k)}
 }
 
+// policyPathValidation checks the use of wildcards and identity templates in
+// a policy path, which Vault otherwise only reports when the policy is used.
+func policyPathValidation(configI interface{}, k string) ([]string, []error) {
+	path := configI.(string)
+	var errs []error
+
+	if i := strings.Index(path, "*"); i != -1 && i != len(path)-1 {
+		errs = append(errs, fmt.Errorf("invalid path %q in %s: the glob wildcard * may only be used at the end of the path", path, k))
+	}
+
+	for _, segment := range strings.Split(path, "/") {
+		if strings.Contains(segment, "+") && segment != "+" {
+			errs = append(errs, fmt.Errorf("invalid path %q in %s: the wildcard + must be used as an entire path segment", path, k))
+			break
+		}
+	}
+
+	if strings.Count(path, "{{") != strings.Count(path, "}}") {
+		errs = append(errs, fmt.Errorf("invalid path %q in %s: unbalanced identity template braces", path, k))
+	}
+
+	for _, match := range policyTemplateRegex.FindAllStringSubmatch(path, -1) {
+		valid := false
+		for _, r := range policyTemplateParamRegexes {
+			if r.MatchString(match[1]) {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			errs = append(errs, fmt.Errorf("invalid path %q in %s: unknown identity template parameter %q", path, k, match[1]))
+		}
+	}
+
+	return nil, errs
+}
+
 func policyDecodeConfigListOfStrings(input []interface{}) []string {
 	output := make([]string, len(input))
 	for i, v := range input {
@@ -224,6 +346,27 @@ func policyDecodeConfigListOfMapsOfListToString(input []interface{}) (map[string
 	return output, nil
 }
 
+func policyDecodeControlGroup(input map[string]interface{}) *PolicyControlGroup {
+	controlGroup := &PolicyControlGroup{
+		TTL: input["ttl"].(string),
+	}
+
+	for _, factorI := range input["factor"].([]interface{}) {
+		rawFactor := factorI.(map[string]interface{})
+		factor := &PolicyControlGroupFactor{
+			Name:       rawFactor["name"].(string),
+			GroupNames: policyDecodeConfigListOfStrings(rawFactor["group_names"].([]interface{})),
+			Approvals:  rawFactor["approvals"].(int),
+		}
+		if capabilityIntfs := rawFactor["controlled_capabilities"].([]interface{}); len(capabilityIntfs) > 0 {
+			factor.ControlledCapabilities = policyDecodeConfigListOfStrings(capabilityIntfs)
+		}
+		controlGroup.Factors = append(controlGroup.Factors, factor)
+	}
+
+	return controlGroup
+}
+
 func policyRenderListOfStrings(items []string) string {
 	if len(items) > 0 {
 		return fmt.Sprintf(`["%s"]`, strings.Join(items, `", "`))
@@ -248,6 +391,28 @@ func policyRenderListOfMapsOfListToString(input map[string][]string) string {
 	return fmt.Sprintf("%s  }", output)
 }
 
+func policyRenderControlGroup(controlGroup *PolicyControlGroup) string {
+	output := "{\n"
+
+	if controlGroup.TTL != "" {
+		output = fmt.Sprintf("%s    ttl = \"%s\"\n", output, controlGroup.TTL)
+	}
+
+	for _, factor := range controlGroup.Factors {
+		output = fmt.Sprintf("%s    factor \"%s\" {\n", output, factor.Name)
+		output = fmt.Sprintf("%s      identity {\n", output)
+		output = fmt.Sprintf("%s        group_names = %s\n", output, policyRenderListOfStrings(factor.GroupNames))
+		output = fmt.Sprintf("%s        approvals = %d\n", output, factor.Approvals)
+		output = fmt.Sprintf("%s      }\n", output)
+		if factor.ControlledCapabilities != nil {
+			output = fmt.Sprintf("%s      controlled_capabilities = %s\n", output, policyRenderListOfStrings(factor.ControlledCapabilities))
+		}
+		output = fmt.Sprintf("%s    }\n", output)
+	}
+
+	return fmt.Sprintf("%s  }", output)
+}
+
 func policyRenderPolicyRule(rule *PolicyRule) string {
 	renderedRule := fmt.Sprintf("path \"%s\" {\n", rule.Path)
 	renderedRule = fmt.Sprintf("%s  capabilities = %s\n", renderedRule, policyRenderListOfStrings(rule.Capabilities))
@@ -276,6 +441,10 @@ func policyRenderPolicyRule(rule *PolicyRule) string {
 		renderedRule = fmt.Sprintf("%s  max_wrapping_ttl = \"%s\"\n", renderedRule, rule.MaxWrappingTTL)
 	}
 
+	if rule.ControlGroup != nil {
+		renderedRule = fmt.Sprintf("%s  control_group = %s\n", renderedRule, policyRenderControlGroup(rule.ControlGroup))
+	}
+
 	return fmt.Sprintf("%s}\n", renderedRule)
 }
 
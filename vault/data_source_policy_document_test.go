@@ -81,6 +81,27 @@ data "vault_policy_document" "test" {
     path                = "secret/test3/"
     capabilities        = ["read", "list"]
   }
+
+  rule {
+    path         = "secret/data/{{identity.entity.name}}/+/*"
+    capabilities = ["read", "patch"]
+  }
+
+  rule {
+    path         = "secret/data/restricted"
+    capabilities = ["read", "update"]
+
+    control_group {
+      ttl = "4h"
+
+      factor {
+        name                    = "managers"
+        group_names             = ["managers", "leads"]
+        approvals               = 2
+        controlled_capabilities = ["update"]
+      }
+    }
+  }
 }
 `
 
@@ -116,6 +137,24 @@ path "secret/test2/*" {
 path "secret/test3/" {
   capabilities = ["read", "list"]
 }
+
+path "secret/data/{{identity.entity.name}}/+/*" {
+  capabilities = ["read", "patch"]
+}
+
+path "secret/data/restricted" {
+  capabilities = ["read", "update"]
+  control_group = {
+    ttl = "4h"
+    factor "managers" {
+      identity {
+        group_names = ["managers", "leads"]
+        approvals = 2
+      }
+      controlled_capabilities = ["update"]
+    }
+  }
+}
 `
 
 func testDataSourcePolicyDocument_check(s *terraform.State) error {
@@ -135,3 +174,28 @@ func testDataSourcePolicyDocument_check(s *terraform.State) error {
 
 	return nil
 }
+
+func TestPolicyPathValidation(t *testing.T) {
+	tests := []struct {
+		path    string
+		wantErr bool
+	}{
+		{path: "secret/data/*"},
+		{path: "secret/+/foo"},
+		{path: "secret/data/{{identity.entity.id}}/*"},
+		{path: "secret/data/{{ identity.entity.metadata.team }}/*"},
+		{path: "secret/data/{{identity.entity.aliases.auth_userpass_6671d643.name}}"},
+		{path: "secret/data/{{identity.groups.names.admins.id}}/*"},
+		{path: "secret/*/foo", wantErr: true},
+		{path: "secret/foo+/bar", wantErr: true},
+		{path: "secret/data/{{identity.entity.id}/*", wantErr: true},
+		{path: "secret/data/{{identity.entity.nmae}}/*", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		_, errs := policyPathValidation(tt.path, "path")
+		if gotErr := len(errs) > 0; gotErr != tt.wantErr {
+			t.Errorf("policyPathValidation(%q) returned errors %v, wantErr %t", tt.path, errs, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package vault
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestDataSourcePolicy(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-policy")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourcePolicyConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_policy.test", "name", name),
+					resource.TestCheckResourceAttrPair("data.vault_policy.test", "policy", "vault_policy.test", "policy"),
+				),
+			},
+			{
+				Config: `
+data "vault_policy" "missing" {
+  name = "does-not-exist"
+}
+`,
+				ExpectError: regexp.MustCompile(`no policy found with name "does-not-exist"`),
+			},
+		},
+	})
+}
+
+func testDataSourcePolicyConfig(name string) string {
+	return testResourcePolicy_initialConfig(name) + `
+data "vault_policy" "test" {
+  name = vault_policy.test.name
+}
+`
+}
@@ -0,0 +1,105 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func sealStatusDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: sealStatusDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Type of the seal, such as shamir or awskms.",
+			},
+			"initialized": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether Vault has been initialized.",
+			},
+			"sealed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether Vault is sealed.",
+			},
+			"threshold": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of key shares required to unseal Vault.",
+			},
+			"shares": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of key shares the unseal key was split into.",
+			},
+			"progress": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of key shares provided towards the current unseal.",
+			},
+			"migration": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether a seal migration is in progress.",
+			},
+			"recovery_seal": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether Vault uses recovery keys rather than unseal keys.",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Version of the Vault server.",
+			},
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the Vault cluster.",
+			},
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the Vault cluster.",
+			},
+			"storage_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Type of the storage backend.",
+			},
+		},
+	}
+}
+
+func sealStatusDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	log.Printf("[DEBUG] Reading seal status")
+	status, err := client.Sys().SealStatus()
+	if err != nil {
+		return fmt.Errorf("error reading seal status: %s", err)
+	}
+	log.Printf("[DEBUG] Read seal status")
+
+	d.SetId("sys/seal-status")
+	d.Set("type", status.Type)
+	d.Set("initialized", status.Initialized)
+	d.Set("sealed", status.Sealed)
+	d.Set("threshold", status.T)
+	d.Set("shares", status.N)
+	d.Set("progress", status.Progress)
+	d.Set("migration", status.Migration)
+	d.Set("recovery_seal", status.RecoverySeal)
+	d.Set("version", status.Version)
+	d.Set("cluster_name", status.ClusterName)
+	d.Set("cluster_id", status.ClusterID)
+	d.Set("storage_type", status.StorageType)
+
+	return nil
+}
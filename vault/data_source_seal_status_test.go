@@ -0,0 +1,32 @@
+package vault
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestDataSourceSealStatus(t *testing.T) {
+	resourceName := "data.vault_seal_status.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceSealStatus_config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "initialized", "true"),
+					resource.TestCheckResourceAttr(resourceName, "sealed", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "type"),
+					resource.TestCheckResourceAttrSet(resourceName, "version"),
+					resource.TestCheckResourceAttrSet(resourceName, "cluster_id"),
+				),
+			},
+		},
+	})
+}
+
+const testDataSourceSealStatus_config = `
+data "vault_seal_status" "test" {}
+`
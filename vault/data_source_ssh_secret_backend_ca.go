@@ -0,0 +1,51 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func sshSecretBackendCADataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: sshSecretBackendCADataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "ssh",
+				Description: "The path of the SSH Secret Backend to read the CA public key from.",
+			},
+			"public_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Public key part of the SSH CA key pair.",
+			},
+		},
+	}
+}
+
+func sshSecretBackendCADataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := strings.Trim(d.Get("backend").(string), "/")
+
+	log.Printf("[DEBUG] Reading CA information from SSH backend %q", backend)
+	secret, err := client.Logical().Read(backend + "/config/ca")
+	if err != nil {
+		return fmt.Errorf("Error reading CA information from SSH backend %q: %s", backend, err)
+	}
+	if secret == nil {
+		return fmt.Errorf("no CA information found in SSH backend %q", backend)
+	}
+	log.Printf("[DEBUG] Read CA information from SSH backend %q", backend)
+
+	d.SetId(backend)
+	d.Set("public_key", secret.Data["public_key"])
+
+	return nil
+}
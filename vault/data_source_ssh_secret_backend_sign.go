@@ -0,0 +1,19 @@
+package vault
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func sshSecretBackendSignDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: sshSecretBackendSignDataSourceRead,
+
+		Schema: sshSecretBackendSignSchema(false),
+	}
+}
+
+// sshSecretBackendSignDataSourceRead signs the public key on every refresh,
+// so that short-lived certificates are always fresh.
+func sshSecretBackendSignDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	return sshSecretBackendSign(d, meta)
+}
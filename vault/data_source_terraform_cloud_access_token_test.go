@@ -0,0 +1,61 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+func TestAccDataSourceTerraformCloudAccessToken_user(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-terraform-cloud")
+	name := acctest.RandomWithPrefix("tf-test-name")
+	token := os.Getenv("TEST_TF_TOKEN")
+	userId := os.Getenv("TEST_TF_USER_ID")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			util.TestAccPreCheck(t)
+			if token == "" || userId == "" {
+				t.Skipf("TEST_TF_TOKEN and TEST_TF_USER_ID must be set. Are currently %s and %s respectively", token, userId)
+			}
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTerraformCloudAccessTokenConfig(backend, token, name, userId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vault_terraform_cloud_access_token.token", "token"),
+					resource.TestCheckResourceAttrSet("data.vault_terraform_cloud_access_token.token", "token_id"),
+					resource.TestCheckResourceAttrSet("data.vault_terraform_cloud_access_token.token", "lease_id"),
+					resource.TestCheckResourceAttr("data.vault_terraform_cloud_access_token.token", "lease_duration", "120"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceTerraformCloudAccessTokenConfig(backend, token, name, userId string) string {
+	return fmt.Sprintf(`
+resource "vault_terraform_cloud_secret_backend" "test" {
+  backend     = "%s"
+  description = "test description"
+  token       = "%s"
+}
+
+resource "vault_terraform_cloud_secret_role" "test" {
+  backend = vault_terraform_cloud_secret_backend.test.backend
+  name    = "%s"
+  user_id = "%s"
+  ttl     = 120
+}
+
+data "vault_terraform_cloud_access_token" "token" {
+  backend = vault_terraform_cloud_secret_backend.test.backend
+  role    = vault_terraform_cloud_secret_role.test.name
+}
+`, backend, token, name, userId)
+}
@@ -0,0 +1,58 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func totpCodeDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: totpCodeDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "totp",
+				Description: "The path of the TOTP secret backend the key belongs to.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the key to generate a code for.",
+			},
+			"code": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The current TOTP code for the key.",
+			},
+		},
+	}
+}
+
+func totpCodeDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := strings.Trim(d.Get("backend").(string), "/") + "/code/" + d.Get("name").(string)
+
+	log.Printf("[DEBUG] Generating TOTP code from %q", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error generating TOTP code from %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Generated TOTP code from %q", path)
+
+	if secret == nil {
+		return fmt.Errorf("no key found at path %q", path)
+	}
+
+	d.SetId(path)
+	d.Set("code", secret.Data["code"])
+
+	return nil
+}
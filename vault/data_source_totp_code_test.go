@@ -0,0 +1,51 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+func TestAccDataSourceTOTPCode(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-totp")
+	name := acctest.RandomWithPrefix("tf-test-key")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceTOTPCodeConfig(backend, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.vault_totp_code.test", "code", regexp.MustCompile("^[0-9]{6}$")),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceTOTPCodeConfig(backend, name string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "totp" {
+  path = "%s"
+  type = "totp"
+}
+
+resource "vault_totp_secret_backend_key" "test" {
+  backend      = vault_mount.totp.path
+  name         = "%s"
+  generate     = true
+  issuer       = "Vault"
+  account_name = "test@example.com"
+}
+
+data "vault_totp_code" "test" {
+  backend = vault_mount.totp.path
+  name    = vault_totp_secret_backend_key.test.name
+}
+`, backend, name)
+}
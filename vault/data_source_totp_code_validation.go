@@ -0,0 +1,65 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func totpCodeValidationDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: totpCodeValidationDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "totp",
+				Description: "The path of the TOTP secret backend the key belongs to.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the key to validate the code against.",
+			},
+			"code": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The TOTP code to validate.",
+			},
+			"valid": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the code is valid for the key.",
+			},
+		},
+	}
+}
+
+func totpCodeValidationDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := strings.Trim(d.Get("backend").(string), "/") + "/code/" + d.Get("name").(string)
+
+	log.Printf("[DEBUG] Validating TOTP code at %q", path)
+	secret, err := client.Logical().Write(path, map[string]interface{}{
+		"code": d.Get("code").(string),
+	})
+	if err != nil {
+		return fmt.Errorf("error validating TOTP code at %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Validated TOTP code at %q", path)
+
+	if secret == nil {
+		return fmt.Errorf("no response validating TOTP code at %q", path)
+	}
+
+	d.SetId(path)
+	d.Set("valid", secret.Data["valid"])
+
+	return nil
+}
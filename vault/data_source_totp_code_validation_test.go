@@ -0,0 +1,54 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+func TestAccDataSourceTOTPCodeValidation(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-totp")
+	name := acctest.RandomWithPrefix("tf-test-key")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { util.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				// a valid code can only be used once, which would make the
+				// refresh after apply fail, so only the negative case is tested
+				Config: testAccDataSourceTOTPCodeValidationConfig(backend, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_totp_code_validation.test", "valid", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceTOTPCodeValidationConfig(backend, name string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "totp" {
+  path = "%s"
+  type = "totp"
+}
+
+resource "vault_totp_secret_backend_key" "test" {
+  backend      = vault_mount.totp.path
+  name         = "%s"
+  generate     = true
+  exported     = false
+  issuer       = "Vault"
+  account_name = "test@example.com"
+}
+
+data "vault_totp_code_validation" "test" {
+  backend = vault_mount.totp.path
+  name    = vault_totp_secret_backend_key.test.name
+  code    = "12345"
+}
+`, backend, name)
+}
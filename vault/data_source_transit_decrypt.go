@@ -3,6 +3,9 @@ package vault
 import (
 	"encoding/base64"
 	"fmt"
+	"log"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/vault/api"
 )
@@ -29,14 +32,45 @@ func transitDecryptDataSource() *schema.Resource {
 				Sensitive:   true,
 			},
 			"context": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Specifies the context for key derivation",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Specifies the context for key derivation",
+				ConflictsWith: []string{"batch_input"},
 			},
 			"ciphertext": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Transit encrypted cipher text.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Transit encrypted cipher text.",
+				ConflictsWith: []string{"batch_input"},
+			},
+			"batch_input": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Description:   "List of items to decrypt in a single request.",
+				ConflictsWith: []string{"ciphertext"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ciphertext": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Transit encrypted cipher text.",
+						},
+						"context": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Specifies the context for key derivation",
+						},
+					},
+				},
+			},
+			"batch_results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Decrypted plain texts, in the same order as batch_input.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
 			},
 		},
 	}
@@ -47,7 +81,43 @@ func transitDecryptDataSourceRead(d *schema.ResourceData, meta interface{}) erro
 
 	backend := d.Get("backend").(string)
 	key := d.Get("key").(string)
-	ciphertext := d.Get("ciphertext").(string)
+	path := strings.Trim(backend, "/") + "/decrypt/" + key
+
+	if batch, ok := d.GetOk("batch_input"); ok {
+		var batchInput []map[string]interface{}
+		for _, v := range batch.([]interface{}) {
+			item := v.(map[string]interface{})
+			batchInput = append(batchInput, map[string]interface{}{
+				"ciphertext": item["ciphertext"].(string),
+				"context":    base64.StdEncoding.EncodeToString([]byte(item["context"].(string))),
+			})
+		}
+
+		log.Printf("[DEBUG] Decrypting %d items with transit key %q", len(batchInput), path)
+		results, err := transitBatchResults(client, path, map[string]interface{}{"batch_input": batchInput}, "plaintext")
+		if err != nil {
+			return fmt.Errorf("issue decrypting with key: %s", err)
+		}
+		log.Printf("[DEBUG] Decrypted %d items with transit key %q", len(results), path)
+
+		for i, v := range results {
+			plaintext, err := base64.StdEncoding.DecodeString(v)
+			if err != nil {
+				return fmt.Errorf("error decoding plaintext for batch item %d: %s", i, err)
+			}
+			results[i] = string(plaintext)
+		}
+
+		d.SetId(path)
+		d.Set("plaintext", "")
+		return d.Set("batch_results", results)
+	}
+
+	ciphertextRaw, ok := d.GetOk("ciphertext")
+	if !ok {
+		return fmt.Errorf("one of ciphertext or batch_input must be set")
+	}
+	ciphertext := ciphertextRaw.(string)
 
 	context := base64.StdEncoding.EncodeToString([]byte(d.Get("context").(string)))
 	payload := map[string]interface{}{
@@ -55,15 +125,16 @@ func transitDecryptDataSourceRead(d *schema.ResourceData, meta interface{}) erro
 		"context":    context,
 	}
 
-	decryptedData, err := client.Logical().Write(backend+"/decrypt/"+key, payload)
+	decryptedData, err := client.Logical().Write(path, payload)
 	if err != nil {
-		return fmt.Errorf("issue encrypting with key: %s", err)
+		return fmt.Errorf("issue decrypting with key: %s", err)
 	}
 
 	plaintext, _ := base64.StdEncoding.DecodeString(decryptedData.Data["plaintext"].(string))
 
 	d.SetId(base64.StdEncoding.EncodeToString([]byte(ciphertext)))
 	d.Set("plaintext", string(plaintext))
+	d.Set("batch_results", nil)
 
 	return nil
 }
@@ -3,8 +3,10 @@ package vault
 import (
 	"encoding/base64"
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"log"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/vault/api"
 )
 
@@ -24,26 +26,57 @@ func transitEncryptDataSource() *schema.Resource {
 				Description: "The Transit secret backend the key belongs to.",
 			},
 			"plaintext": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Map of strings read from Vault.",
-				Sensitive:   true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Plaintext to be encrypted.",
+				Sensitive:     true,
+				ConflictsWith: []string{"batch_input"},
 			},
 			"context": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Specifies the context for key derivation",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Specifies the context for key derivation",
+				ConflictsWith: []string{"batch_input"},
 			},
 			"key_version": {
 				Type:        schema.TypeInt,
 				Optional:    true,
 				Description: "The version of the key to use for encryption",
 			},
+			"batch_input": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Description:   "List of items to encrypt in a single request.",
+				ConflictsWith: []string{"plaintext"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"plaintext": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Plaintext to be encrypted.",
+							Sensitive:   true,
+						},
+						"context": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Specifies the context for key derivation",
+						},
+					},
+				},
+			},
 			"ciphertext": {
 				Type:        schema.TypeString,
 				Computed:    true,
 				Description: "Transit encrypted cipher text.",
 			},
+			"batch_results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Transit encrypted cipher texts, in the same order as batch_input.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -54,8 +87,40 @@ func transitEncryptDataSourceRead(d *schema.ResourceData, meta interface{}) erro
 	backend := d.Get("backend").(string)
 	key := d.Get("key").(string)
 	keyVersion := d.Get("key_version").(int)
+	path := strings.Trim(backend, "/") + "/encrypt/" + key
+
+	if batch, ok := d.GetOk("batch_input"); ok {
+		var batchInput []map[string]interface{}
+		for _, v := range batch.([]interface{}) {
+			item := v.(map[string]interface{})
+			batchInput = append(batchInput, map[string]interface{}{
+				"plaintext": base64.StdEncoding.EncodeToString([]byte(item["plaintext"].(string))),
+				"context":   base64.StdEncoding.EncodeToString([]byte(item["context"].(string))),
+			})
+		}
+		payload := map[string]interface{}{
+			"batch_input": batchInput,
+			"key_version": keyVersion,
+		}
+
+		log.Printf("[DEBUG] Encrypting %d items with transit key %q", len(batchInput), path)
+		results, err := transitBatchResults(client, path, payload, "ciphertext")
+		if err != nil {
+			return fmt.Errorf("issue encrypting with key: %s", err)
+		}
+		log.Printf("[DEBUG] Encrypted %d items with transit key %q", len(results), path)
+
+		d.SetId(path)
+		d.Set("ciphertext", "")
+		return d.Set("batch_results", results)
+	}
 
-	plaintext := base64.StdEncoding.EncodeToString([]byte(d.Get("plaintext").(string)))
+	plaintextRaw, ok := d.GetOk("plaintext")
+	if !ok {
+		return fmt.Errorf("one of plaintext or batch_input must be set")
+	}
+
+	plaintext := base64.StdEncoding.EncodeToString([]byte(plaintextRaw.(string)))
 	context := base64.StdEncoding.EncodeToString([]byte(d.Get("context").(string)))
 	payload := map[string]interface{}{
 		"plaintext":   plaintext,
@@ -63,7 +128,7 @@ func transitEncryptDataSourceRead(d *schema.ResourceData, meta interface{}) erro
 		"key_version": keyVersion,
 	}
 
-	encryptedData, err := client.Logical().Write(backend+"/encrypt/"+key, payload)
+	encryptedData, err := client.Logical().Write(path, payload)
 	if err != nil {
 		return fmt.Errorf("issue encrypting with key: %s", err)
 	}
@@ -72,6 +137,41 @@ func transitEncryptDataSourceRead(d *schema.ResourceData, meta interface{}) erro
 
 	d.SetId(base64.StdEncoding.EncodeToString([]byte(cipherText.(string))))
 	d.Set("ciphertext", cipherText)
+	d.Set("batch_results", nil)
 
 	return nil
 }
+
+// transitBatchResults writes a batch request to the given transit endpoint
+// and returns the named field from each of the batch results, in order.
+// Vault reports per-item failures in the results rather than failing the
+// request, so these are surfaced as an error here.
+func transitBatchResults(client *api.Client, path string, payload map[string]interface{}, field string) ([]string, error) {
+	resp, err := client.Logical().Write(path, payload)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, fmt.Errorf("no response from %q", path)
+	}
+
+	raw, ok := resp.Data["batch_results"].([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("no batch_results in response from %q", path)
+	}
+
+	results := make([]string, 0, len(raw))
+	for i, v := range raw {
+		item, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("unexpected batch result %d from %q: %v", i, path, v)
+		}
+		if e, ok := item["error"].(string); ok && e != "" {
+			return nil, fmt.Errorf("batch item %d: %s", i, e)
+		}
+		result, _ := item[field].(string)
+		results = append(results, result)
+	}
+
+	return results, nil
+}
@@ -65,3 +65,60 @@ func testDataSourceTransitEncrypt_check(s *terraform.State) error {
 
 	return nil
 }
+
+func TestDataSourceTransitEncrypt_batch(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceTransitEncrypt_batchConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_transit_encrypt.test", "batch_results.#", "2"),
+					resource.TestCheckResourceAttr("data.vault_transit_decrypt.test", "batch_results.#", "2"),
+					resource.TestCheckResourceAttr("data.vault_transit_decrypt.test", "batch_results.0", "foo"),
+					resource.TestCheckResourceAttr("data.vault_transit_decrypt.test", "batch_results.1", "bar"),
+				),
+			},
+		},
+	})
+}
+
+var testDataSourceTransitEncrypt_batchConfig = `
+resource "vault_mount" "test" {
+  path        = "transit"
+  type        = "transit"
+  description = "This is an example mount"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  name             = "test"
+  backend          = vault_mount.test.path
+  deletion_allowed = true
+}
+
+data "vault_transit_encrypt" "test" {
+  backend = vault_mount.test.path
+  key     = vault_transit_secret_backend_key.test.name
+
+  batch_input {
+    plaintext = "foo"
+  }
+
+  batch_input {
+    plaintext = "bar"
+  }
+}
+
+data "vault_transit_decrypt" "test" {
+  backend = vault_mount.test.path
+  key     = vault_transit_secret_backend_key.test.name
+
+  dynamic "batch_input" {
+    for_each = data.vault_transit_encrypt.test.batch_results
+    content {
+      ciphertext = batch_input.value
+    }
+  }
+}
+`
@@ -0,0 +1,127 @@
+package vault
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func transitHMACDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: transitHMACDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the key to use.",
+			},
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Transit secret backend the key belongs to.",
+			},
+			"input": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Input to generate the HMAC for.",
+				ConflictsWith: []string{"batch_input"},
+			},
+			"key_version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The version of the key to use.",
+			},
+			"algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The hash algorithm to use.",
+				ValidateFunc: validation.StringInSlice([]string{"sha2-224", "sha2-256", "sha2-384", "sha2-512", "sha3-224", "sha3-256", "sha3-384", "sha3-512"}, false),
+			},
+			"batch_input": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Description:   "List of inputs to generate HMACs for in a single request.",
+				ConflictsWith: []string{"input"},
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"hmac": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The HMAC of the input.",
+			},
+			"batch_results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "HMACs, in the same order as batch_input.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func transitHMACDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	key := d.Get("key").(string)
+	path := strings.Trim(backend, "/") + "/hmac/" + key
+
+	payload := map[string]interface{}{
+		"key_version": d.Get("key_version").(int),
+	}
+	if v, ok := d.GetOk("algorithm"); ok {
+		payload["algorithm"] = v
+	}
+
+	if batch, ok := d.GetOk("batch_input"); ok {
+		var batchInput []map[string]interface{}
+		for _, v := range batch.([]interface{}) {
+			batchInput = append(batchInput, map[string]interface{}{
+				"input": base64.StdEncoding.EncodeToString([]byte(v.(string))),
+			})
+		}
+		payload["batch_input"] = batchInput
+
+		log.Printf("[DEBUG] Generating %d HMACs with transit key %q", len(batchInput), path)
+		results, err := transitBatchResults(client, path, payload, "hmac")
+		if err != nil {
+			return fmt.Errorf("issue generating HMAC with key: %s", err)
+		}
+		log.Printf("[DEBUG] Generated %d HMACs with transit key %q", len(results), path)
+
+		d.SetId(path)
+		d.Set("hmac", "")
+		return d.Set("batch_results", results)
+	}
+
+	input, ok := d.GetOk("input")
+	if !ok {
+		return fmt.Errorf("one of input or batch_input must be set")
+	}
+	payload["input"] = base64.StdEncoding.EncodeToString([]byte(input.(string)))
+
+	log.Printf("[DEBUG] Generating HMAC with transit key %q", path)
+	resp, err := client.Logical().Write(path, payload)
+	if err != nil {
+		return fmt.Errorf("issue generating HMAC with key: %s", err)
+	}
+	log.Printf("[DEBUG] Generated HMAC with transit key %q", path)
+
+	hmac, _ := resp.Data["hmac"].(string)
+
+	d.SetId(base64.StdEncoding.EncodeToString([]byte(hmac)))
+	d.Set("hmac", hmac)
+	d.Set("batch_results", nil)
+
+	return nil
+}
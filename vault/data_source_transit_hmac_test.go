@@ -0,0 +1,56 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestDataSourceTransitHMAC(t *testing.T) {
+	backend := acctest.RandomWithPrefix("transit")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceTransitHMACConfig(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.vault_transit_hmac.test", "hmac", regexp.MustCompile("^vault:v1:")),
+					resource.TestCheckResourceAttr("data.vault_transit_hmac.batch", "batch_results.#", "2"),
+					resource.TestCheckResourceAttrPair("data.vault_transit_hmac.test", "hmac", "data.vault_transit_hmac.batch", "batch_results.0"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceTransitHMACConfig(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  name             = "test"
+  backend          = vault_mount.test.path
+  deletion_allowed = true
+}
+
+data "vault_transit_hmac" "test" {
+  backend = vault_mount.test.path
+  key     = vault_transit_secret_backend_key.test.name
+  input   = "foo"
+}
+
+data "vault_transit_hmac" "batch" {
+  backend     = vault_mount.test.path
+  key         = vault_transit_secret_backend_key.test.name
+  batch_input = ["foo", "bar"]
+}
+`, backend)
+}
@@ -0,0 +1,161 @@
+package vault
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func transitSignDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: transitSignDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the signing key to use.",
+			},
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Transit secret backend the key belongs to.",
+			},
+			"input": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Input to be signed.",
+				ConflictsWith: []string{"batch_input"},
+			},
+			"context": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Specifies the context for key derivation.",
+				ConflictsWith: []string{"batch_input"},
+			},
+			"key_version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The version of the key to use for signing.",
+			},
+			"hash_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The hash algorithm to use. Ignored for ed25519 keys.",
+				ValidateFunc: validation.StringInSlice([]string{"sha1", "sha2-224", "sha2-256", "sha2-384", "sha2-512", "sha3-224", "sha3-256", "sha3-384", "sha3-512"}, false),
+			},
+			"signature_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The signature algorithm to use for RSA keys.",
+				ValidateFunc: validation.StringInSlice([]string{"pss", "pkcs1v15"}, false),
+			},
+			"marshaling_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The way in which the signature is marshaled for ECDSA keys.",
+				ValidateFunc: validation.StringInSlice([]string{"asn1", "jws"}, false),
+			},
+			"batch_input": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Description:   "List of items to sign in a single request.",
+				ConflictsWith: []string{"input"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"input": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Input to be signed.",
+						},
+						"context": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Specifies the context for key derivation.",
+						},
+					},
+				},
+			},
+			"signature": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The signature of the input.",
+			},
+			"batch_results": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Signatures, in the same order as batch_input.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func transitSignDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	key := d.Get("key").(string)
+	path := strings.Trim(backend, "/") + "/sign/" + key
+
+	payload := map[string]interface{}{
+		"key_version": d.Get("key_version").(int),
+	}
+	for _, k := range []string{"hash_algorithm", "signature_algorithm", "marshaling_algorithm"} {
+		if v, ok := d.GetOk(k); ok {
+			payload[k] = v
+		}
+	}
+
+	if batch, ok := d.GetOk("batch_input"); ok {
+		var batchInput []map[string]interface{}
+		for _, v := range batch.([]interface{}) {
+			item := v.(map[string]interface{})
+			batchInput = append(batchInput, map[string]interface{}{
+				"input":   base64.StdEncoding.EncodeToString([]byte(item["input"].(string))),
+				"context": base64.StdEncoding.EncodeToString([]byte(item["context"].(string))),
+			})
+		}
+		payload["batch_input"] = batchInput
+
+		log.Printf("[DEBUG] Signing %d items with transit key %q", len(batchInput), path)
+		results, err := transitBatchResults(client, path, payload, "signature")
+		if err != nil {
+			return fmt.Errorf("issue signing with key: %s", err)
+		}
+		log.Printf("[DEBUG] Signed %d items with transit key %q", len(results), path)
+
+		d.SetId(path)
+		d.Set("signature", "")
+		return d.Set("batch_results", results)
+	}
+
+	input, ok := d.GetOk("input")
+	if !ok {
+		return fmt.Errorf("one of input or batch_input must be set")
+	}
+	payload["input"] = base64.StdEncoding.EncodeToString([]byte(input.(string)))
+	payload["context"] = base64.StdEncoding.EncodeToString([]byte(d.Get("context").(string)))
+
+	log.Printf("[DEBUG] Signing with transit key %q", path)
+	resp, err := client.Logical().Write(path, payload)
+	if err != nil {
+		return fmt.Errorf("issue signing with key: %s", err)
+	}
+	log.Printf("[DEBUG] Signed with transit key %q", path)
+
+	signature, _ := resp.Data["signature"].(string)
+
+	d.SetId(base64.StdEncoding.EncodeToString([]byte(signature)))
+	d.Set("signature", signature)
+	d.Set("batch_results", nil)
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestDataSourceTransitSign(t *testing.T) {
+	backend := acctest.RandomWithPrefix("transit")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceTransitSignConfig(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.vault_transit_sign.test", "signature", regexp.MustCompile("^vault:v1:")),
+					resource.TestCheckResourceAttr("data.vault_transit_sign.batch", "batch_results.#", "2"),
+					resource.TestMatchResourceAttr("data.vault_transit_sign.batch", "batch_results.0", regexp.MustCompile("^vault:v1:")),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceTransitSignConfig(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  name             = "test"
+  backend          = vault_mount.test.path
+  type             = "ecdsa-p256"
+  deletion_allowed = true
+}
+
+data "vault_transit_sign" "test" {
+  backend        = vault_mount.test.path
+  key            = vault_transit_secret_backend_key.test.name
+  hash_algorithm = "sha2-256"
+  input          = "foo"
+}
+
+data "vault_transit_sign" "batch" {
+  backend = vault_mount.test.path
+  key     = vault_transit_secret_backend_key.test.name
+
+  batch_input {
+    input = "foo"
+  }
+
+  batch_input {
+    input = "bar"
+  }
+}
+`, backend)
+}
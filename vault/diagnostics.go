@@ -0,0 +1,165 @@
+package vault
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+// vaultErrorKind classifies the errors returned by Vault.
+type vaultErrorKind string
+
+const (
+	vaultErrorPermissionDenied vaultErrorKind = "permission denied"
+	vaultErrorNotFound         vaultErrorKind = "not found"
+	vaultErrorSealed           vaultErrorKind = "sealed"
+	vaultErrorRateLimited      vaultErrorKind = "rate limited"
+	vaultErrorUnavailable      vaultErrorKind = "unavailable"
+	vaultErrorInvalidRequest   vaultErrorKind = "invalid request"
+	vaultErrorServer           vaultErrorKind = "server error"
+)
+
+// apiErrorURLRegex matches the request line of the errors built by the Vault
+// API client from a response, e.g. "URL: GET https://vault:8200/v1/sys/mounts".
+var apiErrorURLRegex = regexp.MustCompile(`URL: ([A-Z]+) (\S+)`)
+
+// vaultAPIError decorates an error returned by the Vault API with the details
+// of the failed request, so that it can be troubleshooted without enabling
+// trace logging.
+type vaultAPIError struct {
+	Kind       vaultErrorKind
+	Method     string
+	Path       string
+	Namespace  string
+	StatusCode int
+	Err        error
+}
+
+func (e *vaultAPIError) Error() string {
+	details := []string{fmt.Sprintf("status: %d", e.StatusCode)}
+	if e.Namespace != "" {
+		details = append([]string{fmt.Sprintf("namespace: %q", e.Namespace)}, details...)
+	}
+	retriable := "no"
+	if e.Retriable() {
+		retriable = "yes"
+	}
+	details = append(details, "retriable: "+retriable)
+
+	return fmt.Sprintf("%s\n\n[%s] %s %s (%s)", e.Err, e.Kind, e.Method, e.Path, strings.Join(details, ", "))
+}
+
+func (e *vaultAPIError) Unwrap() error {
+	return e.Err
+}
+
+// Retriable returns whether the request may succeed if made again without
+// any change to the configuration.
+func (e *vaultAPIError) Retriable() bool {
+	switch e.Kind {
+	case vaultErrorSealed, vaultErrorRateLimited, vaultErrorUnavailable, vaultErrorServer:
+		return true
+	}
+	return false
+}
+
+// newVaultAPIError returns err decorated with the details of the request it
+// was returned for, if it was built by the Vault API client from a response.
+// Other errors are returned unchanged.
+func newVaultAPIError(client *api.Client, err error) error {
+	if err == nil {
+		return nil
+	}
+	var apiErr *vaultAPIError
+	if errors.As(err, &apiErr) {
+		return err
+	}
+
+	statusCode := util.ErrorStatusCode(err)
+	m := apiErrorURLRegex.FindStringSubmatch(err.Error())
+	if statusCode == 0 || m == nil {
+		return err
+	}
+
+	apiErr = &vaultAPIError{
+		Kind:       vaultErrorKindOf(statusCode, err),
+		Method:     m[1],
+		Path:       m[2],
+		StatusCode: statusCode,
+		Err:        err,
+	}
+	if u, parseErr := url.Parse(m[2]); parseErr == nil {
+		apiErr.Path = strings.TrimPrefix(u.Path, "/v1/")
+	}
+	if client != nil {
+		apiErr.Namespace = client.Headers().Get(mountCacheNamespaceHeader)
+	}
+	return apiErr
+}
+
+func vaultErrorKindOf(statusCode int, err error) vaultErrorKind {
+	switch {
+	case statusCode == http.StatusForbidden:
+		return vaultErrorPermissionDenied
+	case statusCode == http.StatusNotFound:
+		return vaultErrorNotFound
+	case util.IsSealed(err):
+		return vaultErrorSealed
+	case statusCode == http.StatusTooManyRequests:
+		return vaultErrorRateLimited
+	// 412 is returned by performance standbys not yet having caught up
+	// with the state the request depends on.
+	case statusCode == http.StatusServiceUnavailable, statusCode == http.StatusPreconditionFailed:
+		return vaultErrorUnavailable
+	case statusCode >= 500:
+		return vaultErrorServer
+	}
+	return vaultErrorInvalidRequest
+}
+
+// wrapResourceErrors decorates the errors returned by the CRUD functions of r
+// with the details of the failed Vault request.
+func wrapResourceErrors(r *schema.Resource) {
+	wrap := func(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+		if f == nil {
+			return nil
+		}
+		return func(d *schema.ResourceData, meta interface{}) error {
+			client, _ := meta.(*api.Client)
+			return newVaultAPIError(client, f(d, meta))
+		}
+	}
+
+	r.Create = wrap(r.Create)
+	r.Read = wrap(r.Read)
+	r.Update = wrap(r.Update)
+	r.Delete = wrap(r.Delete)
+
+	if exists := r.Exists; exists != nil {
+		r.Exists = func(d *schema.ResourceData, meta interface{}) (bool, error) {
+			client, _ := meta.(*api.Client)
+			ok, err := exists(d, meta)
+			return ok, newVaultAPIError(client, err)
+		}
+	}
+}
+
+// isPermissionDenied returns whether err was returned by Vault for a request
+// denied by its policies, as opposed to one made with an invalid token.
+func isPermissionDenied(err error) bool {
+	return util.Is403(err) && strings.Contains(err.Error(), "permission denied")
+}
+
+// readErrorRemovesResource returns whether a resource whose read failed with
+// err may be removed from the state, see
+// providerOptions.failOnReadPermissionDenied.
+func readErrorRemovesResource(meta interface{}, err error) bool {
+	return !getProviderOptions(meta).failOnReadPermissionDenied || !isPermissionDenied(err)
+}
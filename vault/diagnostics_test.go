@@ -0,0 +1,138 @@
+package vault
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func testAPIError(method, url string, code int, message string) error {
+	return fmt.Errorf("Error making API request.\n\nURL: %s %s\nCode: %d. Errors:\n\n* %s", method, url, code, message)
+}
+
+func TestNewVaultAPIError(t *testing.T) {
+	testCases := []struct {
+		err       error
+		kind      vaultErrorKind
+		path      string
+		retriable bool
+	}{
+		{
+			err:  testAPIError("GET", "https://vault:8200/v1/auth/approle/role/foo", 403, "permission denied"),
+			kind: vaultErrorPermissionDenied,
+			path: "auth/approle/role/foo",
+		},
+		{
+			err:  fmt.Errorf("error reading from Vault: %s", testAPIError("GET", "https://vault:8200/v1/sys/policy/foo?list=true", 404, "")),
+			kind: vaultErrorNotFound,
+			path: "sys/policy/foo",
+		},
+		{
+			err:       testAPIError("PUT", "https://vault:8200/v1/secret/foo", 503, "Vault is sealed"),
+			kind:      vaultErrorSealed,
+			path:      "secret/foo",
+			retriable: true,
+		},
+		{
+			err:       testAPIError("GET", "https://vault:8200/v1/ns1/secret/foo", 429, "rate limit quota exceeded"),
+			kind:      vaultErrorRateLimited,
+			path:      "ns1/secret/foo",
+			retriable: true,
+		},
+		{
+			err:       testAPIError("GET", "https://vault:8200/v1/secret/foo", 500, "internal error"),
+			kind:      vaultErrorServer,
+			path:      "secret/foo",
+			retriable: true,
+		},
+		{
+			err:  testAPIError("POST", "https://vault:8200/v1/sys/mounts/foo", 400, "path is already in use"),
+			kind: vaultErrorInvalidRequest,
+			path: "sys/mounts/foo",
+		},
+	}
+
+	for _, tc := range testCases {
+		err := newVaultAPIError(nil, tc.err)
+
+		var apiErr *vaultAPIError
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("expected %q to be decorated", tc.err)
+		}
+		if apiErr.Kind != tc.kind {
+			t.Errorf("expected kind %q, got %q", tc.kind, apiErr.Kind)
+		}
+		if apiErr.Path != tc.path {
+			t.Errorf("expected path %q, got %q", tc.path, apiErr.Path)
+		}
+		if apiErr.Retriable() != tc.retriable {
+			t.Errorf("expected retriable to be %t for %q", tc.retriable, tc.kind)
+		}
+		if !strings.HasPrefix(err.Error(), tc.err.Error()) {
+			t.Errorf("expected the original error to be kept, got %q", err)
+		}
+
+		// errors are decorated once
+		if again := newVaultAPIError(nil, err); again != err {
+			t.Errorf("expected %q to be returned unchanged", err)
+		}
+	}
+
+	other := errors.New("invalid value for field")
+	if err := newVaultAPIError(nil, other); err != other {
+		t.Errorf("expected %q to be returned unchanged, got %q", other, err)
+	}
+	if err := newVaultAPIError(nil, nil); err != nil {
+		t.Errorf("expected nil, got %q", err)
+	}
+}
+
+func TestWrapResourceErrors(t *testing.T) {
+	r := &schema.Resource{
+		Read: func(*schema.ResourceData, interface{}) error {
+			return testAPIError("GET", "https://vault:8200/v1/secret/foo", 403, "permission denied")
+		},
+	}
+	wrapResourceErrors(r)
+
+	if r.Create != nil || r.Exists != nil {
+		t.Fatal("expected the missing functions to be left unset")
+	}
+
+	err := r.Read(nil, nil)
+	if err == nil || !strings.Contains(err.Error(), "[permission denied] GET secret/foo (status: 403, retriable: no)") {
+		t.Fatalf("expected a decorated error, got %v", err)
+	}
+}
+
+func TestReadErrorRemovesResource(t *testing.T) {
+	denied := testAPIError("GET", "https://vault:8200/v1/auth/token/lookup-accessor", 403, "permission denied")
+	badToken := testAPIError("POST", "https://vault:8200/v1/auth/token/lookup", 403, "bad token")
+	notFound := testAPIError("POST", "https://vault:8200/v1/auth/token/lookup-accessor", 400, "invalid accessor")
+
+	// Each provider alias has its own client, whose options are independent.
+	removing, failing := &api.Client{}, &api.Client{}
+	providerOptionsByClient.Store(removing, providerOptions{})
+	providerOptionsByClient.Store(failing, providerOptions{failOnReadPermissionDenied: true})
+	defer providerOptionsByClient.Delete(removing)
+	defer providerOptionsByClient.Delete(failing)
+
+	for _, err := range []error{denied, badToken, notFound} {
+		if !readErrorRemovesResource(removing, err) {
+			t.Errorf("expected %q to remove the resource", err)
+		}
+	}
+
+	if readErrorRemovesResource(failing, denied) {
+		t.Errorf("expected %q not to remove the resource", denied)
+	}
+	for _, err := range []error{badToken, notFound} {
+		if !readErrorRemovesResource(failing, err) {
+			t.Errorf("expected %q to remove the resource", err)
+		}
+	}
+}
@@ -0,0 +1,209 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const (
+	hcpAuthURL = "https://auth.idp.hashicorp.com/oauth2/token"
+	hcpAPIURL  = "https://api.cloud.hashicorp.com"
+	// hcpAudience is the audience of the tokens issued to use the HCP API.
+	hcpAudience = "https://api.hashicorp.cloud"
+	// hcpVaultAPIVersion is the version of HCP's Vault service API.
+	hcpVaultAPIVersion = "2020-11-25"
+	// hcpVaultNamespace is the namespace the admin token of an HCP Vault
+	// cluster, and everything it manages, lives in.
+	hcpVaultNamespace = "admin"
+)
+
+func hcpSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"address", "agent_address", "token_file"},
+		Description:   "Connect to an HCP Vault cluster, reading its address and an admin token from the HCP API.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"client_id": {
+					Type:        schema.TypeString,
+					Required:    true,
+					DefaultFunc: schema.EnvDefaultFunc("HCP_CLIENT_ID", nil),
+					Description: "The client ID of the HCP service principal.",
+				},
+				"client_secret": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Sensitive:   true,
+					DefaultFunc: schema.EnvDefaultFunc("HCP_CLIENT_SECRET", nil),
+					Description: "The client secret of the HCP service principal.",
+				},
+				"organization_id": {
+					Type:        schema.TypeString,
+					Required:    true,
+					DefaultFunc: schema.EnvDefaultFunc("HCP_ORGANIZATION_ID", nil),
+					Description: "The ID of the HCP organization the cluster belongs to.",
+				},
+				"project_id": {
+					Type:        schema.TypeString,
+					Required:    true,
+					DefaultFunc: schema.EnvDefaultFunc("HCP_PROJECT_ID", nil),
+					Description: "The ID of the HCP project the cluster belongs to.",
+				},
+				"cluster_id": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The ID of the HCP Vault cluster.",
+				},
+				"use_private_endpoint": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Connect to the private endpoint of the cluster rather than its public one.",
+				},
+			},
+		},
+	}
+}
+
+// hcpVaultCluster is what's needed to connect to an HCP Vault cluster.
+type hcpVaultCluster struct {
+	Address    string
+	AdminToken string
+	Namespace  string
+}
+
+// hcpClient makes requests to the HCP API, authenticated as a service
+// principal.
+type hcpClient struct {
+	httpClient   *http.Client
+	authURL      string
+	apiURL       string
+	clientID     string
+	clientSecret string
+	token        string
+}
+
+// newHCPClient returns a client for the HCP API making requests through the
+// given transport, or http.DefaultTransport when it is nil.
+func newHCPClient(clientID, clientSecret string, transport http.RoundTripper) *hcpClient {
+	return &hcpClient{
+		httpClient:   &http.Client{Transport: transport, Timeout: 30 * time.Second},
+		authURL:      hcpAuthURL,
+		apiURL:       hcpAPIURL,
+		clientID:     clientID,
+		clientSecret: clientSecret,
+	}
+}
+
+// readHCPVaultCluster returns the address of the HCP Vault cluster configured
+// in the hcp block of the provider, and an admin token to use with it.
+func readHCPVaultCluster(c *hcpClient, config map[string]interface{}) (*hcpVaultCluster, error) {
+	clusterPath := fmt.Sprintf("/vault/%s/organizations/%s/projects/%s/clusters/%s",
+		hcpVaultAPIVersion,
+		url.PathEscape(config["organization_id"].(string)),
+		url.PathEscape(config["project_id"].(string)),
+		url.PathEscape(config["cluster_id"].(string)))
+
+	var clusterResp struct {
+		Cluster struct {
+			State    string `json:"state"`
+			DNSNames struct {
+				Public  string `json:"public"`
+				Private string `json:"private"`
+			} `json:"dns_names"`
+		} `json:"cluster"`
+	}
+	if err := c.get(clusterPath, &clusterResp); err != nil {
+		return nil, fmt.Errorf("error reading HCP Vault cluster %q: %s", config["cluster_id"], err)
+	}
+
+	host := clusterResp.Cluster.DNSNames.Public
+	if config["use_private_endpoint"].(bool) {
+		host = clusterResp.Cluster.DNSNames.Private
+	}
+	if host == "" {
+		return nil, fmt.Errorf("HCP Vault cluster %q has no endpoint of the requested kind, its state is %q", config["cluster_id"], clusterResp.Cluster.State)
+	}
+
+	var tokenResp struct {
+		Token string `json:"token"`
+	}
+	if err := c.get(clusterPath+"/admintoken", &tokenResp); err != nil {
+		return nil, fmt.Errorf("error reading the admin token of HCP Vault cluster %q: %s", config["cluster_id"], err)
+	}
+
+	log.Printf("[INFO] Using HCP Vault cluster %q at %s", config["cluster_id"], host)
+	return &hcpVaultCluster{
+		Address:    "https://" + host + ":8200",
+		AdminToken: tokenResp.Token,
+		Namespace:  hcpVaultNamespace,
+	}, nil
+}
+
+// authenticate exchanges the service principal's credentials for a token to
+// use the HCP API.
+func (c *hcpClient) authenticate() error {
+	resp, err := c.httpClient.PostForm(c.authURL, url.Values{
+		"grant_type":    {"client_credentials"},
+		"client_id":     {c.clientID},
+		"client_secret": {c.clientSecret},
+		"audience":      {hcpAudience},
+	})
+	if err != nil {
+		return fmt.Errorf("error authenticating to HCP: %s", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("error authenticating to HCP: %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	var tokenResp struct {
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tokenResp); err != nil {
+		return fmt.Errorf("error decoding the HCP token: %s", err)
+	}
+	if tokenResp.AccessToken == "" {
+		return fmt.Errorf("no token was returned authenticating to HCP")
+	}
+	c.token = tokenResp.AccessToken
+	return nil
+}
+
+func (c *hcpClient) get(path string, v interface{}) error {
+	if c.token == "" {
+		if err := c.authenticate(); err != nil {
+			return err
+		}
+	}
+
+	req, err := http.NewRequest(http.MethodGet, c.apiURL+path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("%s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
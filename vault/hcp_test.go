@@ -0,0 +1,96 @@
+package vault
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestReadHCPVaultCluster(t *testing.T) {
+	const clusterPath = "/vault/2020-11-25/organizations/org/projects/project/clusters/cluster"
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/oauth2/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("client_id") != "id" || r.FormValue("client_secret") != "secret" || r.FormValue("grant_type") != "client_credentials" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `{"access_token": "hcp-token", "token_type": "Bearer"}`)
+	})
+	mux.HandleFunc(clusterPath, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer hcp-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `{"cluster": {"state": "RUNNING", "dns_names": {"public": "vault.public.hashicorp.cloud", "private": "vault.private.hashicorp.cloud"}}}`)
+	})
+	mux.HandleFunc(clusterPath+"/admintoken", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer hcp-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `{"token": "s.admin"}`)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	// the requests go through the transport configured for the provider
+	transport := &hcpCountingTransport{}
+	newClient := func(clientSecret string) *hcpClient {
+		c := newHCPClient("id", clientSecret, transport)
+		c.authURL = server.URL + "/oauth2/token"
+		c.apiURL = server.URL
+		return c
+	}
+	config := map[string]interface{}{
+		"organization_id":      "org",
+		"project_id":           "project",
+		"cluster_id":           "cluster",
+		"use_private_endpoint": false,
+	}
+
+	cluster, err := readHCPVaultCluster(newClient("secret"), config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cluster.Address != "https://vault.public.hashicorp.cloud:8200" {
+		t.Errorf("unexpected address %q", cluster.Address)
+	}
+	if cluster.AdminToken != "s.admin" {
+		t.Errorf("unexpected admin token %q", cluster.AdminToken)
+	}
+	if cluster.Namespace != "admin" {
+		t.Errorf("unexpected namespace %q", cluster.Namespace)
+	}
+	if transport.requests != 3 {
+		t.Errorf("expected 3 requests through the transport, got %d", transport.requests)
+	}
+
+	config["use_private_endpoint"] = true
+	cluster, err = readHCPVaultCluster(newClient("secret"), config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cluster.Address != "https://vault.private.hashicorp.cloud:8200" {
+		t.Errorf("unexpected address %q", cluster.Address)
+	}
+
+	if _, err := readHCPVaultCluster(newClient("wrong"), config); err == nil {
+		t.Error("expected an error authenticating with invalid credentials")
+	}
+
+	config["cluster_id"] = "missing"
+	if _, err := readHCPVaultCluster(newClient("secret"), config); err == nil {
+		t.Error("expected an error reading a missing cluster")
+	}
+}
+
+type hcpCountingTransport struct {
+	requests int
+}
+
+func (t *hcpCountingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.requests++
+	return http.DefaultTransport.RoundTrip(req)
+}
@@ -9,7 +9,7 @@ import (
 	"github.com/hashicorp/vault/api"
 )
 
-func versionedSecret(requestedVersion int, path string, client *api.Client) (*api.Secret, error) {
+func versionedSecret(requestedVersion int, path string, isAPIPath bool, client *api.Client) (*api.Secret, error) {
 	mountPath, v2, err := isKVv2(path, client)
 	if err != nil {
 		return nil, err
@@ -18,16 +18,15 @@ func versionedSecret(requestedVersion int, path string, client *api.Client) (*ap
 	var versionParam map[string]string
 
 	if v2 {
-		path = addPrefixToVKVPath(path, mountPath, "data")
-		if err != nil {
-			return nil, err
-		}
+		path = kvV2DataPath(path, mountPath, isAPIPath)
 
 		if requestedVersion > 0 {
 			versionParam = map[string]string{
 				"version": fmt.Sprintf("%d", requestedVersion),
 			}
 		}
+	} else if requestedVersion > 0 {
+		return nil, fmt.Errorf("a version can only be requested for KV v2 secrets, %q is not a KV v2 path", path)
 	}
 
 	secret, err := kvReadRequest(client, path, versionParam)
@@ -145,3 +144,25 @@ func addPrefixToVKVPath(p, mountPath, apiPrefix string) string {
 		return path.Join(mountPath, apiPrefix, p)
 	}
 }
+
+// kvV2DataPath returns the data endpoint of the KV v2 secret at p. If
+// isAPIPath is true, p may already address the data endpoint, e.g.
+// secret/data/foo, and is then returned as is; otherwise p is always the
+// logical path of the secret, which may itself start with data/.
+func kvV2DataPath(p, mountPath string, isAPIPath bool) string {
+	if isAPIPath && strings.HasPrefix(strings.TrimPrefix(p, mountPath), "data/") {
+		return p
+	}
+	return addPrefixToVKVPath(p, mountPath, "data")
+}
+
+// kvV2MetadataPath returns the metadata endpoint of the KV v2 secret at p,
+// which may also be given as the path of its data endpoint if isAPIPath is
+// true.
+func kvV2MetadataPath(p, mountPath string, isAPIPath bool) string {
+	rel := strings.TrimPrefix(p, mountPath)
+	if isAPIPath && strings.HasPrefix(rel, "data/") {
+		p = mountPath + strings.TrimPrefix(rel, "data/")
+	}
+	return addPrefixToVKVPath(p, mountPath, "metadata")
+}
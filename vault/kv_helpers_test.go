@@ -0,0 +1,51 @@
+package vault
+
+import (
+	"testing"
+)
+
+func TestKVV2DataPath(t *testing.T) {
+	tests := []struct {
+		path      string
+		mountPath string
+		isAPIPath bool
+		want      string
+	}{
+		{"secret/foo", "secret/", false, "secret/data/foo"},
+		{"secret/foo/bar", "secret/", false, "secret/data/foo/bar"},
+		{"secret", "secret/", false, "secret/data"},
+		{"ns/kv/database", "ns/kv/", false, "ns/kv/data/database"},
+		// A secret whose name starts with data/ is only addressed by its
+		// logical path unless api_path is set.
+		{"secret/data/foo", "secret/", false, "secret/data/data/foo"},
+		{"secret/data/foo", "secret/", true, "secret/data/foo"},
+		{"secret/foo", "secret/", true, "secret/data/foo"},
+		{"ns/kv/data/database", "ns/kv/", true, "ns/kv/data/database"},
+	}
+
+	for _, tt := range tests {
+		if got := kvV2DataPath(tt.path, tt.mountPath, tt.isAPIPath); got != tt.want {
+			t.Errorf("kvV2DataPath(%q, %q, %t) = %q, want %q", tt.path, tt.mountPath, tt.isAPIPath, got, tt.want)
+		}
+	}
+}
+
+func TestKVV2MetadataPath(t *testing.T) {
+	tests := []struct {
+		path      string
+		mountPath string
+		isAPIPath bool
+		want      string
+	}{
+		{"secret/foo", "secret/", false, "secret/metadata/foo"},
+		{"secret/data/foo", "secret/", false, "secret/metadata/data/foo"},
+		{"secret/data/foo", "secret/", true, "secret/metadata/foo"},
+		{"ns/kv/data/database/creds", "ns/kv/", true, "ns/kv/metadata/database/creds"},
+	}
+
+	for _, tt := range tests {
+		if got := kvV2MetadataPath(tt.path, tt.mountPath, tt.isAPIPath); got != tt.want {
+			t.Errorf("kvV2MetadataPath(%q, %q, %t) = %q, want %q", tt.path, tt.mountPath, tt.isAPIPath, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,311 @@
+package vault
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/logging"
+)
+
+const redactedValue = "<redacted>"
+
+// redactedHeaders are the headers whose value is never logged, in their
+// canonical form.
+var redactedHeaders = map[string]bool{
+	"X-Vault-Token":       true,
+	"Authorization":       true,
+	"Proxy-Authorization": true,
+	"Cookie":              true,
+	"Set-Cookie":          true,
+}
+
+// redactedKeySuffixes are the suffixes of the keys of the JSON request and
+// response bodies whose value is never logged. They cover the tokens,
+// including the wrapped ones returned in wrap_info, the AppRole secret IDs,
+// the passwords and the private keys read from and written to Vault.
+var redactedKeySuffixes = []string{
+	"token",
+	"secret_id",
+	"secret",
+	"secret_key",
+	"password",
+	"bindpass",
+	"passphrase",
+	"private_key",
+	"pem_bundle",
+	"credentials",
+}
+
+// kvMountTypes are the types of the secret engines storing arbitrary
+// secrets, whose keys can't be told apart from the rest of the data.
+var kvMountTypes = map[string]bool{
+	"kv":      true,
+	"generic": true,
+}
+
+// redactingLoggingTransport logs the requests made to Vault and their
+// responses at the DEBUG level, like the SDK's logging transport, with the
+// values of sensitive headers and JSON keys redacted so that debug logs can
+// be shared. The bodies are only logged when logBodies is set.
+//
+// The secrets stored in KV mounts, e.g. vault_generic_secret's, have
+// arbitrary keys, so all the data written to and read from those mounts is
+// redacted. The KV mounts are learned from the sys/internal/ui/mounts/<path>
+// responses the provider reads before using a KV secret, and from sys/mounts.
+type redactingLoggingTransport struct {
+	transport http.RoundTripper
+	logBodies bool
+	// headers are the additional headers whose value is never logged,
+	// in their canonical form.
+	headers map[string]bool
+
+	mu sync.Mutex
+	// kvMounts maps the namespace of a request to the paths of the KV
+	// mounts seen in it, with a trailing slash.
+	kvMounts map[string]map[string]bool
+}
+
+func newRedactingLoggingTransport(transport http.RoundTripper, logBodies bool, headers []string) *redactingLoggingTransport {
+	t := &redactingLoggingTransport{
+		transport: transport,
+		logBodies: logBodies,
+		headers:   map[string]bool{},
+		kvMounts:  map[string]map[string]bool{},
+	}
+	for _, h := range headers {
+		t.headers[http.CanonicalHeaderKey(h)] = true
+	}
+	return t
+}
+
+func (t *redactingLoggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !logging.IsDebugOrHigher() {
+		return t.transport.RoundTrip(req)
+	}
+
+	body, err := t.readBody(&req.Body)
+	if err != nil {
+		return nil, err
+	}
+	namespace, path := redactionRequestPath(req)
+	kv := t.isKVPath(namespace, path)
+	var redactKV func(interface{})
+	if kv {
+		redactKV = redactKVRequest
+	}
+	requestLine := fmt.Sprintf("%s %s %s", req.Method, req.URL.RequestURI(), req.Proto)
+	log.Printf("[DEBUG] Vault API Request Details:\n---[ REQUEST ]---------------------------------------\n%s\n-----------------------------------------------------",
+		t.dump(requestLine, req.Header, body, redactKV))
+
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	body, err = t.readBody(&resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusOK {
+		t.learnKVMounts(namespace, path, body)
+	}
+	if kv {
+		redactKV = redactKVResponse
+	}
+	statusLine := fmt.Sprintf("%s %s", resp.Proto, resp.Status)
+	log.Printf("[DEBUG] Vault API Response Details:\n---[ RESPONSE ]--------------------------------------\n%s\n-----------------------------------------------------",
+		t.dump(statusLine, resp.Header, body, redactKV))
+
+	return resp, nil
+}
+
+// readBody reads the body of a request or response so that it can be logged,
+// and replaces it with a copy. Nothing is read if the bodies aren't logged.
+func (t *redactingLoggingTransport) readBody(body *io.ReadCloser) ([]byte, error) {
+	if !t.logBodies || *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+
+	b, err := ioutil.ReadAll(*body)
+	(*body).Close()
+	if err != nil {
+		return nil, err
+	}
+	*body = ioutil.NopCloser(bytes.NewReader(b))
+	return b, nil
+}
+
+func (t *redactingLoggingTransport) dump(firstLine string, header http.Header, body []byte, redactKV func(interface{})) string {
+	var buf strings.Builder
+	buf.WriteString(firstLine + "\n")
+
+	names := make([]string, 0, len(header))
+	for name := range header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for _, value := range header[name] {
+			if redactedHeaders[name] || t.headers[name] {
+				value = redactedValue
+			}
+			fmt.Fprintf(&buf, "%s: %s\n", name, value)
+		}
+	}
+
+	if len(body) > 0 {
+		buf.WriteString("\n" + redactBody(body, redactKV))
+	}
+	return buf.String()
+}
+
+// redactBody returns the indented JSON body with the values of the sensitive
+// keys redacted, as well as the KV data redacted by redactKV if it isn't nil.
+// Bodies that aren't JSON are not logged.
+func redactBody(body []byte, redactKV func(interface{})) string {
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return fmt.Sprintf("<%d bytes of non-JSON body>", len(body))
+	}
+	if redactKV != nil {
+		redactKV(v)
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(redactJSON(v)); err != nil {
+		return fmt.Sprintf("<%d bytes of body>", len(body))
+	}
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+func redactJSON(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if e != nil && isRedactedKey(k) {
+				v[k] = redactedValue
+				continue
+			}
+			v[k] = redactJSON(e)
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = redactJSON(e)
+		}
+	}
+	return v
+}
+
+func isRedactedKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, suffix := range redactedKeySuffixes {
+		if strings.HasSuffix(key, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// redactKVRequest redacts every top-level value of the body of a request to a
+// KV mount, i.e. the secret data itself for KV v1, and its data and options
+// for KV v2.
+func redactKVRequest(v interface{}) {
+	redactValues(v)
+}
+
+// redactKVResponse redacts every value of the data of a response from a KV
+// mount.
+func redactKVResponse(v interface{}) {
+	if m, ok := v.(map[string]interface{}); ok {
+		redactValues(m["data"])
+	}
+}
+
+func redactValues(v interface{}) {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		return
+	}
+	for k, e := range m {
+		if e != nil {
+			m[k] = redactedValue
+		}
+	}
+}
+
+// redactionRequestPath returns the namespace of the request and its path
+// relative to the API root.
+func redactionRequestPath(req *http.Request) (string, string) {
+	namespace := strings.Trim(req.Header.Get(mountCacheNamespaceHeader), "/")
+	return namespace, strings.TrimPrefix(req.URL.Path, "/v1/")
+}
+
+func (t *redactingLoggingTransport) isKVPath(namespace, path string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	path = strings.Trim(path, "/") + "/"
+	for mountPath := range t.kvMounts[namespace] {
+		if strings.HasPrefix(path, mountPath) {
+			return true
+		}
+	}
+	return false
+}
+
+// learnKVMounts records the KV mounts described by the body of a
+// sys/internal/ui/mounts/<path> or sys/mounts response.
+func (t *redactingLoggingTransport) learnKVMounts(namespace, path string, body []byte) {
+	path = strings.Trim(path, "/")
+	uiMount := strings.HasPrefix(path, mountCacheUIMountsPrefix)
+	if !uiMount && path != "sys/mounts" {
+		return
+	}
+
+	var resp struct {
+		Data map[string]interface{} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return
+	}
+
+	var mountPaths []string
+	if uiMount {
+		mountType, _ := resp.Data["type"].(string)
+		mountPath, _ := resp.Data["path"].(string)
+		if kvMountTypes[mountType] && mountPath != "" {
+			mountPaths = append(mountPaths, mountPath)
+		}
+	} else {
+		for mountPath, v := range resp.Data {
+			mount, _ := v.(map[string]interface{})
+			if mountType, _ := mount["type"].(string); kvMountTypes[mountType] {
+				mountPaths = append(mountPaths, mountPath)
+			}
+		}
+	}
+	if len(mountPaths) == 0 {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.kvMounts[namespace] == nil {
+		t.kvMounts[namespace] = map[string]bool{}
+	}
+	for _, mountPath := range mountPaths {
+		t.kvMounts[namespace][strings.Trim(mountPath, "/")+"/"] = true
+	}
+}
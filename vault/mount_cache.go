@@ -0,0 +1,238 @@
+package vault
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/vault/api"
+)
+
+const (
+	// mountCacheNamespaceHeader and mountCacheTokenHeader partition the
+	// cache, as what a request sees of the mount tables depends on both.
+	mountCacheNamespaceHeader = "X-Vault-Namespace"
+	mountCacheTokenHeader     = "X-Vault-Token"
+
+	mountCacheUIMountsPrefix = "sys/internal/ui/mounts/"
+)
+
+// mountCacheInvalidatingPrefixes are the paths whose modification may change
+// the mount tables.
+var mountCacheInvalidatingPrefixes = []string{
+	"sys/mounts",
+	"sys/auth",
+	"sys/remount",
+	"sys/namespaces",
+}
+
+type mountCacheEntry struct {
+	expires    time.Time
+	statusCode int
+	header     http.Header
+	body       []byte
+}
+
+// mountCacheTransport caches the responses of the requests reading the secret
+// engine and auth method mount tables, i.e. sys/mounts, sys/auth and
+// sys/internal/ui/mounts/<path>, so that refreshing many resources living on
+// the same mounts doesn't read the same tables over and over. The cache is
+// emptied on any write to the mount tables.
+//
+// The responses of sys/internal/ui/mounts/<path> describe the mount path is
+// on, they are cached per mount so that a single request is made per mount
+// rather than per path. This relies on Vault refusing overlapping mounts.
+type mountCacheTransport struct {
+	transport http.RoundTripper
+	ttl       time.Duration
+
+	mu      sync.Mutex
+	entries map[string]*mountCacheEntry
+	// uiMounts maps the partition of the cache to the mount paths for
+	// which a sys/internal/ui/mounts response is cached.
+	uiMounts map[string]map[string]bool
+}
+
+func newMountCacheTransport(transport http.RoundTripper, ttl time.Duration) *mountCacheTransport {
+	return &mountCacheTransport{
+		transport: transport,
+		ttl:       ttl,
+		entries:   map[string]*mountCacheEntry{},
+		uiMounts:  map[string]map[string]bool{},
+	}
+}
+
+func (t *mountCacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	path := mountCacheSysPath(req.URL.Path)
+	if path == "" {
+		return t.transport.RoundTrip(req)
+	}
+
+	if req.Method != http.MethodGet {
+		for _, prefix := range mountCacheInvalidatingPrefixes {
+			if strings.HasPrefix(path, prefix) {
+				t.invalidate()
+				break
+			}
+		}
+		return t.transport.RoundTrip(req)
+	}
+
+	uiMount := strings.HasPrefix(path, mountCacheUIMountsPrefix)
+	if path != "sys/mounts" && path != "sys/auth" && !uiMount {
+		return t.transport.RoundTrip(req)
+	}
+
+	partition := mountCachePartition(req)
+	key := partition + path
+	if uiMount {
+		key = t.uiMountKey(partition, strings.TrimPrefix(path, mountCacheUIMountsPrefix))
+	}
+	if e := t.get(key); e != nil {
+		log.Printf("[TRACE] Using the cached response of %s", req.URL.Path)
+		return e.response(req), nil
+	}
+
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return resp, err
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	if uiMount {
+		mountPath, ok := mountCacheUIMountPath(body)
+		if !ok {
+			return resp, nil
+		}
+		key = partition + mountCacheUIMountsPrefix + mountPath
+		t.mu.Lock()
+		if t.uiMounts[partition] == nil {
+			t.uiMounts[partition] = map[string]bool{}
+		}
+		t.uiMounts[partition][mountPath] = true
+		t.mu.Unlock()
+	}
+	t.set(key, &mountCacheEntry{
+		expires:    time.Now().Add(t.ttl),
+		statusCode: resp.StatusCode,
+		header:     resp.Header.Clone(),
+		body:       body,
+	})
+
+	return resp, nil
+}
+
+// uiMountKey returns the key of the cached sys/internal/ui/mounts response of
+// the mount path is on, or an unused key if there is none.
+func (t *mountCacheTransport) uiMountKey(partition, path string) string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	path = strings.Trim(path, "/") + "/"
+	for mountPath := range t.uiMounts[partition] {
+		if strings.HasPrefix(path, mountPath) {
+			return partition + mountCacheUIMountsPrefix + mountPath
+		}
+	}
+	return partition + mountCacheUIMountsPrefix + path
+}
+
+func (t *mountCacheTransport) get(key string) *mountCacheEntry {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	e, ok := t.entries[key]
+	if !ok {
+		return nil
+	}
+	if time.Now().After(e.expires) {
+		delete(t.entries, key)
+		return nil
+	}
+	return e
+}
+
+func (t *mountCacheTransport) set(key string, e *mountCacheEntry) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.entries[key] = e
+}
+
+func (t *mountCacheTransport) invalidate() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	log.Printf("[TRACE] Invalidating the mount table cache")
+	t.entries = map[string]*mountCacheEntry{}
+	t.uiMounts = map[string]map[string]bool{}
+}
+
+func (e *mountCacheEntry) response(req *http.Request) *http.Response {
+	return newBufferedResponse(req, e.statusCode, e.header, e.body)
+}
+
+// newBufferedResponse returns a response to req built from a response
+// previously read in full.
+func newBufferedResponse(req *http.Request, statusCode int, header http.Header, body []byte) *http.Response {
+	return &http.Response{
+		Status:        http.StatusText(statusCode),
+		StatusCode:    statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header.Clone(),
+		Body:          ioutil.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// mountCacheSysPath returns the path of the request relative to the API root
+// if it's a sys/ path, or "" otherwise. The namespace of the request is given
+// by its X-Vault-Namespace header, as the Vault API client does, so a sys/
+// segment further down the path, e.g. in secret/data/sys/mounts, doesn't match.
+func mountCacheSysPath(urlPath string) string {
+	path := strings.TrimPrefix(urlPath, "/v1/")
+	if path == urlPath || !strings.HasPrefix(path, "sys/") {
+		return ""
+	}
+	return strings.TrimSuffix(path, "/")
+}
+
+// mountCachePartition returns the part of the cache key identifying the
+// namespace and token the request is made with.
+func mountCachePartition(req *http.Request) string {
+	token := sha256.Sum256([]byte(req.Header.Get(mountCacheTokenHeader)))
+	namespace := req.Header.Get(mountCacheNamespaceHeader)
+
+	return req.URL.Host + "|" + strings.Trim(namespace, "/") + "|" + hex.EncodeToString(token[:]) + "|"
+}
+
+// mountCacheUIMountPath returns the mount path from a
+// sys/internal/ui/mounts/<path> response body.
+func mountCacheUIMountPath(body []byte) (string, bool) {
+	var secret api.Secret
+	if err := json.Unmarshal(body, &secret); err != nil {
+		return "", false
+	}
+
+	mountPath, _ := secret.Data["path"].(string)
+	mountPath = strings.Trim(mountPath, "/")
+	if mountPath == "" {
+		return "", false
+	}
+	return mountPath + "/", true
+}
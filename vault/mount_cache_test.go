@@ -0,0 +1,150 @@
+package vault
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestMountCacheTransport(t *testing.T) {
+	var mu sync.Mutex
+	requests := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests[r.Method+" "+r.URL.Path]++
+		mu.Unlock()
+
+		if strings.HasPrefix(r.URL.Path, "/v1/sys/internal/ui/mounts/") {
+			path := strings.TrimPrefix(r.URL.Path, "/v1/sys/internal/ui/mounts/")
+			mountPath := strings.SplitN(path, "/", 2)[0] + "/"
+			fmt.Fprintf(w, `{"data": {"path": %q, "type": "kv", "options": {"version": "2"}}}`, mountPath)
+			return
+		}
+		fmt.Fprintf(w, `{"data": {"path": %q}}`, r.URL.Path)
+	}))
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: newMountCacheTransport(http.DefaultTransport, time.Minute),
+	}
+	do := func(method, path, token string) string {
+		t.Helper()
+
+		req, err := http.NewRequest(method, server.URL+path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("X-Vault-Token", token)
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(body)
+	}
+	expectRequests := func(key string, expected int) {
+		t.Helper()
+
+		mu.Lock()
+		defer mu.Unlock()
+		if requests[key] != expected {
+			t.Fatalf("expected %d requests to %q, got %d", expected, key, requests[key])
+		}
+	}
+
+	// the mount tables are cached
+	do("GET", "/v1/sys/mounts", "token")
+	do("GET", "/v1/sys/mounts", "token")
+	expectRequests("GET /v1/sys/mounts", 1)
+	do("GET", "/v1/sys/auth", "token")
+	do("GET", "/v1/sys/auth", "token")
+	expectRequests("GET /v1/sys/auth", 1)
+
+	// per token
+	do("GET", "/v1/sys/mounts", "other-token")
+	expectRequests("GET /v1/sys/mounts", 2)
+
+	// other paths aren't
+	do("GET", "/v1/secret/data/foo", "token")
+	do("GET", "/v1/secret/data/foo", "token")
+	expectRequests("GET /v1/secret/data/foo", 2)
+
+	// the UI mounts are cached per mount, not per path
+	do("GET", "/v1/sys/internal/ui/mounts/secret/foo", "token")
+	body := do("GET", "/v1/sys/internal/ui/mounts/secret/bar", "token")
+	expectRequests("GET /v1/sys/internal/ui/mounts/secret/foo", 1)
+	expectRequests("GET /v1/sys/internal/ui/mounts/secret/bar", 0)
+	if !strings.Contains(body, `"path": "secret/"`) {
+		t.Fatalf("expected the cached response of the secret/ mount, got %s", body)
+	}
+	do("GET", "/v1/sys/internal/ui/mounts/kv/foo", "token")
+	expectRequests("GET /v1/sys/internal/ui/mounts/kv/foo", 1)
+
+	// writing to the mount tables empties the cache
+	do("POST", "/v1/sys/mounts/new", "token")
+	do("GET", "/v1/sys/mounts", "token")
+	do("GET", "/v1/sys/internal/ui/mounts/secret/bar", "token")
+	expectRequests("GET /v1/sys/mounts", 3)
+	expectRequests("GET /v1/sys/internal/ui/mounts/secret/bar", 1)
+
+	// writing elsewhere doesn't
+	do("PUT", "/v1/secret/data/foo", "token")
+	do("GET", "/v1/sys/mounts", "token")
+	expectRequests("GET /v1/sys/mounts", 3)
+}
+
+func TestMountCacheTransport_expiry(t *testing.T) {
+	var mu sync.Mutex
+	var count int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		count++
+		mu.Unlock()
+		fmt.Fprint(w, `{"data": {}}`)
+	}))
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: newMountCacheTransport(http.DefaultTransport, time.Millisecond),
+	}
+	for i := 0; i < 2; i++ {
+		resp, err := client.Get(server.URL + "/v1/sys/mounts")
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if count != 2 {
+		t.Fatalf("expected the cached response to expire, got %d requests", count)
+	}
+}
+
+func TestMountCacheSysPath(t *testing.T) {
+	for urlPath, expected := range map[string]string{
+		"/v1/sys/mounts":                       "sys/mounts",
+		"/v1/sys/auth/":                        "sys/auth",
+		"/v1/secret/data/sys/mounts":           "",
+		"/v1/secret/sys/auth":                  "",
+		"/v1/sys/internal/ui/mounts/secret/a/": "sys/internal/ui/mounts/secret/a",
+		"/v1/secret/data/foo":                  "",
+		"/sys/mounts":                          "",
+	} {
+		if actual := mountCacheSysPath(urlPath); actual != expected {
+			t.Errorf("expected %q for %q, got %q", expected, urlPath, actual)
+		}
+	}
+}
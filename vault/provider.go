@@ -5,13 +5,16 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"net/url"
 	"os"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/mutexkv"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/vault/api"
 	awsauth "github.com/hashicorp/vault/builtin/credential/aws"
 	"github.com/hashicorp/vault/command/config"
@@ -35,6 +38,39 @@ const (
 // The key of the mutex should be the path in Vault.
 var vaultMutexKV = mutexkv.NewMutexKV()
 
+// providerOptions are the options of a configured provider that change how
+// its resources behave, rather than how its client talks to Vault.
+type providerOptions struct {
+	// rawEndpointEnabled records whether the sys/raw endpoint may be used.
+	// Writing to sys/raw bypasses all of Vault's validation, so resources
+	// using it must be explicitly opted in to.
+	rawEndpointEnabled bool
+	// failOnReadPermissionDenied records whether a vault_token whose lookup
+	// is denied by Vault's policies fails the refresh, rather than being
+	// removed from the state as if it was gone. A transient policy change
+	// would otherwise plan the re-creation of every token it affects. Other
+	// resources already return the error of a denied read.
+	failOnReadPermissionDenied bool
+}
+
+// providerOptionsByClient holds the options of each configured provider,
+// keyed by the client it returns as its meta, so provider aliases configured
+// differently don't affect each other.
+var providerOptionsByClient sync.Map
+
+// getProviderOptions returns the options of the provider whose meta is
+// given, or the defaults if it wasn't configured.
+func getProviderOptions(meta interface{}) providerOptions {
+	client, ok := meta.(*api.Client)
+	if !ok {
+		return providerOptions{}
+	}
+	if opts, ok := providerOptionsByClient.Load(client); ok {
+		return opts.(providerOptions)
+	}
+	return providerOptions{}
+}
+
 func Provider() *schema.Provider {
 	dataSourcesMap, err := parse(DataSourceRegistry)
 	if err != nil {
@@ -48,10 +84,23 @@ func Provider() *schema.Provider {
 		Schema: map[string]*schema.Schema{
 			"address": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("VAULT_ADDR", nil),
 				Description: "URL of the root of the target Vault server.",
 			},
+			"agent_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("VAULT_AGENT_ADDR", ""),
+				Description: "URL of the API proxy of a Vault Agent using its auto-auth token, to make the Vault requests through.",
+			},
+			"hcp": hcpSchema(),
+			"token_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("TERRAFORM_VAULT_TOKEN_FILE", ""),
+				Description: "Path to a file containing the token to use, e.g. the file sink of a Vault Agent. The file is re-read when it changes.",
+			},
 			"add_address_to_env": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -156,12 +205,50 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("VAULT_MAX_RETRIES", 2),
 				Description: "Maximum number of retries when a 5xx error code is encountered.",
 			},
+			"mount_cache_ttl_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("TERRAFORM_VAULT_MOUNT_CACHE_TTL", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Duration in seconds for which the mount tables read from Vault are cached, 0 disables the cache.",
+			},
+			"max_concurrent_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("TERRAFORM_VAULT_MAX_CONCURRENT_REQUESTS", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum number of concurrent requests made to Vault, 0 means no limit.",
+			},
+			"coalesce_reads": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("TERRAFORM_VAULT_COALESCE_READS", false),
+				Description: "Share the response of identical concurrent read requests made to Vault.",
+			},
 			"namespace": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("VAULT_NAMESPACE", ""),
 				Description: "The namespace to use. Available only for Vault Enterprise",
 			},
+			"enable_raw_endpoint": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("TERRAFORM_VAULT_ENABLE_RAW_ENDPOINT", false),
+				Description: "Allow the vault_raw resource to read and write storage entries through sys/raw.",
+			},
+			"audit_log_request_bodies": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("TERRAFORM_VAULT_AUDIT_LOG_REQUEST_BODIES", false),
+				Description: "Log the bodies of the requests made to Vault and of their responses, with sensitive values redacted, at the DEBUG level.",
+			},
+			"fail_on_read_permission_denied": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("TERRAFORM_VAULT_FAIL_ON_READ_PERMISSION_DENIED", false),
+				Description: "Fail the refresh of a vault_token when looking it up is denied, rather than removing it from the state.",
+			},
 			"headers": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -182,6 +269,20 @@ func Provider() *schema.Provider {
 					},
 				},
 			},
+			"request_headers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The headers to send with each Vault request, keyed by name.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"proxy_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("TERRAFORM_VAULT_PROXY_URL", ""),
+				Description:  "URL of the proxy to make the Vault requests through, overriding the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables.",
+				ValidateFunc: validateProxyURL,
+			},
 		},
 		ConfigureFunc:  providerConfigure,
 		DataSourcesMap: dataSourcesMap,
@@ -216,6 +317,14 @@ var (
 			Resource:      approleAuthBackendRoleIDDataSource(),
 			PathInventory: []string{"/auth/approle/role/{role_name}/role-id"},
 		},
+		"vault_auth_backends": {
+			Resource:      authBackendsDataSource(),
+			PathInventory: []string{"/sys/auth"},
+		},
+		"vault_health": {
+			Resource:      healthDataSource(),
+			PathInventory: []string{"/sys/health"},
+		},
 		"vault_identity_entity": {
 			Resource:      identityEntityDataSource(),
 			PathInventory: []string{"/identity/lookup/entity"},
@@ -224,6 +333,21 @@ var (
 			Resource:      identityGroupDataSource(),
 			PathInventory: []string{"/identity/lookup/group"},
 		},
+		"vault_identity_oidc_openid_config": {
+			Resource:      identityOidcOpenIDConfigDataSource(),
+			PathInventory: []string{"/identity/oidc/provider/{name}/.well-known/openid-configuration"},
+		},
+		"vault_identity_oidc_public_keys": {
+			Resource: identityOidcPublicKeysDataSource(),
+			PathInventory: []string{
+				"/identity/oidc/.well-known/keys",
+				"/identity/oidc/provider/{name}/.well-known/keys",
+			},
+		},
+		"vault_jwt_auth_backend_config": {
+			Resource:      jwtAuthBackendConfigDataSource(),
+			PathInventory: []string{"/auth/jwt/config"},
+		},
 		"vault_kubernetes_auth_backend_config": {
 			Resource:      kubernetesAuthBackendConfigDataSource(),
 			PathInventory: []string{"/auth/kubernetes/config"},
@@ -232,30 +356,99 @@ var (
 			Resource:      kubernetesAuthBackendRoleDataSource(),
 			PathInventory: []string{"/auth/kubernetes/role/{name}"},
 		},
+		"vault_kubernetes_service_account_token": {
+			Resource:      kubernetesServiceAccountTokenDataSource(),
+			PathInventory: []string{"/kubernetes/creds/{role}"},
+		},
 		"vault_ad_access_credentials": {
 			Resource:      adAccessCredentialsDataSource(),
 			PathInventory: []string{"/ad/creds/{role}"},
 		},
+		"vault_import_blocks": {
+			Resource:      importBlocksDataSource(),
+			PathInventory: []string{"/sys/mounts", "/sys/auth", "/sys/policy"},
+		},
+		"vault_mounts": {
+			Resource:      mountsDataSource(),
+			PathInventory: []string{"/sys/mounts"},
+		},
 		"vault_nomad_access_token": {
 			Resource:      nomadAccessCredentialsDataSource(),
 			PathInventory: []string{"/nomad/creds/{role}"},
 		},
+		"vault_terraform_cloud_access_token": {
+			Resource:      terraformCloudAccessTokenDataSource(),
+			PathInventory: []string{"/terraform/creds/{role}"},
+		},
 		"vault_aws_access_credentials": {
 			Resource:      awsAccessCredentialsDataSource(),
 			PathInventory: []string{"/aws/creds"},
 		},
+		"vault_aws_sts_credentials": {
+			Resource:      awsSTSCredentialsDataSource(),
+			PathInventory: []string{"/aws/sts/{name}"},
+		},
 		"vault_azure_access_credentials": {
 			Resource:      azureAccessCredentialsDataSource(),
 			PathInventory: []string{"/azure/creds/{role}"},
 		},
+		"vault_generic_list": {
+			Resource:      genericListDataSource(),
+			PathInventory: []string{"/{path}"},
+		},
 		"vault_generic_secret": {
 			Resource:      genericSecretDataSource(),
 			PathInventory: []string{"/secret/data/{path}"},
 		},
+		"vault_kv_secret_v2_metadata": {
+			Resource:      kvSecretV2MetadataDataSource(),
+			PathInventory: []string{"/secret/metadata/{path}"},
+		},
+		"vault_kv_secrets_list_v2": {
+			Resource:      kvSecretsListV2DataSource(),
+			PathInventory: []string{"/secret/metadata/{path}"},
+		},
+		"vault_license": {
+			Resource:       licenseDataSource(),
+			PathInventory:  []string{"/sys/license/status"},
+			EnterpriseOnly: true,
+		},
+		"vault_pki_secret_backend_cert": {
+			Resource:      pkiSecretBackendCertDataSource(),
+			PathInventory: []string{"/pki/cert/{serial}"},
+		},
+		"vault_password_policy_generate": {
+			Resource:      passwordPolicyGenerateDataSource(),
+			PathInventory: []string{"/sys/policies/password/{name}/generate"},
+		},
+		"vault_policies": {
+			Resource:      policiesDataSource(),
+			PathInventory: []string{"/sys/policy"},
+		},
+		"vault_policy": {
+			Resource:      policyDataSource(),
+			PathInventory: []string{"/sys/policy/{name}"},
+		},
 		"vault_policy_document": {
 			Resource:      policyDocumentDataSource(),
 			PathInventory: []string{"/sys/policy/{name}"},
 		},
+		"vault_leader": {
+			Resource:      leaderDataSource(),
+			PathInventory: []string{"/sys/leader"},
+		},
+		"vault_seal_status": {
+			Resource:      sealStatusDataSource(),
+			PathInventory: []string{"/sys/seal-status"},
+		},
+		"vault_ssh_secret_backend_ca": {
+			Resource:      sshSecretBackendCADataSource(),
+			PathInventory: []string{"/ssh/config/ca"},
+		},
+		"vault_ssh_secret_backend_sign": {
+			Resource:      sshSecretBackendSignDataSource(),
+			PathInventory: []string{"/ssh/sign/{role}"},
+		},
 		"vault_auth_backend": {
 			Resource:      authBackendDataSource(),
 			PathInventory: []string{"/sys/auth"},
@@ -268,10 +461,38 @@ var (
 			Resource:      transitDecryptDataSource(),
 			PathInventory: []string{"/transit/decrypt/{name}"},
 		},
+		"vault_transit_sign": {
+			Resource:      transitSignDataSource(),
+			PathInventory: []string{"/transit/sign/{name}"},
+		},
+		"vault_transit_hmac": {
+			Resource:      transitHMACDataSource(),
+			PathInventory: []string{"/transit/hmac/{name}"},
+		},
 		"vault_gcp_auth_backend_role": {
 			Resource:      gcpAuthBackendRoleDataSource(),
 			PathInventory: []string{"/auth/gcp/role/{role_name}"},
 		},
+		"vault_gcpkms_encrypt": {
+			Resource:      gcpkmsEncryptDataSource(),
+			PathInventory: []string{"/gcpkms/encrypt/{key}"},
+		},
+		"vault_gcpkms_decrypt": {
+			Resource:      gcpkmsDecryptDataSource(),
+			PathInventory: []string{"/gcpkms/decrypt/{key}"},
+		},
+		"vault_gcpkms_sign": {
+			Resource:      gcpkmsSignDataSource(),
+			PathInventory: []string{"/gcpkms/sign/{key}"},
+		},
+		"vault_totp_code": {
+			Resource:      totpCodeDataSource(),
+			PathInventory: []string{"/totp/code/{name}"},
+		},
+		"vault_totp_code_validation": {
+			Resource:      totpCodeValidationDataSource(),
+			PathInventory: []string{"/totp/code/{name}"},
+		},
 	}
 
 	ResourceRegistry = map[string]*Description{
@@ -280,6 +501,14 @@ var (
 			Resource:      alicloudAuthBackendRoleResource(),
 			PathInventory: []string{"/auth/alicloud/role/{name}"},
 		},
+		"vault_alicloud_secret_backend": {
+			Resource:      alicloudSecretBackendResource(),
+			PathInventory: []string{"/alicloud/config"},
+		},
+		"vault_alicloud_secret_backend_role": {
+			Resource:      alicloudSecretBackendRoleResource(),
+			PathInventory: []string{"/alicloud/role/{name}"},
+		},
 		"vault_approle_auth_backend_login": {
 			Resource:      approleAuthBackendLoginResource(),
 			PathInventory: []string{"/auth/approle/login"},
@@ -319,6 +548,10 @@ var (
 			Resource:      adSecretBackendLibraryResource(),
 			PathInventory: []string{"/ad/library/{name}"},
 		},
+		"vault_ad_secret_library_checkout": {
+			Resource:      adSecretBackendLibraryCheckoutResource(),
+			PathInventory: []string{"/ad/library/{name}/check-out", "/ad/library/{name}/check-in", "/ad/library/{name}/status"},
+		},
 		"vault_ad_secret_role": {
 			Resource:      adSecretBackendRoleResource(),
 			PathInventory: []string{"/ad/roles/{role}"},
@@ -363,6 +596,10 @@ var (
 			Resource:      awsSecretBackendRoleResource(),
 			PathInventory: []string{"/aws/roles/{name}"},
 		},
+		"vault_aws_secret_backend_static_role": {
+			Resource:      awsSecretBackendStaticRoleResource(),
+			PathInventory: []string{"/aws/static-roles/{name}"},
+		},
 		"vault_azure_secret_backend": {
 			Resource:      azureSecretBackendResource(),
 			PathInventory: []string{"/azure/config"},
@@ -371,6 +608,10 @@ var (
 			Resource:      azureSecretBackendRoleResource(),
 			PathInventory: []string{"/azure/roles/{name}"},
 		},
+		"vault_azure_secret_backend_static_role": {
+			Resource:      azureSecretBackendStaticRoleResource(),
+			PathInventory: []string{"/azure/static-roles/{name}"},
+		},
 		"vault_azure_auth_backend_config": {
 			Resource:      azureAuthBackendConfigResource(),
 			PathInventory: []string{"/auth/azure/config"},
@@ -387,10 +628,57 @@ var (
 			Resource:      consulSecretBackendRoleResource(),
 			PathInventory: []string{"/consul/roles/{name}"},
 		},
+		"vault_control_group_authorization": {
+			Resource: controlGroupAuthorizationResource(),
+			PathInventory: []string{
+				"/sys/control-group/authorize",
+				"/sys/control-group/request",
+			},
+		},
 		"vault_database_secret_backend_connection": {
 			Resource:      databaseSecretBackendConnectionResource(),
 			PathInventory: []string{"/database/config/{name}"},
 		},
+		"vault_database_secret_backend_connection_couchbase": {
+			Resource:      databaseSecretBackendPluginConnectionResource(databaseCouchbasePlugin),
+			PathInventory: []string{"/database/config/{name}"},
+		},
+		"vault_database_secret_backend_connection_elasticsearch": {
+			Resource:      databaseSecretBackendPluginConnectionResource(databaseElasticsearchPlugin),
+			PathInventory: []string{"/database/config/{name}"},
+		},
+		"vault_database_secret_backend_connection_mongodb": {
+			Resource:      databaseSecretBackendPluginConnectionResource(databaseMongoDBPlugin),
+			PathInventory: []string{"/database/config/{name}"},
+		},
+		"vault_database_secret_backend_connection_mssql": {
+			Resource:      databaseSecretBackendPluginConnectionResource(databaseMSSQLPlugin),
+			PathInventory: []string{"/database/config/{name}"},
+		},
+		"vault_database_secret_backend_connection_mysql": {
+			Resource:      databaseSecretBackendPluginConnectionResource(databaseMySQLPlugin),
+			PathInventory: []string{"/database/config/{name}"},
+		},
+		"vault_database_secret_backend_connection_oracle": {
+			Resource:      databaseSecretBackendPluginConnectionResource(databaseOraclePlugin),
+			PathInventory: []string{"/database/config/{name}"},
+		},
+		"vault_database_secret_backend_connection_postgresql": {
+			Resource:      databaseSecretBackendPluginConnectionResource(databasePostgreSQLPlugin),
+			PathInventory: []string{"/database/config/{name}"},
+		},
+		"vault_database_secret_backend_connection_redis": {
+			Resource:      databaseSecretBackendPluginConnectionResource(databaseRedisPlugin),
+			PathInventory: []string{"/database/config/{name}"},
+		},
+		"vault_database_secret_backend_connection_snowflake": {
+			Resource:      databaseSecretBackendPluginConnectionResource(databaseSnowflakePlugin),
+			PathInventory: []string{"/database/config/{name}"},
+		},
+		"vault_database_secret_backend_root_rotation": {
+			Resource:      databaseSecretBackendRootRotationResource(),
+			PathInventory: []string{"/database/rotate-root/{name}"},
+		},
 		"vault_database_secret_backend_role": {
 			Resource:      databaseSecretBackendRoleResource(),
 			PathInventory: []string{"/database/roles/{name}"},
@@ -403,6 +691,13 @@ var (
 			Resource:      githubAuthBackendResource(),
 			PathInventory: []string{"/auth/github/config"},
 		},
+		"vault_github_auth_backend_mappings": {
+			Resource: githubAuthBackendMappingsResource(),
+			PathInventory: []string{
+				"/auth/github/map/teams/{team_name}",
+				"/auth/github/map/users/{user_name}",
+			},
+		},
 		"vault_github_team": {
 			Resource:      githubTeamResource(),
 			PathInventory: []string{"/auth/github/map/teams"},
@@ -431,6 +726,31 @@ var (
 			Resource:      gcpSecretStaticAccountResource(),
 			PathInventory: []string{"/gcp/static-account/{name}"},
 		},
+		"vault_gcp_secret_impersonated_account": {
+			Resource:      gcpSecretImpersonatedAccountResource(),
+			PathInventory: []string{"/gcp/impersonated-account/{name}"},
+		},
+		"vault_gcpkms_secret_backend": {
+			Resource:      gcpkmsSecretBackendResource(),
+			PathInventory: []string{"/gcpkms/config"},
+		},
+		"vault_gcpkms_key": {
+			Resource: gcpkmsKeyResource(),
+			PathInventory: []string{
+				"/gcpkms/keys/{key}",
+				"/gcpkms/keys/config/{key}",
+				"/gcpkms/keys/register/{key}",
+				"/gcpkms/keys/deregister/{key}",
+			},
+		},
+		"vault_cf_auth_backend": {
+			Resource:      cfAuthBackendResource(),
+			PathInventory: []string{"/auth/cf/config"},
+		},
+		"vault_cf_auth_backend_role": {
+			Resource:      cfAuthBackendRoleResource(),
+			PathInventory: []string{"/auth/cf/roles/{role}"},
+		},
 		"vault_cert_auth_backend_role": {
 			Resource:      certAuthBackendRoleResource(),
 			PathInventory: []string{"/auth/cert/certs/{name}"},
@@ -451,6 +771,53 @@ var (
 			Resource:      jwtAuthBackendRoleResource(),
 			PathInventory: []string{"/auth/jwt/role/{name}"},
 		},
+		"vault_keymgmt_key": {
+			Resource: keymgmtKeyResource(),
+			PathInventory: []string{
+				"/keymgmt/key/{name}",
+				"/keymgmt/key/{name}/rotate",
+			},
+		},
+		"vault_keymgmt_kms_provider": {
+			Resource:      keymgmtKMSProviderResource(),
+			PathInventory: []string{"/keymgmt/kms/{name}"},
+		},
+		"vault_keymgmt_distribute_key": {
+			Resource:      keymgmtDistributeKeyResource(),
+			PathInventory: []string{"/keymgmt/kms/{name}/key/{key_name}"},
+		},
+		"vault_kerberos_auth_backend_config": {
+			Resource:      kerberosAuthBackendConfigResource(),
+			PathInventory: []string{"/auth/kerberos/config"},
+		},
+		"vault_kerberos_auth_backend_ldap_config": {
+			Resource:      kerberosAuthBackendLDAPConfigResource(),
+			PathInventory: []string{"/auth/kerberos/config/ldap"},
+		},
+		"vault_kerberos_auth_backend_group": {
+			Resource:      kerberosAuthBackendGroupResource(),
+			PathInventory: []string{"/auth/kerberos/groups/{name}"},
+		},
+		"vault_kmip_secret_backend": {
+			Resource:      kmipSecretBackendResource(),
+			PathInventory: []string{"/kmip/config"},
+		},
+		"vault_kmip_secret_scope": {
+			Resource:      kmipSecretScopeResource(),
+			PathInventory: []string{"/kmip/scope/{scope}"},
+		},
+		"vault_kmip_secret_role": {
+			Resource:      kmipSecretRoleResource(),
+			PathInventory: []string{"/kmip/scope/{scope}/role/{role}"},
+		},
+		"vault_kmip_secret_credential": {
+			Resource: kmipSecretCredentialResource(),
+			PathInventory: []string{
+				"/kmip/scope/{scope}/role/{role}/credential/generate",
+				"/kmip/scope/{scope}/role/{role}/credential/lookup",
+				"/kmip/scope/{scope}/role/{role}/credential/revoke",
+			},
+		},
 		"vault_kubernetes_auth_backend_config": {
 			Resource:      kubernetesAuthBackendConfigResource(),
 			PathInventory: []string{"/auth/kubernetes/config"},
@@ -459,6 +826,22 @@ var (
 			Resource:      kubernetesAuthBackendRoleResource(),
 			PathInventory: []string{"/auth/kubernetes/role/{name}"},
 		},
+		"vault_kubernetes_secret_backend": {
+			Resource:      kubernetesSecretBackendResource(),
+			PathInventory: []string{"/kubernetes/config"},
+		},
+		"vault_kubernetes_secret_backend_role": {
+			Resource:      kubernetesSecretBackendRoleResource(),
+			PathInventory: []string{"/kubernetes/roles/{name}"},
+		},
+		"vault_oci_auth_backend": {
+			Resource:      ociAuthBackendResource(),
+			PathInventory: []string{"/auth/oci/config"},
+		},
+		"vault_oci_auth_backend_role": {
+			Resource:      ociAuthBackendRoleResource(),
+			PathInventory: []string{"/auth/oci/role/{name}"},
+		},
 		"vault_okta_auth_backend": {
 			Resource:      oktaAuthBackendResource(),
 			PathInventory: []string{"/auth/okta/config"},
@@ -483,6 +866,30 @@ var (
 			Resource:      ldapAuthBackendGroupResource(),
 			PathInventory: []string{"/auth/ldap/groups/{name}"},
 		},
+		"vault_ldap_secret_backend": {
+			Resource:      ldapSecretBackendResource(),
+			PathInventory: []string{"/ldap/config"},
+		},
+		"vault_ldap_secret_backend_static_role": {
+			Resource:      ldapSecretBackendStaticRoleResource(),
+			PathInventory: []string{"/ldap/static-role/{role_name}"},
+		},
+		"vault_ldap_secret_backend_dynamic_role": {
+			Resource:      ldapSecretBackendDynamicRoleResource(),
+			PathInventory: []string{"/ldap/role/{role_name}"},
+		},
+		"vault_ldap_secret_backend_library_set": {
+			Resource:      ldapSecretBackendLibrarySetResource(),
+			PathInventory: []string{"/ldap/library/{name}"},
+		},
+		"vault_mongodbatlas_secret_backend": {
+			Resource:      mongodbAtlasSecretBackendResource(),
+			PathInventory: []string{"/mongodbatlas/config"},
+		},
+		"vault_mongodbatlas_secret_role": {
+			Resource:      mongodbAtlasSecretRoleResource(),
+			PathInventory: []string{"/mongodbatlas/roles/{name}"},
+		},
 		"vault_nomad_secret_backend": {
 			Resource: nomadSecretAccessBackendResource(),
 			PathInventory: []string{
@@ -495,6 +902,30 @@ var (
 			Resource:      nomadSecretBackendRoleResource(),
 			PathInventory: []string{"/nomad/role/{role}"},
 		},
+		"vault_plugin": {
+			Resource:      pluginResource(),
+			PathInventory: []string{"/sys/plugins/catalog/{type}/{name}"},
+		},
+		"vault_plugin_pinned_version": {
+			Resource:      pluginPinnedVersionResource(),
+			PathInventory: []string{"/sys/plugins/pins/{type}/{name}"},
+		},
+		"vault_plugin_reload": {
+			Resource:      pluginReloadResource(),
+			PathInventory: []string{"/sys/plugins/reload/backend"},
+		},
+		"vault_plugin_runtime": {
+			Resource:      pluginRuntimeResource(),
+			PathInventory: []string{"/sys/plugins/runtimes/catalog/{type}/{name}"},
+		},
+		"vault_plugin_secret_backend": {
+			Resource:      pluginSecretBackendResource(),
+			PathInventory: []string{"/{path}/config"},
+		},
+		"vault_plugin_secret_backend_role": {
+			Resource:      pluginSecretBackendRoleResource(),
+			PathInventory: []string{"/{path}/roles/{name}"},
+		},
 		"vault_policy": {
 			Resource:      policyResource(),
 			PathInventory: []string{"/sys/policy/{name}"},
@@ -509,11 +940,78 @@ var (
 			PathInventory:  []string{"/sys/policies/rgp/{name}"},
 			EnterpriseOnly: true,
 		},
+		"vault_saml_auth_backend": {
+			Resource:       samlAuthBackendResource(),
+			PathInventory:  []string{"/auth/saml/config"},
+			EnterpriseOnly: true,
+		},
+		"vault_saml_auth_backend_role": {
+			Resource:       samlAuthBackendRoleResource(),
+			PathInventory:  []string{"/auth/saml/role/{name}"},
+			EnterpriseOnly: true,
+		},
+		"vault_seal_rewrap": {
+			Resource:       sealRewrapResource(),
+			PathInventory:  []string{"/sys/sealwrap/rewrap"},
+			EnterpriseOnly: true,
+		},
+		"vault_managed_key_aws": {
+			Resource:       managedKeyAWSResource(),
+			PathInventory:  []string{"/sys/managed-keys/awskms/{name}"},
+			EnterpriseOnly: true,
+		},
+		"vault_managed_key_azure": {
+			Resource:       managedKeyAzureResource(),
+			PathInventory:  []string{"/sys/managed-keys/azurekeyvault/{name}"},
+			EnterpriseOnly: true,
+		},
+		"vault_managed_key_gcp": {
+			Resource:       managedKeyGCPResource(),
+			PathInventory:  []string{"/sys/managed-keys/gcpckms/{name}"},
+			EnterpriseOnly: true,
+		},
+		"vault_managed_key_pkcs11": {
+			Resource:       managedKeyPKCS11Resource(),
+			PathInventory:  []string{"/sys/managed-keys/pkcs11/{name}"},
+			EnterpriseOnly: true,
+		},
 		"vault_mfa_duo": {
 			Resource:       mfaDuoResource(),
 			PathInventory:  []string{"/sys/mfa/method/duo/{name}"},
 			EnterpriseOnly: true,
 		},
+		"vault_identity_mfa_totp": {
+			Resource: identityMFATOTPResource(),
+			PathInventory: []string{
+				"/identity/mfa/method/totp",
+				"/identity/mfa/method/totp/{method_id}",
+			},
+		},
+		"vault_identity_mfa_duo": {
+			Resource: identityMFADuoResource(),
+			PathInventory: []string{
+				"/identity/mfa/method/duo",
+				"/identity/mfa/method/duo/{method_id}",
+			},
+		},
+		"vault_identity_mfa_okta": {
+			Resource: identityMFAOktaResource(),
+			PathInventory: []string{
+				"/identity/mfa/method/okta",
+				"/identity/mfa/method/okta/{method_id}",
+			},
+		},
+		"vault_identity_mfa_pingid": {
+			Resource: identityMFAPingIDResource(),
+			PathInventory: []string{
+				"/identity/mfa/method/pingid",
+				"/identity/mfa/method/pingid/{method_id}",
+			},
+		},
+		"vault_identity_mfa_login_enforcement": {
+			Resource:      identityMFALoginEnforcementResource(),
+			PathInventory: []string{"/identity/mfa/login-enforcement/{name}"},
+		},
 		"vault_mount": {
 			Resource:      MountResource(),
 			PathInventory: []string{"/sys/mounts/{path}"},
@@ -527,6 +1025,22 @@ var (
 			Resource:      auditResource(),
 			PathInventory: []string{"/sys/audit/{path}"},
 		},
+		"vault_audit_file": {
+			Resource:      auditFileResource(),
+			PathInventory: []string{"/sys/audit/{path}"},
+		},
+		"vault_audit_request_header": {
+			Resource:      auditRequestHeaderResource(),
+			PathInventory: []string{"/sys/config/auditing/request-headers/{header}"},
+		},
+		"vault_audit_socket": {
+			Resource:      auditSocketResource(),
+			PathInventory: []string{"/sys/audit/{path}"},
+		},
+		"vault_audit_syslog": {
+			Resource:      auditSyslogResource(),
+			PathInventory: []string{"/sys/audit/{path}"},
+		},
 		"vault_ssh_secret_backend_ca": {
 			Resource:      sshSecretBackendCAResource(),
 			PathInventory: []string{"/ssh/config/ca"},
@@ -535,6 +1049,10 @@ var (
 			Resource:      sshSecretBackendRoleResource(),
 			PathInventory: []string{"/ssh/roles/{role}"},
 		},
+		"vault_ssh_secret_backend_sign": {
+			Resource:      sshSecretBackendSignResource(),
+			PathInventory: []string{"/ssh/sign/{role}"},
+		},
 		"vault_identity_entity": {
 			Resource:      identityEntityResource(),
 			PathInventory: []string{"/identity/entity"},
@@ -547,6 +1065,17 @@ var (
 			Resource:      identityEntityPoliciesResource(),
 			PathInventory: []string{"/identity/lookup/entity"},
 		},
+		"vault_identity_entities": {
+			Resource: identityEntitiesResource(),
+			PathInventory: []string{
+				"/identity/entity/name/{name}",
+				"/identity/entity/batch-delete",
+			},
+		},
+		"vault_identity_entity_merge": {
+			Resource:      identityEntityMergeResource(),
+			PathInventory: []string{"/identity/entity/merge"},
+		},
 		"vault_identity_group": {
 			Resource:      identityGroupResource(),
 			PathInventory: []string{"/identity/group"},
@@ -559,6 +1088,10 @@ var (
 			Resource:      identityGroupMemberEntityIdsResource(),
 			PathInventory: []string{"/identity/group/id/{id}"},
 		},
+		"vault_identity_group_member_group_ids": {
+			Resource:      identityGroupMemberGroupIdsResource(),
+			PathInventory: []string{"/identity/group/id/{id}"},
+		},
 		"vault_identity_group_policies": {
 			Resource:      identityGroupPoliciesResource(),
 			PathInventory: []string{"/identity/lookup/group"},
@@ -579,6 +1112,22 @@ var (
 			Resource:      identityOidcRole(),
 			PathInventory: []string{"/identity/oidc/role/{name}"},
 		},
+		"vault_identity_oidc_scope": {
+			Resource:      identityOidcScopeResource(),
+			PathInventory: []string{"/identity/oidc/scope/{name}"},
+		},
+		"vault_identity_oidc_assignment": {
+			Resource:      identityOidcAssignmentResource(),
+			PathInventory: []string{"/identity/oidc/assignment/{name}"},
+		},
+		"vault_identity_oidc_client": {
+			Resource:      identityOidcClientResource(),
+			PathInventory: []string{"/identity/oidc/client/{name}"},
+		},
+		"vault_identity_oidc_provider": {
+			Resource:      identityOidcProviderResource(),
+			PathInventory: []string{"/identity/oidc/provider/{name}"},
+		},
 		"vault_rabbitmq_secret_backend": {
 			Resource: rabbitmqSecretBackendResource(),
 			PathInventory: []string{
@@ -586,6 +1135,57 @@ var (
 				"/rabbitmq/config/lease",
 			},
 		},
+		"vault_raft_autopilot": {
+			Resource:      raftAutopilotResource(),
+			PathInventory: []string{"/sys/storage/raft/autopilot/configuration"},
+		},
+		"vault_raft_snapshot_agent_config": {
+			Resource:       raftSnapshotAgentConfigResource(),
+			PathInventory:  []string{"/sys/storage/raft/snapshot-auto/config/{name}"},
+			EnterpriseOnly: true,
+		},
+		"vault_raw": {
+			Resource:      rawResource(),
+			PathInventory: []string{"/sys/raw/{path}"},
+		},
+		"vault_ui_custom_message": {
+			Resource:       uiCustomMessageResource(),
+			PathInventory:  []string{"/sys/config/ui/custom-messages/{id}"},
+			EnterpriseOnly: true,
+		},
+		"vault_ui_header": {
+			Resource:      uiHeaderResource(),
+			PathInventory: []string{"/sys/config/ui/headers/{header}"},
+		},
+		"vault_replication_paths_filter": {
+			Resource:       replicationPathsFilterResource(),
+			PathInventory:  []string{"/sys/replication/performance/primary/paths-filter/{id}"},
+			EnterpriseOnly: true,
+		},
+		"vault_replication_primary": {
+			Resource: replicationPrimaryResource(),
+			PathInventory: []string{
+				"/sys/replication/dr/primary/enable",
+				"/sys/replication/performance/primary/enable",
+			},
+			EnterpriseOnly: true,
+		},
+		"vault_replication_secondary": {
+			Resource: replicationSecondaryResource(),
+			PathInventory: []string{
+				"/sys/replication/dr/secondary/enable",
+				"/sys/replication/performance/secondary/enable",
+			},
+			EnterpriseOnly: true,
+		},
+		"vault_replication_secondary_token": {
+			Resource: replicationSecondaryTokenResource(),
+			PathInventory: []string{
+				"/sys/replication/dr/primary/secondary-token",
+				"/sys/replication/performance/primary/secondary-token",
+			},
+			EnterpriseOnly: true,
+		},
 		"vault_rabbitmq_secret_backend_role": {
 			Resource:      rabbitmqSecretBackendRoleResource(),
 			PathInventory: []string{"/rabbitmq/roles/{name}"},
@@ -602,6 +1202,13 @@ var (
 			Resource:      pkiSecretBackendCertResource(),
 			PathInventory: []string{"/pki/issue/{role}"},
 		},
+		"vault_pki_secret_backend_cert_revocation": {
+			Resource: pkiSecretBackendCertRevocationResource(),
+			PathInventory: []string{
+				"/pki/revoke",
+				"/pki/revoke-with-key",
+			},
+		},
 		"vault_pki_secret_backend_crl_config": {
 			Resource:      pkiSecretBackendCrlConfigResource(),
 			PathInventory: []string{"/pki/config/crl"},
@@ -614,6 +1221,22 @@ var (
 			Resource:      pkiSecretBackendConfigUrlsResource(),
 			PathInventory: []string{"/pki/config/urls"},
 		},
+		"vault_pki_secret_backend_config_issuers": {
+			Resource:      pkiSecretBackendConfigIssuersResource(),
+			PathInventory: []string{"/pki/config/issuers"},
+		},
+		"vault_pki_secret_backend_config_acme": {
+			Resource:      pkiSecretBackendConfigACMEResource(),
+			PathInventory: []string{"/pki/config/acme"},
+		},
+		"vault_pki_secret_backend_config_auto_tidy": {
+			Resource:      pkiSecretBackendConfigAutoTidyResource(),
+			PathInventory: []string{"/pki/config/auto-tidy"},
+		},
+		"vault_pki_secret_backend_config_cluster": {
+			Resource:      pkiSecretBackendConfigClusterResource(),
+			PathInventory: []string{"/pki/config/cluster"},
+		},
 		"vault_pki_secret_backend_intermediate_cert_request": {
 			Resource:      pkiSecretBackendIntermediateCertRequestResource(),
 			PathInventory: []string{"/pki/intermediate/generate/{exported}"},
@@ -622,6 +1245,17 @@ var (
 			Resource:      pkiSecretBackendIntermediateSetSignedResource(),
 			PathInventory: []string{"/pki/intermediate/set-signed"},
 		},
+		"vault_pki_secret_backend_issuer": {
+			Resource:      pkiSecretBackendIssuerResource(),
+			PathInventory: []string{"/pki/issuer/{issuer_ref}"},
+		},
+		"vault_pki_secret_backend_key": {
+			Resource: pkiSecretBackendKeyResource(),
+			PathInventory: []string{
+				"/pki/keys/generate/{type}",
+				"/pki/key/{key_ref}",
+			},
+		},
 		"vault_pki_secret_backend_role": {
 			Resource:      pkiSecretBackendRoleResource(),
 			PathInventory: []string{"/pki/roles/{name}"},
@@ -638,10 +1272,18 @@ var (
 			Resource:      pkiSecretBackendSignResource(),
 			PathInventory: []string{"/pki/sign/{role}"},
 		},
+		"vault_pki_secret_backend_tidy": {
+			Resource:      pkiSecretBackendTidyResource(),
+			PathInventory: []string{"/pki/tidy"},
+		},
 		"vault_quota_lease_count": {
 			Resource:      quotaLeaseCountResource(),
 			PathInventory: []string{"/sys/quotas/lease-count/{name}"},
 		},
+		"vault_quota_config": {
+			Resource:      quotaConfigResource(),
+			PathInventory: []string{"/sys/quotas/config"},
+		},
 		"vault_quota_rate_limit": {
 			Resource:      quotaRateLimitResource(),
 			PathInventory: []string{"/sys/quotas/rate-limit/{name}"},
@@ -658,10 +1300,42 @@ var (
 			Resource:      terraformCloudSecretRoleResource(),
 			PathInventory: []string{"/terraform/role/{name}"},
 		},
+		"vault_totp_secret_backend_key": {
+			Resource:      totpSecretBackendKeyResource(),
+			PathInventory: []string{"/totp/keys/{name}"},
+		},
+		"vault_transform_alphabet": {
+			Resource:      transformAlphabetResource(),
+			PathInventory: []string{"/transform/alphabet/{name}"},
+		},
+		"vault_transform_role": {
+			Resource:      transformRoleResource(),
+			PathInventory: []string{"/transform/role/{name}"},
+		},
+		"vault_transform_store": {
+			Resource:      transformStoreResource(),
+			PathInventory: []string{"/transform/stores/{name}"},
+		},
+		"vault_transform_template": {
+			Resource:      transformTemplateResource(),
+			PathInventory: []string{"/transform/template/{name}"},
+		},
+		"vault_transform_transformation": {
+			Resource:      transformTransformationResource(),
+			PathInventory: []string{"/transform/transformation/{name}"},
+		},
 		"vault_transit_secret_backend_key": {
 			Resource:      transitSecretBackendKeyResource(),
 			PathInventory: []string{"/transit/keys/{name}"},
 		},
+		"vault_transit_secret_backend_key_import": {
+			Resource: transitSecretBackendKeyImportResource(),
+			PathInventory: []string{
+				"/transit/wrapping_key",
+				"/transit/keys/{name}/import",
+				"/transit/keys/{name}/import_version",
+			},
+		},
 		"vault_transit_secret_cache_config": {
 			Resource:      transitSecretBackendCacheConfig(),
 			PathInventory: []string{"/transit/cache-config"},
@@ -704,9 +1378,7 @@ func providerToken(d *schema.ResourceData) (string, error) {
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	clientConfig := api.DefaultConfig()
 	addr := d.Get("address").(string)
-	if addr != "" {
-		clientConfig.Address = addr
-	}
+	agentAddr := d.Get("agent_address").(string)
 
 	clientAuthI := d.Get("client_auth").([]interface{})
 	if len(clientAuthI) > 1 {
@@ -733,7 +1405,62 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		return nil, fmt.Errorf("failed to configure TLS for Vault API: %s", err)
 	}
 
-	clientConfig.HttpClient.Transport = logging.NewTransport("Vault", clientConfig.HttpClient.Transport)
+	if proxyURL := d.Get("proxy_url").(string); proxyURL != "" {
+		u, err := url.Parse(proxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse proxy_url: %s", err)
+		}
+		clientConfig.HttpClient.Transport.(*http.Transport).Proxy = http.ProxyURL(u)
+	}
+
+	// The HCP API is called through the same proxy and TLS settings as Vault.
+	var hcpCluster *hcpVaultCluster
+	if v := d.Get("hcp").([]interface{}); len(v) == 1 {
+		if d.Get("token").(string) != "" {
+			return nil, errors.New("token cannot be set when connecting to HCP Vault with hcp, unset it and VAULT_TOKEN")
+		}
+		config := v[0].(map[string]interface{})
+		hcpClient := newHCPClient(config["client_id"].(string), config["client_secret"].(string), clientConfig.HttpClient.Transport)
+		cluster, err := readHCPVaultCluster(hcpClient, config)
+		if err != nil {
+			return nil, err
+		}
+		hcpCluster = cluster
+		addr = cluster.Address
+	}
+
+	if addr == "" && agentAddr == "" {
+		return nil, errors.New("address or agent_address must be set")
+	}
+	if agentAddr != "" {
+		clientConfig.Address = agentAddr
+	} else {
+		clientConfig.Address = addr
+	}
+
+	var tokenFile *tokenFileTransport
+	if path := d.Get("token_file").(string); path != "" && agentAddr == "" {
+		tokenFile, err = newTokenFileTransport(clientConfig.HttpClient.Transport, path)
+		if err != nil {
+			return nil, err
+		}
+		clientConfig.HttpClient.Transport = tokenFile
+	}
+
+	var redactedHeaderNames []string
+	for _, h := range d.Get("headers").([]interface{}) {
+		redactedHeaderNames = append(redactedHeaderNames, h.(map[string]interface{})["name"].(string))
+	}
+	for name := range d.Get("request_headers").(map[string]interface{}) {
+		redactedHeaderNames = append(redactedHeaderNames, name)
+	}
+	clientConfig.HttpClient.Transport = newRedactingLoggingTransport(clientConfig.HttpClient.Transport, d.Get("audit_log_request_bodies").(bool), redactedHeaderNames)
+	if ttl := d.Get("mount_cache_ttl_seconds").(int); ttl > 0 {
+		clientConfig.HttpClient.Transport = newMountCacheTransport(clientConfig.HttpClient.Transport, time.Duration(ttl)*time.Second)
+	}
+	if maxConcurrent, coalesce := d.Get("max_concurrent_requests").(int), d.Get("coalesce_reads").(bool); maxConcurrent > 0 || coalesce {
+		clientConfig.HttpClient.Transport = newCoalescingTransport(clientConfig.HttpClient.Transport, maxConcurrent, coalesce)
+	}
 
 	client, err := api.NewClient(clientConfig)
 	if err != nil {
@@ -756,15 +1483,31 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 			parsedHeaders.Add(name.(string), header["value"].(string))
 		}
 	}
+	for name, value := range d.Get("request_headers").(map[string]interface{}) {
+		parsedHeaders.Add(name, value.(string))
+	}
 	client.SetHeaders(parsedHeaders)
 
 	client.SetMaxRetries(d.Get("max_retries").(int))
 
+	providerOptionsByClient.Store(client, providerOptions{
+		rawEndpointEnabled:         d.Get("enable_raw_endpoint").(bool),
+		failOnReadPermissionDenied: d.Get("fail_on_read_permission_denied").(bool),
+	})
+
+	if agentAddr != "" || tokenFile != nil {
+		return configureAgentClient(d, client, tokenFile)
+	}
+
 	// Try an get the token from the config or token helper
 	token, err := providerToken(d)
 	if err != nil {
 		return nil, err
 	}
+	if hcpCluster != nil {
+		token = hcpCluster.AdminToken
+		client.SetNamespace(hcpCluster.Namespace)
+	}
 
 	// Attempt to use auth/<mount>login if 'auth_login' is provided in provider config
 	authLoginI := d.Get("auth_login").([]interface{})
@@ -864,7 +1607,11 @@ func parse(descs map[string]*Description) (map[string]*schema.Resource, error) {
 	var errs error
 	resourceMap := make(map[string]*schema.Resource)
 	for k, desc := range descs {
-		resourceMap[k] = desc.Resource
+		// the registry is shared by all the providers, so the errors are
+		// decorated on a copy of the resource
+		r := *desc.Resource
+		wrapResourceErrors(&r)
+		resourceMap[k] = &r
 		if len(desc.PathInventory) == 0 {
 			errs = multierror.Append(errs, fmt.Errorf("%q needs its paths inventoried", k))
 		}
@@ -137,6 +137,17 @@ func getTestGCPCreds(t *testing.T) (string, string) {
 	return string(contents), project
 }
 
+func getTestGCPKMSKeyRing(t *testing.T) (string, string) {
+	credentials, _ := getTestGCPCreds(t)
+	keyRing := os.Getenv("GOOGLE_KMS_KEY_RING")
+
+	if keyRing == "" {
+		t.Skip("GOOGLE_KMS_KEY_RING not set")
+	}
+
+	return credentials, keyRing
+}
+
 func getTestRMQCreds(t *testing.T) (string, string, string) {
 	connectionUri := os.Getenv("RMQ_CONNECTION_URI")
 	username := os.Getenv("RMQ_USERNAME")
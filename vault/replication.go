@@ -0,0 +1,69 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+// replicationModeSchema returns the schema of the replication mode, which
+// decides whether DR or performance replication is managed.
+func replicationModeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		Description:  "Type of replication. Valid values are dr and performance.",
+		ValidateFunc: validation.StringInSlice([]string{"dr", "performance"}, false),
+	}
+}
+
+func replicationPath(mode, path string) string {
+	return fmt.Sprintf("sys/replication/%s/%s", mode, path)
+}
+
+// replicationStatus reads the status of the given replication mode on the
+// cluster the client is connected to.
+func replicationStatus(client *api.Client, mode string) (map[string]interface{}, error) {
+	log.Printf("[DEBUG] Reading %s replication status", mode)
+	resp, err := client.Logical().Read(replicationPath(mode, "status"))
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s replication status: %s", mode, err)
+	}
+	log.Printf("[DEBUG] Read %s replication status", mode)
+
+	if resp == nil {
+		return nil, fmt.Errorf("no %s replication status returned", mode)
+	}
+	return resp.Data, nil
+}
+
+// replicationStatusUnauthenticated reads the status of the given replication
+// mode from the unauthenticated sys/replication/status endpoint. It works once
+// enabling a secondary has wiped the token the client is using.
+func replicationStatusUnauthenticated(client *api.Client, mode string) (map[string]interface{}, error) {
+	client, err := client.Clone()
+	if err != nil {
+		return nil, fmt.Errorf("error cloning client: %s", err)
+	}
+	client.ClearToken()
+
+	log.Printf("[DEBUG] Reading %s replication status", mode)
+	resp, err := client.Logical().Read("sys/replication/status")
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s replication status: %s", mode, err)
+	}
+	log.Printf("[DEBUG] Read %s replication status", mode)
+
+	if resp == nil {
+		return nil, fmt.Errorf("no %s replication status returned", mode)
+	}
+	status, ok := resp.Data[mode].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("no %s replication status returned", mode)
+	}
+	return status, nil
+}
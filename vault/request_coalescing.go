@@ -0,0 +1,136 @@
+package vault
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"sync"
+)
+
+// coalescedCall is a GET request in flight, whose response is shared by the
+// identical requests made in the meantime.
+type coalescedCall struct {
+	done       chan struct{}
+	statusCode int
+	header     http.Header
+	body       []byte
+	err        error
+	// shared is false when the response can't be shared, e.g. when it
+	// issued a lease.
+	shared bool
+}
+
+// coalescingTransport bounds the number of concurrent requests made to Vault
+// and, when coalesce is set, makes identical GET requests issued while one is
+// already in flight wait for and share its response rather than hitting
+// Vault again. During a refresh this collapses the reads of the resources
+// sharing the same Vault objects, e.g. the members of a group or the allowed
+// client IDs of a key, into a single request. Responses issuing a lease or a
+// token, e.g. from database/creds, are never shared: the waiting requests
+// are sent to Vault once the first one completed.
+type coalescingTransport struct {
+	transport http.RoundTripper
+	coalesce  bool
+	// sem bounds the number of concurrent requests, it's nil when
+	// unbounded.
+	sem chan struct{}
+
+	mu    sync.Mutex
+	calls map[string]*coalescedCall
+}
+
+func newCoalescingTransport(transport http.RoundTripper, maxConcurrent int, coalesce bool) *coalescingTransport {
+	t := &coalescingTransport{
+		transport: transport,
+		coalesce:  coalesce,
+		calls:     map[string]*coalescedCall{},
+	}
+	if maxConcurrent > 0 {
+		t.sem = make(chan struct{}, maxConcurrent)
+	}
+	return t
+}
+
+func (t *coalescingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Wrapped responses can only be unwrapped once, so they can't be shared.
+	if !t.coalesce || req.Method != http.MethodGet || req.Header.Get("X-Vault-Wrap-TTL") != "" {
+		return t.roundTrip(req)
+	}
+
+	key := mountCachePartition(req) + req.URL.RequestURI()
+
+	t.mu.Lock()
+	if c, ok := t.calls[key]; ok {
+		t.mu.Unlock()
+		log.Printf("[TRACE] Waiting for the in flight request to %s", req.URL.Path)
+		select {
+		case <-c.done:
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+		if !c.shared {
+			return t.roundTrip(req)
+		}
+		return c.response(req)
+	}
+	c := &coalescedCall{done: make(chan struct{})}
+	t.calls[key] = c
+	t.mu.Unlock()
+
+	resp, err := t.roundTrip(req)
+	if err == nil {
+		c.statusCode = resp.StatusCode
+		c.header = resp.Header.Clone()
+		c.body, err = ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = ioutil.NopCloser(bytes.NewReader(c.body))
+	}
+	c.err = err
+	c.shared = err != nil || !coalescingResponseIssuesSecret(c.body)
+
+	t.mu.Lock()
+	delete(t.calls, key)
+	t.mu.Unlock()
+	close(c.done)
+
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (t *coalescingTransport) roundTrip(req *http.Request) (*http.Response, error) {
+	if t.sem != nil {
+		select {
+		case t.sem <- struct{}{}:
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+		defer func() { <-t.sem }()
+	}
+
+	return t.transport.RoundTrip(req)
+}
+
+func (c *coalescedCall) response(req *http.Request) (*http.Response, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	return newBufferedResponse(req, c.statusCode, c.header, c.body), nil
+}
+
+// coalescingResponseIssuesSecret returns whether the body of a Vault response
+// carries a lease or a token, which must only be handed to a single caller.
+func coalescingResponseIssuesSecret(body []byte) bool {
+	var secret struct {
+		LeaseID string          `json:"lease_id"`
+		Auth    json.RawMessage `json:"auth"`
+	}
+	if err := json.Unmarshal(body, &secret); err != nil {
+		return false
+	}
+	return secret.LeaseID != "" || (len(secret.Auth) > 0 && string(secret.Auth) != "null")
+}
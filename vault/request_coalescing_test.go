@@ -0,0 +1,203 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCoalescingTransport_coalesce(t *testing.T) {
+	var count int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&count, 1)
+		if r.Method == http.MethodGet {
+			<-release
+		}
+		w.Write([]byte(r.Method + " " + r.URL.Path))
+	}))
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: newCoalescingTransport(http.DefaultTransport, 0, true),
+	}
+
+	var wg sync.WaitGroup
+	bodies := make([]string, 5)
+	for i := range bodies {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			resp, err := client.Get(server.URL + "/v1/identity/group/id/foo")
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			defer resp.Body.Close()
+
+			body, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			bodies[i] = string(body)
+		}(i)
+	}
+
+	// give the requests time to reach the transport before answering
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if c := atomic.LoadInt32(&count); c != 1 {
+		t.Fatalf("expected a single request to reach Vault, got %d", c)
+	}
+	for i, body := range bodies {
+		if body != "GET /v1/identity/group/id/foo" {
+			t.Errorf("unexpected body for request %d: %q", i, body)
+		}
+	}
+
+	// writes are never coalesced
+	for i := 0; i < 2; i++ {
+		resp, err := client.Post(server.URL+"/v1/identity/group/id/foo", "application/json", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+	if c := atomic.LoadInt32(&count); c != 3 {
+		t.Fatalf("expected 3 requests to reach Vault, got %d", c)
+	}
+}
+
+func TestCoalescingTransport_lease(t *testing.T) {
+	var count int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&count, 1)
+		<-release
+		fmt.Fprintf(w, `{"lease_id": "database/creds/app/%d", "data": {}}`, n)
+	}))
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: newCoalescingTransport(http.DefaultTransport, 0, true),
+	}
+
+	var wg sync.WaitGroup
+	bodies := make([]string, 3)
+	for i := range bodies {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			resp, err := client.Get(server.URL + "/v1/database/creds/app")
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			defer resp.Body.Close()
+
+			body, err := ioutil.ReadAll(resp.Body)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			bodies[i] = string(body)
+		}(i)
+	}
+
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if c := atomic.LoadInt32(&count); c != 3 {
+		t.Fatalf("expected each request to reach Vault, got %d", c)
+	}
+	seen := map[string]bool{}
+	for i, body := range bodies {
+		if seen[body] {
+			t.Errorf("lease shared with request %d: %q", i, body)
+		}
+		seen[body] = true
+	}
+}
+
+func TestCoalescingTransport_waitCanceled(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client := &http.Client{
+		Transport: newCoalescingTransport(http.DefaultTransport, 0, true),
+	}
+
+	go func() {
+		resp, err := client.Get(server.URL + "/v1/sys/mounts")
+		if err == nil {
+			resp.Body.Close()
+		}
+	}()
+	time.Sleep(100 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/v1/sys/mounts", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Do(req); err == nil {
+		t.Fatal("expected the canceled request to fail while waiting")
+	}
+}
+
+func TestCoalescingTransport_maxConcurrent(t *testing.T) {
+	var current, max int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&current, 1)
+		for {
+			m := atomic.LoadInt32(&max)
+			if n <= m || atomic.CompareAndSwapInt32(&max, m, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		atomic.AddInt32(&current, -1)
+	}))
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: newCoalescingTransport(http.DefaultTransport, 2, false),
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			resp, err := client.Get(server.URL + "/v1/sys/mounts")
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	if m := atomic.LoadInt32(&max); m > 2 {
+		t.Fatalf("expected at most 2 concurrent requests, got %d", m)
+	}
+}
@@ -13,11 +13,12 @@ import (
 func adSecretBackendResource() *schema.Resource {
 	fields := map[string]*schema.Schema{
 		"backend": {
-			Type:        schema.TypeString,
-			Default:     "ad",
-			ForceNew:    true,
-			Optional:    true,
-			Description: `The mount path for a backend, for example, the path given in "$ vault auth enable -path=my-ad ad".`,
+			Type:         schema.TypeString,
+			Default:      "ad",
+			ForceNew:     true,
+			Optional:     true,
+			Description:  `The mount path for a backend, for example, the path given in "$ vault auth enable -path=my-ad ad".`,
+			ValidateFunc: validateMountPath,
 			StateFunc: func(v interface{}) string {
 				return strings.Trim(v.(string), "/")
 			},
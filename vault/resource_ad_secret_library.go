@@ -38,7 +38,6 @@ func adSecretBackendLibraryResource() *schema.Resource {
 			Elem:        &schema.Schema{Type: schema.TypeString},
 			Required:    true,
 			Description: "The names of all the service accounts that can be checked out from this set. These service accounts must already exist in Active Directory.",
-			ForceNew:    true,
 		},
 		"max_ttl": {
 			Type:        schema.TypeInt,
@@ -175,16 +174,14 @@ func updateLibraryResource(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if raw, ok := d.GetOk("max_ttl"); ok {
-		data["ttl"] = raw
+		data["max_ttl"] = raw
 	}
 
 	if raw, ok := d.GetOk("ttl"); ok {
 		data["ttl"] = raw
 	}
 
-	if raw, ok := d.GetOk("disable_check_in_enforcement"); ok {
-		data["disable_check_in_enforcement"] = raw
-	}
+	data["disable_check_in_enforcement"] = d.Get("disable_check_in_enforcement")
 
 	if _, err := client.Logical().Write(setPath, data); err != nil {
 		return fmt.Errorf("error updating library %q: %s", setPath, err)
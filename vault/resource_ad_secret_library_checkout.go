@@ -0,0 +1,165 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+func adSecretBackendLibraryCheckoutResource() *schema.Resource {
+	return &schema.Resource{
+		Create: adSecretBackendLibraryCheckoutCreate,
+		Read:   adSecretBackendLibraryCheckoutRead,
+		Delete: adSecretBackendLibraryCheckoutDelete,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "AD Secret Backend to check the service account out from.",
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the library set to check a service account out from.",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The length of the check-out, in seconds. Uses the library set's ttl when not specified.",
+			},
+			"service_account_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the service account that was checked out.",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Password for the service account.",
+			},
+			"lease_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Lease identifier assigned by vault.",
+			},
+			"lease_duration": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Lease duration in seconds relative to the time in lease_start_time.",
+			},
+			"lease_start_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time at which the service account was checked out, using the clock of the system where Terraform was running",
+			},
+			"lease_renewable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if the duration of this lease can be extended through renewal.",
+			},
+		},
+	}
+}
+
+func adSecretBackendLibraryCheckoutPath(d *schema.ResourceData, action string) string {
+	return strings.Trim(d.Get("backend").(string), "/") + "/library/" + d.Get("name").(string) + "/" + action
+}
+
+func adSecretBackendLibraryCheckoutCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := adSecretBackendLibraryCheckoutPath(d, "check-out")
+
+	data := map[string]interface{}{}
+	if v, ok := d.GetOk("ttl"); ok {
+		data["ttl"] = v
+	}
+
+	log.Printf("[DEBUG] Checking out service account from %q", path)
+	secret, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error checking out service account from %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Checked out service account from %q", path)
+
+	if secret == nil {
+		return fmt.Errorf("no library found at path %q", path)
+	}
+
+	serviceAccountName, _ := secret.Data["service_account_name"].(string)
+	if serviceAccountName == "" {
+		return fmt.Errorf("service_account_name is not set in response")
+	}
+
+	d.SetId(secret.LeaseID)
+	d.Set("service_account_name", serviceAccountName)
+	d.Set("password", secret.Data["password"])
+	d.Set("lease_id", secret.LeaseID)
+	d.Set("lease_duration", secret.LeaseDuration)
+	d.Set("lease_start_time", time.Now().Format(time.RFC3339))
+	d.Set("lease_renewable", secret.Renewable)
+
+	return adSecretBackendLibraryCheckoutRead(d, meta)
+}
+
+func adSecretBackendLibraryCheckoutRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := adSecretBackendLibraryCheckoutPath(d, "status")
+	serviceAccountName := d.Get("service_account_name").(string)
+
+	log.Printf("[DEBUG] Reading check-out status from %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil && !util.Is404(err) {
+		return fmt.Errorf("error reading check-out status from %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read check-out status from %q", path)
+
+	if resp == nil {
+		log.Printf("[WARN] Library for check-out %q not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	// The account becomes available again once it has been checked in,
+	// including when its check-out expired.
+	status, _ := resp.Data[serviceAccountName].(map[string]interface{})
+	if available, _ := status["available"].(bool); status == nil || available {
+		log.Printf("[WARN] Service account %q is no longer checked out, removing from state", serviceAccountName)
+		d.SetId("")
+		return nil
+	}
+
+	return nil
+}
+
+func adSecretBackendLibraryCheckoutDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := adSecretBackendLibraryCheckoutPath(d, "check-in")
+	serviceAccountName := d.Get("service_account_name").(string)
+
+	log.Printf("[DEBUG] Checking in service account %q to %q", serviceAccountName, path)
+	_, err := client.Logical().Write(path, map[string]interface{}{
+		"service_account_names": []string{serviceAccountName},
+	})
+	if err != nil && !util.Is404(err) {
+		return fmt.Errorf("error checking in service account %q to %q: %s", serviceAccountName, path, err)
+	}
+	log.Printf("[DEBUG] Checked in service account %q to %q", serviceAccountName, path)
+
+	return nil
+}
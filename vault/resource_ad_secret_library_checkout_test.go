@@ -0,0 +1,90 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccADSecretBackendLibraryCheckout_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-ad")
+	bindDN, bindPass, url := util.GetTestADCreds(t)
+	resourceName := "vault_ad_secret_library_checkout.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { util.TestAccPreCheck(t) },
+		CheckDestroy: testAccADSecretBackendLibraryCheckoutCheckDestroy(backend, "qa"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccADSecretBackendLibraryCheckoutConfig(backend, bindDN, bindPass, url, "qa", 60),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "service_account_name", "Bob"),
+					resource.TestCheckResourceAttrSet(resourceName, "password"),
+					resource.TestCheckResourceAttrSet(resourceName, "lease_id"),
+					resource.TestCheckResourceAttr(resourceName, "lease_duration", "60"),
+				),
+			},
+		},
+	})
+}
+
+// testAccADSecretBackendLibraryCheckoutCheckDestroy checks the service account
+// was checked back in when the check-out was destroyed.
+func testAccADSecretBackendLibraryCheckoutCheckDestroy(backend, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testProvider.Meta().(*api.Client)
+
+		path := backend + "/library/" + name + "/status"
+		resp, err := client.Logical().Read(path)
+		if err != nil {
+			if util.Is404(err) {
+				return nil
+			}
+			return fmt.Errorf("error reading %q: %s", path, err)
+		}
+		if resp == nil {
+			return nil
+		}
+		status, _ := resp.Data["Bob"].(map[string]interface{})
+		if available, _ := status["available"].(bool); status != nil && !available {
+			return fmt.Errorf("service account %q is still checked out", "Bob")
+		}
+		return nil
+	}
+}
+
+func testAccADSecretBackendLibraryCheckoutConfig(backend, bindDN, bindPass, url, name string, ttl int) string {
+	return fmt.Sprintf(`
+resource "vault_ad_secret_backend" "config" {
+	backend = "%s"
+	description = "test description"
+	default_lease_ttl_seconds = "3600"
+	max_lease_ttl_seconds = "7200"
+	binddn = "%s"
+	bindpass = "%s"
+	url = "%s"
+	insecure_tls = "true"
+	userdn = "CN=Users,DC=corp,DC=example,DC=net"
+}
+
+resource "vault_ad_secret_library" "test" {
+    backend = "${vault_ad_secret_backend.config.backend}"
+    name = "%s"
+    service_account_names = ["Bob"]
+    ttl = 120
+    max_ttl = 240
+}
+
+resource "vault_ad_secret_library_checkout" "test" {
+  backend = "${vault_ad_secret_backend.config.backend}"
+  name    = "${vault_ad_secret_library.test.name}"
+  ttl     = %d
+}
+`, backend, bindDN, bindPass, url, name, ttl)
+}
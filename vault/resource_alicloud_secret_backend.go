@@ -0,0 +1,187 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func alicloudSecretBackendResource() *schema.Resource {
+	return &schema.Resource{
+		Create: alicloudSecretBackendCreate,
+		Read:   alicloudSecretBackendRead,
+		Update: alicloudSecretBackendUpdate,
+		Delete: alicloudSecretBackendDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "alicloud",
+				Description:  "Path to mount the backend at.",
+				ValidateFunc: validateMountPath,
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Human-friendly description of the mount for the backend.",
+			},
+			"default_lease_ttl_seconds": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Default lease duration for secrets in seconds",
+			},
+			"max_lease_ttl_seconds": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum possible lease duration for secrets in seconds",
+			},
+			"access_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The AliCloud access key ID Vault uses to generate credentials.",
+			},
+			"secret_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The AliCloud secret key Vault uses to generate credentials.",
+			},
+		},
+	}
+}
+
+func alicloudSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Get("path").(string)
+
+	log.Printf("[DEBUG] Mounting AliCloud backend at %q", path)
+	err := client.Sys().Mount(path, &api.MountInput{
+		Type:        "alicloud",
+		Description: d.Get("description").(string),
+		Config: api.MountConfigInput{
+			DefaultLeaseTTL: fmt.Sprintf("%ds", d.Get("default_lease_ttl_seconds")),
+			MaxLeaseTTL:     fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds")),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error mounting to %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Mounted AliCloud backend at %q", path)
+	d.SetId(path)
+
+	if err := alicloudSecretBackendWriteConfig(client, d); err != nil {
+		return err
+	}
+
+	return alicloudSecretBackendRead(d, meta)
+}
+
+func alicloudSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	if d.HasChange("default_lease_ttl_seconds") || d.HasChange("max_lease_ttl_seconds") {
+		config := api.MountConfigInput{
+			DefaultLeaseTTL: fmt.Sprintf("%ds", d.Get("default_lease_ttl_seconds")),
+			MaxLeaseTTL:     fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds")),
+		}
+		log.Printf("[DEBUG] Updating lease TTLs for %q", path)
+		if err := client.Sys().TuneMount(path, config); err != nil {
+			return fmt.Errorf("error updating mount TTLs for %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Updated lease TTLs for %q", path)
+	}
+
+	if d.HasChange("access_key") || d.HasChange("secret_key") {
+		if err := alicloudSecretBackendWriteConfig(client, d); err != nil {
+			return err
+		}
+	}
+
+	return alicloudSecretBackendRead(d, meta)
+}
+
+func alicloudSecretBackendWriteConfig(client *api.Client, d *schema.ResourceData) error {
+	path := d.Id() + "/config"
+
+	log.Printf("[DEBUG] Writing root credentials to %q", path)
+	_, err := client.Logical().Write(path, map[string]interface{}{
+		"access_key": d.Get("access_key").(string),
+		"secret_key": d.Get("secret_key").(string),
+	})
+	if err != nil {
+		return fmt.Errorf("error configuring root credentials for %q: %s", d.Id(), err)
+	}
+	log.Printf("[DEBUG] Wrote root credentials to %q", path)
+
+	return nil
+}
+
+func alicloudSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading AliCloud backend mount %q from Vault", path)
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return fmt.Errorf("error reading mount %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read AliCloud backend mount %q from Vault", path)
+
+	mount, ok := mounts[strings.Trim(path, "/")+"/"]
+	if !ok {
+		log.Printf("[WARN] Mount %q not found, removing backend from state.", path)
+		d.SetId("")
+		return nil
+	}
+
+	log.Printf("[DEBUG] Reading AliCloud secret backend config %q", path+"/config")
+	resp, err := client.Logical().Read(path + "/config")
+	if err != nil {
+		return fmt.Errorf("error reading AliCloud secret backend config %q: %s", path+"/config", err)
+	}
+	log.Printf("[DEBUG] Read AliCloud secret backend config %q", path+"/config")
+
+	// The secret key is never returned by Vault, so it is kept as configured.
+	if resp != nil {
+		if v, ok := resp.Data["access_key"].(string); ok {
+			d.Set("access_key", v)
+		}
+	}
+
+	d.Set("path", path)
+	d.Set("description", mount.Description)
+	d.Set("default_lease_ttl_seconds", mount.Config.DefaultLeaseTTL)
+	d.Set("max_lease_ttl_seconds", mount.Config.MaxLeaseTTL)
+
+	return nil
+}
+
+func alicloudSecretBackendDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	log.Printf("[DEBUG] Unmounting AliCloud backend %q", path)
+	if err := client.Sys().Unmount(path); err != nil {
+		return fmt.Errorf("error unmounting AliCloud backend from %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Unmounted AliCloud backend %q", path)
+
+	return nil
+}
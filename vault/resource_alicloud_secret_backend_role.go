@@ -0,0 +1,192 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	alicloudSecretBackendRoleBackendFromPathRegex = regexp.MustCompile("^(.+)/role/.+$")
+	alicloudSecretBackendRoleNameFromPathRegex    = regexp.MustCompile("^.+/role/(.+)$")
+)
+
+func alicloudSecretBackendRoleResource() *schema.Resource {
+	return &schema.Resource{
+		Create: alicloudSecretBackendRoleWrite,
+		Read:   alicloudSecretBackendRoleRead,
+		Update: alicloudSecretBackendRoleWrite,
+		Delete: alicloudSecretBackendRoleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Unique name for the role.",
+			},
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the AliCloud Secret Backend the role belongs to.",
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"remote_policies": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"role_arn"},
+				Description:   "Existing RAM policies to attach to the generated RAM user, in the form name:<name>,type:<System|Custom>.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(regexp.MustCompile("^name:[^,]+,type:[^,]+$"), "must be in the form name:<name>,type:<type>"),
+				},
+			},
+			"inline_policies": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ConflictsWith:    []string{"role_arn"},
+				Description:      "JSON array of RAM policy documents to attach to the generated RAM user.",
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: util.JsonDiffSuppress,
+			},
+			"role_arn": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"remote_policies", "inline_policies"},
+				Description:   "ARN of the RAM role to assume for STS credentials instead of creating a RAM user.",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The default TTL of generated credentials in seconds.",
+			},
+			"max_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The maximum TTL of generated credentials in seconds.",
+			},
+		},
+	}
+}
+
+func alicloudSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+	path := alicloudSecretBackendRolePath(backend, name)
+
+	data := map[string]interface{}{
+		"remote_policies": util.TerraformSetToStringArray(d.Get("remote_policies")),
+		"inline_policies": d.Get("inline_policies").(string),
+		"role_arn":        d.Get("role_arn").(string),
+		"ttl":             d.Get("ttl").(int),
+		"max_ttl":         d.Get("max_ttl").(int),
+	}
+
+	log.Printf("[DEBUG] Writing AliCloud secret backend role %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing AliCloud secret backend role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote AliCloud secret backend role %q", path)
+
+	d.SetId(path)
+
+	return alicloudSecretBackendRoleRead(d, meta)
+}
+
+func alicloudSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	backend := alicloudSecretBackendRoleBackendFromPathRegex.FindStringSubmatch(path)
+	name := alicloudSecretBackendRoleNameFromPathRegex.FindStringSubmatch(path)
+	if len(backend) != 2 || len(name) != 2 {
+		return fmt.Errorf("invalid path %q for AliCloud secret backend role", path)
+	}
+
+	log.Printf("[DEBUG] Reading AliCloud secret backend role %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading AliCloud secret backend role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read AliCloud secret backend role %q", path)
+
+	if resp == nil {
+		log.Printf("[WARN] AliCloud secret backend role %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	// Vault returns the remote policies as objects, convert them back to the
+	// name:<name>,type:<type> form they are configured in.
+	var remotePolicies []string
+	if v, ok := resp.Data["remote_policies"].([]interface{}); ok {
+		for _, p := range v {
+			policy, _ := p.(map[string]interface{})
+			remotePolicies = append(remotePolicies, fmt.Sprintf("name:%s,type:%s", policy["name"], policy["type"]))
+		}
+	}
+
+	// Vault returns the inline policies with their hash, only the policy
+	// documents are kept.
+	inlinePolicies := ""
+	if v, ok := resp.Data["inline_policies"].([]interface{}); ok && len(v) > 0 {
+		var documents []interface{}
+		for _, p := range v {
+			policy, _ := p.(map[string]interface{})
+			documents = append(documents, policy["policy_document"])
+		}
+		b, err := json.Marshal(documents)
+		if err != nil {
+			return fmt.Errorf("error encoding inline policies of %q: %s", path, err)
+		}
+		inlinePolicies = string(b)
+	}
+
+	d.Set("backend", backend[1])
+	d.Set("name", name[1])
+	d.Set("inline_policies", inlinePolicies)
+	for k, v := range map[string]interface{}{
+		"remote_policies": remotePolicies,
+		"role_arn":        resp.Data["role_arn"],
+		"ttl":             resp.Data["ttl"],
+		"max_ttl":         resp.Data["max_ttl"],
+	} {
+		if err := d.Set(k, v); err != nil {
+			return fmt.Errorf("error setting state key %q: %s", k, err)
+		}
+	}
+
+	return nil
+}
+
+func alicloudSecretBackendRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting AliCloud secret backend role %q", path)
+	if _, err := client.Logical().Delete(path); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error deleting AliCloud secret backend role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted AliCloud secret backend role %q", path)
+
+	return nil
+}
+
+func alicloudSecretBackendRolePath(backend, name string) string {
+	return strings.Trim(backend, "/") + "/role/" + strings.Trim(name, "/")
+}
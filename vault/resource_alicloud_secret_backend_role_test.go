@@ -0,0 +1,129 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAlicloudSecretBackendRole_policies(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-alicloud")
+	name := acctest.RandomWithPrefix("tf-test-alicloud-role")
+	resName := "vault_alicloud_secret_backend_role.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAlicloudSecretBackendRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAlicloudSecretBackendRoleConfig_policies(path, name, "AliyunOSSReadOnlyAccess"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "backend", path),
+					resource.TestCheckResourceAttr(resName, "name", name),
+					resource.TestCheckResourceAttr(resName, "remote_policies.#", "1"),
+					resource.TestCheckResourceAttrSet(resName, "inline_policies"),
+					resource.TestCheckResourceAttr(resName, "ttl", "300"),
+				),
+			},
+			{
+				Config: testAlicloudSecretBackendRoleConfig_policies(path, name, "AliyunECSReadOnlyAccess"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "remote_policies.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAlicloudSecretBackendRole_roleARN(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-alicloud")
+	name := acctest.RandomWithPrefix("tf-test-alicloud-role")
+	resName := "vault_alicloud_secret_backend_role.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAlicloudSecretBackendRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAlicloudSecretBackendRoleConfig_roleARN(path, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "role_arn", "acs:ram::5138828231865461:role/hastrustedactors"),
+					resource.TestCheckResourceAttr(resName, "remote_policies.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAlicloudSecretBackendRoleDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_alicloud_secret_backend_role" {
+			continue
+		}
+		resp, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if resp != nil {
+			return fmt.Errorf("AliCloud secret backend role %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAlicloudSecretBackendRoleConfig_policies(path, name, remotePolicy string) string {
+	return fmt.Sprintf(`
+resource "vault_alicloud_secret_backend" "test" {
+  path       = "%s"
+  access_key = "LTAI4FexampleAccessKey"
+  secret_key = "example-secret-key"
+}
+
+resource "vault_alicloud_secret_backend_role" "test" {
+  backend         = vault_alicloud_secret_backend.test.path
+  name            = "%s"
+  remote_policies = ["name:%s,type:System"]
+  inline_policies = <<EOT
+[
+  {
+    "Statement": [
+      {
+        "Action": "rds:Describe*",
+        "Effect": "Allow",
+        "Resource": "*"
+      }
+    ],
+    "Version": "1"
+  }
+]
+EOT
+  ttl = 300
+}
+`, path, name, remotePolicy)
+}
+
+func testAlicloudSecretBackendRoleConfig_roleARN(path, name string) string {
+	return fmt.Sprintf(`
+resource "vault_alicloud_secret_backend" "test" {
+  path       = "%s"
+  access_key = "LTAI4FexampleAccessKey"
+  secret_key = "example-secret-key"
+}
+
+resource "vault_alicloud_secret_backend_role" "test" {
+  backend  = vault_alicloud_secret_backend.test.path
+  name     = "%s"
+  role_arn = "acs:ram::5138828231865461:role/hastrustedactors"
+}
+`, path, name)
+}
@@ -0,0 +1,73 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAlicloudSecretBackend_basic(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-alicloud")
+	resName := "vault_alicloud_secret_backend.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAlicloudSecretBackendDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAlicloudSecretBackendConfig(path, "LTAI4FexampleAccessKey1", 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "path", path),
+					resource.TestCheckResourceAttr(resName, "access_key", "LTAI4FexampleAccessKey1"),
+					resource.TestCheckResourceAttr(resName, "default_lease_ttl_seconds", "3600"),
+				),
+			},
+			{
+				Config: testAlicloudSecretBackendConfig(path, "LTAI4FexampleAccessKey2", 7200),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "access_key", "LTAI4FexampleAccessKey2"),
+					resource.TestCheckResourceAttr(resName, "default_lease_ttl_seconds", "7200"),
+				),
+			},
+			{
+				ResourceName:            resName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret_key"},
+			},
+		},
+	})
+}
+
+func testAlicloudSecretBackendDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_alicloud_secret_backend" {
+			continue
+		}
+		if _, ok := mounts[rs.Primary.ID+"/"]; ok {
+			return fmt.Errorf("AliCloud secret backend %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAlicloudSecretBackendConfig(path, accessKey string, defaultTTL int) string {
+	return fmt.Sprintf(`
+resource "vault_alicloud_secret_backend" "test" {
+  path                      = "%s"
+  access_key                = "%s"
+  secret_key                = "example-secret-key"
+  default_lease_ttl_seconds = %d
+}
+`, path, accessKey, defaultTTL)
+}
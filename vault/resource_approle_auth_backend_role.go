@@ -41,7 +41,8 @@ func approleAuthBackendRoleResource() *schema.Resource {
 			Optional:    true,
 			Description: "List of CIDR blocks that can log in using the AppRole.",
 			Elem: &schema.Schema{
-				Type: schema.TypeString,
+				Type:         schema.TypeString,
+				ValidateFunc: validateCIDR,
 			},
 			Deprecated:    "use `secret_id_bound_cidrs` instead",
 			ConflictsWith: []string{"secret_id_bound_cidrs"},
@@ -51,7 +52,8 @@ func approleAuthBackendRoleResource() *schema.Resource {
 			Optional:    true,
 			Description: "List of CIDR blocks that can log in using the AppRole.",
 			Elem: &schema.Schema{
-				Type: schema.TypeString,
+				Type:         schema.TypeString,
+				ValidateFunc: validateCIDR,
 			},
 			ConflictsWith: []string{"bound_cidr_list"},
 		},
@@ -6,6 +6,7 @@ import (
 	"log"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-provider-vault/util"
@@ -20,9 +21,12 @@ func approleAuthBackendRoleSecretIDResource() *schema.Resource {
 	return &schema.Resource{
 		Create: approleAuthBackendRoleSecretIDCreate,
 		Read:   approleAuthBackendRoleSecretIDRead,
+		Update: approleAuthBackendRoleSecretIDUpdate,
 		Delete: approleAuthBackendRoleSecretIDDelete,
 		Exists: approleAuthBackendRoleSecretIDExists,
 
+		CustomizeDiff: approleAuthBackendRoleSecretIDDiff,
+
 		Schema: map[string]*schema.Schema{
 			"role_name": {
 				Type:        schema.TypeString,
@@ -45,7 +49,8 @@ func approleAuthBackendRoleSecretIDResource() *schema.Resource {
 				Optional:    true,
 				Description: "List of CIDR blocks that can log in using the SecretID.",
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:         schema.TypeString,
+					ValidateFunc: validateCIDR,
 				},
 				ForceNew: true,
 			},
@@ -68,6 +73,35 @@ func approleAuthBackendRoleSecretIDResource() *schema.Resource {
 				},
 			},
 
+			"num_uses": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Number of times the SecretID can be used, overriding the role's secret_id_num_uses.",
+			},
+
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Duration in seconds after which the SecretID expires, overriding the role's secret_id_ttl.",
+			},
+
+			"regenerate_when_expired": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Destroy and regenerate the SecretID once it has expired.",
+			},
+
+			"expiration_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time at which the SecretID expires, empty if it never expires.",
+			},
+
 			"backend": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -87,11 +121,12 @@ func approleAuthBackendRoleSecretIDResource() *schema.Resource {
 			},
 
 			"wrapping_ttl": {
-				Type:        schema.TypeString,
-				Required:    false,
-				Optional:    true,
-				ForceNew:    true,
-				Description: "The TTL duration of the wrapped SecretID.",
+				Type:         schema.TypeString,
+				Required:     false,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "The TTL duration of the wrapped SecretID.",
+				ValidateFunc: validateVaultDuration,
 			},
 
 			"wrapping_token": {
@@ -141,6 +176,12 @@ func approleAuthBackendRoleSecretIDCreate(d *schema.ResourceData, meta interface
 	} else {
 		data["metadata"] = ""
 	}
+	if v, ok := d.GetOk("num_uses"); ok {
+		data["num_uses"] = v.(int)
+	}
+	if v, ok := d.GetOk("ttl"); ok {
+		data["ttl"] = v.(int)
+	}
 
 	wrappingTTL, wrapped := d.GetOk("wrapping_ttl")
 
@@ -240,6 +281,18 @@ func approleAuthBackendRoleSecretIDRead(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("unknown type %T for cidr_list in response for SecretID %q", resp.Data["cidr_list"], accessor)
 	}
 
+	var expirationTime string
+	if v, ok := resp.Data["expiration_time"].(string); ok {
+		expiration, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			return fmt.Errorf("error parsing expiration_time %q for SecretID %q: %s", v, id, err)
+		}
+		// SecretIDs without a TTL are returned with the zero time.
+		if !expiration.IsZero() {
+			expirationTime = v
+		}
+	}
+
 	metadata, err := json.Marshal(resp.Data["metadata"])
 	if err != nil {
 		return fmt.Errorf("error encoding metadata for SecretID %q to JSON: %s", id, err)
@@ -253,10 +306,47 @@ func approleAuthBackendRoleSecretIDRead(d *schema.ResourceData, meta interface{}
 	}
 	d.Set("metadata", string(metadata))
 	d.Set("accessor", accessor)
+	// secret_id_num_uses is the remaining number of uses, which goes down on
+	// every login, so the configured num_uses is kept instead.
+	d.Set("ttl", resp.Data["secret_id_ttl"])
+	d.Set("expiration_time", expirationTime)
 
 	return nil
 }
 
+// approleAuthBackendRoleSecretIDDiff forces a new SecretID once it has expired
+// and regenerate_when_expired is set, leaving the old one to be destroyed by
+// Delete.
+func approleAuthBackendRoleSecretIDDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.Get("regenerate_when_expired").(bool) {
+		return nil
+	}
+
+	v := d.Get("expiration_time").(string)
+	if v == "" {
+		return nil
+	}
+	expiration, err := time.Parse(time.RFC3339Nano, v)
+	if err != nil {
+		return fmt.Errorf("error parsing expiration_time %q for SecretID %q: %s", v, d.Id(), err)
+	}
+	if time.Now().Before(expiration) {
+		return nil
+	}
+
+	log.Printf("[DEBUG] AppRole auth backend role SecretID %q has expired and will be regenerated", d.Id())
+	if err := d.SetNewComputed("expiration_time"); err != nil {
+		return err
+	}
+	return d.ForceNew("expiration_time")
+}
+
+func approleAuthBackendRoleSecretIDUpdate(d *schema.ResourceData, meta interface{}) error {
+	// Only regenerate_when_expired can be updated in place and it is never
+	// sent to Vault.
+	return approleAuthBackendRoleSecretIDRead(d, meta)
+}
+
 func approleAuthBackendRoleSecretIDDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 	id := d.Id()
@@ -279,8 +369,8 @@ func approleAuthBackendRoleSecretIDDelete(d *schema.ResourceData, meta interface
 	_, err = client.Logical().Write(path, map[string]interface{}{
 		accessorParam: accessor,
 	})
-	if err != nil {
-		return fmt.Errorf("error deleting AppRole auth backend role SecretID %q", id)
+	if err != nil && !util.IsExpiredTokenErr(err) && !util.Is404(err) {
+		return fmt.Errorf("error deleting AppRole auth backend role SecretID %q: %s", id, err)
 	}
 	log.Printf("[DEBUG] Deleted AppRole auth backend role SecretID %q", id)
 
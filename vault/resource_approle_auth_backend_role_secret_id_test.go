@@ -2,7 +2,9 @@ package vault
 
 import (
 	"fmt"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -109,12 +111,56 @@ func TestAccAppRoleAuthBackendRoleSecretID_full(t *testing.T) {
 					resource.TestCheckResourceAttrSet(secretIDResource, "accessor"),
 					resource.TestCheckResourceAttr(secretIDResource, "cidr_list.#", "2"),
 					resource.TestCheckResourceAttr(secretIDResource, "metadata", `{"hello":"world"}`),
+					resource.TestCheckResourceAttr(secretIDResource, "num_uses", "5"),
+					resource.TestCheckResourceAttr(secretIDResource, "ttl", "3600"),
+					resource.TestCheckResourceAttrSet(secretIDResource, "expiration_time"),
+					resource.TestCheckResourceAttr(secretIDResource, "regenerate_when_expired", "true"),
 				),
 			},
 		},
 	})
 }
 
+func TestAppRoleAuthBackendRoleSecretIDDiff_regenerate(t *testing.T) {
+	tests := []struct {
+		expiration  time.Time
+		regenerate  bool
+		requiresNew bool
+	}{
+		{time.Now().Add(time.Hour), true, false},
+		{time.Now().Add(-time.Hour), true, true},
+		{time.Now().Add(-time.Hour), false, false},
+	}
+
+	for i, test := range tests {
+		state := &terraform.InstanceState{
+			ID: "backend=approle::role=test::accessor=abc",
+			Attributes: map[string]string{
+				"backend":                 "approle",
+				"role_name":               "test",
+				"secret_id":               "secret",
+				"accessor":                "abc",
+				"num_uses":                "5",
+				"regenerate_when_expired": strconv.FormatBool(test.regenerate),
+				"expiration_time":         test.expiration.Format(time.RFC3339Nano),
+			},
+		}
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"role_name":               "test",
+			"num_uses":                5,
+			"regenerate_when_expired": test.regenerate,
+		})
+
+		diff, err := approleAuthBackendRoleSecretIDResource().Diff(state, config, nil)
+		if err != nil {
+			t.Fatalf("test %d: %s", i, err)
+		}
+		if actual := diff != nil && diff.RequiresNew(); actual != test.requiresNew {
+			t.Errorf("test %d: expected RequiresNew %t, got %t", i, test.requiresNew, actual)
+		}
+	}
+}
+
 func testAccCheckAppRoleAuthBackendRoleSecretIDDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -175,7 +221,10 @@ resource "vault_approle_auth_backend_role_secret_id" "secret_id" {
 }
 EOF
 
-  secret_id = "%s"
+  secret_id               = "%s"
+  num_uses                = 5
+  ttl                     = 3600
+  regenerate_when_expired = true
 }`, backend, role, secretID)
 }
 
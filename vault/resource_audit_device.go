@@ -0,0 +1,224 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+// auditDeviceCommonOptions are the typed options supported by every audit
+// device type.
+var auditDeviceCommonOptions = []string{
+	"log_raw",
+	"hmac_accessor",
+	"prefix",
+	"elide_list_responses",
+	"format",
+	"fallback",
+	"filter",
+}
+
+// auditDeviceEnterpriseOptions are the common options only accepted by Vault
+// Enterprise. Vault rejects them whenever they are present otherwise, so they
+// are only sent when set.
+var auditDeviceEnterpriseOptions = map[string]bool{
+	"fallback": true,
+	"filter":   true,
+}
+
+// auditDeviceResource returns a resource managing an audit device of the
+// given type. Each field of deviceSchema is passed to Vault as the device
+// option of the same name, alongside the options common to all devices.
+func auditDeviceResource(deviceType string, deviceSchema map[string]*schema.Schema) *schema.Resource {
+	s := map[string]*schema.Schema{
+		"path": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     deviceType,
+			Description: "Path in which to enable the audit device.",
+			StateFunc: func(v interface{}) string {
+				return strings.Trim(v.(string), "/")
+			},
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Human-friendly description of the audit device.",
+		},
+		"local": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "Specifies if the audit device is a local only. Local audit devices are not replicated nor (if a secondary) removed by replication.",
+		},
+		"log_raw": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Log security sensitive information without hashing.",
+		},
+		"hmac_accessor": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Hash the token accessor.",
+		},
+		"prefix": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Customizable string prefix to write before the actual log line.",
+		},
+		"elide_list_responses": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Replace the details of list responses with a count of the keys.",
+		},
+		"format": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "json",
+			Description:  "Format of the audit log entries. Valid values are json and jsonx.",
+			ValidateFunc: validation.StringInSlice([]string{"json", "jsonx"}, false),
+		},
+		"fallback": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Use the device as the fallback device, which receives entries not matched by the filter of any other device. Requires Vault Enterprise 1.16+.",
+		},
+		"filter": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Filter expression deciding which entries are written to the device. Requires Vault Enterprise 1.16+.",
+		},
+	}
+
+	device := &auditDevice{
+		deviceType: deviceType,
+		schema:     s,
+		options:    append([]string{}, auditDeviceCommonOptions...),
+	}
+	for k, v := range deviceSchema {
+		s[k] = v
+		device.options = append(device.options, k)
+	}
+	// Vault has no way of tuning an audit device, so any change replaces it.
+	for _, v := range s {
+		v.ForceNew = true
+	}
+
+	return &schema.Resource{
+		Create: device.create,
+		Read:   device.read,
+		Delete: auditDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: s,
+	}
+}
+
+// auditDevice implements the CRUD operations for a typed audit device.
+type auditDevice struct {
+	deviceType string
+	schema     map[string]*schema.Schema
+	// options are the fields passed to Vault as device options
+	options []string
+}
+
+func (a *auditDevice) create(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Get("path").(string)
+
+	log.Printf("[DEBUG] Enabling %s audit device %q", a.deviceType, path)
+	if err := client.Sys().EnableAuditWithOptions(path, a.enableOptions(d)); err != nil {
+		return fmt.Errorf("error enabling %s audit device %q: %s", a.deviceType, path, err)
+	}
+	log.Printf("[DEBUG] Enabled %s audit device %q", a.deviceType, path)
+	d.SetId(path)
+
+	return a.read(d, meta)
+}
+
+func (a *auditDevice) read(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading %s audit device %q", a.deviceType, path)
+	audits, err := client.Sys().ListAudit()
+	if err != nil {
+		return fmt.Errorf("error reading %s audit device %q: %s", a.deviceType, path, err)
+	}
+	log.Printf("[DEBUG] Read %s audit device %q", a.deviceType, path)
+
+	audit, ok := audits[strings.Trim(path, "/")+"/"]
+	if !ok {
+		log.Printf("[WARN] Audit device %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+	if audit.Type != a.deviceType {
+		return fmt.Errorf("audit device %q is of type %q, expected %q", path, audit.Type, a.deviceType)
+	}
+
+	d.Set("path", path)
+	d.Set("description", audit.Description)
+	d.Set("local", audit.Local)
+
+	for _, k := range a.options {
+		v, ok := audit.Options[k]
+		if !ok {
+			continue
+		}
+
+		var value interface{} = v
+		if a.schema[k].Type == schema.TypeBool {
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return fmt.Errorf("error parsing option %q of audit device %q: %s", k, path, err)
+			}
+			value = b
+		}
+		if err := d.Set(k, value); err != nil {
+			return fmt.Errorf("error setting state key %q: %s", k, err)
+		}
+	}
+
+	return nil
+}
+
+func (a *auditDevice) enableOptions(d *schema.ResourceData) *api.EnableAuditOptions {
+	opts := &api.EnableAuditOptions{
+		Type:        a.deviceType,
+		Description: d.Get("description").(string),
+		Local:       d.Get("local").(bool),
+		Options:     map[string]string{},
+	}
+
+	for _, k := range a.options {
+		switch v := d.Get(k).(type) {
+		case bool:
+			if !v && auditDeviceEnterpriseOptions[k] {
+				continue
+			}
+			opts.Options[k] = strconv.FormatBool(v)
+		case string:
+			// unset options are left to Vault's defaults
+			if v != "" {
+				opts.Options[k] = v
+			}
+		}
+	}
+
+	return opts
+}
@@ -0,0 +1,22 @@
+package vault
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func auditFileResource() *schema.Resource {
+	return auditDeviceResource("file", map[string]*schema.Schema{
+		"file_path": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Path to the file the audit log is written to, or one of stdout or discard.",
+		},
+		"mode": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Octal file mode of the audit log file. A value of 0000 leaves the mode unchanged.",
+		},
+	})
+}
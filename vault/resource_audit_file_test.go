@@ -0,0 +1,94 @@
+package vault
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestResourceAuditFile(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-audit-file")
+	resourceName := "vault_audit_file.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceAuditFile_config(path, "initial", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "path", path),
+					resource.TestCheckResourceAttr(resourceName, "description", "initial"),
+					resource.TestCheckResourceAttr(resourceName, "file_path", "stdout"),
+					resource.TestCheckResourceAttr(resourceName, "log_raw", "false"),
+					resource.TestCheckResourceAttr(resourceName, "hmac_accessor", "true"),
+					resource.TestCheckResourceAttr(resourceName, "elide_list_responses", "false"),
+					resource.TestCheckResourceAttr(resourceName, "format", "json"),
+					resource.TestCheckResourceAttr(resourceName, "prefix", ""),
+				),
+			},
+			{
+				Config: testResourceAuditFile_config(path, "updated", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "path", path),
+					resource.TestCheckResourceAttr(resourceName, "description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "file_path", "stdout"),
+					resource.TestCheckResourceAttr(resourceName, "hmac_accessor", "false"),
+					resource.TestCheckResourceAttr(resourceName, "elide_list_responses", "true"),
+					resource.TestCheckResourceAttr(resourceName, "prefix", "tf:"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAuditFileEnableOptions_default(t *testing.T) {
+	r := auditFileResource()
+	device := &auditDevice{
+		deviceType: "file",
+		schema:     r.Schema,
+		options:    append([]string{"file_path", "mode"}, auditDeviceCommonOptions...),
+	}
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"file_path": "stdout",
+	})
+
+	expected := map[string]string{
+		"file_path":            "stdout",
+		"log_raw":              "false",
+		"hmac_accessor":        "true",
+		"elide_list_responses": "false",
+		"format":               "json",
+	}
+	if actual := device.enableOptions(d).Options; !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected options %#v, got %#v", expected, actual)
+	}
+}
+
+func testResourceAuditFile_config(path, description string, updated bool) string {
+	config := fmt.Sprintf(`
+resource "vault_audit_file" "test" {
+  path        = "%s"
+  description = "%s"
+  file_path   = "stdout"
+`, path, description)
+
+	if updated {
+		config += `
+  hmac_accessor        = false
+  elide_list_responses = true
+  prefix               = "tf:"
+`
+	}
+
+	return config + "}\n"
+}
@@ -0,0 +1,111 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+const auditRequestHeadersPath = "sys/config/auditing/request-headers"
+
+func auditRequestHeaderResource() *schema.Resource {
+	return &schema.Resource{
+		Create: auditRequestHeaderWrite,
+		Read:   auditRequestHeaderRead,
+		Update: auditRequestHeaderWrite,
+		Delete: auditRequestHeaderDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the request header to audit.",
+			},
+			"hmac": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the header value should be HMAC'd in the audit logs.",
+			},
+		},
+	}
+}
+
+func auditRequestHeaderWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Get("name").(string)
+	path := auditRequestHeaderPath(name)
+
+	log.Printf("[DEBUG] Writing audit request header %q", name)
+	if _, err := client.Logical().Write(path, map[string]interface{}{
+		"hmac": d.Get("hmac").(bool),
+	}); err != nil {
+		return fmt.Errorf("error writing audit request header %q: %s", name, err)
+	}
+	d.SetId(name)
+	log.Printf("[DEBUG] Wrote audit request header %q", name)
+
+	return auditRequestHeaderRead(d, meta)
+}
+
+func auditRequestHeaderRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	name := d.Id()
+
+	// Reading a single header that isn't configured is an error, so all of
+	// the headers are read instead.
+	log.Printf("[DEBUG] Reading audit request header %q", name)
+	resp, err := client.Logical().Read(auditRequestHeadersPath)
+	if err != nil {
+		return fmt.Errorf("error reading audit request header %q: %s", name, err)
+	}
+	log.Printf("[DEBUG] Read audit request header %q", name)
+
+	// Vault stores header names in lower case.
+	var settings map[string]interface{}
+	if resp != nil {
+		headers, _ := resp.Data["headers"].(map[string]interface{})
+		for k, v := range headers {
+			if strings.EqualFold(k, name) {
+				settings, _ = v.(map[string]interface{})
+				break
+			}
+		}
+	}
+	if settings == nil {
+		log.Printf("[WARN] Audit request header %q not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	d.Set("hmac", settings["hmac"])
+
+	return nil
+}
+
+func auditRequestHeaderDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	name := d.Id()
+
+	log.Printf("[DEBUG] Deleting audit request header %q", name)
+	if _, err := client.Logical().Delete(auditRequestHeaderPath(name)); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error deleting audit request header %q: %s", name, err)
+	}
+	log.Printf("[DEBUG] Deleted audit request header %q", name)
+
+	return nil
+}
+
+func auditRequestHeaderPath(name string) string {
+	return auditRequestHeadersPath + "/" + name
+}
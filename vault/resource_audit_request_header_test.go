@@ -0,0 +1,78 @@
+package vault
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestResourceAuditRequestHeader(t *testing.T) {
+	name := acctest.RandomWithPrefix("X-Tf-Test")
+	resourceName := "vault_audit_request_header.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testResourceAuditRequestHeader_destroyCheck,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceAuditRequestHeader_config(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "hmac", "false"),
+				),
+			},
+			{
+				Config: testResourceAuditRequestHeader_config(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "hmac", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testResourceAuditRequestHeader_destroyCheck(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	resp, err := client.Logical().Read(auditRequestHeadersPath)
+	if err != nil {
+		return fmt.Errorf("error reading audit request headers: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_audit_request_header" {
+			continue
+		}
+		if resp == nil {
+			continue
+		}
+		headers, _ := resp.Data["headers"].(map[string]interface{})
+		for k := range headers {
+			if strings.EqualFold(k, rs.Primary.ID) {
+				return fmt.Errorf("audit request header %q still exists", rs.Primary.ID)
+			}
+		}
+	}
+	return nil
+}
+
+func testResourceAuditRequestHeader_config(name string, hmac bool) string {
+	return fmt.Sprintf(`
+resource "vault_audit_request_header" "test" {
+  name = "%s"
+  hmac = %t
+}
+`, name, hmac)
+}
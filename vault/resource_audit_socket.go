@@ -0,0 +1,32 @@
+package vault
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func auditSocketResource() *schema.Resource {
+	return auditDeviceResource("socket", map[string]*schema.Schema{
+		"address": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Address of the socket the audit log is written to, such as 127.0.0.1:9090.",
+		},
+		"socket_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      "tcp",
+			Description:  "Type of the socket. Valid values are tcp, udp and unix.",
+			ValidateFunc: validation.StringInSlice([]string{"tcp", "udp", "unix"}, false),
+		},
+		"write_timeout": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "2s",
+			Description:  "Timeout for writing to the socket, such as 2s. A value of 0 disables the timeout.",
+			ValidateFunc: validateVaultDuration,
+		},
+	})
+}
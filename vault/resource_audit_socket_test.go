@@ -0,0 +1,58 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestResourceAuditSocket(t *testing.T) {
+	address := os.Getenv("VAULT_AUDIT_SOCKET_ADDRESS")
+	if address == "" {
+		t.Skip("VAULT_AUDIT_SOCKET_ADDRESS not set")
+	}
+
+	path := acctest.RandomWithPrefix("tf-test-audit-socket")
+	resourceName := "vault_audit_socket.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceAuditSocket_config(path, address, "2s"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "path", path),
+					resource.TestCheckResourceAttr(resourceName, "address", address),
+					resource.TestCheckResourceAttr(resourceName, "socket_type", "tcp"),
+					resource.TestCheckResourceAttr(resourceName, "write_timeout", "2s"),
+				),
+			},
+			{
+				Config: testResourceAuditSocket_config(path, address, "5s"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "path", path),
+					resource.TestCheckResourceAttr(resourceName, "write_timeout", "5s"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testResourceAuditSocket_config(path, address, writeTimeout string) string {
+	return fmt.Sprintf(`
+resource "vault_audit_socket" "test" {
+  path          = "%s"
+  address       = "%s"
+  write_timeout = "%s"
+}
+`, path, address, writeTimeout)
+}
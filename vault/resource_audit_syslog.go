@@ -0,0 +1,24 @@
+package vault
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func auditSyslogResource() *schema.Resource {
+	return auditDeviceResource("syslog", map[string]*schema.Schema{
+		"facility": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     "AUTH",
+			Description: "Syslog facility to use.",
+		},
+		"tag": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     "vault",
+			Description: "Syslog tag to use.",
+		},
+	})
+}
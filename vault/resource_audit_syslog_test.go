@@ -0,0 +1,56 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestResourceAuditSyslog(t *testing.T) {
+	if os.Getenv("VAULT_AUDIT_SYSLOG") == "" {
+		t.Skip("VAULT_AUDIT_SYSLOG is not set, test requires syslog to be available to the Vault server")
+	}
+
+	path := acctest.RandomWithPrefix("tf-test-audit-syslog")
+	resourceName := "vault_audit_syslog.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceAuditSyslog_config(path, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "path", path),
+					resource.TestCheckResourceAttr(resourceName, "facility", "AUTH"),
+					resource.TestCheckResourceAttr(resourceName, "tag", "vault"),
+					resource.TestCheckResourceAttr(resourceName, "log_raw", "false"),
+				),
+			},
+			{
+				Config: testResourceAuditSyslog_config(path, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "path", path),
+					resource.TestCheckResourceAttr(resourceName, "log_raw", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testResourceAuditSyslog_config(path string, logRaw bool) string {
+	return fmt.Sprintf(`
+resource "vault_audit_syslog" "test" {
+  path    = "%s"
+  log_raw = %t
+}
+`, path, logRaw)
+}
@@ -20,6 +20,15 @@ func TestResourceAudit(t *testing.T) {
 				Config: testResourceAudit_initialConfig(path),
 				Check:  testResourceAudit_initialCheck(path),
 			},
+			{
+				Config: testResourceAudit_updateConfig(path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_audit.test", "description", "Updated file audit for vault"),
+					resource.TestCheckResourceAttr("vault_audit.test", "options.path", "stdout"),
+					resource.TestCheckResourceAttr("vault_audit.test", "options.prefix", "tf:"),
+					resource.TestCheckResourceAttr("vault_audit.test", "options.log_raw", "false"),
+				),
+			},
 		},
 	})
 }
@@ -38,6 +47,22 @@ resource "vault_audit" "test" {
 `, path)
 }
 
+func testResourceAudit_updateConfig(path string) string {
+	return fmt.Sprintf(`
+resource "vault_audit" "test" {
+	path = "%s"
+	type = "file"
+	description = "Updated file audit for vault"
+	local = true
+	options = {
+		path    = "stdout"
+		prefix  = "tf:"
+		log_raw = "false"
+	}
+}
+`, path)
+}
+
 func testResourceAudit_initialCheck(expectedPath string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		resourceState := s.Modules[0].Resources["vault_audit.test"]
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/vault/api"
 )
 
@@ -36,7 +37,7 @@ func AuthBackendResource() *schema.Resource {
 				Computed:     true,
 				ForceNew:     true,
 				Description:  "path to mount the backend. This defaults to the type.",
-				ValidateFunc: validateNoTrailingSlash,
+				ValidateFunc: validation.All(validateNoTrailingSlash, validateMountPath),
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					return old+"/" == new || new+"/" == old
 				},
@@ -44,7 +45,6 @@ func AuthBackendResource() *schema.Resource {
 
 			"description": {
 				Type:        schema.TypeString,
-				ForceNew:    true,
 				Optional:    true,
 				Description: "The description of the auth backend",
 			},
@@ -190,7 +190,7 @@ func authBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 
 			err := authMountTune(client, "auth/"+path, raw)
 			if err != nil {
-				return nil
+				return fmt.Errorf("error writing auth tune to %q: %s", path, err)
 			}
 
 			log.Printf("[INFO] Written %s auth tune to '%q'", backendType, path)
@@ -198,5 +198,16 @@ func authBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	// The description is set when the backend is enabled, so it only needs
+	// to be tuned when it changes afterwards.
+	if !d.IsNewResource() && d.HasChange("description") {
+		description := d.Get("description").(string)
+		tune := api.MountConfigInput{Description: &description}
+		if err := client.Sys().TuneMount("auth/"+path, tune); err != nil {
+			return fmt.Errorf("error updating description of auth %q: %s", path, err)
+		}
+		d.SetPartial("description")
+	}
+
 	return authBackendRead(d, meta)
 }
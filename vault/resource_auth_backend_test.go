@@ -274,6 +274,56 @@ resource "vault_auth_backend" "test" {
 }`, backend)
 }
 
+func TestResourceAuthDescriptionUpdate(t *testing.T) {
+	backend := acctest.RandomWithPrefix("github")
+	resName := "vault_auth_backend.test"
+	var resAuthFirst api.AuthMount
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceAuthDescription_config(backend, "initial description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAuthMountExists(resName, &resAuthFirst),
+					resource.TestCheckResourceAttr(resName, "description", "initial description"),
+					checkAuthMount(backend, func(auth *api.AuthMount) error {
+						if auth.Description != "initial description" {
+							return fmt.Errorf("unexpected auth description %q", auth.Description)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				Config: testResourceAuthDescription_config(backend, "updated description"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(resName, "accessor", &resAuthFirst.Accessor),
+					resource.TestCheckResourceAttr(resName, "description", "updated description"),
+					checkAuthMount(backend, func(auth *api.AuthMount) error {
+						if auth.Description != "updated description" {
+							return fmt.Errorf("unexpected auth description %q", auth.Description)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testResourceAuthDescription_config(backend, description string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "test" {
+	type        = "github"
+	path        = "%s"
+	description = "%s"
+	tune {
+		token_type = "batch"
+	}
+}`, backend, description)
+}
+
 func TestResourceAuthTuneTtlConflict(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		Providers: testProviders,
@@ -40,10 +40,11 @@ func awsAuthBackendRoleTagResource() *schema.Resource {
 				ForceNew: true,
 			},
 			"max_ttl": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "The maximum allowed lifetime of tokens issued using this role.",
-				ForceNew:    true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The maximum allowed lifetime of tokens issued using this role.",
+				ValidateFunc: validateVaultDuration,
+				ForceNew:     true,
 			},
 			"instance_id": {
 				Type:        schema.TypeString,
@@ -9,6 +9,14 @@ import (
 	"github.com/hashicorp/vault/api"
 )
 
+// awsSecretBackendWIFFields are the fields that configure plugin workload
+// identity federation, which replaces the static access keys.
+var awsSecretBackendWIFFields = []string{
+	"identity_token_audience",
+	"identity_token_ttl",
+	"role_arn",
+}
+
 func awsSecretBackendResource() *schema.Resource {
 	return &schema.Resource{
 		Create: awsSecretBackendCreate,
@@ -19,6 +27,7 @@ func awsSecretBackendResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: awsSecretBackendCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"path": {
@@ -58,16 +67,34 @@ func awsSecretBackendResource() *schema.Resource {
 				Description: "Maximum possible lease duration for secrets in seconds",
 			},
 			"access_key": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "The AWS Access Key ID to use when generating new credentials.",
+				Sensitive:     true,
+				ConflictsWith: []string{"role_arn"},
+			},
+			"secret_key": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "The AWS Secret Access Key to use when generating new credentials.",
+				Sensitive:     true,
+				ConflictsWith: []string{"role_arn"},
+			},
+			"role_arn": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "The AWS Access Key ID to use when generating new credentials.",
-				Sensitive:   true,
+				Description: "Role ARN to assume for plugin workload identity federation. Requires Vault 1.16+.",
 			},
-			"secret_key": {
+			"identity_token_audience": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "The AWS Secret Access Key to use when generating new credentials.",
-				Sensitive:   true,
+				Description: "The audience claim value of the plugin identity token. Requires Vault 1.16+.",
+			},
+			"identity_token_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The TTL of generated identity tokens in seconds. Requires Vault 1.16+.",
 			},
 			"region": {
 				Type:        schema.TypeString,
@@ -89,6 +116,24 @@ func awsSecretBackendResource() *schema.Resource {
 	}
 }
 
+// awsSecretBackendCustomizeDiff checks that role_arn and
+// identity_token_audience are set together, as workload identity federation
+// needs both. Values that aren't known until apply aren't checked.
+func awsSecretBackendCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("role_arn") || !d.NewValueKnown("identity_token_audience") {
+		return nil
+	}
+	_, hasRoleARN := d.GetOk("role_arn")
+	_, hasAudience := d.GetOk("identity_token_audience")
+	if hasRoleARN && !hasAudience {
+		return fmt.Errorf("\"identity_token_audience\" is required when \"role_arn\" is set")
+	}
+	if hasAudience && !hasRoleARN {
+		return fmt.Errorf("\"role_arn\" is required when \"identity_token_audience\" is set")
+	}
+	return nil
+}
+
 func awsSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 
@@ -137,6 +182,11 @@ func awsSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
 	if stsEndpoint != "" {
 		data["sts_endpoint"] = stsEndpoint
 	}
+	for _, k := range awsSecretBackendWIFFields {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
 	_, err = client.Logical().Write(path+"/config/root", data)
 	if err != nil {
 		return fmt.Errorf("error configuring root credentials for %q: %s", path, err)
@@ -144,6 +194,9 @@ func awsSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Wrote root credentials to %q", path+"/config/root")
 	d.SetPartial("access_key")
 	d.SetPartial("secret_key")
+	for _, k := range awsSecretBackendWIFFields {
+		d.SetPartial(k)
+	}
 	if region == "" {
 		d.Set("region", "us-east-1")
 	}
@@ -216,6 +269,11 @@ func awsSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
 		if v, ok := resp.Data["sts_endpoint"].(string); ok {
 			d.Set("sts_endpoint", v)
 		}
+		for _, k := range awsSecretBackendWIFFields {
+			if v, ok := resp.Data[k]; ok {
+				d.Set(k, v)
+			}
+		}
 	}
 
 	d.Set("path", path)
@@ -245,7 +303,8 @@ func awsSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 		d.SetPartial("default_lease_ttl_seconds")
 		d.SetPartial("max_lease_ttl_seconds")
 	}
-	if d.HasChange("access_key") || d.HasChange("secret_key") || d.HasChange("region") || d.HasChange("iam_endpoint") || d.HasChange("sts_endpoint") {
+	if d.HasChange("access_key") || d.HasChange("secret_key") || d.HasChange("region") || d.HasChange("iam_endpoint") || d.HasChange("sts_endpoint") ||
+		d.HasChange("role_arn") || d.HasChange("identity_token_audience") || d.HasChange("identity_token_ttl") {
 		log.Printf("[DEBUG] Updating root credentials at %q", path+"/config/root")
 		data := map[string]interface{}{
 			"access_key": d.Get("access_key").(string),
@@ -263,6 +322,11 @@ func awsSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 		if stsEndpoint != "" {
 			data["sts_endpoint"] = stsEndpoint
 		}
+		for _, k := range awsSecretBackendWIFFields {
+			if v, ok := d.GetOk(k); ok || d.HasChange(k) {
+				data[k] = v
+			}
+		}
 		_, err := client.Logical().Write(path+"/config/root", data)
 		if err != nil {
 			return fmt.Errorf("error configuring root credentials for %q: %s", path, err)
@@ -270,6 +334,9 @@ func awsSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 		log.Printf("[DEBUG] Updated root credentials at %q", path+"/config/root")
 		d.SetPartial("access_key")
 		d.SetPartial("secret_key")
+		for _, k := range awsSecretBackendWIFFields {
+			d.SetPartial(k)
+		}
 		if region == "" {
 			d.Set("region", "us-east-1")
 		}
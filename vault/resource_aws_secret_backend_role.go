@@ -11,6 +11,13 @@ import (
 	"github.com/hashicorp/vault/api"
 )
 
+// awsSecretBackendRoleVaultFeatures gates the STS options of the role.
+var awsSecretBackendRoleVaultFeatures = map[string]vaultFeature{
+	"session_tags": {MinVersion: "1.17"},
+	"external_id":  {MinVersion: "1.17"},
+	"mfa_serial":   {MinVersion: "1.16"},
+}
+
 func awsSecretBackendRoleResource() *schema.Resource {
 	return &schema.Resource{
 		Create: awsSecretBackendRoleWrite,
@@ -21,6 +28,7 @@ func awsSecretBackendRoleResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: vaultVersionCustomizeDiff(awsSecretBackendRoleVaultFeatures),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -101,6 +109,22 @@ func awsSecretBackendRoleResource() *schema.Resource {
 				Computed:    true,
 				Description: "The max allowed TTL in seconds for STS credentials (credentials TTL are capped to max_sts_ttl). Valid only when credential_type is one of assumed_role or federation_token.",
 			},
+			"session_tags": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Session tags to set when assuming the role. Valid only when credential_type is assumed_role. Requires Vault 1.17+.",
+			},
+			"external_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "External ID to set when assuming the role. Valid only when credential_type is assumed_role. Requires Vault 1.17+.",
+			},
+			"mfa_serial": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The ARN or hardware device number of the MFA device used for STS credentials. Valid only when credential_type is session_token. Requires Vault 1.16+.",
+			},
 		},
 	}
 }
@@ -171,6 +195,29 @@ func awsSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	for _, k := range []string{"session_tags", "external_id"} {
+		v, ok := d.GetOk(k)
+		if !ok {
+			if d.HasChange(k) {
+				data[k] = v
+			}
+			continue
+		}
+		if credentialType != "assumed_role" {
+			return fmt.Errorf("%s is only valid when credential_type is assumed_role", k)
+		}
+		data[k] = v
+	}
+
+	if v, ok := d.GetOk("mfa_serial"); ok {
+		if credentialType != "session_token" {
+			return fmt.Errorf("mfa_serial is only valid when credential_type is session_token")
+		}
+		data["mfa_serial_number"] = v
+	} else if d.HasChange("mfa_serial") {
+		data["mfa_serial_number"] = ""
+	}
+
 	log.Printf("[DEBUG] Creating role %q on AWS backend %q", name, backend)
 	_, err := client.Logical().Write(backend+"/roles/"+name, data)
 	if err != nil {
@@ -230,6 +277,15 @@ func awsSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
 	if v, ok := secret.Data["iam_groups"]; ok {
 		d.Set("iam_groups", v)
 	}
+	if v, ok := secret.Data["session_tags"]; ok {
+		d.Set("session_tags", v)
+	}
+	if v, ok := secret.Data["external_id"]; ok {
+		d.Set("external_id", v)
+	}
+	if v, ok := secret.Data["mfa_serial_number"]; ok {
+		d.Set("mfa_serial", v)
+	}
 	d.Set("backend", strings.Join(pathPieces[:len(pathPieces)-2], "/"))
 	d.Set("name", pathPieces[len(pathPieces)-1])
 	return nil
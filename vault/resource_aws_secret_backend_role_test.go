@@ -202,6 +202,40 @@ func TestAccAWSSecretBackendRole_nested(t *testing.T) {
 	})
 }
 
+func TestAccAWSSecretBackendRole_sessionTags(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-aws")
+	name := acctest.RandomWithPrefix("tf-test-aws")
+	accessKey, secretKey := getTestAWSCreds(t)
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccAWSSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecretBackendRoleConfig_sessionTags(name, backend, accessKey, secretKey, "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_aws_secret_backend_role.test", "session_tags.%", "2"),
+					resource.TestCheckResourceAttr("vault_aws_secret_backend_role.test", "session_tags.team", "foo"),
+					resource.TestCheckResourceAttr("vault_aws_secret_backend_role.test", "session_tags.env", "test"),
+					resource.TestCheckResourceAttr("vault_aws_secret_backend_role.test", "external_id", "external-foo"),
+				),
+			},
+			{
+				Config: testAccAWSSecretBackendRoleConfig_sessionTags(name, backend, accessKey, secretKey, "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_aws_secret_backend_role.test", "session_tags.team", "bar"),
+					resource.TestCheckResourceAttr("vault_aws_secret_backend_role.test", "external_id", "external-bar"),
+				),
+			},
+			{
+				ResourceName:      "vault_aws_secret_backend_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccAWSSecretBackendRoleCheckDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -310,3 +344,26 @@ resource "vault_aws_secret_backend_role" "test_role_groups" {
 }
 `, path, accessKey, secretKey, name, testAccAWSSecretBackendRolePolicyInline_updated, name, testAccAWSSecretBackendRolePolicyArn_updated, name, testAccAWSSecretBackendRolePolicyInline_updated, testAccAWSSecretBackendRolePolicyArn_updated, name, testAccAWSSecretBackendRoleRoleArn_updated, name)
 }
+
+func testAccAWSSecretBackendRoleConfig_sessionTags(name, path, accessKey, secretKey, team string) string {
+	return fmt.Sprintf(`
+resource "vault_aws_secret_backend" "test" {
+  path       = "%s"
+  access_key = "%s"
+  secret_key = "%s"
+}
+
+resource "vault_aws_secret_backend_role" "test" {
+  name            = "%s"
+  backend         = vault_aws_secret_backend.test.path
+  credential_type = "assumed_role"
+  role_arns       = ["%s"]
+  external_id     = "external-%s"
+
+  session_tags = {
+    team = "%s"
+    env  = "test"
+  }
+}
+`, path, accessKey, secretKey, name, testAccAWSSecretBackendRoleRoleArn_basic, team, team)
+}
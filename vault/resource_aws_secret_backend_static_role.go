@@ -0,0 +1,133 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+var awsSecretBackendStaticRolePathRegex = regexp.MustCompile("^(.+)/static-roles/([^/]+)$")
+
+func awsSecretBackendStaticRoleResource() *schema.Resource {
+	return &schema.Resource{
+		Create: awsSecretBackendStaticRoleWrite,
+		Read:   awsSecretBackendStaticRoleRead,
+		Update: awsSecretBackendStaticRoleWrite,
+		Delete: awsSecretBackendStaticRoleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the AWS Secret Backend the static role belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Unique name for the static role.",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the IAM user whose access keys are managed by Vault.",
+			},
+			"rotation_period": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  "How often Vault should rotate the access keys of the IAM user, in seconds.",
+				ValidateFunc: validation.IntAtLeast(60),
+			},
+		},
+	}
+}
+
+func awsSecretBackendStaticRoleWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+	path := awsSecretBackendStaticRolePath(backend, name)
+
+	data := map[string]interface{}{
+		"username":        d.Get("username"),
+		"rotation_period": d.Get("rotation_period"),
+	}
+
+	log.Printf("[DEBUG] Writing AWS static role %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing AWS static role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote AWS static role %q", path)
+
+	d.SetId(path)
+	return awsSecretBackendStaticRoleRead(d, meta)
+}
+
+func awsSecretBackendStaticRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	res := awsSecretBackendStaticRolePathRegex.FindStringSubmatch(path)
+	if len(res) != 3 {
+		return fmt.Errorf("invalid id %q; must be {backend}/static-roles/{name}", path)
+	}
+
+	log.Printf("[DEBUG] Reading AWS static role %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading AWS static role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read AWS static role %q", path)
+	if resp == nil {
+		log.Printf("[WARN] AWS static role %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", res[1])
+	d.Set("name", res[2])
+	d.Set("username", resp.Data["username"])
+
+	if v, ok := resp.Data["rotation_period"]; ok {
+		n, err := v.(json.Number).Int64()
+		if err != nil {
+			return fmt.Errorf("unexpected value %q for rotation_period of %q", v, path)
+		}
+		d.Set("rotation_period", n)
+	}
+
+	return nil
+}
+
+func awsSecretBackendStaticRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Deleting AWS static role %q", path)
+	if _, err := client.Logical().Delete(path); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error deleting AWS static role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted AWS static role %q", path)
+	return nil
+}
+
+func awsSecretBackendStaticRolePath(backend, name string) string {
+	return strings.Trim(backend, "/") + "/static-roles/" + strings.Trim(name, "/")
+}
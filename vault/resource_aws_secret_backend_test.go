@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-provider-vault/util"
 	"github.com/hashicorp/vault/api"
 )
 
@@ -96,6 +97,86 @@ func TestAccAWSSecretBackend_import(t *testing.T) {
 	})
 }
 
+func TestAccAWSSecretBackend_wif(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-aws")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { util.TestEntPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccAWSSecretBackendCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecretBackendConfig_wif(path, "wif-audience", 600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_aws_secret_backend.test", "path", path),
+					resource.TestCheckResourceAttr("vault_aws_secret_backend.test", "role_arn", "arn:aws:iam::123456789012:role/vault"),
+					resource.TestCheckResourceAttr("vault_aws_secret_backend.test", "identity_token_audience", "wif-audience"),
+					resource.TestCheckResourceAttr("vault_aws_secret_backend.test", "identity_token_ttl", "600"),
+				),
+			},
+			{
+				Config: testAccAWSSecretBackendConfig_wif(path, "wif-audience-updated", 1800),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_aws_secret_backend.test", "identity_token_audience", "wif-audience-updated"),
+					resource.TestCheckResourceAttr("vault_aws_secret_backend.test", "identity_token_ttl", "1800"),
+				),
+			},
+			{
+				ResourceName:      "vault_aws_secret_backend.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAWSSecretBackendCustomizeDiff(t *testing.T) {
+	testCases := []struct {
+		name        string
+		config      map[string]interface{}
+		expectedErr string
+	}{
+		{
+			name: "both set",
+			config: map[string]interface{}{
+				"role_arn":                "arn:aws:iam::123456789012:role/vault",
+				"identity_token_audience": "wif-audience",
+			},
+		},
+		{
+			name:   "neither set",
+			config: map[string]interface{}{},
+		},
+		{
+			name: "role_arn only",
+			config: map[string]interface{}{
+				"role_arn": "arn:aws:iam::123456789012:role/vault",
+			},
+			expectedErr: `"identity_token_audience" is required when "role_arn" is set`,
+		},
+		{
+			name: "identity_token_audience only",
+			config: map[string]interface{}{
+				"identity_token_audience": "wif-audience",
+			},
+			expectedErr: `"role_arn" is required when "identity_token_audience" is set`,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := awsSecretBackendResource().Diff(nil, terraform.NewResourceConfigRaw(testCase.config), nil)
+			if testCase.expectedErr == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), testCase.expectedErr) {
+				t.Fatalf("expected error %q, received %v", testCase.expectedErr, err)
+			}
+		})
+	}
+}
+
 func testAccAWSSecretBackendCheckDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -157,3 +238,13 @@ resource "vault_aws_secret_backend" "test" {
   region = "us-west-1"
 }`, path)
 }
+
+func testAccAWSSecretBackendConfig_wif(path, audience string, ttl int) string {
+	return fmt.Sprintf(`
+resource "vault_aws_secret_backend" "test" {
+  path                    = "%s"
+  role_arn                = "arn:aws:iam::123456789012:role/vault"
+  identity_token_audience = "%s"
+  identity_token_ttl      = %d
+}`, path, audience, ttl)
+}
@@ -9,6 +9,13 @@ import (
 	"github.com/hashicorp/vault/api"
 )
 
+// azureSecretBackendWIFFields are the fields that configure plugin workload
+// identity federation, which replaces the client secret.
+var azureSecretBackendWIFFields = []string{
+	"identity_token_audience",
+	"identity_token_ttl",
+}
+
 func azureSecretBackendResource() *schema.Resource {
 	return &schema.Resource{
 		Create: azureSecretBackendCreate,
@@ -63,10 +70,11 @@ func azureSecretBackendResource() *schema.Resource {
 				Sensitive:   true,
 			},
 			"client_secret": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "The client secret for credentials to query the Azure APIs",
-				Sensitive:   true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "The client secret for credentials to query the Azure APIs",
+				Sensitive:     true,
+				ConflictsWith: []string{"identity_token_audience"},
 			},
 			"environment": {
 				Type:        schema.TypeString,
@@ -74,6 +82,17 @@ func azureSecretBackendResource() *schema.Resource {
 				Default:     "AzurePublicCloud",
 				Description: "The Azure cloud environment. Valid values: AzurePublicCloud, AzureUSGovernmentCloud, AzureChinaCloud, AzureGermanCloud.",
 			},
+			"identity_token_audience": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The audience claim value of the plugin identity token. Requires Vault 1.17+.",
+			},
+			"identity_token_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The TTL of generated identity tokens in seconds. Requires Vault 1.17+.",
+			},
 		},
 	}
 }
@@ -98,6 +117,11 @@ func azureSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
 		"environment":     environment,
 		"subscription_id": subscriptionID,
 	}
+	for _, k := range azureSecretBackendWIFFields {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
 
 	d.Partial(true)
 	log.Printf("[DEBUG] Mounting Azure backend at %q", path)
@@ -165,6 +189,11 @@ func azureSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
 	} else {
 		d.Set("environment", "AzurePublicCloud")
 	}
+	for _, k := range azureSecretBackendWIFFields {
+		if v, ok := resp.Data[k]; ok {
+			d.Set(k, v)
+		}
+	}
 
 	d.Set("path", path)
 	d.Set("description", mount.Description)
@@ -177,7 +206,8 @@ func azureSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	path := d.Id()
 
-	if d.HasChange("client_id") || d.HasChange("environment") || d.HasChange("tenant_id") || d.HasChange("client_secret") {
+	if d.HasChange("client_id") || d.HasChange("environment") || d.HasChange("tenant_id") || d.HasChange("client_secret") ||
+		d.HasChange("identity_token_audience") || d.HasChange("identity_token_ttl") {
 		log.Printf("[DEBUG] Updating Azure Backend Config at %q", azureSecretBackendPath(path))
 		data := map[string]interface{}{
 			"tenant_id":     d.Get("tenant_id").(string),
@@ -190,6 +220,12 @@ func azureSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 			data["environment"] = environment
 		}
 
+		for _, k := range azureSecretBackendWIFFields {
+			if v, ok := d.GetOk(k); ok || d.HasChange(k) {
+				data[k] = v
+			}
+		}
+
 		_, err := client.Logical().Write(azureSecretBackendPath(path), data)
 		if err != nil {
 			return fmt.Errorf("error writing config for %q: %s", path, err)
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/vault/api"
 )
 
@@ -89,14 +90,28 @@ func azureSecretBackendRoleResource() *schema.Resource {
 				Description: "Application Object ID for an existing service principal that will be used instead of creating dynamic service principals.",
 			},
 			"ttl": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Human-friendly description of the mount for the backend.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Human-friendly description of the mount for the backend.",
+				ValidateFunc: validateVaultDuration,
 			},
 			"max_ttl": {
-				Type:        schema.TypeString,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Human-friendly description of the mount for the backend.",
+				ValidateFunc: validateVaultDuration,
+			},
+			"permanently_delete": {
+				Type:        schema.TypeBool,
 				Optional:    true,
-				Description: "Human-friendly description of the mount for the backend.",
+				Computed:    true,
+				Description: "Whether to permanently delete Applications and Service Principals that are dynamically created by Vault, instead of moving them to the recycle bin. Requires Vault 1.12+.",
+			},
+			"sign_in_audience": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The Microsoft accounts supported by the dynamically created Application. Requires Vault 1.16+.",
+				ValidateFunc: validation.StringInSlice([]string{"AzureADMyOrg", "AzureADMultipleOrgs", "AzureADandPersonalMicrosoftAccount", "PersonalMicrosoftAccount"}, false),
 			},
 		},
 	}
@@ -147,6 +162,14 @@ func azureSecretBackendRoleUpdateFields(d *schema.ResourceData, data map[string]
 		data["max_ttl"] = v.(string)
 	}
 
+	if v, ok := d.GetOkExists("permanently_delete"); ok {
+		data["permanently_delete"] = v.(bool)
+	}
+
+	if v, ok := d.GetOk("sign_in_audience"); ok {
+		data["sign_in_audience"] = v.(string)
+	}
+
 	return nil
 }
 
@@ -197,6 +220,8 @@ func azureSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error
 		"ttl",
 		"max_ttl",
 		"application_object_id",
+		"permanently_delete",
+		"sign_in_audience",
 	} {
 		if v, ok := resp.Data[k]; ok {
 			if err := d.Set(k, v); err != nil {
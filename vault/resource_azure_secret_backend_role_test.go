@@ -38,6 +38,8 @@ func TestAzureSecretBackendRole(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_azure_secret_backend_role.test_azure_roles", "azure_roles.1111275791.role_name", "Reader"),
 					resource.TestCheckResourceAttrSet("vault_azure_secret_backend_role.test_azure_roles", "azure_roles.1111275791.scope"),
 					resource.TestCheckResourceAttrSet("vault_azure_secret_backend_role.test_azure_roles", "azure_roles.1111275791.role_id"),
+					resource.TestCheckResourceAttr("vault_azure_secret_backend_role.test_azure_roles", "permanently_delete", "true"),
+					resource.TestCheckResourceAttr("vault_azure_secret_backend_role.test_azure_roles", "sign_in_audience", "AzureADMyOrg"),
 					resource.TestCheckResourceAttr("vault_azure_secret_backend_role.test_azure_groups", "role", role+"-azure-groups"),
 					resource.TestCheckResourceAttr("vault_azure_secret_backend_role.test_azure_groups", "description", "Test for Vault Provider"),
 					resource.TestCheckResourceAttr("vault_azure_secret_backend_role.test_azure_groups", "azure_groups.#", "1"),
@@ -84,10 +86,12 @@ resource "vault_azure_secret_backend" "azure" {
 
 resource "vault_azure_secret_backend_role" "test_azure_roles" {
   backend     = "${vault_azure_secret_backend.azure.path}"
-  role        = "%[6]s-azure-roles"
-  ttl         = 300
-  max_ttl     = 600
-  description = "Test for Vault Provider"
+  role               = "%[6]s-azure-roles"
+  ttl                = 300
+  max_ttl            = 600
+  description        = "Test for Vault Provider"
+  permanently_delete = true
+  sign_in_audience   = "AzureADMyOrg"
 
   azure_roles {
     role_name = "Reader"
@@ -0,0 +1,177 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+var azureSecretBackendStaticRolePathRegex = regexp.MustCompile("^(.+)/static-roles/([^/]+)$")
+
+func azureSecretBackendStaticRoleResource() *schema.Resource {
+	return &schema.Resource{
+		Create: azureSecretBackendStaticRoleWrite,
+		Read:   azureSecretBackendStaticRoleRead,
+		Update: azureSecretBackendStaticRoleWrite,
+		Delete: azureSecretBackendStaticRoleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "azure",
+				Description: "Path of the Azure Secret Backend the static role belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"role": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the static role to create.",
+			},
+			"application_object_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Object ID of the existing Azure Application whose client secret is managed by Vault.",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "How long the client secret is valid before Vault rotates it, in seconds.",
+			},
+			"metadata": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary key-value metadata stored with the static role.",
+			},
+			"secret_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Key ID of an existing client secret to import instead of generating a new one.",
+			},
+			"client_secret": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				ForceNew:    true,
+				Description: "Value of the existing client secret identified by secret_id.",
+			},
+			"expiration": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Expiration time of the imported client secret, in RFC3339 format.",
+			},
+		},
+	}
+}
+
+func azureSecretBackendStaticRoleWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := azureSecretBackendStaticRolePath(d.Get("backend").(string), d.Get("role").(string))
+
+	data := map[string]interface{}{
+		"application_object_id": d.Get("application_object_id"),
+	}
+	if v, ok := d.GetOk("ttl"); ok {
+		data["ttl"] = v
+	}
+	if v, ok := d.GetOk("metadata"); ok || d.HasChange("metadata") {
+		data["metadata"] = v
+	}
+	if d.IsNewResource() {
+		for _, k := range []string{"secret_id", "client_secret", "expiration"} {
+			if v, ok := d.GetOk(k); ok {
+				data[k] = v
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] Writing Azure Secret static role %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing Azure Secret static role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote Azure Secret static role %q", path)
+
+	d.SetId(path)
+	return azureSecretBackendStaticRoleRead(d, meta)
+}
+
+func azureSecretBackendStaticRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	res := azureSecretBackendStaticRolePathRegex.FindStringSubmatch(path)
+	if len(res) != 3 {
+		return fmt.Errorf("invalid id %q; must be {backend}/static-roles/{role}", path)
+	}
+
+	log.Printf("[DEBUG] Reading Azure Secret static role %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading Azure Secret static role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read Azure Secret static role %q", path)
+	if resp == nil {
+		log.Printf("[WARN] Azure Secret static role %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", res[1])
+	d.Set("role", res[2])
+
+	for _, k := range []string{"application_object_id", "metadata", "secret_id", "expiration"} {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error reading %s for Azure Secret static role %q: %s", k, path, err)
+			}
+		}
+	}
+
+	if v, ok := resp.Data["ttl"]; ok {
+		n, err := v.(json.Number).Int64()
+		if err != nil {
+			return fmt.Errorf("unexpected value %q for ttl of %q", v, path)
+		}
+		d.Set("ttl", n)
+	}
+
+	return nil
+}
+
+func azureSecretBackendStaticRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Deleting Azure Secret static role %q", path)
+	if _, err := client.Logical().Delete(path); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error deleting Azure Secret static role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted Azure Secret static role %q", path)
+
+	return nil
+}
+
+func azureSecretBackendStaticRolePath(backend, role string) string {
+	return strings.Trim(backend, "/") + "/static-roles/" + strings.Trim(role, "/")
+}
@@ -0,0 +1,100 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAzureSecretBackendStaticRole(t *testing.T) {
+	subscriptionID := os.Getenv("ARM_SUBSCRIPTION_ID")
+	if subscriptionID == "" {
+		t.Skip("ARM_SUBSCRIPTION_ID not set")
+	}
+	appObjectID := os.Getenv("ARM_APPLICATION_OBJECT_ID")
+	if appObjectID == "" {
+		t.Skip("ARM_APPLICATION_OBJECT_ID not set")
+	}
+	tenantID := os.Getenv("ARM_TENANT_ID")
+	clientID := os.Getenv("ARM_CLIENT_ID")
+	clientSecret := os.Getenv("ARM_CLIENT_SECRET")
+
+	path := acctest.RandomWithPrefix("tf-test-azure")
+	role := acctest.RandomWithPrefix("tf-test-azure-static-role")
+	resourceName := "vault_azure_secret_backend_static_role.test"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccAzureSecretBackendStaticRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAzureSecretBackendStaticRoleConfig(subscriptionID, tenantID, clientID, clientSecret, path, role, appObjectID, 86400),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "role", role),
+					resource.TestCheckResourceAttr(resourceName, "backend", path),
+					resource.TestCheckResourceAttr(resourceName, "application_object_id", appObjectID),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "86400"),
+					resource.TestCheckResourceAttr(resourceName, "metadata.team", "vault"),
+					resource.TestCheckResourceAttrSet(resourceName, "secret_id"),
+				),
+			},
+			{
+				Config: testAzureSecretBackendStaticRoleConfig(subscriptionID, tenantID, clientID, clientSecret, path, role, appObjectID, 172800),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ttl", "172800"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAzureSecretBackendStaticRoleCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_azure_secret_backend_static_role" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("static role %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAzureSecretBackendStaticRoleConfig(subscriptionID, tenantID, clientID, clientSecret, path, role, appObjectID string, ttl int) string {
+	return fmt.Sprintf(`
+resource "vault_azure_secret_backend" "azure" {
+  subscription_id = "%s"
+  tenant_id       = "%s"
+  client_id       = "%s"
+  client_secret   = "%s"
+  path            = "%s"
+}
+
+resource "vault_azure_secret_backend_static_role" "test" {
+  backend               = vault_azure_secret_backend.azure.path
+  role                  = "%s"
+  application_object_id = "%s"
+  ttl                   = %d
+
+  metadata = {
+    team = "vault"
+  }
+}
+`, subscriptionID, tenantID, clientID, clientSecret, path, role, appObjectID, ttl)
+}
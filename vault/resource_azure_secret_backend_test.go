@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-provider-vault/util"
 	"github.com/hashicorp/vault/api"
 )
 
@@ -44,6 +45,32 @@ func TestAzureSecretBackend(t *testing.T) {
 	})
 }
 
+func TestAzureSecretBackend_wif(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-azure")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { util.TestEntPreCheck(t) },
+		CheckDestroy: testAccAzureSecretBackendCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAzureSecretBackend_wif(path, "wif-audience", 600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_azure_secret_backend.test", "path", path),
+					resource.TestCheckResourceAttr("vault_azure_secret_backend.test", "identity_token_audience", "wif-audience"),
+					resource.TestCheckResourceAttr("vault_azure_secret_backend.test", "identity_token_ttl", "600"),
+				),
+			},
+			{
+				Config: testAzureSecretBackend_wif(path, "wif-audience-updated", 1800),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_azure_secret_backend.test", "identity_token_audience", "wif-audience-updated"),
+					resource.TestCheckResourceAttr("vault_azure_secret_backend.test", "identity_token_ttl", "1800"),
+				),
+			},
+		},
+	})
+}
+
 func testAccAzureSecretBackendCheckDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -90,3 +117,15 @@ func testAzureSecretBackend_updated(path string) string {
 	 environment = "AzurePublicCloud"
 	}`, path)
 }
+
+func testAzureSecretBackend_wif(path, audience string, ttl int) string {
+	return fmt.Sprintf(`
+	resource "vault_azure_secret_backend" "test" {
+	 path = "%s"
+	 subscription_id = "11111111-2222-3333-4444-111111111111"
+	 tenant_id = "11111111-2222-3333-4444-222222222222"
+	 client_id = "11111111-2222-3333-4444-333333333333"
+	 identity_token_audience = "%s"
+	 identity_token_ttl = %d
+	}`, path, audience, ttl)
+}
@@ -3,6 +3,7 @@ package vault
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -10,6 +11,19 @@ import (
 	"github.com/hashicorp/vault/api"
 )
 
+var certAuthBackendRoleFromPathRegex = regexp.MustCompile("^auth/(.+)/certs/([^/]+)$")
+
+// certAuthBackendRoleVaultFeatures gates the OCSP and metadata settings, see
+// also certAuthBackendRoleOCSPFields.
+var certAuthBackendRoleVaultFeatures = map[string]vaultFeature{
+	"allowed_metadata_extensions": {MinVersion: "1.13"},
+	"ocsp_enabled":                {MinVersion: "1.13"},
+	"ocsp_ca_certificates":        {MinVersion: "1.13"},
+	"ocsp_servers_override":       {MinVersion: "1.13"},
+	"ocsp_fail_open":              {MinVersion: "1.13"},
+	"ocsp_query_all_servers":      {MinVersion: "1.13"},
+}
+
 func certAuthBackendRoleResource() *schema.Resource {
 	fields := map[string]*schema.Schema{
 		"name": {
@@ -83,6 +97,48 @@ func certAuthBackendRoleResource() *schema.Resource {
 			Optional: true,
 			Computed: true,
 		},
+		"allowed_metadata_extensions": {
+			Type: schema.TypeSet,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Optional:    true,
+			Computed:    true,
+			Description: "Certificate extensions, as OIDs, whose values are added to the alias metadata. Requires Vault 1.13+.",
+		},
+		"ocsp_enabled": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "Check the revocation status of the client certificate with OCSP. Requires Vault 1.13+.",
+		},
+		"ocsp_ca_certificates": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "PEM encoded CA certificates used to verify OCSP responses. Requires Vault 1.13+.",
+		},
+		"ocsp_servers_override": {
+			Type: schema.TypeList,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Optional:    true,
+			Computed:    true,
+			Description: "OCSP servers to query instead of the ones in the certificate. Requires Vault 1.13+.",
+		},
+		"ocsp_fail_open": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "Allow the login when no OCSP server could be reached. Requires Vault 1.13+.",
+		},
+		"ocsp_query_all_servers": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "Query all OCSP servers instead of stopping at the first answer. Requires Vault 1.13+.",
+		},
 		"backend": {
 			Type:     schema.TypeString,
 			Optional: true,
@@ -97,7 +153,8 @@ func certAuthBackendRoleResource() *schema.Resource {
 		"bound_cidrs": {
 			Type: schema.TypeSet,
 			Elem: &schema.Schema{
-				Type: schema.TypeString,
+				Type:         schema.TypeString,
+				ValidateFunc: validateCIDR,
 			},
 			Optional:      true,
 			Computed:      true,
@@ -110,6 +167,7 @@ func certAuthBackendRoleResource() *schema.Resource {
 			Computed:      true,
 			Deprecated:    "use `token_ttl` instead if you are running Vault >= 1.2",
 			ConflictsWith: []string{"token_ttl"},
+			ValidateFunc:  validateVaultDuration,
 		},
 		"max_ttl": {
 			Type:          schema.TypeString,
@@ -117,6 +175,7 @@ func certAuthBackendRoleResource() *schema.Resource {
 			Computed:      true,
 			Deprecated:    "use `token_max_ttl` instead if you are running Vault >= 1.2",
 			ConflictsWith: []string{"token_max_ttl"},
+			ValidateFunc:  validateVaultDuration,
 		},
 		"period": {
 			Type:          schema.TypeString,
@@ -124,6 +183,7 @@ func certAuthBackendRoleResource() *schema.Resource {
 			Computed:      true,
 			Deprecated:    "use `token_period` instead if you are running Vault >= 1.2",
 			ConflictsWith: []string{"token_period"},
+			ValidateFunc:  validateVaultDuration,
 		},
 		"policies": {
 			Type: schema.TypeSet,
@@ -152,15 +212,44 @@ func certAuthBackendRoleResource() *schema.Resource {
 		Update: certAuthResourceUpdate,
 		Read:   certAuthResourceRead,
 		Delete: certAuthResourceDelete,
+		Importer: &schema.ResourceImporter{
+			State: certAuthResourceImport,
+		},
+		CustomizeDiff: vaultVersionCustomizeDiff(certAuthBackendRoleVaultFeatures),
 
 		Schema: fields,
 	}
 }
 
+// certAuthBackendRoleOCSPFields are the OCSP and metadata fields of a cert
+// role, they are only sent when set to support older Vault versions.
+var certAuthBackendRoleOCSPFields = []string{
+	"allowed_metadata_extensions",
+	"ocsp_enabled",
+	"ocsp_ca_certificates",
+	"ocsp_servers_override",
+	"ocsp_fail_open",
+	"ocsp_query_all_servers",
+}
+
 func certCertResourcePath(backend, name string) string {
 	return "auth/" + strings.Trim(backend, "/") + "/certs/" + strings.Trim(name, "/")
 }
 
+// certAuthResourceImport sets the backend and name of the role from its path,
+// as built by certCertResourcePath.
+func certAuthResourceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	res := certAuthBackendRoleFromPathRegex.FindStringSubmatch(d.Id())
+	if len(res) != 3 {
+		return nil, fmt.Errorf("invalid ID %q, expected auth/<backend>/certs/<name>", d.Id())
+	}
+
+	d.Set("backend", res[1])
+	d.Set("name", res[2])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func certAuthResourceWrite(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 
@@ -202,6 +291,15 @@ func certAuthResourceWrite(d *schema.ResourceData, meta interface{}) error {
 		data["display_name"] = v.(string)
 	}
 
+	for _, k := range certAuthBackendRoleOCSPFields {
+		if v, ok := d.GetOkExists(k); ok {
+			if set, ok := v.(*schema.Set); ok {
+				v = set.List()
+			}
+			data[k] = v
+		}
+	}
+
 	// Deprecated fields
 	if v, ok := d.GetOk("bound_cidrs"); ok {
 		data["bound_cidrs"] = v.(*schema.Set).List()
@@ -288,6 +386,15 @@ func certAuthResourceUpdate(d *schema.ResourceData, meta interface{}) error {
 		data["display_name"] = v.(string)
 	}
 
+	for _, k := range certAuthBackendRoleOCSPFields {
+		if v, ok := d.GetOkExists(k); ok || d.HasChange(k) {
+			if set, ok := v.(*schema.Set); ok {
+				v = set.List()
+			}
+			data[k] = v
+		}
+	}
+
 	if v, ok := d.GetOk("bound_cidrs"); ok {
 		data["bound_cidrs"] = v.(*schema.Set).List()
 	}
@@ -390,6 +497,14 @@ func certAuthResourceRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("certificate", resp.Data["certificate"])
 	d.Set("display_name", resp.Data["display_name"])
 
+	for _, k := range certAuthBackendRoleOCSPFields {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting state key %q: %s", k, err)
+			}
+		}
+	}
+
 	// Vault sometimes returns these as null instead of an empty list.
 	if resp.Data["allowed_names"] != nil {
 		d.Set("allowed_names",
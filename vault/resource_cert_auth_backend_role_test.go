@@ -96,6 +96,11 @@ func TestCertAuthBackend(t *testing.T) {
 						"allowed_names.#", "2"),
 				),
 			},
+			{
+				ResourceName:      "vault_cert_auth_backend_role.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testCertAuthBackendConfig_unset(backend, name, testCertificate, allowedNames),
 				Check: resource.ComposeTestCheckFunc(
@@ -152,6 +157,36 @@ func TestCertAuthBackend_deprecated(t *testing.T) {
 	})
 }
 
+func TestCertAuthBackend_ocsp(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-cert-auth")
+	name := acctest.RandomWithPrefix("tf-test-cert-name")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testCertAuthBackendDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCertAuthBackendConfig_ocsp(backend, name, testCertificate),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_cert_auth_backend_role.test",
+						"ocsp_enabled", "true"),
+					resource.TestCheckResourceAttr("vault_cert_auth_backend_role.test",
+						"ocsp_fail_open", "true"),
+					resource.TestCheckResourceAttr("vault_cert_auth_backend_role.test",
+						"ocsp_query_all_servers", "true"),
+					resource.TestCheckResourceAttr("vault_cert_auth_backend_role.test",
+						"ocsp_servers_override.#", "1"),
+					resource.TestCheckResourceAttr("vault_cert_auth_backend_role.test",
+						"ocsp_servers_override.0", "http://ocsp.example.com"),
+					resource.TestCheckResourceAttr("vault_cert_auth_backend_role.test",
+						"allowed_metadata_extensions.#", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testCertAuthBackendDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -380,3 +415,25 @@ __CERTIFICATE__
 `, backend, name, certificate, strings.Join(quotedNames, ", "))
 
 }
+
+func testCertAuthBackendConfig_ocsp(backend, name, certificate string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "cert" {
+    path = "%s"
+    type = "cert"
+}
+
+resource "vault_cert_auth_backend_role" "test" {
+    name                        = "%s"
+    certificate                 = <<__CERTIFICATE__
+%s
+__CERTIFICATE__
+    backend                     = "${vault_auth_backend.cert.path}"
+    ocsp_enabled                = true
+    ocsp_fail_open              = true
+    ocsp_query_all_servers      = true
+    ocsp_servers_override       = ["http://ocsp.example.com"]
+    allowed_metadata_extensions = ["2.1.1.1", "2.1.1.2"]
+}
+`, backend, name, certificate)
+}
@@ -0,0 +1,206 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+const cfAuthType = "cf"
+
+// fields of the CF config that are read back from Vault, cf_password is
+// never returned.
+var cfAuthBackendConfigFields = []string{
+	"identity_ca_certificates",
+	"cf_api_addr",
+	"cf_username",
+	"cf_api_trusted_certificates",
+	"login_max_seconds_not_before",
+	"login_max_seconds_not_after",
+}
+
+func cfAuthBackendResource() *schema.Resource {
+	return &schema.Resource{
+		Create: cfAuthBackendCreate,
+		Read:   cfAuthBackendRead,
+		Update: cfAuthBackendUpdate,
+		Delete: cfAuthBackendDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      cfAuthType,
+				Description:  "Path to mount the CF auth backend.",
+				ValidateFunc: validateMountPath,
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Description of the auth backend.",
+			},
+			"identity_ca_certificates": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "The PEM encoded CA certificates of the CF instance identity, used to verify the client certificates presented on login.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"cf_api_addr": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "CF API address, such as https://api.sys.example.com.",
+			},
+			"cf_username": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The username of the CF API user Vault uses to verify instances.",
+			},
+			"cf_password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The password of the CF API user.",
+			},
+			"cf_api_trusted_certificates": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The PEM encoded CA certificates trusted when talking to the CF API.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"login_max_seconds_not_before": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The maximum number of seconds in the past when a login signature could have been created.",
+			},
+			"login_max_seconds_not_after": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The maximum number of seconds in the future when a login signature could have been created.",
+			},
+			"accessor": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The accessor of the CF auth backend.",
+			},
+		},
+	}
+}
+
+func cfAuthBackendCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Get("path").(string)
+
+	log.Printf("[DEBUG] Enabling CF auth backend %q", path)
+	err := client.Sys().EnableAuthWithOptions(path, &api.EnableAuthOptions{
+		Type:        cfAuthType,
+		Description: d.Get("description").(string),
+	})
+	if err != nil {
+		return fmt.Errorf("error enabling CF auth backend %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Enabled CF auth backend %q", path)
+
+	d.SetId(path)
+
+	return cfAuthBackendUpdate(d, meta)
+}
+
+func cfAuthBackendUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := cfAuthBackendConfigPath(d.Id())
+
+	data := map[string]interface{}{
+		"cf_password": d.Get("cf_password").(string),
+	}
+	for _, k := range cfAuthBackendConfigFields {
+		if v, ok := d.GetOk(k); ok || d.HasChange(k) {
+			data[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Writing CF auth backend config %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing CF auth backend config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote CF auth backend config %q", path)
+
+	return cfAuthBackendRead(d, meta)
+}
+
+func cfAuthBackendRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	auths, err := client.Sys().ListAuth()
+	if err != nil {
+		return fmt.Errorf("error reading from auth mounts: %s", err)
+	}
+
+	mount := auths[path+"/"]
+	if mount == nil {
+		log.Printf("[WARN] CF auth backend %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	configPath := cfAuthBackendConfigPath(path)
+	log.Printf("[DEBUG] Reading CF auth backend config %q", configPath)
+	resp, err := client.Logical().Read(configPath)
+	if err != nil {
+		return fmt.Errorf("error reading CF auth backend config %q: %s", configPath, err)
+	}
+	log.Printf("[DEBUG] Read CF auth backend config %q", configPath)
+
+	if resp == nil {
+		log.Printf("[WARN] CF auth backend config %q not found, removing from state", configPath)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("path", path)
+	d.Set("description", mount.Description)
+	d.Set("accessor", mount.Accessor)
+	for _, k := range cfAuthBackendConfigFields {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return fmt.Errorf("error setting state key %q: %s", k, err)
+		}
+	}
+
+	return nil
+}
+
+func cfAuthBackendDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	log.Printf("[DEBUG] Disabling CF auth backend %q", path)
+	if err := client.Sys().DisableAuth(path); err != nil {
+		return fmt.Errorf("error disabling CF auth backend %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Disabled CF auth backend %q", path)
+
+	return nil
+}
+
+func cfAuthBackendConfigPath(path string) string {
+	return "auth/" + strings.Trim(path, "/") + "/config"
+}
@@ -0,0 +1,176 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	cfAuthBackendRoleBackendFromPathRegex = regexp.MustCompile("^auth/(.+)/roles/.+$")
+	cfAuthBackendRoleNameFromPathRegex    = regexp.MustCompile("^auth/.+/roles/(.+)$")
+
+	cfAuthBackendRoleFields = []string{
+		"bound_application_ids",
+		"bound_space_ids",
+		"bound_organization_ids",
+		"bound_instance_ids",
+		"disable_ip_matching",
+	}
+)
+
+func cfAuthBackendRoleResource() *schema.Resource {
+	fields := map[string]*schema.Schema{
+		"path": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Path where the CF auth backend is mounted.",
+			StateFunc: func(v interface{}) string {
+				return strings.Trim(v.(string), "/")
+			},
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Name of the role.",
+		},
+		"bound_application_ids": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "Application GUIDs allowed to login with the role.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"bound_space_ids": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "Space GUIDs allowed to login with the role.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"bound_organization_ids": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "Organization GUIDs allowed to login with the role.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"bound_instance_ids": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "Instance GUIDs allowed to login with the role.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"disable_ip_matching": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Skip matching the IP address of the login request against the IP addresses of the instance certificate.",
+		},
+	}
+
+	addTokenFields(fields, &addTokenFieldsConfig{})
+
+	return &schema.Resource{
+		Create: cfAuthBackendRoleWrite,
+		Read:   cfAuthBackendRoleRead,
+		Update: cfAuthBackendRoleWrite,
+		Delete: cfAuthBackendRoleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: fields,
+	}
+}
+
+func cfAuthBackendRoleWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := cfAuthBackendRolePath(d.Get("path").(string), d.Get("name").(string))
+
+	data := map[string]interface{}{
+		"bound_application_ids":  util.TerraformSetToStringArray(d.Get("bound_application_ids")),
+		"bound_space_ids":        util.TerraformSetToStringArray(d.Get("bound_space_ids")),
+		"bound_organization_ids": util.TerraformSetToStringArray(d.Get("bound_organization_ids")),
+		"bound_instance_ids":     util.TerraformSetToStringArray(d.Get("bound_instance_ids")),
+		"disable_ip_matching":    d.Get("disable_ip_matching").(bool),
+	}
+	updateTokenFields(d, data, d.IsNewResource())
+
+	log.Printf("[DEBUG] Writing CF auth backend role %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing CF auth backend role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote CF auth backend role %q", path)
+
+	d.SetId(path)
+
+	return cfAuthBackendRoleRead(d, meta)
+}
+
+func cfAuthBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	backend := cfAuthBackendRoleBackendFromPathRegex.FindStringSubmatch(path)
+	name := cfAuthBackendRoleNameFromPathRegex.FindStringSubmatch(path)
+	if len(backend) != 2 || len(name) != 2 {
+		return fmt.Errorf("invalid path %q for CF auth backend role", path)
+	}
+
+	log.Printf("[DEBUG] Reading CF auth backend role %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading CF auth backend role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read CF auth backend role %q", path)
+
+	if resp == nil {
+		log.Printf("[WARN] CF auth backend role %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("path", backend[1])
+	d.Set("name", name[1])
+	if err := readTokenFields(d, resp); err != nil {
+		return err
+	}
+	for _, k := range cfAuthBackendRoleFields {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return fmt.Errorf("error setting state key %q: %s", k, err)
+		}
+	}
+
+	return nil
+}
+
+func cfAuthBackendRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting CF auth backend role %q", path)
+	if _, err := client.Logical().Delete(path); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error deleting CF auth backend role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted CF auth backend role %q", path)
+
+	return nil
+}
+
+func cfAuthBackendRolePath(backend, name string) string {
+	return "auth/" + strings.Trim(backend, "/") + "/roles/" + strings.Trim(name, "/")
+}
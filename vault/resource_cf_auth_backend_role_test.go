@@ -0,0 +1,88 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccCFAuthBackendRole_basic(t *testing.T) {
+	path := acctest.RandomWithPrefix("cf")
+	name := acctest.RandomWithPrefix("role")
+	resName := "vault_cf_auth_backend_role.test"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCFAuthBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCFAuthBackendRoleConfig(path, name, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "path", path),
+					resource.TestCheckResourceAttr(resName, "name", name),
+					resource.TestCheckResourceAttr(resName, "bound_organization_ids.#", "1"),
+					resource.TestCheckResourceAttr(resName, "bound_space_ids.#", "1"),
+					resource.TestCheckResourceAttr(resName, "bound_application_ids.#", "2"),
+					resource.TestCheckResourceAttr(resName, "disable_ip_matching", "false"),
+				),
+			},
+			{
+				Config: testAccCFAuthBackendRoleConfig(path, name, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "disable_ip_matching", "true"),
+				),
+			},
+			{
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCFAuthBackendRoleCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_cf_auth_backend_role" {
+			continue
+		}
+		resp, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if resp != nil {
+			return fmt.Errorf("CF auth backend role %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCFAuthBackendRoleConfig(path, name string, disableIPMatching bool) string {
+	return fmt.Sprintf(`
+resource "vault_cf_auth_backend" "test" {
+  path                     = "%s"
+  identity_ca_certificates = [<<EOT
+%s
+EOT
+  ]
+  cf_api_addr = "https://api.sys.example.com"
+  cf_username = "vault"
+  cf_password = "s3cr3t"
+}
+
+resource "vault_cf_auth_backend_role" "test" {
+  path                   = vault_cf_auth_backend.test.path
+  name                   = "%s"
+  bound_organization_ids = ["34a878d0-c2f9-4521-ba73-a9f664e82c7b"]
+  bound_space_ids        = ["3d2eba6b-ef19-44d5-91dd-1975b0db5cc9"]
+  bound_application_ids  = ["2d3e834a-3a25-4591-974c-fa5626d5d0a1", "6b814521-5f08-4b1a-8c4e-fbe7c5f5a0c5"]
+  disable_ip_matching    = %t
+  token_policies         = ["default"]
+}
+`, path, testCertificate, name, disableIPMatching)
+}
@@ -0,0 +1,66 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccCFAuthBackend_basic(t *testing.T) {
+	path := acctest.RandomWithPrefix("cf")
+	resName := "vault_cf_auth_backend.test"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCFAuthBackendCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCFAuthBackendConfig(path, "https://api.sys.example.com", 300),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "path", path),
+					resource.TestCheckResourceAttr(resName, "cf_api_addr", "https://api.sys.example.com"),
+					resource.TestCheckResourceAttr(resName, "cf_username", "vault"),
+					resource.TestCheckResourceAttr(resName, "identity_ca_certificates.#", "1"),
+					resource.TestCheckResourceAttr(resName, "login_max_seconds_not_before", "300"),
+					resource.TestCheckResourceAttrSet(resName, "accessor"),
+				),
+			},
+			{
+				Config: testAccCFAuthBackendConfig(path, "https://api.sys.example.org", 60),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "cf_api_addr", "https://api.sys.example.org"),
+					resource.TestCheckResourceAttr(resName, "login_max_seconds_not_before", "60"),
+				),
+			},
+			{
+				ResourceName:            resName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"cf_password"},
+			},
+		},
+	})
+}
+
+func testAccCFAuthBackendCheckDestroy(s *terraform.State) error {
+	return testAccCheckAuthMountDestroy(s, "vault_cf_auth_backend")
+}
+
+func testAccCFAuthBackendConfig(path, apiAddr string, notBefore int) string {
+	return fmt.Sprintf(`
+resource "vault_cf_auth_backend" "test" {
+  path                         = "%s"
+  identity_ca_certificates     = [<<EOT
+%s
+EOT
+  ]
+  cf_api_addr                  = "%s"
+  cf_username                  = "vault"
+  cf_password                  = "s3cr3t"
+  login_max_seconds_not_before = %d
+}
+`, path, testCertificate, apiAddr, notBefore)
+}
@@ -22,11 +22,12 @@ func consulSecretBackendResource() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"path": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				ForceNew:    true,
-				Default:     "consul",
-				Description: "Unique name of the Vault Consul mount to configure",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "consul",
+				Description:  "Unique name of the Vault Consul mount to configure",
+				ValidateFunc: validateMountPath,
 				StateFunc: func(s interface{}) string {
 					return strings.Trim(s.(string), "/")
 				},
@@ -64,10 +65,18 @@ func consulSecretBackendResource() *schema.Resource {
 				Description: "Specifies the URL scheme to use. Defaults to \"http\".",
 			},
 			"token": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Specifies the Consul ACL token to use. This must be a management type token.",
-				Sensitive:   true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "Specifies the Consul ACL token to use. This must be a management type token.",
+				Sensitive:     true,
+				ConflictsWith: []string{"bootstrap"},
+			},
+			"bootstrap": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+				Description: "Denotes a backend resource that is used to bootstrap the Consul ACL system. Only one resource may be used to bootstrap.",
 			},
 			"ca_cert": {
 				Type:        schema.TypeString,
@@ -104,6 +113,12 @@ func consulSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
 	ca_cert := d.Get("ca_cert").(string)
 	client_cert := d.Get("client_cert").(string)
 	client_key := d.Get("client_key").(string)
+	bootstrap := d.Get("bootstrap").(bool)
+
+	// Vault bootstraps the Consul ACL system when no token is given
+	if token == "" && !bootstrap {
+		return fmt.Errorf("token must be set unless bootstrap is true for Consul backend %q", path)
+	}
 
 	configPath := consulSecretBackendConfigPath(path)
 
@@ -224,6 +239,11 @@ func consulSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 	if d.HasChange("address") || d.HasChange("token") || d.HasChange("scheme") ||
 		d.HasChange("ca_cert") || d.HasChange("client_cert") || d.HasChange("client_key") {
+		// Vault never returns the token it generated during bootstrap, so
+		// writing the configuration again would attempt a second bootstrap.
+		if d.Get("bootstrap").(bool) {
+			return fmt.Errorf("cannot update Consul configuration for %q: the backend was used to bootstrap the Consul ACL system", path)
+		}
 		log.Printf("[DEBUG] Updating Consul configuration at %q", configPath)
 		data := map[string]interface{}{
 			"address":     d.Get("address").(string),
@@ -49,12 +49,40 @@ func consulSecretBackendRoleResource() *schema.Resource {
 			},
 			"policies": {
 				Type:        schema.TypeList,
-				Required:    true,
+				Optional:    true,
 				Description: "List of Consul policies to associate with this role",
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
 			},
+			"service_identities": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Set of Consul service identities to attach to the token. Applicable for Vault 1.11+ with Consul 1.5+.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"node_identities": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Set of Consul node identities to attach to the token. Applicable for Vault 1.11+ with Consul 1.8+.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"consul_namespace": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The Consul namespace that the token will be created in. Applicable for Vault 1.10+ and Consul 1.7+",
+			},
+			"partition": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The Consul admin partition that the token will be created in. Applicable for Vault 1.10+ and Consul 1.11+",
+			},
 			"max_ttl": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -106,9 +134,16 @@ func consulSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) erro
 	path := consulSecretBackendRolePath(backend, name)
 
 	policies := d.Get("policies").([]interface{})
+	serviceIdentities := d.Get("service_identities").(*schema.Set).List()
+	nodeIdentities := d.Get("node_identities").(*schema.Set).List()
+	if len(policies) == 0 && len(serviceIdentities) == 0 && len(nodeIdentities) == 0 {
+		return fmt.Errorf("at least one of policies, service_identities or node_identities must be set for Consul secret backend role %s", name)
+	}
 
 	payload := map[string]interface{}{
-		"policies": policies,
+		"policies":           policies,
+		"service_identities": serviceIdentities,
+		"node_identities":    nodeIdentities,
 	}
 
 	if v, ok := d.GetOkExists("max_ttl"); ok {
@@ -123,6 +158,12 @@ func consulSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) erro
 	if v, ok := d.GetOkExists("local"); ok {
 		payload["local"] = v
 	}
+	if v, ok := d.GetOk("consul_namespace"); ok {
+		payload["consul_namespace"] = v
+	}
+	if v, ok := d.GetOk("partition"); ok {
+		payload["partition"] = v
+	}
 
 	log.Printf("[DEBUG] Configuring Consul secrets backend role at %q", path)
 
@@ -178,6 +219,14 @@ func consulSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("token_type", data["token_type"])
 	d.Set("local", data["local"])
 
+	for _, k := range []string{"service_identities", "node_identities", "consul_namespace", "partition"} {
+		if v, ok := data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting state key %q: %s", k, err)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -49,6 +49,29 @@ func TestConsulSecretBackendRole(t *testing.T) {
 	})
 }
 
+func TestConsulSecretBackendRole_identities(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-backend")
+	name := acctest.RandomWithPrefix("tf-test-name")
+	token := "026a0c16-87cd-4c2d-b3f3-fb539f592b7e"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccConsulSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testConsulSecretBackendRole_identitiesConfig(backend, name, token),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_consul_secret_backend_role.test", "backend", backend),
+					resource.TestCheckResourceAttr("vault_consul_secret_backend_role.test", "name", name),
+					resource.TestCheckResourceAttr("vault_consul_secret_backend_role.test", "policies.#", "0"),
+					resource.TestCheckResourceAttr("vault_consul_secret_backend_role.test", "service_identities.#", "2"),
+					resource.TestCheckResourceAttr("vault_consul_secret_backend_role.test", "node_identities.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccConsulSecretBackendRoleCheckDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -133,6 +156,30 @@ resource "vault_consul_secret_backend_role" "test_path" {
 `, backend, token, name)
 }
 
+func testConsulSecretBackendRole_identitiesConfig(backend, name, token string) string {
+	return fmt.Sprintf(`
+resource "vault_consul_secret_backend" "test" {
+  path = "%s"
+  description = "test description"
+  address = "127.0.0.1:8500"
+  token = "%s"
+}
+
+resource "vault_consul_secret_backend_role" "test" {
+  backend = vault_consul_secret_backend.test.path
+  name = "%s"
+
+  service_identities = [
+    "web:dc1",
+    "api",
+  ]
+  node_identities = [
+    "client-1:dc1",
+  ]
+}
+`, backend, token, name)
+}
+
 func TestConsulSecretBackendRoleNameFromPath(t *testing.T) {
 	{
 		name, err := consulSecretBackendRoleNameFromPath("foo/roles/bar")
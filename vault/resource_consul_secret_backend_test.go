@@ -2,6 +2,7 @@ package vault
 
 import (
 	"fmt"
+	"os"
 	"strings"
 	"testing"
 
@@ -83,6 +84,32 @@ func TestConsulSecretBackend(t *testing.T) {
 	})
 }
 
+func TestConsulSecretBackend_bootstrap(t *testing.T) {
+	// bootstrapping requires a Consul agent with ACLs enabled that has
+	// not been bootstrapped yet
+	address := os.Getenv("CONSUL_HTTP_ADDR")
+	if address == "" {
+		t.Skip("CONSUL_HTTP_ADDR not set")
+	}
+	path := acctest.RandomWithPrefix("tf-test-consul")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccConsulSecretBackendCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testConsulSecretBackend_bootstrapConfig(path, address),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_consul_secret_backend.test", "path", path),
+					resource.TestCheckResourceAttr("vault_consul_secret_backend.test", "address", address),
+					resource.TestCheckResourceAttr("vault_consul_secret_backend.test", "bootstrap", "true"),
+					resource.TestCheckNoResourceAttr("vault_consul_secret_backend.test", "token"),
+				),
+			},
+		},
+	})
+}
+
 func testAccConsulSecretBackendCheckDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -156,3 +183,13 @@ resource "vault_consul_secret_backend" "test" {
   client_key = "UPDATED-FAKE-CLIENT-CERT-KEY-MATERIAL"
 }`, path, token)
 }
+
+func testConsulSecretBackend_bootstrapConfig(path, address string) string {
+	return fmt.Sprintf(`
+resource "vault_consul_secret_backend" "test" {
+  path = "%s"
+  description = "test description"
+  address = "%s"
+  bootstrap = true
+}`, path, address)
+}
@@ -0,0 +1,143 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+const (
+	controlGroupAuthorizePath = "sys/control-group/authorize"
+	controlGroupRequestPath   = "sys/control-group/request"
+)
+
+func controlGroupAuthorizationResource() *schema.Resource {
+	return &schema.Resource{
+		Create: controlGroupAuthorizationCreate,
+		Read:   controlGroupAuthorizationRead,
+		Delete: controlGroupAuthorizationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"accessor": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Accessor of the wrapping token returned for the control group request.",
+			},
+			"approved": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the request has received all required approvals.",
+			},
+			"request_path": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Path of the request awaiting authorization.",
+			},
+			"request_entity_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the entity that made the request.",
+			},
+			"request_entity_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the entity that made the request.",
+			},
+			"authorizations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Entities that have authorized the request.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"entity_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"entity_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func controlGroupAuthorizationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	accessor := d.Get("accessor").(string)
+
+	log.Printf("[DEBUG] Authorizing control group request %q", accessor)
+	if _, err := client.Logical().Write(controlGroupAuthorizePath, map[string]interface{}{
+		"accessor": accessor,
+	}); err != nil {
+		return fmt.Errorf("error authorizing control group request %q: %s", accessor, err)
+	}
+	d.SetId(accessor)
+	log.Printf("[DEBUG] Authorized control group request %q", accessor)
+
+	return controlGroupAuthorizationRead(d, meta)
+}
+
+func controlGroupAuthorizationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	accessor := d.Id()
+
+	log.Printf("[DEBUG] Checking control group request %q", accessor)
+	resp, err := client.Logical().Write(controlGroupRequestPath, map[string]interface{}{
+		"accessor": accessor,
+	})
+	if err != nil {
+		return fmt.Errorf("error checking control group request %q: %s", accessor, err)
+	}
+	log.Printf("[DEBUG] Checked control group request %q", accessor)
+
+	if resp == nil {
+		log.Printf("[WARN] Control group request %q not found, removing from state", accessor)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("accessor", accessor)
+	d.Set("approved", resp.Data["approved"])
+	d.Set("request_path", resp.Data["request_path"])
+
+	if entity, ok := resp.Data["request_entity"].(map[string]interface{}); ok {
+		d.Set("request_entity_id", entity["id"])
+		d.Set("request_entity_name", entity["name"])
+	}
+
+	var authorizations []map[string]interface{}
+	if v, ok := resp.Data["authorizations"].([]interface{}); ok {
+		for _, authI := range v {
+			auth, ok := authI.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			authorizations = append(authorizations, map[string]interface{}{
+				"entity_id":   auth["entity_id"],
+				"entity_name": auth["entity_name"],
+			})
+		}
+	}
+	if err := d.Set("authorizations", authorizations); err != nil {
+		return fmt.Errorf("error setting state key \"authorizations\": %s", err)
+	}
+
+	return nil
+}
+
+func controlGroupAuthorizationDelete(d *schema.ResourceData, meta interface{}) error {
+	// An authorization can't be withdrawn once given, so removing the
+	// resource only removes it from state.
+	log.Printf("[DEBUG] Removing control group authorization %q from state", d.Id())
+	return nil
+}
@@ -0,0 +1,151 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestResourceControlGroupAuthorization(t *testing.T) {
+	isEnterprise := os.Getenv("TF_ACC_ENTERPRISE")
+	if isEnterprise == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+
+	backend := acctest.RandomWithPrefix("userpass")
+	policy := acctest.RandomWithPrefix("control-group")
+	group := acctest.RandomWithPrefix("approvers")
+
+	client := testProvider.Meta().(*api.Client)
+	approverToken, accessor := testControlGroupAuthorizationSetup(t, client, backend, policy, group)
+	defer testControlGroupAuthorizationCleanup(t, client, backend, policy, group)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceControlGroupAuthorization_config(approverToken, accessor),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_control_group_authorization.test", "accessor", accessor),
+					resource.TestCheckResourceAttr("vault_control_group_authorization.test", "approved", "true"),
+					resource.TestCheckResourceAttr("vault_control_group_authorization.test", "request_path", "secret/control-group"),
+					resource.TestCheckResourceAttr("vault_control_group_authorization.test", "request_entity_name", "requester"),
+					resource.TestCheckResourceAttr("vault_control_group_authorization.test", "authorizations.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+// testControlGroupAuthorizationSetup creates a requester whose reads are
+// gated by a control group and an approver who can authorize them. It returns
+// the approver's token and the accessor of the pending request.
+func testControlGroupAuthorizationSetup(t *testing.T, client *api.Client, backend, policy, group string) (string, string) {
+	if err := client.Sys().EnableAuthWithOptions(backend, &api.EnableAuthOptions{Type: "userpass"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.Sys().PutPolicy(policy, `
+path "secret/control-group" {
+  capabilities = ["read"]
+  control_group = {
+    factor "approvers" {
+      identity {
+        group_names = ["`+group+`"]
+        approvals = 1
+      }
+    }
+  }
+}
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	approverPolicy := policy + "-approver"
+	if err := client.Sys().PutPolicy(approverPolicy, `
+path "auth/token/create" {
+  capabilities = ["update"]
+}
+
+path "sys/control-group/authorize" {
+  capabilities = ["create", "update"]
+}
+
+path "sys/control-group/request" {
+  capabilities = ["create", "update"]
+}
+`); err != nil {
+		t.Fatal(err)
+	}
+
+	login := func(name, policies string) *api.Secret {
+		if _, err := client.Logical().Write(fmt.Sprintf("auth/%s/users/%s", backend, name), map[string]interface{}{
+			"password": "password",
+			"policies": policies,
+		}); err != nil {
+			t.Fatal(err)
+		}
+		resp, err := client.Logical().Write(fmt.Sprintf("auth/%s/login/%s", backend, name), map[string]interface{}{
+			"password": "password",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp
+	}
+
+	approver := login("approver", approverPolicy)
+	if _, err := client.Logical().Write("identity/group/name/"+group, map[string]interface{}{
+		"member_entity_ids": []string{approver.Auth.EntityID},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	requester := login("requester", policy)
+	requesterClient, err := client.Clone()
+	if err != nil {
+		t.Fatal(err)
+	}
+	requesterClient.SetToken(requester.Auth.ClientToken)
+
+	resp, err := requesterClient.Logical().Read("secret/control-group")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp == nil || resp.WrapInfo == nil {
+		t.Fatal("expected the read to be wrapped by the control group")
+	}
+
+	return approver.Auth.ClientToken, resp.WrapInfo.Accessor
+}
+
+func testControlGroupAuthorizationCleanup(t *testing.T, client *api.Client, backend, policy, group string) {
+	if err := client.Sys().DisableAuth(backend); err != nil {
+		t.Error(err)
+	}
+	for _, name := range []string{policy, policy + "-approver"} {
+		if err := client.Sys().DeletePolicy(name); err != nil {
+			t.Error(err)
+		}
+	}
+	if _, err := client.Logical().Delete("identity/group/name/" + group); err != nil {
+		t.Error(err)
+	}
+}
+
+func testResourceControlGroupAuthorization_config(token, accessor string) string {
+	return fmt.Sprintf(`
+provider "vault" {
+  token = "%s"
+}
+
+resource "vault_control_group_authorization" "test" {
+  accessor = "%s"
+}
+`, token, accessor)
+}
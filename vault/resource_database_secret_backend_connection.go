@@ -69,6 +69,18 @@ func databaseSecretBackendConnectionResource() *schema.Resource {
 				Description: "A map of sensitive data to pass to the endpoint. Useful for templated connection strings.",
 				Sensitive:   true,
 			},
+			"rotate_root_on_create": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Whether to rotate the root credentials once the connection has been created, so they are only known to Vault.",
+			},
+			"last_root_rotation": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time of the last root credential rotation performed by Terraform, in RFC3339 format.",
+			},
 
 			"elasticsearch": {
 				Type:        schema.TypeList,
@@ -651,6 +663,12 @@ func databaseSecretBackendConnectionCreate(d *schema.ResourceData, meta interfac
 	d.SetId(path)
 	log.Printf("[DEBUG] Wrote database connection config %q", path)
 
+	if d.Get("rotate_root_on_create").(bool) {
+		if err := databaseSecretBackendConnectionRotateRoot(client, d, backend, name); err != nil {
+			return err
+		}
+	}
+
 	return databaseSecretBackendConnectionRead(d, meta)
 }
 
@@ -794,6 +812,10 @@ func databaseSecretBackendConnectionRead(d *schema.ResourceData, meta interface{
 	if v, ok := resp.Data["verify_connection"]; ok {
 		d.Set("verify_connection", v.(bool))
 	}
+	// rotate_root_on_create is not returned by Vault, default it for imported connections
+	if _, ok := d.GetOkExists("rotate_root_on_create"); !ok {
+		d.Set("rotate_root_on_create", false)
+	}
 
 	return nil
 }
@@ -0,0 +1,637 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+// databasePluginField describes a plugin specific connection field, and how
+// it is exchanged with Vault.
+type databasePluginField struct {
+	schema *schema.Schema
+	// writeOnly fields are never returned by Vault, so the configured value
+	// is kept in state.
+	writeOnly bool
+	// duration fields are configured in seconds and returned by Vault as a
+	// duration string.
+	duration bool
+	// commaList fields are configured as a list and sent to Vault as a
+	// comma separated string.
+	commaList bool
+}
+
+// databasePlugin describes a database plugin that has its own connection
+// resource.
+type databasePlugin struct {
+	// pluginNames are the names the plugin can be registered under in
+	// Vault, the first being the default.
+	pluginNames []string
+	description string
+	fields      map[string]*databasePluginField
+}
+
+var (
+	databasePostgreSQLPlugin = &databasePlugin{
+		pluginNames: []string{"postgresql-database-plugin"},
+		description: "PostgreSQL",
+		fields: databaseSQLPluginFields(map[string]*databasePluginField{
+			"disable_escaping": {
+				schema: &schema.Schema{
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Disable special character escaping in username and password.",
+				},
+			},
+			"password_authentication": {
+				schema: &schema.Schema{
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					Description:  "When set to scram-sha-256, passwords will be hashed by Vault before being sent to PostgreSQL. Requires Vault 1.14+.",
+					ValidateFunc: validation.StringInSlice([]string{"password", "scram-sha-256"}, false),
+				},
+			},
+		}),
+	}
+	databaseMySQLPlugin = &databasePlugin{
+		pluginNames: []string{"mysql-database-plugin", "mysql-rds-database-plugin", "mysql-aurora-database-plugin", "mysql-legacy-database-plugin"},
+		description: "MySQL",
+		fields: databaseSQLPluginFields(map[string]*databasePluginField{
+			"tls_certificate_key": {
+				schema: &schema.Schema{
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					Description: "x509 certificate for connecting to the database. This must be a PEM encoded version of the private key and the certificate combined.",
+				},
+				writeOnly: true,
+			},
+			"tls_ca": {
+				schema: &schema.Schema{
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "x509 CA file for validating the certificate presented by the MySQL server. Must be PEM encoded.",
+				},
+			},
+		}),
+	}
+	databaseMSSQLPlugin = &databasePlugin{
+		pluginNames: []string{"mssql-database-plugin"},
+		description: "MSSQL",
+		fields: databaseSQLPluginFields(map[string]*databasePluginField{
+			"contained_db": {
+				schema: &schema.Schema{
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Set to true when the database is a contained database.",
+				},
+			},
+		}),
+	}
+	databaseMongoDBPlugin = &databasePlugin{
+		pluginNames: []string{"mongodb-database-plugin"},
+		description: "MongoDB",
+		fields: map[string]*databasePluginField{
+			"connection_url":    databaseConnectionURLField(),
+			"username":          databaseUsernameField(false),
+			"password":          databasePasswordField(false),
+			"username_template": databaseUsernameTemplateField(),
+			"write_concern": {
+				schema: &schema.Schema{
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The default write concern for MongoDB, as a JSON string.",
+				},
+			},
+			"tls_certificate_key": {
+				schema: &schema.Schema{
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					Description: "x509 certificate and private key for connecting to the database, PEM encoded.",
+				},
+				writeOnly: true,
+			},
+			"tls_ca": {
+				schema: &schema.Schema{
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "x509 CA certificate for validating the certificate presented by the MongoDB server. Must be PEM encoded.",
+				},
+			},
+		},
+	}
+	databaseOraclePlugin = &databasePlugin{
+		pluginNames: []string{"oracle-database-plugin", "vault-plugin-database-oracle"},
+		description: "Oracle",
+		fields: databaseSQLPluginFields(map[string]*databasePluginField{
+			"split_statements": {
+				schema: &schema.Schema{
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Computed:    true,
+					Description: "Whether to split statements on semicolons before executing them.",
+				},
+			},
+			"disconnect_sessions": {
+				schema: &schema.Schema{
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Computed:    true,
+					Description: "Whether to disconnect the sessions of a user when it is revoked.",
+				},
+			},
+		}),
+	}
+	databaseElasticsearchPlugin = &databasePlugin{
+		pluginNames: []string{"elasticsearch-database-plugin"},
+		description: "Elasticsearch",
+		fields: map[string]*databasePluginField{
+			"url": {
+				schema: &schema.Schema{
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The URL for Elasticsearch's API.",
+				},
+			},
+			"username":          databaseUsernameField(true),
+			"password":          databasePasswordField(true),
+			"username_template": databaseUsernameTemplateField(),
+			"ca_cert": {
+				schema: &schema.Schema{
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The path to a PEM-encoded CA cert file to use to verify the Elasticsearch server's identity.",
+				},
+			},
+			"ca_path": {
+				schema: &schema.Schema{
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The path to a directory of PEM-encoded CA cert files to use to verify the Elasticsearch server's identity.",
+				},
+			},
+			"client_cert": {
+				schema: &schema.Schema{
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The path to the certificate for the Elasticsearch client to present for communication.",
+				},
+			},
+			"client_key": {
+				schema: &schema.Schema{
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The path to the key for the Elasticsearch client to use for communication.",
+				},
+			},
+			"tls_server_name": {
+				schema: &schema.Schema{
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "This, if set, is used to set the SNI host when connecting via TLS.",
+				},
+			},
+			"insecure": {
+				schema: &schema.Schema{
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Whether to disable certificate verification.",
+				},
+			},
+		},
+	}
+	databaseSnowflakePlugin = &databasePlugin{
+		pluginNames: []string{"snowflake-database-plugin"},
+		description: "Snowflake",
+		fields:      databaseSQLPluginFields(nil),
+	}
+	databaseRedisPlugin = &databasePlugin{
+		pluginNames: []string{"redis-database-plugin"},
+		description: "Redis",
+		fields: map[string]*databasePluginField{
+			"host": {
+				schema: &schema.Schema{
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The host to connect to.",
+				},
+			},
+			"port": {
+				schema: &schema.Schema{
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Default:     6379,
+					Description: "The transport port to use to connect to Redis.",
+				},
+			},
+			"username": databaseUsernameField(true),
+			"password": databasePasswordField(true),
+			"tls": {
+				schema: &schema.Schema{
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Whether to use TLS when connecting to Redis.",
+				},
+			},
+			"insecure_tls": {
+				schema: &schema.Schema{
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Whether to skip verification of the server certificate when using TLS.",
+				},
+			},
+			"ca_cert": {
+				schema: &schema.Schema{
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The contents of a PEM-encoded CA cert file to use to verify the Redis server's identity.",
+				},
+			},
+		},
+	}
+	databaseCouchbasePlugin = &databasePlugin{
+		pluginNames: []string{"couchbase-database-plugin"},
+		description: "Couchbase",
+		fields: map[string]*databasePluginField{
+			"hosts": {
+				schema: &schema.Schema{
+					Type:        schema.TypeList,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Required:    true,
+					Description: "A set of Couchbase URIs to connect to.",
+				},
+				commaList: true,
+			},
+			"username":          databaseUsernameField(true),
+			"password":          databasePasswordField(true),
+			"username_template": databaseUsernameTemplateField(),
+			"tls": {
+				schema: &schema.Schema{
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Whether to use TLS when connecting to Couchbase.",
+				},
+			},
+			"insecure_tls": {
+				schema: &schema.Schema{
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Whether to skip verification of the server certificate when using TLS.",
+				},
+			},
+			"base64_pem": {
+				schema: &schema.Schema{
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Base64 encoded CA certificate used to verify the Couchbase server's identity.",
+				},
+			},
+			"bucket_name": {
+				schema: &schema.Schema{
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Required for Couchbase versions prior to 6.5.0. This is only used to verify vault's connection to the server.",
+				},
+			},
+		},
+	}
+)
+
+func databaseConnectionURLField() *databasePluginField {
+	return &databasePluginField{
+		schema: &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Connection string to use to connect to the database.",
+		},
+	}
+}
+
+func databaseUsernameField(required bool) *databasePluginField {
+	return &databasePluginField{
+		schema: &schema.Schema{
+			Type:        schema.TypeString,
+			Required:    required,
+			Optional:    !required,
+			Description: "The root credential username used in the connection.",
+		},
+	}
+}
+
+func databasePasswordField(required bool) *databasePluginField {
+	return &databasePluginField{
+		schema: &schema.Schema{
+			Type:        schema.TypeString,
+			Required:    required,
+			Optional:    !required,
+			Sensitive:   true,
+			Description: "The root credential password used in the connection.",
+		},
+		writeOnly: true,
+	}
+}
+
+func databaseUsernameTemplateField() *databasePluginField {
+	return &databasePluginField{
+		schema: &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Template describing how dynamic usernames are generated.",
+		},
+	}
+}
+
+// databaseSQLPluginFields returns the fields shared by the plugins built on
+// Vault's SQL connection producer, along with any plugin specific fields.
+func databaseSQLPluginFields(extra map[string]*databasePluginField) map[string]*databasePluginField {
+	fields := map[string]*databasePluginField{
+		"connection_url":    databaseConnectionURLField(),
+		"username":          databaseUsernameField(false),
+		"password":          databasePasswordField(false),
+		"username_template": databaseUsernameTemplateField(),
+		"max_open_connections": {
+			schema: &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     4,
+				Description: "Maximum number of open connections to the database.",
+			},
+		},
+		"max_idle_connections": {
+			schema: &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum number of idle connections to the database.",
+			},
+		},
+		"max_connection_lifetime": {
+			schema: &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum number of seconds a connection may be reused.",
+			},
+			duration: true,
+		},
+	}
+	for k, v := range extra {
+		fields[k] = v
+	}
+	return fields
+}
+
+func databaseSecretBackendPluginConnectionResource(plugin *databasePlugin) *schema.Resource {
+	fields := map[string]*schema.Schema{
+		"backend": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Unique name of the Vault mount to configure.",
+			// standardise on no beginning or trailing slashes
+			StateFunc: func(v interface{}) string {
+				return strings.Trim(v.(string), "/")
+			},
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Name of the database connection.",
+		},
+		"plugin_name": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      plugin.pluginNames[0],
+			Description:  fmt.Sprintf("Name of the %s database plugin registered in Vault.", plugin.description),
+			ValidateFunc: validation.StringInSlice(plugin.pluginNames, false),
+		},
+		"verify_connection": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Specifies if the connection is verified during initial configuration.",
+		},
+		"allowed_roles": {
+			Type:        schema.TypeList,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Description: "A list of roles that are allowed to use this connection.",
+		},
+		"root_rotation_statements": {
+			Type:        schema.TypeList,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Description: "A list of database statements to be executed to rotate the root user's credentials.",
+		},
+		"password_policy": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The name of the password policy to use when generating passwords for this connection. Requires Vault 1.7+.",
+		},
+		"rotate_root_on_create": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			ForceNew:    true,
+			Default:     false,
+			Description: "Whether to rotate the root credentials once the connection has been created, so they are only known to Vault.",
+		},
+		"last_root_rotation": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Time of the last root credential rotation performed by Terraform, in RFC3339 format.",
+		},
+	}
+	for k, v := range plugin.fields {
+		fields[k] = v.schema
+	}
+
+	return &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			return databaseSecretBackendPluginConnectionWrite(plugin, d, meta)
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return databaseSecretBackendPluginConnectionRead(plugin, d, meta)
+		},
+		Update: func(d *schema.ResourceData, meta interface{}) error {
+			return databaseSecretBackendPluginConnectionWrite(plugin, d, meta)
+		},
+		Delete: databaseSecretBackendConnectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: fields,
+	}
+}
+
+// databaseSecretBackendPluginConnectionRequestData builds the request to
+// configure the connection for the given plugin.
+func databaseSecretBackendPluginConnectionRequestData(plugin *databasePlugin, d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"plugin_name":       d.Get("plugin_name").(string),
+		"verify_connection": d.Get("verify_connection").(bool),
+		"allowed_roles":     strings.Join(util.ToStringArray(d.Get("allowed_roles").([]interface{})), ","),
+	}
+	if v, ok := d.GetOk("root_rotation_statements"); ok {
+		data["root_rotation_statements"] = v
+	}
+	if v, ok := d.GetOk("password_policy"); ok {
+		data["password_policy"] = v
+	}
+
+	for k, field := range plugin.fields {
+		// Vault does not return the password in the API. If the root credentials have been rotated, sending
+		// the old password in the update request would break the connection config. Thus we only send it,
+		// if it actually changed, to still support updating it for non-rotated cases.
+		if k == "password" && !d.IsNewResource() && !d.HasChange(k) {
+			continue
+		}
+
+		v, ok := d.GetOkExists(k)
+		if !ok {
+			continue
+		}
+		switch {
+		case field.duration:
+			data[k] = fmt.Sprintf("%ds", v)
+		case field.commaList:
+			data[k] = strings.Join(util.ToStringArray(v.([]interface{})), ",")
+		default:
+			data[k] = v
+		}
+	}
+
+	return data
+}
+
+func databaseSecretBackendPluginConnectionWrite(plugin *databasePlugin, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := databaseSecretBackendConnectionPath(d.Get("backend").(string), d.Get("name").(string))
+	data := databaseSecretBackendPluginConnectionRequestData(plugin, d)
+
+	log.Printf("[DEBUG] Writing %s connection config to %q", plugin.description, path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error configuring database connection %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote %s connection config to %q", plugin.description, path)
+
+	d.SetId(path)
+
+	if d.IsNewResource() && d.Get("rotate_root_on_create").(bool) {
+		if err := databaseSecretBackendConnectionRotateRoot(client, d, d.Get("backend").(string), d.Get("name").(string)); err != nil {
+			return err
+		}
+	}
+
+	return databaseSecretBackendPluginConnectionRead(plugin, d, meta)
+}
+
+func databaseSecretBackendPluginConnectionRead(plugin *databasePlugin, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	backend, err := databaseSecretBackendConnectionBackendFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q for database connection: %s", path, err)
+	}
+	name, err := databaseSecretBackendConnectionNameFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q for database connection: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading database connection config %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading database connection config %q: %s", path, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] Database connection %q not found, removing it from state", path)
+		d.SetId("")
+		return nil
+	}
+	log.Printf("[DEBUG] Read database connection config %q", path)
+
+	pluginName, _ := resp.Data["plugin_name"].(string)
+	if found, _ := util.SliceHasElement(flattenStringSlice(plugin.pluginNames), pluginName); !found {
+		return fmt.Errorf("database connection %q uses plugin %q, expected one of %v", path, pluginName, plugin.pluginNames)
+	}
+
+	d.Set("backend", backend)
+	d.Set("name", name)
+	d.Set("plugin_name", pluginName)
+	d.Set("allowed_roles", resp.Data["allowed_roles"])
+	d.Set("root_rotation_statements", resp.Data["root_credentials_rotate_statements"])
+	if v, ok := resp.Data["verify_connection"]; ok {
+		d.Set("verify_connection", v)
+	}
+	if v, ok := resp.Data["password_policy"]; ok {
+		d.Set("password_policy", v)
+	}
+	// rotate_root_on_create is not returned by Vault, default it for imported connections
+	if _, ok := d.GetOkExists("rotate_root_on_create"); !ok {
+		d.Set("rotate_root_on_create", false)
+	}
+
+	details, _ := resp.Data["connection_details"].(map[string]interface{})
+	for k, field := range plugin.fields {
+		if field.writeOnly {
+			continue
+		}
+		v, ok := details[k]
+		if !ok {
+			continue
+		}
+		v, err := databasePluginFieldFromResponse(field, v)
+		if err != nil {
+			return fmt.Errorf("error reading %q for database connection %q: %s", k, path, err)
+		}
+		if err := d.Set(k, v); err != nil {
+			return fmt.Errorf("error setting %q for database connection %q: %s", k, path, err)
+		}
+	}
+
+	return nil
+}
+
+// databasePluginFieldFromResponse converts a connection detail returned by
+// Vault to the value stored in state.
+func databasePluginFieldFromResponse(field *databasePluginField, v interface{}) (interface{}, error) {
+	switch {
+	case field.duration:
+		s, _ := v.(string)
+		if s == "" {
+			return 0, nil
+		}
+		dur, err := time.ParseDuration(s)
+		if err != nil {
+			return nil, err
+		}
+		return int(dur.Seconds()), nil
+	case field.commaList:
+		if s, ok := v.(string); ok {
+			if s == "" {
+				return []string{}, nil
+			}
+			return strings.Split(s, ","), nil
+		}
+		return v, nil
+	}
+
+	if n, ok := v.(json.Number); ok && field.schema.Type == schema.TypeInt {
+		return n.Int64()
+	}
+	return v, nil
+}
@@ -0,0 +1,189 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestDatabasePluginFieldFromResponse(t *testing.T) {
+	fields := databaseSQLPluginFields(nil)
+	tests := []struct {
+		name  string
+		field *databasePluginField
+		value interface{}
+		want  interface{}
+	}{
+		{"duration", fields["max_connection_lifetime"], "1m30s", 90},
+		{"empty duration", fields["max_connection_lifetime"], "", 0},
+		{"int", fields["max_open_connections"], json.Number("4"), int64(4)},
+		{"string", fields["username_template"], "{{.RoleName}}", "{{.RoleName}}"},
+		{"comma list", databaseCouchbasePlugin.fields["hosts"], "couchbase://a,couchbase://b", []string{"couchbase://a", "couchbase://b"}},
+		{"empty comma list", databaseCouchbasePlugin.fields["hosts"], "", []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := databasePluginFieldFromResponse(tt.field, tt.value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected %#v, got %#v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDatabaseSecretBackendPluginConnectionResource_schema(t *testing.T) {
+	r := databaseSecretBackendPluginConnectionResource(databaseMySQLPlugin)
+	if err := r.InternalValidate(nil, true); err != nil {
+		t.Fatal(err)
+	}
+	for _, k := range []string{"tls_certificate_key", "tls_ca", "username_template", "max_connection_lifetime", "password_policy"} {
+		if _, ok := r.Schema[k]; !ok {
+			t.Errorf("expected %q in the MySQL connection schema", k)
+		}
+	}
+	if _, ok := r.Schema["hosts"]; ok {
+		t.Error("unexpected Couchbase field in the MySQL connection schema")
+	}
+}
+
+func TestAccDatabaseSecretBackendPluginConnection_postgresql(t *testing.T) {
+	connURL := os.Getenv("POSTGRES_URL")
+	if connURL == "" {
+		t.Skip("POSTGRES_URL not set")
+	}
+	backend := acctest.RandomWithPrefix("tf-test-db")
+	name := acctest.RandomWithPrefix("db")
+	resourceName := "vault_database_secret_backend_connection_postgresql.test"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccDatabaseSecretBackendPluginConnectionCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseSecretBackendPluginConnectionConfig_postgresql(name, backend, connURL, "{{.DisplayName}}"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "plugin_name", "postgresql-database-plugin"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_roles.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_roles.0", "dev"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_roles.1", "prod"),
+					resource.TestCheckResourceAttr(resourceName, "verify_connection", "true"),
+					resource.TestCheckResourceAttr(resourceName, "connection_url", connURL),
+					resource.TestCheckResourceAttr(resourceName, "max_open_connections", "2"),
+					resource.TestCheckResourceAttr(resourceName, "max_connection_lifetime", "0"),
+					resource.TestCheckResourceAttr(resourceName, "username_template", "{{.DisplayName}}"),
+				),
+			},
+			{
+				Config: testAccDatabaseSecretBackendPluginConnectionConfig_postgresql(name, backend, connURL, "{{.RoleName}}"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "username_template", "{{.RoleName}}"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"verify_connection"},
+			},
+		},
+	})
+}
+
+func TestAccDatabaseSecretBackendPluginConnection_mysql(t *testing.T) {
+	connURL := os.Getenv("MYSQL_URL")
+	if connURL == "" {
+		t.Skip("MYSQL_URL not set")
+	}
+	backend := acctest.RandomWithPrefix("tf-test-db")
+	name := acctest.RandomWithPrefix("db")
+	resourceName := "vault_database_secret_backend_connection_mysql.test"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccDatabaseSecretBackendPluginConnectionCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseSecretBackendPluginConnectionConfig_mysql(name, backend, connURL, "mysql-database-plugin"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "plugin_name", "mysql-database-plugin"),
+					resource.TestCheckResourceAttr(resourceName, "connection_url", connURL),
+					resource.TestCheckResourceAttr(resourceName, "max_connection_lifetime", "300"),
+				),
+			},
+			{
+				Config: testAccDatabaseSecretBackendPluginConnectionConfig_mysql(name, backend, connURL, "mysql-rds-database-plugin"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "plugin_name", "mysql-rds-database-plugin"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatabaseSecretBackendPluginConnectionCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if !strings.HasPrefix(rs.Type, "vault_database_secret_backend_connection_") {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("connection %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccDatabaseSecretBackendPluginConnectionConfig_postgresql(name, path, connURL, userTempl string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "db" {
+  path = "%s"
+  type = "database"
+}
+
+resource "vault_database_secret_backend_connection_postgresql" "test" {
+  backend              = vault_mount.db.path
+  name                 = "%s"
+  allowed_roles        = ["dev", "prod"]
+  connection_url       = "%s"
+  max_open_connections = 2
+  username_template    = "%s"
+}
+`, path, name, connURL, userTempl)
+}
+
+func testAccDatabaseSecretBackendPluginConnectionConfig_mysql(name, path, connURL, pluginName string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "db" {
+  path = "%s"
+  type = "database"
+}
+
+resource "vault_database_secret_backend_connection_mysql" "test" {
+  backend                 = vault_mount.db.path
+  name                    = "%s"
+  allowed_roles           = ["dev"]
+  connection_url          = "%s"
+  plugin_name             = "%s"
+  max_connection_lifetime = 300
+}
+`, path, name, connURL, pluginName)
+}
@@ -0,0 +1,121 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+var databaseSecretBackendRootRotationPathRegex = regexp.MustCompile("^(.+)/rotate-root/([^/]+)$")
+
+func databaseSecretBackendRootRotationResource() *schema.Resource {
+	return &schema.Resource{
+		Create: databaseSecretBackendRootRotationCreate,
+		Read:   databaseSecretBackendRootRotationRead,
+		Delete: databaseSecretBackendRootRotationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Unique name of the Vault mount the database connection belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the database connection to rotate the root credentials of.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary map of values that, when changed, rotates the root credentials of the connection again.",
+			},
+			"last_root_rotation": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time of the last root credential rotation performed by Terraform, in RFC3339 format.",
+			},
+		},
+	}
+}
+
+func databaseSecretBackendRootRotationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := strings.Trim(d.Get("backend").(string), "/")
+	name := d.Get("name").(string)
+
+	if err := databaseSecretBackendConnectionRotateRoot(client, d, backend, name); err != nil {
+		return err
+	}
+
+	d.SetId(databaseSecretBackendRootRotationPath(backend, name))
+
+	return databaseSecretBackendRootRotationRead(d, meta)
+}
+
+func databaseSecretBackendRootRotationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	res := databaseSecretBackendRootRotationPathRegex.FindStringSubmatch(d.Id())
+	if len(res) != 3 {
+		return fmt.Errorf("invalid ID %q for database root rotation", d.Id())
+	}
+	backend, name := res[1], res[2]
+
+	path := databaseSecretBackendConnectionPath(backend, name)
+	log.Printf("[DEBUG] Reading database connection config %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading database connection config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read database connection config %q", path)
+	if resp == nil {
+		log.Printf("[WARN] Database connection %q not found, removing root rotation from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("name", name)
+
+	return nil
+}
+
+func databaseSecretBackendRootRotationDelete(d *schema.ResourceData, meta interface{}) error {
+	// Root credentials cannot be unrotated, so there is nothing to do in Vault.
+	log.Printf("[DEBUG] Removing database root rotation %q from state", d.Id())
+	return nil
+}
+
+// databaseSecretBackendConnectionRotateRoot rotates the root credentials of
+// the database connection and records the time in last_root_rotation.
+func databaseSecretBackendConnectionRotateRoot(client *api.Client, d *schema.ResourceData, backend, name string) error {
+	path := databaseSecretBackendRootRotationPath(backend, name)
+
+	log.Printf("[DEBUG] Rotating root credentials of database connection %q", path)
+	if _, err := client.Logical().Write(path, nil); err != nil {
+		return fmt.Errorf("error rotating root credentials of database connection %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Rotated root credentials of database connection %q", path)
+
+	d.Set("last_root_rotation", time.Now().UTC().Format(time.RFC3339))
+	return nil
+}
+
+func databaseSecretBackendRootRotationPath(backend, name string) string {
+	return strings.Trim(backend, "/") + "/rotate-root/" + strings.Trim(name, "/")
+}
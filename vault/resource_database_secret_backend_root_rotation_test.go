@@ -0,0 +1,93 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDatabaseSecretBackendRootRotation_mysql(t *testing.T) {
+	connURL := os.Getenv("MYSQL_CONNECTION_URL")
+	if connURL == "" {
+		t.Skip("MYSQL_CONNECTION_URL not set")
+	}
+	username := os.Getenv("MYSQL_CONNECTION_USERNAME")
+	if username == "" {
+		t.Skip("MYSQL_CONNECTION_USERNAME not set")
+	}
+	password := os.Getenv("MYSQL_CONNECTION_PASSWORD")
+	if password == "" {
+		t.Skip("MYSQL_CONNECTION_PASSWORD not set")
+	}
+
+	// MYSQL_TEMPLATED_URL is the template URL to be used in vault
+	testConnURL := os.Getenv("MYSQL_TEMPLATED_URL")
+	if testConnURL == "" {
+		testConnURL = connURL
+	}
+
+	backend := acctest.RandomWithPrefix("tf-test-db")
+	name := acctest.RandomWithPrefix("db")
+	testUsername := acctest.RandomWithPrefix("username")
+	testPassword := acctest.RandomWithPrefix("password")
+
+	// the root credentials are rotated, so use a dedicated user
+	db := newMySQLConnection(t, connURL, username, password)
+	createMySQSUser(t, db, testUsername, testPassword)
+	defer deleteMySQLUser(t, db, testUsername)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccDatabaseSecretBackendPluginConnectionCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseSecretBackendRootRotationConfig(name, backend, testConnURL, testUsername, testPassword, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_database_secret_backend_connection_mysql.test", "rotate_root_on_create", "true"),
+					resource.TestCheckResourceAttrSet("vault_database_secret_backend_connection_mysql.test", "last_root_rotation"),
+					resource.TestCheckResourceAttr("vault_database_secret_backend_root_rotation.test", "backend", backend),
+					resource.TestCheckResourceAttr("vault_database_secret_backend_root_rotation.test", "name", name),
+					resource.TestCheckResourceAttrSet("vault_database_secret_backend_root_rotation.test", "last_root_rotation"),
+				),
+			},
+			{
+				Config: testAccDatabaseSecretBackendRootRotationConfig(name, backend, testConnURL, testUsername, testPassword, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_database_secret_backend_root_rotation.test", "triggers.rotation", "2"),
+					resource.TestCheckResourceAttrSet("vault_database_secret_backend_root_rotation.test", "last_root_rotation"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatabaseSecretBackendRootRotationConfig(name, path, connURL, username, password, trigger string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "db" {
+  path = "%s"
+  type = "database"
+}
+
+resource "vault_database_secret_backend_connection_mysql" "test" {
+  backend               = vault_mount.db.path
+  name                  = "%s"
+  connection_url        = "%s"
+  username              = "%s"
+  password              = "%s"
+  rotate_root_on_create = true
+}
+
+resource "vault_database_secret_backend_root_rotation" "test" {
+  backend = vault_mount.db.path
+  name    = vault_database_secret_backend_connection_mysql.test.name
+
+  triggers = {
+    rotation = "%s"
+  }
+}
+`, path, name, connURL, username, password, trigger)
+}
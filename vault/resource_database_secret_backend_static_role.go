@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/vault/api"
 )
 
@@ -16,6 +17,12 @@ var (
 	databaseSecretBackendStaticRoleNameFromPathRegex    = regexp.MustCompile("^.+/static-roles/(.+$)")
 )
 
+// databaseSecretBackendStaticRoleVaultFeatures gates schedule based rotation.
+var databaseSecretBackendStaticRoleVaultFeatures = map[string]vaultFeature{
+	"rotation_schedule": {MinVersion: "1.15"},
+	"rotation_window":   {MinVersion: "1.15"},
+}
+
 func databaseSecretBackendStaticRoleResource() *schema.Resource {
 	return &schema.Resource{
 		Create: databaseSecretBackendStaticRoleWrite,
@@ -26,6 +33,7 @@ func databaseSecretBackendStaticRoleResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: vaultVersionCustomizeDiff(databaseSecretBackendStaticRoleVaultFeatures),
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -47,9 +55,10 @@ func databaseSecretBackendStaticRoleResource() *schema.Resource {
 				Description: "The database username that this role corresponds to.",
 			},
 			"rotation_period": {
-				Type:        schema.TypeInt,
-				Required:    true,
-				Description: "The amount of time Vault should wait before rotating the password, in seconds.",
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"rotation_schedule"},
+				Description:   "The amount of time Vault should wait before rotating the password, in seconds.",
 				ValidateFunc: func(v interface{}, k string) (ws []string, errs []error) {
 					value := v.(int)
 					if value < 5 {
@@ -70,6 +79,30 @@ func databaseSecretBackendStaticRoleResource() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 				Description: "Database statements to execute to rotate the password for the configured database user.",
 			},
+			"rotation_schedule": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"rotation_period"},
+				Description:   "A cron-style string that will define the schedule on which rotations should occur. Requires Vault 1.15+.",
+			},
+			"rotation_window": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The amount of time, in seconds, in which rotations are allowed to occur starting from a given rotation_schedule. Requires Vault 1.15+.",
+				ValidateFunc: validation.IntAtLeast(3600),
+			},
+			"rotate_immediately_on_create": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: "Whether Vault should rotate the password of the database user when the role is created. Setting this to false requires Vault 1.18+.",
+			},
+			"rotate": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Arbitrary number that, when increased, forces Vault to rotate the password of the database user.",
+			},
 		},
 	}
 }
@@ -84,7 +117,6 @@ func databaseSecretBackendStaticRoleWrite(d *schema.ResourceData, meta interface
 
 	data := map[string]interface{}{
 		"username":            d.Get("username"),
-		"rotation_period":     d.Get("rotation_period"),
 		"db_name":             d.Get("db_name"),
 		"rotation_statements": []string{},
 	}
@@ -93,6 +125,28 @@ func databaseSecretBackendStaticRoleWrite(d *schema.ResourceData, meta interface
 		data["rotation_statements"] = v
 	}
 
+	rotationPeriod, hasPeriod := d.GetOk("rotation_period")
+	rotationSchedule, hasSchedule := d.GetOk("rotation_schedule")
+	if !hasPeriod && !hasSchedule {
+		return fmt.Errorf("one of rotation_period or rotation_schedule must be set")
+	}
+	if hasPeriod {
+		data["rotation_period"] = rotationPeriod
+	}
+	if hasSchedule {
+		data["rotation_schedule"] = rotationSchedule
+	}
+	if v, ok := d.GetOk("rotation_window"); ok {
+		if !hasSchedule {
+			return fmt.Errorf("rotation_window can only be set together with rotation_schedule")
+		}
+		data["rotation_window"] = v
+	}
+
+	if d.IsNewResource() && !d.Get("rotate_immediately_on_create").(bool) {
+		data["skip_import_rotation"] = true
+	}
+
 	log.Printf("[DEBUG] Creating static role %q on database backend %q", name, backend)
 	_, err := client.Logical().Write(path, data)
 	if err != nil {
@@ -100,6 +154,15 @@ func databaseSecretBackendStaticRoleWrite(d *schema.ResourceData, meta interface
 	}
 	log.Printf("[DEBUG] Created static role %q on AWS backend %q", name, backend)
 
+	if !d.IsNewResource() && d.HasChange("rotate") {
+		rotatePath := databaseSecretBackendStaticRoleRotatePath(backend, name)
+		log.Printf("[DEBUG] Rotating credentials of static role %q", path)
+		if _, err := client.Logical().Write(rotatePath, nil); err != nil {
+			return fmt.Errorf("error rotating credentials of static role %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Rotated credentials of static role %q", path)
+	}
+
 	d.SetId(path)
 	return databaseSecretBackendStaticRoleRead(d, meta)
 }
@@ -148,6 +211,23 @@ func databaseSecretBackendStaticRoleRead(d *schema.ResourceData, meta interface{
 		d.Set("rotation_period", n)
 	}
 
+	// rotate_immediately_on_create is not returned by Vault, default it for imported roles
+	if _, ok := d.GetOkExists("rotate_immediately_on_create"); !ok {
+		d.Set("rotate_immediately_on_create", true)
+	}
+
+	if v, ok := role.Data["rotation_schedule"]; ok {
+		d.Set("rotation_schedule", v)
+	}
+
+	if v, ok := role.Data["rotation_window"]; ok {
+		n, err := v.(json.Number).Int64()
+		if err != nil {
+			return fmt.Errorf("unexpected value %q for rotation_window of %q", v, path)
+		}
+		d.Set("rotation_window", n)
+	}
+
 	var rotation []string
 	if rotationStr, ok := role.Data["rotation_statements"].(string); ok {
 		rotation = append(rotation, rotationStr)
@@ -194,6 +274,10 @@ func databaseSecretBackendStaticRolePath(backend, name string) string {
 	return strings.Trim(backend, "/") + "/static-roles/" + strings.Trim(name, "/")
 }
 
+func databaseSecretBackendStaticRoleRotatePath(backend, name string) string {
+	return strings.Trim(backend, "/") + "/rotate-role/" + strings.Trim(name, "/")
+}
+
 func databaseSecretBackendStaticRoleNameFromPath(path string) (string, error) {
 	if !databaseSecretBackendStaticRoleNameFromPathRegex.MatchString(path) {
 		return "", fmt.Errorf("no name found")
@@ -96,6 +96,49 @@ func TestAccDatabaseSecretBackendStaticRole_basic(t *testing.T) {
 	})
 }
 
+func TestAccDatabaseSecretBackendStaticRole_rotationSchedule(t *testing.T) {
+	connURL := os.Getenv("MYSQL_URL")
+	if connURL == "" {
+		t.Skip("MYSQL_URL not set")
+	}
+	backend := acctest.RandomWithPrefix("tf-test-db")
+	username := acctest.RandomWithPrefix("user")
+	dbName := acctest.RandomWithPrefix("db")
+	name := acctest.RandomWithPrefix("staticrole")
+
+	if err := createTestUser(connURL, username); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccDatabaseSecretBackendStaticRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseSecretBackendStaticRoleConfig_rotationSchedule(name, username, dbName, backend, connURL, 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_database_secret_backend_static_role.test", "rotation_schedule", "0 0 * * SAT"),
+					resource.TestCheckResourceAttr("vault_database_secret_backend_static_role.test", "rotation_window", "7200"),
+					resource.TestCheckResourceAttr("vault_database_secret_backend_static_role.test", "rotation_period", "0"),
+				),
+			},
+			{
+				Config: testAccDatabaseSecretBackendStaticRoleConfig_rotationSchedule(name, username, dbName, backend, connURL, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_database_secret_backend_static_role.test", "rotate", "1"),
+				),
+			},
+			{
+				ResourceName:            "vault_database_secret_backend_static_role.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rotate"},
+			},
+		},
+	})
+}
+
 func testAccDatabaseSecretBackendStaticRoleCheckDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -195,3 +238,32 @@ resource "vault_database_secret_backend_static_role" "test" {
 }
 `, path, db, connURL, name, username)
 }
+
+func testAccDatabaseSecretBackendStaticRoleConfig_rotationSchedule(name, username, db, path, connURL string, rotate int) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "db" {
+  path = "%s"
+  type = "database"
+}
+
+resource "vault_database_secret_backend_connection" "test" {
+  backend = "${vault_mount.db.path}"
+  name = "%s"
+  allowed_roles = ["*"]
+
+  mysql {
+	  connection_url = "%s"
+  }
+}
+
+resource "vault_database_secret_backend_static_role" "test" {
+  backend = "${vault_mount.db.path}"
+  db_name = "${vault_database_secret_backend_connection.test.name}"
+  name = "%s"
+  username = "%s"
+  rotation_schedule = "0 0 * * SAT"
+  rotation_window = 7200
+  rotate = %d
+}
+`, path, db, connURL, name, username, rotate)
+}
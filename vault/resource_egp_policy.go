@@ -13,7 +13,7 @@ func egpPolicyResource() *schema.Resource {
 		Delete: egpPolicyDelete,
 		Read:   egpPolicyRead,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: sentinelPolicyImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -24,6 +24,8 @@ func egpPolicyResource() *schema.Resource {
 				Description: "Name of the policy",
 			},
 
+			"namespace": sentinelPolicyNamespaceSchema(),
+
 			"enforcement_level": {
 				Type:         schema.TypeString,
 				Required:     true,
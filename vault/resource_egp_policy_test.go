@@ -44,6 +44,38 @@ func TestAccEndpointGoverningPolicy(t *testing.T) {
 	})
 }
 
+func TestAccEndpointGoverningPolicy_namespace(t *testing.T) {
+	isEnterprise := os.Getenv("TF_ACC_ENTERPRISE")
+	if isEnterprise == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+
+	namespace := acctest.RandomWithPrefix("test-namespace")
+	policyName := acctest.RandomWithPrefix("test-policy")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccEndpointGoverningPolicyCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEndpointGoverningPolicy_namespace(namespace, policyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_egp_policy.test", "name", policyName),
+					resource.TestCheckResourceAttr("vault_egp_policy.test", "namespace", namespace),
+					resource.TestCheckResourceAttr("vault_egp_policy.test", "paths.0", "test/*"),
+					resource.TestCheckResourceAttr("vault_egp_policy.test", "enforcement_level", "advisory"),
+				),
+			},
+			{
+				ResourceName:      "vault_egp_policy.test",
+				ImportState:       true,
+				ImportStateId:     namespace + "/" + policyName,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccEndpointGoverningPolicyCheckDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 	for _, rs := range s.RootModule().Resources {
@@ -75,3 +107,22 @@ main = rule {
 EOT
 }`, policyName, path, enforcementLevel)
 }
+
+func testAccEndpointGoverningPolicy_namespace(namespace, policyName string) string {
+	return fmt.Sprintf(`
+resource "vault_namespace" "test" {
+  path = "%s"
+}
+
+resource "vault_egp_policy" "test" {
+  namespace = vault_namespace.test.path
+  name = "%s"
+  paths = ["test/*"]
+  enforcement_level = "advisory"
+  policy = <<EOT
+main = rule {
+  2+2 > 3
+}
+EOT
+}`, namespace, policyName)
+}
@@ -66,6 +66,7 @@ func gcpAuthBackendResource() *schema.Resource {
 				StateFunc: func(v interface{}) string {
 					return strings.Trim(v.(string), "/")
 				},
+				ValidateFunc: validateMountPath,
 			},
 			"local": {
 				Type:        schema.TypeBool,
@@ -114,6 +114,7 @@ func gcpAuthBackendRoleResource() *schema.Resource {
 			Computed:      true,
 			ConflictsWith: []string{"token_ttl"},
 			Deprecated:    "use `token_ttl` instead if you are running Vault >= 1.2",
+			ValidateFunc:  validateVaultDuration,
 		},
 		"max_ttl": {
 			Type:          schema.TypeString,
@@ -121,6 +122,7 @@ func gcpAuthBackendRoleResource() *schema.Resource {
 			Computed:      true,
 			Deprecated:    "use `token_max_ttl` instead if you are running Vault >= 1.2",
 			ConflictsWith: []string{"token_max_ttl"},
+			ValidateFunc:  validateVaultDuration,
 		},
 		"period": {
 			Type:          schema.TypeString,
@@ -128,6 +130,7 @@ func gcpAuthBackendRoleResource() *schema.Resource {
 			Computed:      true,
 			Deprecated:    "use `token_period` instead if you are running Vault >= 1.2",
 			ConflictsWith: []string{"token_period"},
+			ValidateFunc:  validateVaultDuration,
 		},
 		"policies": {
 			Type: schema.TypeSet,
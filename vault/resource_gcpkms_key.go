@@ -0,0 +1,365 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	gcpkmsBackendFromKeyPathRegex = regexp.MustCompile("^(.+)/keys/.+$")
+	gcpkmsKeyNameFromPathRegex    = regexp.MustCompile("^.+/keys/(.+$)")
+)
+
+func gcpkmsKeyResource() *schema.Resource {
+	return &schema.Resource{
+		Create: gcpkmsKeyCreate,
+		Read:   gcpkmsKeyRead,
+		Update: gcpkmsKeyUpdate,
+		Delete: gcpkmsKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the GCP KMS secret backend the key belongs to.",
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the key in Vault.",
+			},
+			"register": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Register an existing GCP KMS crypto key with Vault instead of creating a new one. The crypto key is only deregistered, not destroyed, when the resource is deleted.",
+			},
+			"verify": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: "Verify that Vault can use the crypto key when registering it. Only used when register is true.",
+			},
+			"key_ring": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Full resource ID of the GCP KMS key ring to create the crypto key in, e.g. projects/my-project/locations/global/keyRings/my-keyring.",
+			},
+			"crypto_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Name of the GCP KMS crypto key to create, which defaults to the name of the key, or the full resource ID of the crypto key to register.",
+			},
+			"purpose": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "Purpose of the crypto key. Valid values are encrypt_decrypt, asymmetric_sign and asymmetric_decrypt.",
+				ValidateFunc: validation.StringInSlice([]string{"encrypt_decrypt", "asymmetric_sign", "asymmetric_decrypt"}, false),
+			},
+			"algorithm": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Algorithm of the crypto key, e.g. symmetric_encryption or rsa_sign_pss_2048_sha256.",
+			},
+			"protection_level": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "Protection level of the crypto key. Valid values are software and hsm.",
+				ValidateFunc: validation.StringInSlice([]string{"software", "hsm"}, false),
+			},
+			"rotation_period": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Period in seconds after which GCP automatically rotates the crypto key. Only applies to symmetric keys.",
+			},
+			"labels": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Labels to apply to the crypto key.",
+			},
+			"min_version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Minimum allowed crypto key version for cryptographic operations.",
+			},
+			"max_version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum allowed crypto key version for cryptographic operations.",
+			},
+			"crypto_key_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Full resource ID of the GCP KMS crypto key.",
+			},
+			"primary_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The primary version of the crypto key.",
+			},
+		},
+	}
+}
+
+func gcpkmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+	path := gcpkmsKeyPath(backend, name)
+
+	if d.Get("register").(bool) {
+		cryptoKey, ok := d.GetOk("crypto_key")
+		if !ok {
+			return fmt.Errorf("crypto_key must be set when registering GCP KMS key %q", name)
+		}
+		registerPath := strings.Trim(backend, "/") + "/keys/register/" + name
+		data := map[string]interface{}{
+			"crypto_key": cryptoKey,
+			"verify":     d.Get("verify"),
+		}
+
+		log.Printf("[DEBUG] Writing %q", registerPath)
+		if _, err := client.Logical().Write(registerPath, data); err != nil {
+			return fmt.Errorf("error writing %q: %s", registerPath, err)
+		}
+		log.Printf("[DEBUG] Wrote %q", registerPath)
+	} else {
+		if _, ok := d.GetOk("key_ring"); !ok {
+			return fmt.Errorf("key_ring must be set when creating GCP KMS key %q", name)
+		}
+		data := gcpkmsKeyData(d)
+		for _, k := range []string{"crypto_key", "purpose", "algorithm", "protection_level"} {
+			if v, ok := d.GetOk(k); ok {
+				data[k] = v
+			}
+		}
+
+		log.Printf("[DEBUG] Writing %q", path)
+		if _, err := client.Logical().Write(path, data); err != nil {
+			return fmt.Errorf("error writing %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Wrote %q", path)
+	}
+	d.SetId(path)
+
+	if err := gcpkmsKeyWriteConfig(d, client); err != nil {
+		return err
+	}
+
+	return gcpkmsKeyRead(d, meta)
+}
+
+func gcpkmsKeyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	backend, err := gcpkmsBackendFromKeyPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid key ID %q: %s", path, err)
+	}
+	name, err := gcpkmsKeyNameFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid key ID %q: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read %q", path)
+	if resp == nil {
+		log.Printf("[WARN] %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("name", name)
+	for _, k := range []string{"purpose", "algorithm", "protection_level", "labels", "min_version", "max_version", "primary_version"} {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting state key %q: %s", k, err)
+			}
+		}
+	}
+
+	// the API returns the full resource ID of the crypto key, from which the
+	// key ring can be derived, and the rotation period as a duration string
+	if v, ok := resp.Data["id"].(string); ok {
+		d.Set("crypto_key_id", v)
+		if i := strings.Index(v, "/cryptoKeys/"); i != -1 {
+			d.Set("key_ring", v[:i])
+			if !d.Get("register").(bool) {
+				d.Set("crypto_key", v[i+len("/cryptoKeys/"):])
+			} else {
+				d.Set("crypto_key", v)
+			}
+		}
+	}
+	if v, ok := resp.Data["rotation_period"].(string); ok && v != "" {
+		rotationPeriod, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("error parsing rotation_period %q: %s", v, err)
+		}
+		d.Set("rotation_period", int(rotationPeriod.Seconds()))
+	}
+
+	return nil
+}
+
+func gcpkmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	if d.HasChange("rotation_period") || d.HasChange("labels") {
+		data := gcpkmsKeyData(d)
+
+		log.Printf("[DEBUG] Updating %q", path)
+		if _, err := client.Logical().Write(path, data); err != nil {
+			return fmt.Errorf("error updating %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Updated %q", path)
+	}
+
+	if d.HasChange("min_version") || d.HasChange("max_version") {
+		if err := gcpkmsKeyWriteConfig(d, client); err != nil {
+			return err
+		}
+	}
+
+	return gcpkmsKeyRead(d, meta)
+}
+
+func gcpkmsKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	// registered crypto keys are managed outside of Vault, so only
+	// deregister them instead of destroying their versions
+	if d.Get("register").(bool) {
+		backend, err := gcpkmsBackendFromKeyPath(path)
+		if err != nil {
+			return fmt.Errorf("invalid key ID %q: %s", path, err)
+		}
+		name, err := gcpkmsKeyNameFromPath(path)
+		if err != nil {
+			return fmt.Errorf("invalid key ID %q: %s", path, err)
+		}
+		deregisterPath := strings.Trim(backend, "/") + "/keys/deregister/" + name
+
+		log.Printf("[DEBUG] Writing %q", deregisterPath)
+		if _, err := client.Logical().Write(deregisterPath, nil); err != nil && !util.Is404(err) {
+			return fmt.Errorf("error writing %q: %s", deregisterPath, err)
+		}
+		log.Printf("[DEBUG] Wrote %q", deregisterPath)
+		return nil
+	}
+
+	log.Printf("[DEBUG] Deleting %q", path)
+	if _, err := client.Logical().Delete(path); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error deleting %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted %q", path)
+
+	return nil
+}
+
+// gcpkmsKeyData returns the fields that can be written when
+// creating or updating a key.
+func gcpkmsKeyData(d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"key_ring": d.Get("key_ring"),
+	}
+	if v, ok := d.GetOk("rotation_period"); ok {
+		data["rotation_period"] = fmt.Sprintf("%ds", v)
+	}
+	if v, ok := d.GetOk("labels"); ok || d.HasChange("labels") {
+		data["labels"] = v
+	}
+	return data
+}
+
+func gcpkmsKeyWriteConfig(d *schema.ResourceData, client *api.Client) error {
+	data := map[string]interface{}{}
+	for _, k := range []string{"min_version", "max_version"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+	if len(data) == 0 {
+		return nil
+	}
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+	configPath := strings.Trim(backend, "/") + "/keys/config/" + name
+
+	log.Printf("[DEBUG] Writing %q", configPath)
+	if _, err := client.Logical().Write(configPath, data); err != nil {
+		return fmt.Errorf("error writing %q: %s", configPath, err)
+	}
+	log.Printf("[DEBUG] Wrote %q", configPath)
+
+	return nil
+}
+
+func gcpkmsKeyPath(backend, name string) string {
+	return strings.Trim(backend, "/") + "/keys/" + strings.Trim(name, "/")
+}
+
+func gcpkmsBackendFromKeyPath(path string) (string, error) {
+	if !gcpkmsBackendFromKeyPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := gcpkmsBackendFromKeyPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
+
+func gcpkmsKeyNameFromPath(path string) (string, error) {
+	if !gcpkmsKeyNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no name found")
+	}
+	res := gcpkmsKeyNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for name", len(res))
+	}
+	return res[1], nil
+}
@@ -0,0 +1,94 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccGCPKMSKey_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-gcpkms")
+	name := acctest.RandomWithPrefix("tf-test-key")
+	credentials, keyRing := getTestGCPKMSKeyRing(t)
+	resourceName := "vault_gcpkms_key.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccGCPKMSKeyCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testGCPKMSKeyConfig(backend, credentials, keyRing, name, 86400, "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "key_ring", keyRing),
+					resource.TestCheckResourceAttr(resourceName, "crypto_key", name),
+					resource.TestCheckResourceAttr(resourceName, "purpose", "encrypt_decrypt"),
+					resource.TestCheckResourceAttr(resourceName, "protection_level", "software"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_period", "86400"),
+					resource.TestCheckResourceAttr(resourceName, "labels.env", "foo"),
+					resource.TestCheckResourceAttr(resourceName, "crypto_key_id", keyRing+"/cryptoKeys/"+name),
+					resource.TestCheckResourceAttrSet(resourceName, "primary_version"),
+				),
+			},
+			{
+				Config: testGCPKMSKeyConfig(backend, credentials, keyRing, name, 172800, "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rotation_period", "172800"),
+					resource.TestCheckResourceAttr(resourceName, "labels.env", "bar"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"register", "verify"},
+			},
+		},
+	})
+}
+
+func testAccGCPKMSKeyCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_gcpkms_key" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("key %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testGCPKMSKeyConfig(backend, credentials, keyRing, name string, rotationPeriod int, label string) string {
+	return fmt.Sprintf(`
+resource "vault_gcpkms_secret_backend" "test" {
+  backend     = "%s"
+  credentials = <<EOT
+%s
+EOT
+}
+
+resource "vault_gcpkms_key" "test" {
+  backend         = vault_gcpkms_secret_backend.test.backend
+  name            = "%s"
+  key_ring        = "%s"
+  rotation_period = %d
+
+  labels = {
+    env = "%s"
+  }
+}
+`, backend, credentials, name, keyRing, rotationPeriod, label)
+}
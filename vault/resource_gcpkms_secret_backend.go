@@ -0,0 +1,223 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+func gcpkmsSecretBackendResource() *schema.Resource {
+	fields := map[string]*schema.Schema{
+		"backend": {
+			Type:         schema.TypeString,
+			Default:      "gcpkms",
+			ForceNew:     true,
+			Optional:     true,
+			Description:  "The mount path for the GCP KMS backend.",
+			ValidateFunc: validateMountPath,
+			StateFunc: func(v interface{}) string {
+				return strings.Trim(v.(string), "/")
+			},
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Human-friendly description of the mount for the backend.",
+		},
+		"default_lease_ttl_seconds": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Default lease duration for secrets in seconds.",
+		},
+		"max_lease_ttl_seconds": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Maximum possible lease duration for secrets in seconds.",
+		},
+		"local": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "Mark the secrets engine as local-only. Local engines are not replicated or removed by replication.",
+		},
+		"credentials": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			Description:  "JSON-encoded credentials of a GCP service account with permissions to manage KMS keys. Defaults to Application Default Credentials.",
+			StateFunc:    NormalizeDataJSON,
+			ValidateFunc: ValidateDataJSON,
+		},
+		"scopes": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The OAuth scopes to request when authenticating to GCP.",
+		},
+	}
+	return &schema.Resource{
+		Create: gcpkmsSecretBackendCreate,
+		Update: gcpkmsSecretBackendUpdate,
+		Read:   gcpkmsSecretBackendRead,
+		Delete: gcpkmsSecretBackendDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: fields,
+	}
+}
+
+func gcpkmsSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	backend := d.Get("backend").(string)
+	description := d.Get("description").(string)
+	defaultTTL := d.Get("default_lease_ttl_seconds").(int)
+	local := d.Get("local").(bool)
+	maxTTL := d.Get("max_lease_ttl_seconds").(int)
+
+	log.Printf("[DEBUG] Mounting GCP KMS backend at %q", backend)
+	err := client.Sys().Mount(backend, &api.MountInput{
+		Type:        "gcpkms",
+		Description: description,
+		Local:       local,
+		Config: api.MountConfigInput{
+			DefaultLeaseTTL: fmt.Sprintf("%ds", defaultTTL),
+			MaxLeaseTTL:     fmt.Sprintf("%ds", maxTTL),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error mounting to %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Mounted GCP KMS backend at %q", backend)
+	d.SetId(backend)
+
+	data := map[string]interface{}{}
+	if v, ok := d.GetOk("credentials"); ok {
+		data["credentials"] = v
+	}
+	if v, ok := d.GetOk("scopes"); ok {
+		data["scopes"] = v.(*schema.Set).List()
+	}
+
+	configPath := gcpkmsSecretBackendConfigPath(backend)
+	log.Printf("[DEBUG] Writing %q", configPath)
+	if _, err := client.Logical().Write(configPath, data); err != nil {
+		return fmt.Errorf("error writing %q: %s", configPath, err)
+	}
+	log.Printf("[DEBUG] Wrote %q", configPath)
+
+	return gcpkmsSecretBackendRead(d, meta)
+}
+
+func gcpkmsSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Reading %q", path)
+
+	mountResp, err := client.Sys().MountConfig(path)
+	if err != nil && util.Is404(err) {
+		log.Printf("[WARN] %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("error reading %q: %s", path, err)
+	}
+
+	d.Set("backend", path)
+	d.Set("default_lease_ttl_seconds", mountResp.DefaultLeaseTTL)
+	d.Set("max_lease_ttl_seconds", mountResp.MaxLeaseTTL)
+
+	configPath := gcpkmsSecretBackendConfigPath(path)
+	log.Printf("[DEBUG] Reading %q", configPath)
+	resp, err := client.Logical().Read(configPath)
+	if err != nil {
+		return fmt.Errorf("error reading %q: %s", configPath, err)
+	}
+	log.Printf("[DEBUG] Read %q", configPath)
+	if resp == nil {
+		log.Printf("[WARN] %q not found, removing from state", configPath)
+		d.SetId("")
+		return nil
+	}
+
+	// credentials are never returned by the API
+	if v, ok := resp.Data["scopes"]; ok {
+		if err := d.Set("scopes", v); err != nil {
+			return fmt.Errorf("error setting state key %q: %s", "scopes", err)
+		}
+	}
+
+	return nil
+}
+
+func gcpkmsSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	backend := d.Id()
+
+	if d.HasChange("default_lease_ttl_seconds") || d.HasChange("max_lease_ttl_seconds") {
+		tune := api.MountConfigInput{
+			DefaultLeaseTTL: fmt.Sprintf("%ds", d.Get("default_lease_ttl_seconds")),
+			MaxLeaseTTL:     fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds")),
+		}
+
+		log.Printf("[DEBUG] Updating mount lease TTLs for %q", backend)
+		if err := client.Sys().TuneMount(backend, tune); err != nil {
+			return fmt.Errorf("error updating mount TTLs for %q: %s", backend, err)
+		}
+		log.Printf("[DEBUG] Updated lease TTLs for %q", backend)
+	}
+
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		log.Printf("[DEBUG] Updating description for %q", backend)
+		if err := client.Sys().TuneMount(backend, api.MountConfigInput{Description: &description}); err != nil {
+			return fmt.Errorf("error updating description for %q: %s", backend, err)
+		}
+		log.Printf("[DEBUG] Updated description for %q", backend)
+	}
+
+	if d.HasChange("credentials") || d.HasChange("scopes") {
+		data := map[string]interface{}{
+			"credentials": d.Get("credentials"),
+			"scopes":      d.Get("scopes").(*schema.Set).List(),
+		}
+
+		configPath := gcpkmsSecretBackendConfigPath(backend)
+		log.Printf("[DEBUG] Updating %q", configPath)
+		if _, err := client.Logical().Write(configPath, data); err != nil {
+			return fmt.Errorf("error updating %q: %s", configPath, err)
+		}
+		log.Printf("[DEBUG] Updated %q", configPath)
+	}
+
+	return gcpkmsSecretBackendRead(d, meta)
+}
+
+func gcpkmsSecretBackendDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	backend := d.Id()
+
+	log.Printf("[DEBUG] Unmounting GCP KMS backend %q", backend)
+	if err := client.Sys().Unmount(backend); err != nil {
+		if util.Is404(err) {
+			log.Printf("[WARN] %q not found, removing from state", backend)
+			return nil
+		}
+		return fmt.Errorf("error unmounting GCP KMS backend from %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Unmounted GCP KMS backend %q", backend)
+
+	return nil
+}
+
+func gcpkmsSecretBackendConfigPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config"
+}
@@ -0,0 +1,84 @@
+package vault
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccGCPKMSSecretBackend_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-gcpkms")
+	resourceName := "vault_gcpkms_secret_backend.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccGCPKMSSecretBackendCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testGCPKMSSecretBackendConfig(backend, "https://www.googleapis.com/auth/cloudkms"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "description", "test description"),
+					resource.TestCheckResourceAttr(resourceName, "scopes.#", "1"),
+				),
+			},
+			{
+				Config: testGCPKMSSecretBackendConfig(backend, "https://www.googleapis.com/auth/cloud-platform"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "scopes.#", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"credentials", "description", "local"},
+			},
+		},
+	})
+}
+
+func testAccGCPKMSSecretBackendCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_gcpkms_secret_backend" {
+			continue
+		}
+		for backend, mount := range mounts {
+			backend = strings.Trim(backend, "/")
+			rsBackend := strings.Trim(rs.Primary.Attributes["backend"], "/")
+			if mount.Type == "gcpkms" && backend == rsBackend {
+				return fmt.Errorf("mount %q still exists", backend)
+			}
+		}
+	}
+	return nil
+}
+
+func testGCPKMSSecretBackendConfig(backend, scope string) string {
+	return fmt.Sprintf(`
+resource "vault_gcpkms_secret_backend" "test" {
+  backend     = "%s"
+  description = "test description"
+  credentials = <<EOT
+{
+  "type": "service_account"
+}
+EOT
+  scopes      = ["%s"]
+}
+`, backend, scope)
+}
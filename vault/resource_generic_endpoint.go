@@ -64,6 +64,17 @@ func genericEndpointResource() *schema.Resource {
 				Default:     false,
 				Description: "When reading, disregard fields not present in data_json",
 			},
+			"ignore_fields": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Top-level fields to disregard when reading, treating the value written as up to date",
+			},
+			"read_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path to read from, if it differs from the path written to",
+			},
 			"write_data_json": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -174,28 +185,39 @@ func genericEndpointResourceRead(d *schema.ResourceData, meta interface{}) error
 	if shouldRead {
 		client := meta.(*api.Client)
 
-		log.Printf("[DEBUG] Reading %s from Vault", path)
-		data, err := client.Logical().Read(path)
+		readPath := path
+		if v, ok := d.GetOk("read_path"); ok {
+			readPath = v.(string)
+		}
+
+		log.Printf("[DEBUG] Reading %s from Vault", readPath)
+		data, err := client.Logical().Read(readPath)
 
 		if err != nil {
-			return fmt.Errorf("error reading %s from Vault: %s", path, err)
+			return fmt.Errorf("error reading %s from Vault: %s", readPath, err)
 		}
 		if data == nil {
-			log.Printf("[WARN] endpoint (%s) not found, removing from state", path)
+			log.Printf("[WARN] endpoint (%s) not found, removing from state", readPath)
 			d.SetId("")
 			return nil
 		}
 
-		log.Printf("[DEBUG] data from %q: %#v", path, data)
+		log.Printf("[DEBUG] data from %q: %#v", readPath, data)
+
+		var suppliedData map[string]interface{}
+		if v := d.Get("data_json").(string); v != "" {
+			err = json.Unmarshal([]byte(v), &suppliedData)
+			if err != nil {
+				return fmt.Errorf("data_json %#v syntax error: %s", v, err)
+			}
+		}
 
 		var relevantData map[string]interface{}
 		if ignore_absent_fields {
-			var suppliedData map[string]interface{}
-			err = json.Unmarshal([]byte(d.Get("data_json").(string)), &suppliedData)
-			if err != nil {
-				return fmt.Errorf("data_json %#v syntax error: %s", d.Get("data_json"), err)
+			relevantData = map[string]interface{}{}
+			for k, v := range suppliedData {
+				relevantData[k] = v
 			}
-			relevantData = suppliedData
 			for k, v := range data.Data {
 				if _, ok := suppliedData[k]; ok {
 					relevantData[k] = v
@@ -203,6 +225,20 @@ func genericEndpointResourceRead(d *schema.ResourceData, meta interface{}) error
 			}
 		} else {
 			relevantData = data.Data
+			if relevantData == nil {
+				relevantData = map[string]interface{}{}
+			}
+		}
+
+		// Ignored fields keep the value that was written, as the value read
+		// back frequently differs in shape or is never returned at all.
+		for _, iField := range d.Get("ignore_fields").([]interface{}) {
+			field := iField.(string)
+			if v, ok := suppliedData[field]; ok {
+				relevantData[field] = v
+			} else {
+				delete(relevantData, field)
+			}
 		}
 
 		jsonData, err := json.Marshal(relevantData)
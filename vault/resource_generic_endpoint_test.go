@@ -118,6 +118,37 @@ resource "vault_generic_endpoint" "u1_entity" {
 }
 EOT
 }
+
+# Policies are written as a comma separated string but read back as a
+# list, so they are ignored when reading.
+resource "vault_generic_endpoint" "u2" {
+  depends_on           = ["vault_auth_backend.userpass"]
+  path                 = "auth/${var.up_path}/users/u2"
+  ignore_absent_fields = true
+  ignore_fields        = ["token_policies"]
+
+  data_json = <<EOT
+{
+  "token_policies": "p1,p2",
+  "password": "something"
+}
+EOT
+}
+
+# The password endpoint is write-only, so the user is read instead.
+resource "vault_generic_endpoint" "u2_password" {
+  depends_on           = ["vault_generic_endpoint.u2"]
+  path                 = "auth/${var.up_path}/users/u2/password"
+  read_path            = "auth/${var.up_path}/users/u2"
+  ignore_absent_fields = true
+  disable_delete       = true
+
+  data_json = <<EOT
+{
+  "password": "something-else"
+}
+EOT
+}
 `, path)
 }
 
@@ -166,6 +197,35 @@ func testResourceGenericEndpoint_initialCheck(s *terraform.State) error {
 		return fmt.Errorf("write_data.%% has value %q, not 0", write_data_count)
 	}
 
+	resourceState = s.Modules[0].Resources["vault_generic_endpoint.u2"]
+	if resourceState == nil {
+		return fmt.Errorf("resource vault_generic_endpoint.u2 not found in state")
+	}
+
+	instanceState = resourceState.Primary
+	if instanceState == nil {
+		return fmt.Errorf("resource vault_generic_endpoint.u2 has no primary instance")
+	}
+
+	data_json := instanceState.Attributes["data_json"]
+	if data_json != `{"password":"something","token_policies":"p1,p2"}` {
+		return fmt.Errorf("data_json has unexpected value %q", data_json)
+	}
+
+	resourceState = s.Modules[0].Resources["vault_generic_endpoint.u2_password"]
+	if resourceState == nil {
+		return fmt.Errorf("resource vault_generic_endpoint.u2_password not found in state")
+	}
+
+	instanceState = resourceState.Primary
+	if instanceState == nil {
+		return fmt.Errorf("resource vault_generic_endpoint.u2_password has no primary instance")
+	}
+
+	if instanceState.ID != instanceState.Attributes["path"] {
+		return fmt.Errorf("id doesn't match path")
+	}
+
 	return nil
 }
 
@@ -181,7 +241,11 @@ func testResourceGenericEndpoint_destroyCheck(path string) resource.TestCheckFun
 			// Check to make sure resources that we can read are no longer
 			// there.
 			if instanceState.Attributes["disable_read"] != "true" {
-				data, err := client.Logical().Read(rs.Primary.ID)
+				readPath := rs.Primary.ID
+				if v := instanceState.Attributes["read_path"]; v != "" {
+					readPath = v
+				}
+				data, err := client.Logical().Read(readPath)
 				if err != nil {
 					return fmt.Errorf("error checking for vault generic endpoint %q: %s", rs.Primary.ID, err)
 				}
@@ -32,6 +32,13 @@ func genericSecretResource() *schema.Resource {
 				Description: "Full path where the generic secret will be written.",
 			},
 
+			"api_path": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Treat a KV v2 path whose first segment after the mount is data/ as the API path of the secret, rather than as part of its name.",
+			},
+
 			// Data is passed as JSON so that an arbitrary structure is
 			// possible, rather than forcing e.g. all values to be strings.
 			"data_json": {
@@ -61,6 +68,19 @@ func genericSecretResource() *schema.Resource {
 				Description: "Don't attempt to read the token from Vault if true; drift won't be detected.",
 			},
 
+			"detect_drift": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Compare the version of KV v2 secrets with the one written by Terraform during refresh, to detect changes made outside Terraform when disable_read is true.",
+			},
+
+			"secret_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Version of the KV v2 secret written by Terraform, 0 for other secrets.",
+			},
+
 			"data": {
 				Type:        schema.TypeMap,
 				Computed:    true,
@@ -119,7 +139,7 @@ func genericSecretResourceWrite(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if v2 {
-		path = addPrefixToVKVPath(path, mountPath, "data")
+		path = kvV2DataPath(path, mountPath, d.Get("api_path").(bool))
 		data = map[string]interface{}{
 			"data":    data,
 			"options": map[string]interface{}{},
@@ -128,13 +148,24 @@ func genericSecretResourceWrite(d *schema.ResourceData, meta interface{}) error
 	}
 
 	log.Printf("[DEBUG] Writing generic Vault secret to %s", path)
-	_, err = client.Logical().Write(path, data)
+	resp, err := client.Logical().Write(path, data)
 	if err != nil {
 		return fmt.Errorf("error writing to Vault: %s", err)
 	}
 
 	d.SetId(originalPath)
 
+	var secretVersion int64
+	if v2 && resp != nil {
+		if v, ok := resp.Data["version"].(json.Number); ok {
+			secretVersion, err = v.Int64()
+			if err != nil {
+				return fmt.Errorf("unexpected version %q returned for %q: %s", v, path, err)
+			}
+		}
+	}
+	d.Set("secret_version", secretVersion)
+
 	return genericSecretResourceRead(d, meta)
 }
 
@@ -149,7 +180,7 @@ func genericSecretResourceDelete(d *schema.ResourceData, meta interface{}) error
 	}
 
 	if v2 {
-		path = addPrefixToVKVPath(path, mountPath, "data")
+		path = kvV2DataPath(path, mountPath, d.Get("api_path").(bool))
 	}
 
 	log.Printf("[DEBUG] Deleting vault_generic_secret from %q", path)
@@ -176,7 +207,7 @@ func genericSecretResourceRead(d *schema.ResourceData, meta interface{}) error {
 		client := meta.(*api.Client)
 
 		log.Printf("[DEBUG] Reading %s from Vault", path)
-		secret, err := versionedSecret(latestSecretVersion, path, client)
+		secret, err := versionedSecret(latestSecretVersion, path, d.Get("api_path").(bool), client)
 
 		if err != nil {
 			return fmt.Errorf("error reading from Vault: %s", err)
@@ -198,12 +229,27 @@ func genericSecretResourceRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("data_json", string(jsonData))
 		d.Set("path", path)
 	} else {
+		if d.Get("detect_drift").(bool) {
+			found, err := genericSecretResourceDetectDrift(d, meta.(*api.Client))
+			if err != nil {
+				return err
+			}
+			if !found {
+				log.Printf("[WARN] secret (%s) not found, removing from state", path)
+				d.SetId("")
+				return nil
+			}
+		} else {
+			log.Printf("[WARN] vault_generic_secret does not refresh when disable_read is set to true")
+		}
+
 		// Populate data from data_json from state
-		err := json.Unmarshal([]byte(d.Get("data_json").(string)), &data)
-		if err != nil {
-			return fmt.Errorf("data_json %#v syntax error: %s", d.Get("data_json"), err)
+		if dataJSON := d.Get("data_json").(string); dataJSON != "" {
+			err := json.Unmarshal([]byte(dataJSON), &data)
+			if err != nil {
+				return fmt.Errorf("data_json %#v syntax error: %s", dataJSON, err)
+			}
 		}
-		log.Printf("[WARN] vault_generic_secret does not refresh when disable_read is set to true")
 	}
 	d.Set("disable_read", !shouldRead)
 
@@ -228,3 +274,51 @@ func genericSecretResourceRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("data", dataMap)
 	return nil
 }
+
+// genericSecretResourceDetectDrift compares the current version of a KV v2
+// secret with the one written by Terraform, without reading the secret data.
+// When the secret was changed outside Terraform data_json is cleared so that
+// the next plan rewrites it. It returns false if the secret doesn't exist.
+func genericSecretResourceDetectDrift(d *schema.ResourceData, client *api.Client) (bool, error) {
+	path := d.Id()
+
+	mountPath, v2, err := isKVv2(path, client)
+	if err != nil {
+		return false, fmt.Errorf("error determining if it's a v2 path: %s", err)
+	}
+	if !v2 {
+		log.Printf("[WARN] vault_generic_secret can only detect drift of KV v2 secrets when disable_read is set to true")
+		return true, nil
+	}
+
+	metadataPath := kvV2MetadataPath(path, mountPath, d.Get("api_path").(bool))
+	log.Printf("[DEBUG] Reading secret metadata from %q", metadataPath)
+	resp, err := client.Logical().Read(metadataPath)
+	if err != nil {
+		return false, fmt.Errorf("error reading secret metadata from %q: %s", metadataPath, err)
+	}
+	if resp == nil {
+		return false, nil
+	}
+
+	v, ok := resp.Data["current_version"].(json.Number)
+	if !ok {
+		return false, fmt.Errorf("no current_version found in secret metadata %q", metadataPath)
+	}
+	currentVersion, err := v.Int64()
+	if err != nil {
+		return false, fmt.Errorf("unexpected current_version %q in secret metadata %q: %s", v, metadataPath, err)
+	}
+
+	// States written before secret_version was tracked don't know the
+	// version they wrote, so start from the current one.
+	if d.Get("secret_version").(int) == 0 {
+		d.Set("secret_version", currentVersion)
+	} else if currentVersion != int64(d.Get("secret_version").(int)) {
+		log.Printf("[WARN] secret (%s) was changed outside Terraform, version %d found, expected %d",
+			path, currentVersion, d.Get("secret_version").(int))
+		d.Set("data_json", "")
+	}
+
+	return true, nil
+}
@@ -2,6 +2,8 @@ package vault
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
@@ -53,6 +55,111 @@ func TestResourceGenericSecret_deleted(t *testing.T) {
 	})
 }
 
+func TestGenericSecretResourceDetectDrift_seedVersion(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/sys/internal/ui/mounts/secret/foo", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data": {"path": "secret/", "options": {"version": "2"}}}`)
+	})
+	mux.HandleFunc("/v1/secret/metadata/foo", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data": {"current_version": 3}}`)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	config := api.DefaultConfig()
+	config.Address = server.URL
+	client, err := api.NewClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := genericSecretResource().TestResourceData()
+	d.SetId("secret/foo")
+	d.Set("data_json", `{"foo": "bar"}`)
+
+	// states written before secret_version was tracked have none
+	if _, err := genericSecretResourceDetectDrift(d, client); err != nil {
+		t.Fatal(err)
+	}
+	if v := d.Get("secret_version").(int); v != 3 {
+		t.Errorf("expected secret_version 3, got %d", v)
+	}
+	if v := d.Get("data_json").(string); v != `{"foo": "bar"}` {
+		t.Errorf("expected data_json to be kept, got %q", v)
+	}
+
+	d.Set("secret_version", 2)
+	if _, err := genericSecretResourceDetectDrift(d, client); err != nil {
+		t.Fatal(err)
+	}
+	if v := d.Get("data_json").(string); v != "" {
+		t.Errorf("expected data_json to be cleared, got %q", v)
+	}
+}
+
+func TestResourceGenericSecret_detectDrift(t *testing.T) {
+	mount := acctest.RandomWithPrefix("secretsv2")
+	path := mount + "/test"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceGenericSecret_detectDriftConfig(mount),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_generic_secret.test", "secret_version", "1"),
+					resource.TestCheckResourceAttr("vault_generic_secret.test", "data.zip", "zap"),
+				),
+			},
+			{
+				PreConfig: func() {
+					client := testProvider.Meta().(*api.Client)
+					_, err := client.Logical().Write(mount+"/data/test", map[string]interface{}{
+						"data": map[string]interface{}{
+							"zip": "changed",
+						},
+					})
+					if err != nil {
+						t.Fatalf("unable to manually update the secret via the SDK: %s", err)
+					}
+				},
+				Config:             testResourceGenericSecret_detectDriftConfig(mount),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testResourceGenericSecret_detectDriftConfig(mount),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_generic_secret.test", "secret_version", "3"),
+					resource.TestCheckResourceAttr("vault_generic_secret.test", "path", path),
+				),
+			},
+		},
+	})
+}
+
+func testResourceGenericSecret_detectDriftConfig(mount string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "v2" {
+	path = "%s"
+	type = "kv"
+	options = {
+		version = "2"
+	}
+}
+
+resource "vault_generic_secret" "test" {
+    path = "${vault_mount.v2.path}/test"
+    disable_read = true
+    detect_drift = true
+    data_json = <<EOT
+{
+    "zip": "zap"
+}
+EOT
+}`, mount)
+}
+
 func testResourceGenericSecret_initialConfig(path string) string {
 	return fmt.Sprintf(`
 resource "vault_mount" "v1" {
@@ -12,11 +12,12 @@ import (
 func githubAuthBackendResource() *schema.Resource {
 	fields := map[string]*schema.Schema{
 		"path": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			ForceNew:    true,
-			Description: "Path where the auth backend is mounted",
-			Default:     "github",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Description:  "Path where the auth backend is mounted",
+			ValidateFunc: validateMountPath,
+			Default:      "github",
 			StateFunc: func(v interface{}) string {
 				return strings.Trim(v.(string), "/")
 			},
@@ -26,6 +27,12 @@ func githubAuthBackendResource() *schema.Resource {
 			Required:    true,
 			Description: "The organization users must be part of.",
 		},
+		"organization_id": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "The ID of the organization users must be part of. Vault looks it up from the organization name if it is not set, binding the backend to the organization even if it is renamed.",
+		},
 		"base_url": {
 			Type:        schema.TypeString,
 			Optional:    true,
@@ -117,6 +124,13 @@ func githubAuthBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 	if v, ok := d.GetOk("organization"); ok {
 		data["organization"] = v.(string)
 	}
+	// organization_id is computed when not configured, so a changed
+	// organization must reset it for Vault to look up the new ID.
+	if d.HasChange("organization") && !d.HasChange("organization_id") {
+		data["organization_id"] = 0
+	} else if v, ok := d.GetOk("organization_id"); ok {
+		data["organization_id"] = v.(int)
+	}
 	if v, ok := d.GetOk("base_url"); ok {
 		data["base_url"] = v.(string)
 	}
@@ -157,6 +171,7 @@ func githubAuthBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[INFO] Github auth config successfully written to '%q'", configPath)
 
 	d.SetPartial("organization")
+	d.SetPartial("organization_id")
 	d.SetPartial("base_url")
 	if _, ok := data["ttl"]; ok {
 		d.SetPartial("ttl")
@@ -266,6 +281,7 @@ func githubAuthBackendRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("path", d.Id())
 	d.Set("organization", dt.Data["organization"])
+	d.Set("organization_id", dt.Data["organization_id"])
 	d.Set("base_url", dt.Data["base_url"])
 	d.Set("description", authMount.Description)
 	d.Set("accessor", mount.Accessor)
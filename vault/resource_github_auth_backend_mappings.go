@@ -0,0 +1,208 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+// githubMappingTypes maps the block names of the mappings resource to the
+// map types of the GitHub auth backend.
+var githubMappingTypes = map[string]string{
+	"team": "teams",
+	"user": "users",
+}
+
+func githubAuthBackendMappingsResource() *schema.Resource {
+	mappingSchema := func(description string) *schema.Schema {
+		return &schema.Schema{
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: description,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "Name of the GitHub team or user.",
+						// Vault stores the names lowercased
+						StateFunc: func(v interface{}) string {
+							return strings.ToLower(v.(string))
+						},
+					},
+					"policies": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Description: "Policies to be assigned.",
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+				},
+			},
+			Set: githubAuthBackendMappingHash,
+		}
+	}
+
+	return &schema.Resource{
+		Create: githubAuthBackendMappingsWrite,
+		Read:   githubAuthBackendMappingsRead,
+		Update: githubAuthBackendMappingsWrite,
+		Delete: githubAuthBackendMappingsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Auth backend to which the mappings will be configured.",
+				ForceNew:    true,
+				Default:     "github",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"team": mappingSchema("Policy mappings of GitHub teams, in \"slugified\" format. Team mappings not listed are removed."),
+			"user": mappingSchema("Policy mappings of GitHub users. User mappings not listed are removed."),
+		},
+	}
+}
+
+func githubAuthBackendMappingsWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	backend := d.Get("backend").(string)
+
+	for block, mapType := range githubMappingTypes {
+		configured := map[string][]string{}
+		for _, raw := range d.Get(block).(*schema.Set).List() {
+			m := raw.(map[string]interface{})
+			configured[strings.ToLower(m["name"].(string))] = expandStringSlice(m["policies"].([]interface{}))
+		}
+
+		existing, err := githubAuthBackendMappingNames(client, backend, mapType)
+		if err != nil {
+			return err
+		}
+		for _, name := range existing {
+			if _, ok := configured[name]; ok {
+				continue
+			}
+			path := githubMapId(backend, name, mapType)
+			log.Printf("[DEBUG] Deleting github %s map %q", mapType, path)
+			if _, err := client.Logical().Delete(path); err != nil {
+				return fmt.Errorf("error deleting github %s map %q: %s", mapType, path, err)
+			}
+		}
+
+		for name, policies := range configured {
+			path := githubMapId(backend, name, mapType)
+			log.Printf("[DEBUG] Writing github %s map %q", mapType, path)
+			_, err := client.Logical().Write(path, map[string]interface{}{
+				"value": strings.Join(policies, ","),
+			})
+			if err != nil {
+				return fmt.Errorf("error writing github %s map %q: %s", mapType, path, err)
+			}
+		}
+	}
+
+	d.SetId(backend)
+
+	return githubAuthBackendMappingsRead(d, meta)
+}
+
+func githubAuthBackendMappingsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	backend := d.Id()
+
+	for block, mapType := range githubMappingTypes {
+		names, err := githubAuthBackendMappingNames(client, backend, mapType)
+		if err != nil {
+			return err
+		}
+
+		var mappings []map[string]interface{}
+		for _, name := range names {
+			path := githubMapId(backend, name, mapType)
+			resp, err := client.Logical().Read(path)
+			if err != nil {
+				return fmt.Errorf("error reading github %s map %q: %s", mapType, path, err)
+			}
+			if resp == nil {
+				continue
+			}
+			value, _ := resp.Data["value"].(string)
+			mappings = append(mappings, map[string]interface{}{
+				"name":     name,
+				"policies": flattenCommaSeparatedStringSlice(value),
+			})
+		}
+
+		if err := d.Set(block, mappings); err != nil {
+			return fmt.Errorf("error setting state key %q: %s", block, err)
+		}
+	}
+
+	d.Set("backend", backend)
+
+	return nil
+}
+
+func githubAuthBackendMappingsDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	backend := d.Id()
+
+	for _, mapType := range githubMappingTypes {
+		names, err := githubAuthBackendMappingNames(client, backend, mapType)
+		if err != nil {
+			return err
+		}
+		for _, name := range names {
+			path := githubMapId(backend, name, mapType)
+			log.Printf("[DEBUG] Deleting github %s map %q", mapType, path)
+			if _, err := client.Logical().Delete(path); err != nil {
+				return fmt.Errorf("error deleting github %s map %q: %s", mapType, path, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func githubAuthBackendMappingHash(v interface{}) int {
+	m, castOk := v.(map[string]interface{})
+	if !castOk {
+		return 0
+	}
+	if v, ok := m["name"]; ok {
+		return hashcode.String(strings.ToLower(v.(string)))
+	}
+
+	return 0
+}
+
+func githubAuthBackendMappingNames(client *api.Client, backend, mapType string) ([]string, error) {
+	path := fmt.Sprintf("auth/%s/map/%s", strings.Trim(backend, "/"), mapType)
+	resp, err := client.Logical().List(path)
+	if err != nil {
+		return nil, fmt.Errorf("error listing github %s maps at %q: %s", mapType, path, err)
+	}
+	if resp == nil {
+		return nil, nil
+	}
+
+	var names []string
+	if keys, ok := resp.Data["keys"].([]interface{}); ok {
+		for _, k := range keys {
+			names = append(names, k.(string))
+		}
+	}
+	return names, nil
+}
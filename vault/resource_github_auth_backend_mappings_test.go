@@ -0,0 +1,142 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestGithubAuthBackendMappingsDiff_mixedCase(t *testing.T) {
+	r := githubAuthBackendMappingsResource()
+
+	d := r.TestResourceData()
+	d.SetId("github")
+	d.Set("backend", "github")
+	d.Set("team", []interface{}{
+		map[string]interface{}{"name": "developers", "policies": []interface{}{"dev"}},
+	})
+	d.Set("user", []interface{}{
+		map[string]interface{}{"name": "octocat", "policies": []interface{}{"admin"}},
+	})
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"team": []interface{}{
+			map[string]interface{}{"name": "Developers", "policies": []interface{}{"dev"}},
+		},
+		"user": []interface{}{
+			map[string]interface{}{"name": "OctoCat", "policies": []interface{}{"admin"}},
+		},
+	})
+
+	diff, err := r.Diff(d.State(), config, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff != nil && !diff.Empty() {
+		t.Errorf("expected no diff, got %#v", diff.Attributes)
+	}
+}
+
+func TestAccGithubAuthBackendMappings_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("github")
+	resName := "vault_github_auth_backend_mappings.mappings"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccGithubAuthBackendMappingsCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGithubAuthBackendMappingsConfig(backend, `
+  team {
+    name     = "admins"
+    policies = ["admin"]
+  }
+  team {
+    name     = "developers"
+    policies = ["dev", "default"]
+  }
+  user {
+    name     = "octocat"
+    policies = ["readonly"]
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "backend", backend),
+					resource.TestCheckResourceAttr(resName, "team.#", "2"),
+					resource.TestCheckResourceAttr(resName, "user.#", "1"),
+					testAccGithubAuthBackendMappingsCheckCount(backend, "teams", 2),
+					testAccGithubAuthBackendMappingsCheckCount(backend, "users", 1),
+				),
+			},
+			{
+				Config: testAccGithubAuthBackendMappingsConfig(backend, `
+  team {
+    name     = "admins"
+    policies = ["admin", "security"]
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "team.#", "1"),
+					resource.TestCheckResourceAttr(resName, "user.#", "0"),
+					testAccGithubAuthBackendMappingsCheckCount(backend, "teams", 1),
+					testAccGithubAuthBackendMappingsCheckCount(backend, "users", 0),
+				),
+			},
+			{
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccGithubAuthBackendMappingsCheckCount(backend, mapType string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testProvider.Meta().(*api.Client)
+		names, err := githubAuthBackendMappingNames(client, backend, mapType)
+		if err != nil {
+			return err
+		}
+		if len(names) != expected {
+			return fmt.Errorf("expected %d github %s maps, got %v", expected, mapType, names)
+		}
+		return nil
+	}
+}
+
+func testAccGithubAuthBackendMappingsCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_github_auth_backend_mappings" {
+			continue
+		}
+		for _, mapType := range githubMappingTypes {
+			names, err := githubAuthBackendMappingNames(client, rs.Primary.ID, mapType)
+			if err != nil {
+				// the backend itself has been removed
+				continue
+			}
+			if len(names) > 0 {
+				return fmt.Errorf("github %s maps still exist: %v", mapType, names)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccGithubAuthBackendMappingsConfig(backend, mappings string) string {
+	return fmt.Sprintf(`
+resource "vault_github_auth_backend" "gh" {
+  path         = "%s"
+  organization = "vault"
+}
+
+resource "vault_github_auth_backend_mappings" "mappings" {
+  backend = vault_github_auth_backend.gh.path
+%s
+}
+`, backend, mappings)
+}
@@ -28,6 +28,7 @@ func TestAccGithubAuthBackend_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resName, "id", backend),
 					resource.TestCheckResourceAttr(resName, "path", backend),
 					resource.TestCheckResourceAttr(resName, "organization", "vault"),
+					resource.TestCheckResourceAttrSet(resName, "organization_id"),
 					resource.TestCheckResourceAttr(resName, "ttl", "20m"),
 					resource.TestCheckResourceAttr(resName, "max_ttl", "50m"),
 					resource.TestCheckResourceAttrPtr(resName, "accessor", &resAuth.Accessor),
@@ -49,7 +49,8 @@ func githubUserResource() *schema.Resource {
 			"token_bound_cidrs": {
 				Type: schema.TypeSet,
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:         schema.TypeString,
+					ValidateFunc: validateCIDR,
 				},
 				Description: "Specifies the blocks of IP addresses which are allowed to use the generated token",
 				Optional:    true,
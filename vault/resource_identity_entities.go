@@ -0,0 +1,274 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+const identityEntityBatchDeletePath = identityEntityPath + "/batch-delete"
+
+func identityEntitiesResource() *schema.Resource {
+	return &schema.Resource{
+		Create: identityEntitiesCreate,
+		Update: identityEntitiesUpdate,
+		Read:   identityEntitiesRead,
+		Delete: identityEntitiesDelete,
+
+		Schema: map[string]*schema.Schema{
+			"entity": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "Entities to be managed, matched by name.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of the entity.",
+						},
+						"policies": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Policies to be tied to the entity.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"metadata": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Description: "Metadata to be associated with the entity.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"disabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Whether the entity is disabled.",
+						},
+						"alias": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Aliases of the entity. Aliases not listed are removed from the entity.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Name of the alias.",
+									},
+									"mount_accessor": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Mount accessor of the auth backend the alias belongs to.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"entity_ids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Map of the entity names to their IDs.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func identityEntitiesCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(resource.UniqueId())
+
+	return identityEntitiesUpdate(d, meta)
+}
+
+func identityEntitiesUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	configured := map[string]bool{}
+	entityIDs := map[string]interface{}{}
+	for _, raw := range d.Get("entity").(*schema.Set).List() {
+		entity := raw.(map[string]interface{})
+		name := entity["name"].(string)
+		if configured[name] {
+			return fmt.Errorf("duplicate entity %q", name)
+		}
+		configured[name] = true
+
+		id, err := identityEntitiesWriteEntity(client, entity)
+		if err != nil {
+			return err
+		}
+		entityIDs[name] = id
+	}
+
+	// Entities dropped from the configuration are removed in a single call.
+	var removed []string
+	for name, id := range d.Get("entity_ids").(map[string]interface{}) {
+		if !configured[name] {
+			removed = append(removed, id.(string))
+		}
+	}
+	if err := identityEntitiesBatchDelete(client, removed); err != nil {
+		return err
+	}
+
+	if err := d.Set("entity_ids", entityIDs); err != nil {
+		return fmt.Errorf("error setting state key %q: %s", "entity_ids", err)
+	}
+
+	return identityEntitiesRead(d, meta)
+}
+
+// identityEntitiesWriteEntity creates or updates an entity by name and
+// reconciles its aliases, returning the entity ID.
+func identityEntitiesWriteEntity(client *api.Client, entity map[string]interface{}) (string, error) {
+	name := entity["name"].(string)
+	path := identityEntityNamePath(name)
+
+	vaultMutexKV.Lock(path)
+	defer vaultMutexKV.Unlock(path)
+
+	data := map[string]interface{}{
+		"policies": entity["policies"].(*schema.Set).List(),
+		"metadata": entity["metadata"],
+		"disabled": entity["disabled"],
+	}
+
+	log.Printf("[DEBUG] Writing IdentityEntity %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return "", fmt.Errorf("error writing IdentityEntity %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote IdentityEntity %q", path)
+
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return "", fmt.Errorf("error reading IdentityEntity %q: %s", path, err)
+	}
+	if resp == nil {
+		return "", fmt.Errorf("IdentityEntity %q not found after writing it", path)
+	}
+	id := resp.Data["id"].(string)
+
+	type aliasKey struct {
+		name, mountAccessor string
+	}
+	wanted := map[aliasKey]bool{}
+	for _, raw := range entity["alias"].(*schema.Set).List() {
+		alias := raw.(map[string]interface{})
+		wanted[aliasKey{alias["name"].(string), alias["mount_accessor"].(string)}] = true
+	}
+
+	existing, _ := resp.Data["aliases"].([]interface{})
+	for _, raw := range existing {
+		alias := raw.(map[string]interface{})
+		key := aliasKey{alias["name"].(string), alias["mount_accessor"].(string)}
+		if wanted[key] {
+			delete(wanted, key)
+			continue
+		}
+
+		aliasPath := identityEntityAliasIDPath(alias["id"].(string))
+		log.Printf("[DEBUG] Deleting IdentityEntityAlias %q", aliasPath)
+		if _, err := client.Logical().Delete(aliasPath); err != nil {
+			return "", fmt.Errorf("error deleting IdentityEntityAlias %q: %s", aliasPath, err)
+		}
+	}
+
+	for key := range wanted {
+		log.Printf("[DEBUG] Creating IdentityEntityAlias %q for IdentityEntity %q", key.name, id)
+		_, err := client.Logical().Write(identityEntityAliasPath, map[string]interface{}{
+			"name":           key.name,
+			"mount_accessor": key.mountAccessor,
+			"canonical_id":   id,
+		})
+		if err != nil {
+			return "", fmt.Errorf("error creating IdentityEntityAlias %q for IdentityEntity %q: %s", key.name, id, err)
+		}
+	}
+
+	return id, nil
+}
+
+func identityEntitiesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	var entities []map[string]interface{}
+	entityIDs := map[string]interface{}{}
+	for name, id := range d.Get("entity_ids").(map[string]interface{}) {
+		resp, err := readIdentityEntity(client, id.(string))
+		if err != nil {
+			return err
+		}
+		if resp == nil {
+			log.Printf("[WARN] IdentityEntity %q (%s) not found, removing from state", name, id)
+			continue
+		}
+
+		var aliases []map[string]interface{}
+		if v, ok := resp.Data["aliases"].([]interface{}); ok {
+			for _, raw := range v {
+				alias := raw.(map[string]interface{})
+				aliases = append(aliases, map[string]interface{}{
+					"name":           alias["name"],
+					"mount_accessor": alias["mount_accessor"],
+				})
+			}
+		}
+
+		entities = append(entities, map[string]interface{}{
+			"name":     resp.Data["name"],
+			"policies": resp.Data["policies"],
+			"metadata": resp.Data["metadata"],
+			"disabled": resp.Data["disabled"],
+			"alias":    aliases,
+		})
+		entityIDs[resp.Data["name"].(string)] = id
+	}
+
+	if err := d.Set("entity", entities); err != nil {
+		return fmt.Errorf("error setting state key %q: %s", "entity", err)
+	}
+	if err := d.Set("entity_ids", entityIDs); err != nil {
+		return fmt.Errorf("error setting state key %q: %s", "entity_ids", err)
+	}
+
+	return nil
+}
+
+func identityEntitiesDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	var ids []string
+	for _, id := range d.Get("entity_ids").(map[string]interface{}) {
+		ids = append(ids, id.(string))
+	}
+
+	return identityEntitiesBatchDelete(client, ids)
+}
+
+func identityEntitiesBatchDelete(client *api.Client, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Deleting %d IdentityEntities", len(ids))
+	_, err := client.Logical().Write(identityEntityBatchDeletePath, map[string]interface{}{
+		"entity_ids": ids,
+	})
+	if err != nil {
+		return fmt.Errorf("error deleting IdentityEntities %v: %s", ids, err)
+	}
+	log.Printf("[DEBUG] Deleted %d IdentityEntities", len(ids))
+
+	return nil
+}
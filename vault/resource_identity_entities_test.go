@@ -0,0 +1,92 @@
+package vault
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccIdentityEntities(t *testing.T) {
+	prefix := acctest.RandomWithPrefix("test-entities")
+	resourceName := "vault_identity_entities.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityEntitiesDestroy(prefix),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityEntitiesConfig(prefix, 3),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "entity.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "entity_ids.%", "3"),
+					resource.TestCheckResourceAttrSet(resourceName, "entity_ids."+prefix+"-0"),
+				),
+			},
+			{
+				Config: testAccIdentityEntitiesConfig(prefix, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "entity.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "entity_ids.%", "1"),
+					testAccCheckIdentityEntitiesDeleted(prefix+"-1", prefix+"-2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityEntitiesDeleted(names ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testProvider.Meta().(*api.Client)
+
+		for _, name := range names {
+			resp, err := client.Logical().Read(identityEntityNamePath(name))
+			if err != nil {
+				return fmt.Errorf("error checking for IdentityEntity %q: %s", name, err)
+			}
+			if resp != nil {
+				return fmt.Errorf("IdentityEntity %q still exists", name)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCheckIdentityEntitiesDestroy(prefix string) resource.TestCheckFunc {
+	return testAccCheckIdentityEntitiesDeleted(prefix+"-0", prefix+"-1", prefix+"-2")
+}
+
+func testAccIdentityEntitiesConfig(prefix string, count int) string {
+	var entities []string
+	for i := 0; i < count; i++ {
+		entities = append(entities, fmt.Sprintf(`
+  entity {
+    name     = "%s-%d"
+    policies = ["test"]
+    metadata = {
+      index = "%d"
+    }
+
+    alias {
+      name           = "%s-%d"
+      mount_accessor = vault_auth_backend.userpass.accessor
+    }
+  }`, prefix, i, i, prefix, i))
+	}
+
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "userpass" {
+  type = "userpass"
+  path = "%s"
+}
+
+resource "vault_identity_entities" "test" {
+%s
+}
+`, prefix, strings.Join(entities, "\n"))
+}
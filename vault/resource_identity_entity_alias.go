@@ -39,6 +39,15 @@ func identityEntityAliasResource() *schema.Resource {
 				Required:    true,
 				Description: "ID of the entity to which this is an alias.",
 			},
+
+			"custom_metadata": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Custom metadata to be associated with this alias.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -58,6 +67,10 @@ func identityEntityAliasCreate(d *schema.ResourceData, meta interface{}) error {
 		"canonical_id":   canonicalID,
 	}
 
+	if customMetadata, ok := d.GetOk("custom_metadata"); ok {
+		data["custom_metadata"] = customMetadata
+	}
+
 	resp, err := client.Logical().Write(path, data)
 
 	if err != nil {
@@ -108,6 +121,9 @@ func identityEntityAliasUpdate(d *schema.ResourceData, meta interface{}) error {
 	if canonicalID, ok := d.GetOk("canonical_id"); ok {
 		data["canonical_id"] = canonicalID
 	}
+	if d.HasChange("custom_metadata") {
+		data["custom_metadata"] = d.Get("custom_metadata")
+	}
 
 	_, err = client.Logical().Write(path, data)
 
@@ -138,7 +154,7 @@ func identityEntityAliasRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	d.SetId(resp.Data["id"].(string))
-	for _, k := range []string{"name", "mount_accessor", "canonical_id"} {
+	for _, k := range []string{"name", "mount_accessor", "canonical_id", "custom_metadata"} {
 		if err := d.Set(k, resp.Data[k]); err != nil {
 			return fmt.Errorf("error setting state key \"%s\" on IdentityEntityAlias %q: %s", k, id, err)
 		}
@@ -73,6 +73,39 @@ func TestAccIdentityEntityAlias_Update(t *testing.T) {
 	})
 }
 
+func TestAccIdentityEntityAlias_CustomMetadata(t *testing.T) {
+	entity := acctest.RandomWithPrefix("my-entity")
+
+	nameEntityAlias := "vault_identity_entity_alias.entity-alias"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityEntityAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityEntityAliasConfigCustomMetadata(entity, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(nameEntityAlias, "custom_metadata.%", "1"),
+					resource.TestCheckResourceAttr(nameEntityAlias, "custom_metadata.version", "1"),
+				),
+			},
+			{
+				Config: testAccIdentityEntityAliasConfigCustomMetadata(entity, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(nameEntityAlias, "custom_metadata.%", "1"),
+					resource.TestCheckResourceAttr(nameEntityAlias, "custom_metadata.version", "2"),
+				),
+			},
+			{
+				ResourceName:      nameEntityAlias,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckIdentityEntityAliasDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -139,3 +172,26 @@ resource "vault_identity_entity_alias" "entity-alias-dupe" {
 
 	return ret
 }
+
+func testAccIdentityEntityAliasConfigCustomMetadata(entityName, version string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_entity" "entity" {
+  name = "%s"
+}
+
+resource "vault_auth_backend" "github" {
+  type = "github"
+  path = "github-%s"
+}
+
+resource "vault_identity_entity_alias" "entity-alias" {
+  name           = vault_identity_entity.entity.name
+  mount_accessor = vault_auth_backend.github.accessor
+  canonical_id   = vault_identity_entity.entity.id
+
+  custom_metadata = {
+    version = "%s"
+  }
+}
+`, entityName, entityName, version)
+}
@@ -0,0 +1,106 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+const identityEntityMergePath = identityEntityPath + "/merge"
+
+func identityEntityMergeResource() *schema.Resource {
+	return &schema.Resource{
+		Create: identityEntityMergeCreate,
+		Read:   identityEntityMergeRead,
+		Delete: identityEntityMergeDelete,
+
+		Schema: map[string]*schema.Schema{
+			"from_entity_ids": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Entity IDs which need to get merged into the to_entity_id.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"to_entity_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Entity ID into which all the other entities need to get merged.",
+			},
+			"force": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Setting this will follow the 'mine' strategy for merging MFA secrets.",
+			},
+			"conflicting_alias_ids_to_keep": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Alias IDs to keep in case of conflicting aliases, ignored if no conflicting aliases are found.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func identityEntityMergeCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	toEntityID := d.Get("to_entity_id").(string)
+
+	data := map[string]interface{}{
+		"from_entity_ids": d.Get("from_entity_ids").(*schema.Set).List(),
+		"to_entity_id":    toEntityID,
+		"force":           d.Get("force").(bool),
+	}
+	if v, ok := d.GetOk("conflicting_alias_ids_to_keep"); ok {
+		data["conflicting_alias_ids_to_keep"] = v.(*schema.Set).List()
+	}
+
+	path := identityEntityIDPath(toEntityID)
+	vaultMutexKV.Lock(path)
+	defer vaultMutexKV.Unlock(path)
+
+	log.Printf("[DEBUG] Merging IdentityEntities into %q", toEntityID)
+	if _, err := client.Logical().Write(identityEntityMergePath, data); err != nil {
+		return fmt.Errorf("error merging IdentityEntities into %q: %s", toEntityID, err)
+	}
+	log.Printf("[DEBUG] Merged IdentityEntities into %q", toEntityID)
+
+	d.SetId(toEntityID)
+
+	return identityEntityMergeRead(d, meta)
+}
+
+func identityEntityMergeRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	id := d.Id()
+
+	resp, err := readIdentityEntity(client, id)
+	if err != nil {
+		return fmt.Errorf("error reading IdentityEntity %q: %s", id, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] IdentityEntity %q not found, removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("to_entity_id", id)
+
+	return nil
+}
+
+func identityEntityMergeDelete(d *schema.ResourceData, meta interface{}) error {
+	// A merge can't be undone, removing the resource only removes it from
+	// state.
+	return nil
+}
@@ -0,0 +1,90 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccIdentityEntityMerge(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-entity")
+	resourceName := "vault_identity_entity_merge.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityEntityMergeConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "to_entity_id", "vault_identity_entity.to", "id"),
+					resource.TestCheckResourceAttr(resourceName, "from_entity_ids.#", "1"),
+					testAccCheckIdentityEntityMerged("vault_identity_entity.to", name+"-from"),
+				),
+				// the merged entity is gone, so the next refresh wants to
+				// recreate it.
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityEntityMerged(toResource, alias string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[toResource]
+		if !ok {
+			return fmt.Errorf("resource %q not found in state", toResource)
+		}
+
+		client := testProvider.Meta().(*api.Client)
+		resp, err := readIdentityEntity(client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if resp == nil {
+			return fmt.Errorf("IdentityEntity %q not found", rs.Primary.ID)
+		}
+
+		aliases, _ := resp.Data["aliases"].([]interface{})
+		for _, raw := range aliases {
+			if raw.(map[string]interface{})["name"] == alias {
+				return nil
+			}
+		}
+		return fmt.Errorf("alias %q was not merged into IdentityEntity %q", alias, rs.Primary.ID)
+	}
+}
+
+func testAccIdentityEntityMergeConfig(name string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "userpass" {
+  type = "userpass"
+  path = "%[1]s"
+}
+
+resource "vault_identity_entity" "to" {
+  name = "%[1]s-to"
+}
+
+resource "vault_identity_entity" "from" {
+  name = "%[1]s-from"
+}
+
+resource "vault_identity_entity_alias" "from" {
+  name           = "%[1]s-from"
+  mount_accessor = vault_auth_backend.userpass.accessor
+  canonical_id   = vault_identity_entity.from.id
+}
+
+resource "vault_identity_entity_merge" "test" {
+  from_entity_ids = [vault_identity_entity.from.id]
+  to_entity_id    = vault_identity_entity.to.id
+
+  depends_on = [vault_identity_entity_alias.from]
+}
+`, name)
+}
@@ -15,6 +15,9 @@ func identityEntityPoliciesResource() *schema.Resource {
 		Update: identityEntityPoliciesUpdate,
 		Read:   identityEntityPoliciesRead,
 		Delete: identityEntityPoliciesDelete,
+		Importer: &schema.ResourceImporter{
+			State: identityExclusiveImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"policies": {
@@ -164,3 +167,14 @@ func identityEntityPoliciesDelete(d *schema.ResourceData, meta interface{}) erro
 
 	return nil
 }
+
+// identityExclusiveImport imports the resources managing a part of an
+// identity entity or group. They are imported as managing it exclusively,
+// as the Default of exclusive isn't applied on import and a non-exclusive
+// resource reads back nothing before it's configured.
+func identityExclusiveImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if err := d.Set("exclusive", true); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -34,6 +34,12 @@ func TestAccIdentityEntityPoliciesExclusive(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_identity_entity_policies.policies", "policies.1785148924", "test"),
 				),
 			},
+			{
+				ResourceName:      "vault_identity_entity_policies.policies",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateCheck:  testAccIdentityImportStateCheckAttr("policies.#", "2"),
+			},
 		},
 	})
 }
@@ -321,3 +327,17 @@ resource "vault_identity_entity_policies" "test" {
 }
 `, entity)
 }
+
+// testAccIdentityImportStateCheckAttr checks the imported state has the
+// attribute key set to value.
+func testAccIdentityImportStateCheckAttr(key, value string) resource.ImportStateCheckFunc {
+	return func(states []*terraform.InstanceState) error {
+		if len(states) != 1 {
+			return fmt.Errorf("expected 1 imported state, got %d", len(states))
+		}
+		if actual := states[0].Attributes[key]; actual != value {
+			return fmt.Errorf("expected %q to be %q after import, got %q", key, value, actual)
+		}
+		return nil
+	}
+}
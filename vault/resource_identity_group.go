@@ -76,7 +76,7 @@ func identityGroupResource() *schema.Resource {
 				// Suppress the diff if group type is "external" because we cannot manage
 				// group members
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-					if d.Get("type").(string) == "external" {
+					if d.Get("type").(string) == "external" || d.Get("external_member_group_ids").(bool) == true {
 						return true
 					}
 					return false
@@ -106,6 +106,13 @@ func identityGroupResource() *schema.Resource {
 				Default:     false,
 				Description: "Manage member entities externally through `vault_identity_group_policies_member_entity_ids`",
 			},
+
+			"external_member_group_ids": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Manage member groups externally through `vault_identity_group_member_group_ids`",
+			},
 		},
 	}
 }
@@ -122,7 +129,9 @@ func identityGroupUpdateFields(d *schema.ResourceData, data map[string]interface
 
 		// Member groups and entities can't be set for external groups
 		if d.Get("type").(string) == "internal" {
-			data["member_group_ids"] = d.Get("member_group_ids").(*schema.Set).List()
+			if externalMemberGroupIds, ok := d.GetOk("external_member_group_ids"); !(ok && externalMemberGroupIds.(bool)) {
+				data["member_group_ids"] = d.Get("member_group_ids").(*schema.Set).List()
+			}
 
 			if externalMemberEntityIds, ok := d.GetOk("external_member_entity_ids"); !(ok && externalMemberEntityIds.(bool)) {
 				data["member_entity_ids"] = d.Get("member_entity_ids").(*schema.Set).List()
@@ -151,6 +160,11 @@ func identityGroupUpdateFields(d *schema.ResourceData, data map[string]interface
 			if data["external_member_entity_ids"].(bool) {
 				data["member_entity_ids"] = nil
 			}
+			// if external_member_group_ids is true, member_group_ids will be nil
+			data["external_member_group_ids"] = d.Get("external_member_group_ids").(bool)
+			if data["external_member_group_ids"].(bool) {
+				data["member_group_ids"] = nil
+			}
 		}
 	}
 
@@ -329,6 +343,21 @@ func readIdentityGroupMemberEntityIds(client *api.Client, groupID string) ([]int
 	return make([]interface{}, 0), nil
 }
 
+func readIdentityGroupMemberGroupIds(client *api.Client, groupID string) ([]interface{}, error) {
+	resp, err := readIdentityGroup(client, groupID)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, fmt.Errorf("error IdentityGroup %s does not exist", groupID)
+	}
+
+	if v, ok := resp.Data["member_group_ids"]; ok && v != nil {
+		return v.([]interface{}), nil
+	}
+	return make([]interface{}, 0), nil
+}
+
 // This function may return `nil` for the IdentityGroup if it does not exist
 func readIdentityGroup(client *api.Client, groupID string) (*api.Secret, error) {
 	path := identityGroupIDPath(groupID)
@@ -15,6 +15,9 @@ func identityGroupMemberEntityIdsResource() *schema.Resource {
 		Update: identityGroupMemberEntityIdsUpdate,
 		Read:   identityGroupMemberEntityIdsRead,
 		Delete: identityGroupMemberEntityIdsDelete,
+		Importer: &schema.ResourceImporter{
+			State: identityExclusiveImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"member_entity_ids": {
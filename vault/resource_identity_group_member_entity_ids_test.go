@@ -34,6 +34,12 @@ func TestAccIdentityGroupMemberEntityIdsExclusiveEmpty(t *testing.T) {
 					testAccIdentityGroupMemberEntityIdsCheckAttrs("vault_identity_group_member_entity_ids.member_entity_ids"),
 				),
 			},
+			{
+				ResourceName:      "vault_identity_group_member_entity_ids.member_entity_ids",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateCheck:  testAccIdentityImportStateCheckAttr("member_entity_ids.#", "1"),
+			},
 			{
 				Config: testAccIdentityGroupMemberEntityIdsConfigExclusiveEmpty(),
 				Check: resource.ComposeTestCheckFunc(
@@ -0,0 +1,186 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+func identityGroupMemberGroupIdsResource() *schema.Resource {
+	return &schema.Resource{
+		Create: identityGroupMemberGroupIdsUpdate,
+		Update: identityGroupMemberGroupIdsUpdate,
+		Read:   identityGroupMemberGroupIdsRead,
+		Delete: identityGroupMemberGroupIdsDelete,
+		Importer: &schema.ResourceImporter{
+			State: identityExclusiveImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"member_group_ids": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Group IDs to be assigned as group members.",
+			},
+
+			"exclusive": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Should the resource manage member group ids exclusively? Beware of race conditions when disabling exclusive management",
+			},
+
+			"group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the group.",
+			},
+
+			"group_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the group.",
+			},
+		},
+	}
+}
+
+func identityGroupMemberGroupIdsUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	id := d.Get("group_id").(string)
+
+	log.Printf("[DEBUG] Updating IdentityGroupMemberGroupIds %q", id)
+	path := identityGroupIDPath(id)
+
+	vaultMutexKV.Lock(path)
+	defer vaultMutexKV.Unlock(path)
+
+	data := make(map[string]interface{})
+	memberGroupIds := d.Get("member_group_ids").(*schema.Set).List()
+
+	resp, err := readIdentityGroup(client, id)
+	if err != nil {
+		return err
+	}
+
+	t, ok := resp.Data["type"]
+	if ok && t != "external" {
+		if d.Get("exclusive").(bool) {
+			data["member_group_ids"] = memberGroupIds
+		} else {
+			apiMemberGroupIds, err := readIdentityGroupMemberGroupIds(client, id)
+			if err != nil {
+				return err
+			}
+			if d.HasChange("member_group_ids") {
+				oldMemberGroupIdsI, _ := d.GetChange("member_group_ids")
+				oldMemberGroupIds := oldMemberGroupIdsI.(*schema.Set).List()
+				for _, memberGroupId := range oldMemberGroupIds {
+					apiMemberGroupIds = util.SliceRemoveIfPresent(apiMemberGroupIds, memberGroupId)
+				}
+			}
+			for _, memberGroupId := range memberGroupIds {
+				apiMemberGroupIds = util.SliceAppendIfMissing(apiMemberGroupIds, memberGroupId)
+			}
+			data["member_group_ids"] = apiMemberGroupIds
+		}
+	}
+
+	_, err = client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error updating IdentityGroupMemberGroupIds %q: %s", id, err)
+	}
+	log.Printf("[DEBUG] Updated IdentityGroupMemberGroupIds %q", id)
+
+	d.SetId(id)
+
+	return identityGroupMemberGroupIdsRead(d, meta)
+}
+
+func identityGroupMemberGroupIdsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	id := d.Id()
+
+	resp, err := readIdentityGroup(client, id)
+	if err != nil {
+		return err
+	}
+	log.Printf("[DEBUG] Read IdentityGroupMemberGroupIds %s", id)
+	if resp == nil {
+		log.Printf("[WARN] IdentityGroupMemberGroupIds %q not found, removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("group_id", id)
+	d.Set("group_name", resp.Data["name"])
+
+	if d.Get("exclusive").(bool) {
+		respdata := resp.Data["member_group_ids"]
+		if err = d.Set("member_group_ids", respdata); err != nil {
+			return fmt.Errorf("error setting member group ids for IdentityGroupMemberGroupIds %q: %s", id, err)
+		}
+	} else {
+		userMemberGroupIds := d.Get("member_group_ids").(*schema.Set).List()
+		newMemberGroupIds := make([]string, 0)
+		apiMemberGroupIds, _ := resp.Data["member_group_ids"].([]interface{})
+
+		for _, memberGroupId := range userMemberGroupIds {
+			if found, _ := util.SliceHasElement(apiMemberGroupIds, memberGroupId); found {
+				newMemberGroupIds = append(newMemberGroupIds, memberGroupId.(string))
+			}
+		}
+		if err = d.Set("member_group_ids", newMemberGroupIds); err != nil {
+			return fmt.Errorf("error setting member group ids for IdentityGroupMemberGroupIds %q: %s", id, err)
+		}
+	}
+	return nil
+}
+
+func identityGroupMemberGroupIdsDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	id := d.Get("group_id").(string)
+
+	log.Printf("[DEBUG] Deleting IdentityGroupMemberGroupIds %q", id)
+	path := identityGroupIDPath(id)
+
+	vaultMutexKV.Lock(path)
+	defer vaultMutexKV.Unlock(path)
+
+	data := make(map[string]interface{})
+
+	resp, err := readIdentityGroup(client, id)
+	if err != nil {
+		return err
+	}
+
+	t, ok := resp.Data["type"]
+	if ok && t != "external" {
+		if d.Get("exclusive").(bool) {
+			data["member_group_ids"] = make([]string, 0)
+		} else {
+			apiMemberGroupIds, err := readIdentityGroupMemberGroupIds(client, id)
+			if err != nil {
+				return err
+			}
+			for _, memberGroupId := range d.Get("member_group_ids").(*schema.Set).List() {
+				apiMemberGroupIds = util.SliceRemoveIfPresent(apiMemberGroupIds, memberGroupId)
+			}
+			data["member_group_ids"] = apiMemberGroupIds
+		}
+	}
+
+	_, err = client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error updating IdentityGroupMemberGroupIds %q: %s", id, err)
+	}
+	log.Printf("[DEBUG] Updated IdentityGroupMemberGroupIds %q", id)
+
+	return nil
+}
@@ -0,0 +1,167 @@
+package vault
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccIdentityGroupMemberGroupIdsExclusive(t *testing.T) {
+	parent := acctest.RandomWithPrefix("parent-group")
+	dev := acctest.RandomWithPrefix("dev-group")
+	test := acctest.RandomWithPrefix("test-group")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityGroupMemberGroupIdsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityGroupMemberGroupIdsConfigExclusive(parent, dev, test, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_identity_group_member_group_ids.members", "member_group_ids.#", "1"),
+					resource.TestCheckResourceAttr("vault_identity_group_member_group_ids.members", "group_name", parent),
+					testAccIdentityGroupMemberGroupIdsCheckLogical("vault_identity_group_member_group_ids.members", 1),
+				),
+			},
+			{
+				Config: testAccIdentityGroupMemberGroupIdsConfigExclusive(parent, dev, test, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_identity_group_member_group_ids.members", "member_group_ids.#", "2"),
+					testAccIdentityGroupMemberGroupIdsCheckLogical("vault_identity_group_member_group_ids.members", 2),
+				),
+			},
+			{
+				ResourceName:      "vault_identity_group_member_group_ids.members",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateCheck:  testAccIdentityImportStateCheckAttr("member_group_ids.#", "2"),
+			},
+		},
+	})
+}
+
+func TestAccIdentityGroupMemberGroupIdsNonExclusive(t *testing.T) {
+	parent := acctest.RandomWithPrefix("parent-group")
+	dev := acctest.RandomWithPrefix("dev-group")
+	test := acctest.RandomWithPrefix("test-group")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityGroupMemberGroupIdsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityGroupMemberGroupIdsConfigNonExclusive(parent, dev, test),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_identity_group_member_group_ids.dev", "member_group_ids.#", "1"),
+					resource.TestCheckResourceAttr("vault_identity_group_member_group_ids.test", "member_group_ids.#", "1"),
+					testAccIdentityGroupMemberGroupIdsCheckLogical("vault_identity_group_member_group_ids.dev", 2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityGroupMemberGroupIdsDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_identity_group_member_group_ids" {
+			continue
+		}
+
+		group, err := readIdentityGroup(client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if group == nil {
+			continue
+		}
+		apiMemberGroupIds, err := readIdentityGroupMemberGroupIds(client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		for k, v := range rs.Primary.Attributes {
+			if !strings.HasPrefix(k, "member_group_ids.") || k == "member_group_ids.#" {
+				continue
+			}
+			if found, _ := util.SliceHasElement(apiMemberGroupIds, v); found {
+				return fmt.Errorf("identity group %s still has member group id %s", rs.Primary.ID, v)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccIdentityGroupMemberGroupIdsCheckLogical(resourceName string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %q not found in state", resourceName)
+		}
+
+		client := testProvider.Meta().(*api.Client)
+		apiMemberGroupIds, err := readIdentityGroupMemberGroupIds(client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if len(apiMemberGroupIds) != count {
+			return fmt.Errorf("expected %d member group ids in group %s, got %d", count, rs.Primary.ID, len(apiMemberGroupIds))
+		}
+		return nil
+	}
+}
+
+func testAccIdentityGroupMemberGroupIdsConfigGroups(parent, dev, test string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_group" "parent" {
+  name                      = "%s"
+  external_member_group_ids = true
+}
+
+resource "vault_identity_group" "dev" {
+  name = "%s"
+}
+
+resource "vault_identity_group" "test" {
+  name = "%s"
+}
+`, parent, dev, test)
+}
+
+func testAccIdentityGroupMemberGroupIdsConfigExclusive(parent, dev, test string, both bool) string {
+	members := "vault_identity_group.dev.id"
+	if both {
+		members += ", vault_identity_group.test.id"
+	}
+
+	return testAccIdentityGroupMemberGroupIdsConfigGroups(parent, dev, test) + fmt.Sprintf(`
+resource "vault_identity_group_member_group_ids" "members" {
+  group_id         = vault_identity_group.parent.id
+  member_group_ids = [%s]
+}
+`, members)
+}
+
+func testAccIdentityGroupMemberGroupIdsConfigNonExclusive(parent, dev, test string) string {
+	return testAccIdentityGroupMemberGroupIdsConfigGroups(parent, dev, test) + `
+resource "vault_identity_group_member_group_ids" "dev" {
+  group_id         = vault_identity_group.parent.id
+  exclusive        = false
+  member_group_ids = [vault_identity_group.dev.id]
+}
+
+resource "vault_identity_group_member_group_ids" "test" {
+  group_id         = vault_identity_group.parent.id
+  exclusive        = false
+  member_group_ids = [vault_identity_group.test.id]
+}
+`
+}
@@ -15,6 +15,9 @@ func identityGroupPoliciesResource() *schema.Resource {
 		Update: identityGroupPoliciesUpdate,
 		Read:   identityGroupPoliciesRead,
 		Delete: identityGroupPoliciesDelete,
+		Importer: &schema.ResourceImporter{
+			State: identityExclusiveImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"policies": {
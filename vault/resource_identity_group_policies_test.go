@@ -32,6 +32,12 @@ func TestAccIdentityGroupPoliciesExclusive(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_identity_group_policies.policies", "policies.1785148924", "test"),
 				),
 			},
+			{
+				ResourceName:      "vault_identity_group_policies.policies",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateCheck:  testAccIdentityImportStateCheckAttr("policies.#", "2"),
+			},
 		},
 	})
 }
@@ -0,0 +1,174 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+const identityMFAMethodBasePath = "identity/mfa/method"
+
+// identityMFAMethodConfig describes one of the identity MFA method types, the
+// resources only differ by their type and the method specific fields.
+type identityMFAMethodConfig struct {
+	methodType string
+	schema     map[string]*schema.Schema
+	// fields that are written to Vault and read back from it.
+	fields []string
+	// fields that are written to Vault but never returned by it.
+	writeOnlyFields []string
+	// computed fields that are only read back from Vault.
+	computedFields []string
+}
+
+func identityMFAMethodResource(config *identityMFAMethodConfig) *schema.Resource {
+	fields := map[string]*schema.Schema{
+		"method_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Method ID.",
+		},
+		"type": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "MFA type.",
+		},
+		"namespace_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Method's namespace ID.",
+		},
+		"mount_accessor": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Mount accessor.",
+		},
+	}
+	for k, v := range config.schema {
+		fields[k] = v
+	}
+
+	return &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			return identityMFAMethodCreate(d, meta, config)
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return identityMFAMethodRead(d, meta, config)
+		},
+		Update: func(d *schema.ResourceData, meta interface{}) error {
+			return identityMFAMethodUpdate(d, meta, config)
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			return identityMFAMethodDelete(d, meta, config)
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: fields,
+	}
+}
+
+func identityMFAMethodRequestData(d *schema.ResourceData, config *identityMFAMethodConfig) map[string]interface{} {
+	data := map[string]interface{}{}
+	for _, k := range append(config.fields, config.writeOnlyFields...) {
+		if v, ok := d.GetOkExists(k); ok || d.HasChange(k) {
+			data[k] = v
+		}
+	}
+	return data
+}
+
+func identityMFAMethodCreate(d *schema.ResourceData, meta interface{}, config *identityMFAMethodConfig) error {
+	client := meta.(*api.Client)
+	path := identityMFAMethodTypePath(config.methodType)
+
+	log.Printf("[DEBUG] Creating identity MFA %s method at %q", config.methodType, path)
+	resp, err := client.Logical().Write(path, identityMFAMethodRequestData(d, config))
+	if err != nil {
+		return fmt.Errorf("error creating identity MFA %s method at %q: %s", config.methodType, path, err)
+	}
+	if resp == nil {
+		return fmt.Errorf("no response creating identity MFA %s method at %q", config.methodType, path)
+	}
+
+	id, ok := resp.Data["method_id"].(string)
+	if !ok || id == "" {
+		return fmt.Errorf("no method_id returned creating identity MFA %s method at %q", config.methodType, path)
+	}
+	log.Printf("[DEBUG] Created identity MFA %s method %q", config.methodType, id)
+
+	d.SetId(id)
+
+	return identityMFAMethodRead(d, meta, config)
+}
+
+func identityMFAMethodUpdate(d *schema.ResourceData, meta interface{}, config *identityMFAMethodConfig) error {
+	client := meta.(*api.Client)
+	path := identityMFAMethodPath(config.methodType, d.Id())
+
+	log.Printf("[DEBUG] Updating identity MFA %s method %q", config.methodType, path)
+	if _, err := client.Logical().Write(path, identityMFAMethodRequestData(d, config)); err != nil {
+		return fmt.Errorf("error updating identity MFA %s method %q: %s", config.methodType, path, err)
+	}
+	log.Printf("[DEBUG] Updated identity MFA %s method %q", config.methodType, path)
+
+	return identityMFAMethodRead(d, meta, config)
+}
+
+func identityMFAMethodRead(d *schema.ResourceData, meta interface{}, config *identityMFAMethodConfig) error {
+	client := meta.(*api.Client)
+	path := identityMFAMethodPath(config.methodType, d.Id())
+
+	log.Printf("[DEBUG] Reading identity MFA %s method %q", config.methodType, path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading identity MFA %s method %q: %s", config.methodType, path, err)
+	}
+	log.Printf("[DEBUG] Read identity MFA %s method %q", config.methodType, path)
+
+	if resp == nil {
+		log.Printf("[WARN] Identity MFA %s method %q not found, removing from state", config.methodType, path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("method_id", d.Id())
+	fields := []string{"type", "namespace_id", "mount_accessor"}
+	fields = append(fields, config.fields...)
+	fields = append(fields, config.computedFields...)
+	for _, k := range fields {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting state key %q: %s", k, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func identityMFAMethodDelete(d *schema.ResourceData, meta interface{}, config *identityMFAMethodConfig) error {
+	client := meta.(*api.Client)
+	path := identityMFAMethodPath(config.methodType, d.Id())
+
+	log.Printf("[DEBUG] Deleting identity MFA %s method %q", config.methodType, path)
+	if _, err := client.Logical().Delete(path); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error deleting identity MFA %s method %q: %s", config.methodType, path, err)
+	}
+	log.Printf("[DEBUG] Deleted identity MFA %s method %q", config.methodType, path)
+
+	return nil
+}
+
+func identityMFAMethodTypePath(methodType string) string {
+	return identityMFAMethodBasePath + "/" + methodType
+}
+
+func identityMFAMethodPath(methodType, id string) string {
+	return identityMFAMethodTypePath(methodType) + "/" + strings.Trim(id, "/")
+}
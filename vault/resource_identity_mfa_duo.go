@@ -0,0 +1,56 @@
+package vault
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func identityMFADuoResource() *schema.Resource {
+	return identityMFAMethodResource(&identityMFAMethodConfig{
+		methodType: "duo",
+		schema: map[string]*schema.Schema{
+			"secret_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Secret key for Duo.",
+			},
+			"integration_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Integration key for Duo.",
+			},
+			"api_hostname": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "API hostname for Duo.",
+			},
+			"username_format": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A template string for mapping Identity names to MFA methods.",
+			},
+			"push_info": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Push information for Duo.",
+			},
+			"use_passcode": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Require passcode upon MFA validation.",
+			},
+		},
+		fields: []string{
+			"api_hostname",
+			"username_format",
+			"push_info",
+			"use_passcode",
+		},
+		// the Duo keys can't be read back from Vault.
+		writeOnlyFields: []string{
+			"secret_key",
+			"integration_key",
+		},
+	})
+}
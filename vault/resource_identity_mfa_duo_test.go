@@ -0,0 +1,52 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccIdentityMFADuo(t *testing.T) {
+	resourceName := "vault_identity_mfa_duo.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityMFAMethodDestroy("duo"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityMFADuoConfig("api-2b5c39f5.duosecurity.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "method_id"),
+					resource.TestCheckResourceAttr(resourceName, "type", "duo"),
+					resource.TestCheckResourceAttr(resourceName, "api_hostname", "api-2b5c39f5.duosecurity.com"),
+					resource.TestCheckResourceAttr(resourceName, "use_passcode", "true"),
+				),
+			},
+			{
+				Config: testAccIdentityMFADuoConfig("api-3c6d40g6.duosecurity.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "api_hostname", "api-3c6d40g6.duosecurity.com"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret_key", "integration_key"},
+			},
+		},
+	})
+}
+
+func testAccIdentityMFADuoConfig(hostname string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_mfa_duo" "test" {
+  secret_key      = "8C7THtrIigh2rPZQMbguugt8IUftWhMRCOBzbuyz"
+  integration_key = "BIACEUEAXI20BNWTEYXT"
+  api_hostname    = "%s"
+  use_passcode    = true
+}
+`, hostname)
+}
@@ -0,0 +1,138 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+var identityMFALoginEnforcementFields = []string{
+	"mfa_method_ids",
+	"auth_method_accessors",
+	"auth_method_types",
+	"identity_group_ids",
+	"identity_entity_ids",
+}
+
+func identityMFALoginEnforcementResource() *schema.Resource {
+	stringSet := func(description string) *schema.Schema {
+		return &schema.Schema{
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: description,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		}
+	}
+
+	fields := map[string]*schema.Schema{
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			Description:  "Login enforcement name.",
+			ValidateFunc: validateNoTrailingSlash,
+		},
+		"mfa_method_ids": {
+			Type:        schema.TypeSet,
+			Required:    true,
+			Description: "Set of MFA method UUIDs.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"auth_method_accessors": stringSet("Set of auth method accessor IDs."),
+		"auth_method_types":     stringSet("Set of auth method types."),
+		"identity_group_ids":    stringSet("Set of identity group IDs."),
+		"identity_entity_ids":   stringSet("Set of identity entity IDs."),
+		"namespace_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Method's namespace ID.",
+		},
+	}
+
+	return &schema.Resource{
+		Create: identityMFALoginEnforcementWrite,
+		Read:   identityMFALoginEnforcementRead,
+		Update: identityMFALoginEnforcementWrite,
+		Delete: identityMFALoginEnforcementDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: fields,
+	}
+}
+
+func identityMFALoginEnforcementWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Get("name").(string)
+	path := identityMFALoginEnforcementPath(name)
+
+	data := map[string]interface{}{}
+	for _, k := range identityMFALoginEnforcementFields {
+		data[k] = d.Get(k).(*schema.Set).List()
+	}
+
+	log.Printf("[DEBUG] Writing identity MFA login enforcement %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing identity MFA login enforcement %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote identity MFA login enforcement %q", path)
+
+	d.SetId(name)
+
+	return identityMFALoginEnforcementRead(d, meta)
+}
+
+func identityMFALoginEnforcementRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := identityMFALoginEnforcementPath(d.Id())
+
+	log.Printf("[DEBUG] Reading identity MFA login enforcement %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading identity MFA login enforcement %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read identity MFA login enforcement %q", path)
+
+	if resp == nil {
+		log.Printf("[WARN] Identity MFA login enforcement %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", d.Id())
+	d.Set("namespace_id", resp.Data["namespace_id"])
+	for _, k := range identityMFALoginEnforcementFields {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return fmt.Errorf("error setting state key %q: %s", k, err)
+		}
+	}
+
+	return nil
+}
+
+func identityMFALoginEnforcementDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := identityMFALoginEnforcementPath(d.Id())
+
+	log.Printf("[DEBUG] Deleting identity MFA login enforcement %q", path)
+	if _, err := client.Logical().Delete(path); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error deleting identity MFA login enforcement %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted identity MFA login enforcement %q", path)
+
+	return nil
+}
+
+func identityMFALoginEnforcementPath(name string) string {
+	return "identity/mfa/login-enforcement/" + strings.Trim(name, "/")
+}
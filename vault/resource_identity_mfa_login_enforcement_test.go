@@ -0,0 +1,76 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccIdentityMFALoginEnforcement(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-enforcement")
+	resourceName := "vault_identity_mfa_login_enforcement.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityMFALoginEnforcementDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityMFALoginEnforcementConfig(name, `["userpass"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "mfa_method_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auth_method_types.#", "1"),
+				),
+			},
+			{
+				Config: testAccIdentityMFALoginEnforcementConfig(name, `["userpass", "ldap"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "auth_method_types.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityMFALoginEnforcementDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_identity_mfa_login_enforcement" {
+			continue
+		}
+		path := identityMFALoginEnforcementPath(rs.Primary.ID)
+		resp, err := client.Logical().Read(path)
+		if err != nil {
+			return fmt.Errorf("error checking for identity MFA login enforcement %q: %s", path, err)
+		}
+		if resp != nil {
+			return fmt.Errorf("identity MFA login enforcement %q still exists", path)
+		}
+	}
+	return nil
+}
+
+func testAccIdentityMFALoginEnforcementConfig(name, authMethodTypes string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_mfa_totp" "test" {
+  issuer = "%s"
+}
+
+resource "vault_identity_mfa_login_enforcement" "test" {
+  name              = "%s"
+  mfa_method_ids    = [vault_identity_mfa_totp.test.method_id]
+  auth_method_types = %s
+}
+`, name, name, authMethodTypes)
+}
@@ -0,0 +1,50 @@
+package vault
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func identityMFAOktaResource() *schema.Resource {
+	return identityMFAMethodResource(&identityMFAMethodConfig{
+		methodType: "okta",
+		schema: map[string]*schema.Schema{
+			"org_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the organization to be used in the Okta API.",
+			},
+			"api_token": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Okta API token.",
+			},
+			"base_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The base domain to use for API requests, such as okta.com or oktapreview.com.",
+			},
+			"username_format": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A template string for mapping Identity names to MFA methods.",
+			},
+			"primary_email": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only match the primary email for the account.",
+			},
+		},
+		fields: []string{
+			"org_name",
+			"base_url",
+			"username_format",
+			"primary_email",
+		},
+		// the API token can't be read back from Vault.
+		writeOnlyFields: []string{
+			"api_token",
+		},
+	})
+}
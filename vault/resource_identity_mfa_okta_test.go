@@ -0,0 +1,53 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccIdentityMFAOkta(t *testing.T) {
+	org := acctest.RandomWithPrefix("tf-org")
+	resourceName := "vault_identity_mfa_okta.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityMFAMethodDestroy("okta"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityMFAOktaConfig(org, "okta.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "method_id"),
+					resource.TestCheckResourceAttr(resourceName, "type", "okta"),
+					resource.TestCheckResourceAttr(resourceName, "org_name", org),
+					resource.TestCheckResourceAttr(resourceName, "base_url", "okta.com"),
+				),
+			},
+			{
+				Config: testAccIdentityMFAOktaConfig(org, "oktapreview.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "base_url", "oktapreview.com"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"api_token"},
+			},
+		},
+	})
+}
+
+func testAccIdentityMFAOktaConfig(org, baseURL string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_mfa_okta" "test" {
+  org_name  = "%s"
+  api_token = "token1"
+  base_url  = "%s"
+}
+`, org, baseURL)
+}
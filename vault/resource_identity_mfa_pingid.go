@@ -0,0 +1,64 @@
+package vault
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func identityMFAPingIDResource() *schema.Resource {
+	return identityMFAMethodResource(&identityMFAMethodConfig{
+		methodType: "pingid",
+		schema: map[string]*schema.Schema{
+			"settings_file_base64": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "A base64-encoded third-party settings contents as retrieved from PingID's configuration page.",
+			},
+			"username_format": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A template string for mapping Identity names to MFA methods.",
+			},
+			"use_signature": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Use signature value from the settings file.",
+			},
+			"idp_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The IDP URL from the settings file.",
+			},
+			"admin_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The admin URL from the settings file.",
+			},
+			"authenticator_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The authenticator URL from the settings file.",
+			},
+			"org_alias": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The org alias from the settings file.",
+			},
+		},
+		fields: []string{
+			"username_format",
+		},
+		// the settings file can't be read back from Vault, only the values
+		// parsed out of it.
+		writeOnlyFields: []string{
+			"settings_file_base64",
+		},
+		computedFields: []string{
+			"use_signature",
+			"idp_url",
+			"admin_url",
+			"authenticator_url",
+			"org_alias",
+		},
+	})
+}
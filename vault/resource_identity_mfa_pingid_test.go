@@ -0,0 +1,54 @@
+package vault
+
+import (
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+const testPingIDSettings = `use_base64_key=YmFzZTY0a2V5
+use_signature=true
+token=token1
+idp_url=https://idpxnyl3m.pingidentity.com/pingid
+org_alias=org-alias
+admin_url=https://idpxnyl3m.pingidentity.com/pingid
+authenticator_url=https://authenticator.pingone.com/pingid/ppm
+`
+
+func TestAccIdentityMFAPingID(t *testing.T) {
+	resourceName := "vault_identity_mfa_pingid.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityMFAMethodDestroy("pingid"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityMFAPingIDConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "method_id"),
+					resource.TestCheckResourceAttr(resourceName, "type", "pingid"),
+					resource.TestCheckResourceAttr(resourceName, "use_signature", "true"),
+					resource.TestCheckResourceAttr(resourceName, "org_alias", "org-alias"),
+					resource.TestCheckResourceAttr(resourceName, "idp_url", "https://idpxnyl3m.pingidentity.com/pingid"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"settings_file_base64"},
+			},
+		},
+	})
+}
+
+func testAccIdentityMFAPingIDConfig() string {
+	return fmt.Sprintf(`
+resource "vault_identity_mfa_pingid" "test" {
+  settings_file_base64 = "%s"
+}
+`, base64.StdEncoding.EncodeToString([]byte(testPingIDSettings)))
+}
@@ -0,0 +1,30 @@
+package vault
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func testAccCheckIdentityMFAMethodDestroy(methodType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testProvider.Meta().(*api.Client)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "vault_identity_mfa_"+methodType {
+				continue
+			}
+			path := identityMFAMethodPath(methodType, rs.Primary.ID)
+			resp, err := client.Logical().Read(path)
+			if err != nil {
+				return fmt.Errorf("error checking for identity MFA %s method %q: %s", methodType, path, err)
+			}
+			if resp != nil {
+				return fmt.Errorf("identity MFA %s method %q still exists", methodType, path)
+			}
+		}
+		return nil
+	}
+}
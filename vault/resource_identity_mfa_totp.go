@@ -0,0 +1,74 @@
+package vault
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func identityMFATOTPResource() *schema.Resource {
+	return identityMFAMethodResource(&identityMFAMethodConfig{
+		methodType: "totp",
+		schema: map[string]*schema.Schema{
+			"issuer": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the key's issuing organization.",
+			},
+			"period": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The length of time in seconds used to generate a counter for the TOTP token calculation.",
+			},
+			"key_size": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies the size in bytes of the generated key.",
+			},
+			"qr_size": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The pixel size of the generated square QR code.",
+			},
+			"algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Specifies the hashing algorithm used to generate the TOTP code.",
+				ValidateFunc: validation.StringInSlice([]string{"SHA1", "SHA256", "SHA512"}, false),
+			},
+			"digits": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The number of digits in the generated TOTP token.",
+				ValidateFunc: validation.IntInSlice([]int{6, 8}),
+			},
+			"skew": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The number of delay periods that are allowed when validating a TOTP token.",
+				ValidateFunc: validation.IntInSlice([]int{0, 1}),
+			},
+			"max_validation_attempts": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The maximum number of consecutive failed validation attempts allowed.",
+			},
+		},
+		fields: []string{
+			"issuer",
+			"period",
+			"key_size",
+			"qr_size",
+			"algorithm",
+			"digits",
+			"skew",
+			"max_validation_attempts",
+		},
+	})
+}
@@ -0,0 +1,55 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccIdentityMFATOTP(t *testing.T) {
+	issuer := acctest.RandomWithPrefix("tf-issuer")
+	resourceName := "vault_identity_mfa_totp.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityMFAMethodDestroy("totp"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityMFATOTPConfig(issuer, 30),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "method_id"),
+					resource.TestCheckResourceAttr(resourceName, "type", "totp"),
+					resource.TestCheckResourceAttr(resourceName, "issuer", issuer),
+					resource.TestCheckResourceAttr(resourceName, "period", "30"),
+					resource.TestCheckResourceAttr(resourceName, "algorithm", "SHA256"),
+					resource.TestCheckResourceAttr(resourceName, "digits", "8"),
+				),
+			},
+			{
+				Config: testAccIdentityMFATOTPConfig(issuer, 60),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "period", "60"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccIdentityMFATOTPConfig(issuer string, period int) string {
+	return fmt.Sprintf(`
+resource "vault_identity_mfa_totp" "test" {
+  issuer    = "%s"
+  period    = %d
+  algorithm = "SHA256"
+  digits    = 8
+}
+`, issuer, period)
+}
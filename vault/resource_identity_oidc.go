@@ -17,6 +17,9 @@ func identityOidc() *schema.Resource {
 		Read:   identityOidcRead,
 		Delete: identityOidcDelete,
 		Exists: identityOidcExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"issuer": {
@@ -0,0 +1,119 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+var identityOidcAssignmentFields = []string{
+	"entity_ids",
+	"group_ids",
+}
+
+func identityOidcAssignmentResource() *schema.Resource {
+	return &schema.Resource{
+		Create: identityOidcAssignmentWrite,
+		Update: identityOidcAssignmentWrite,
+		Read:   identityOidcAssignmentRead,
+		Delete: identityOidcAssignmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the assignment.",
+			},
+			"entity_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "A list of Vault entity IDs.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"group_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "A list of Vault group IDs.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func identityOidcAssignmentWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Get("name").(string)
+	path := identityOidcAssignmentPath(name)
+
+	data := map[string]interface{}{}
+	for _, k := range identityOidcAssignmentFields {
+		data[k] = d.Get(k).(*schema.Set).List()
+	}
+
+	log.Printf("[DEBUG] Writing identity OIDC assignment %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing identity OIDC assignment %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote identity OIDC assignment %q", path)
+
+	d.SetId(name)
+
+	return identityOidcAssignmentRead(d, meta)
+}
+
+func identityOidcAssignmentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := identityOidcAssignmentPath(d.Id())
+
+	log.Printf("[DEBUG] Reading identity OIDC assignment %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading identity OIDC assignment %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read identity OIDC assignment %q", path)
+
+	if resp == nil {
+		log.Printf("[WARN] Identity OIDC assignment %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", d.Id())
+	for _, k := range identityOidcAssignmentFields {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return fmt.Errorf("error setting state key %q: %s", k, err)
+		}
+	}
+
+	return nil
+}
+
+func identityOidcAssignmentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := identityOidcAssignmentPath(d.Id())
+
+	log.Printf("[DEBUG] Deleting identity OIDC assignment %q", path)
+	if _, err := client.Logical().Delete(path); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error deleting identity OIDC assignment %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted identity OIDC assignment %q", path)
+
+	return nil
+}
+
+func identityOidcAssignmentPath(name string) string {
+	return "identity/oidc/assignment/" + strings.Trim(name, "/")
+}
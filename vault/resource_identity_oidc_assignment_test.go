@@ -0,0 +1,67 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccIdentityOidcAssignment(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-assignment")
+	resourceName := "vault_identity_oidc_assignment.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityOidcResourceDestroy("vault_identity_oidc_assignment", identityOidcAssignmentPath),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityOidcAssignmentConfig(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "entity_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "group_ids.#", "0"),
+				),
+			},
+			{
+				Config: testAccIdentityOidcAssignmentConfig(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "entity_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "group_ids.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccIdentityOidcAssignmentConfig(name string, withGroup bool) string {
+	groupIDs := "[]"
+	if withGroup {
+		groupIDs = "[vault_identity_group.test.id]"
+	}
+
+	return fmt.Sprintf(`
+resource "vault_identity_entity" "test" {
+  name     = "%[1]s"
+  policies = ["test"]
+}
+
+resource "vault_identity_group" "test" {
+  name     = "%[1]s"
+  policies = ["test"]
+}
+
+resource "vault_identity_oidc_assignment" "test" {
+  name       = "%[1]s"
+  entity_ids = [vault_identity_entity.test.id]
+  group_ids  = %[2]s
+}
+`, name, groupIDs)
+}
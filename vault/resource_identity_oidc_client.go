@@ -0,0 +1,168 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+var identityOidcClientFields = []string{
+	"key",
+	"redirect_uris",
+	"assignments",
+	"id_token_ttl",
+	"access_token_ttl",
+	"client_type",
+}
+
+func identityOidcClientResource() *schema.Resource {
+	return &schema.Resource{
+		Create: identityOidcClientWrite,
+		Update: identityOidcClientWrite,
+		Read:   identityOidcClientRead,
+		Delete: identityOidcClientDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the client.",
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "A reference to a named key resource in Vault. This cannot be modified after creation.",
+			},
+			"redirect_uris": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Redirection URI values used by the client. One of these values must exactly match the redirect_uri parameter value used in each authentication request.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"assignments": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "A list of assignment resources associated with the client.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"id_token_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The time-to-live for ID tokens obtained by the client, in seconds.",
+			},
+			"access_token_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The time-to-live for access tokens obtained by the client, in seconds.",
+			},
+			"client_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "The client type based on its ability to maintain confidentiality of credentials, either \"confidential\" or \"public\".",
+				ValidateFunc: validation.StringInSlice([]string{"confidential", "public"}, false),
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Client ID from Vault.",
+			},
+			"client_secret": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The Client Secret from Vault.",
+			},
+		},
+	}
+}
+
+func identityOidcClientWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Get("name").(string)
+	path := identityOidcClientPath(name)
+
+	data := map[string]interface{}{}
+	for _, k := range identityOidcClientFields {
+		if v, ok := d.GetOk(k); ok || d.HasChange(k) {
+			if set, ok := v.(*schema.Set); ok {
+				v = set.List()
+			}
+			data[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Writing identity OIDC client %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing identity OIDC client %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote identity OIDC client %q", path)
+
+	d.SetId(name)
+
+	return identityOidcClientRead(d, meta)
+}
+
+func identityOidcClientRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := identityOidcClientPath(d.Id())
+
+	log.Printf("[DEBUG] Reading identity OIDC client %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading identity OIDC client %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read identity OIDC client %q", path)
+
+	if resp == nil {
+		log.Printf("[WARN] Identity OIDC client %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", d.Id())
+	for _, k := range append([]string{"client_id", "client_secret"}, identityOidcClientFields...) {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting state key %q: %s", k, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func identityOidcClientDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := identityOidcClientPath(d.Id())
+
+	log.Printf("[DEBUG] Deleting identity OIDC client %q", path)
+	if _, err := client.Logical().Delete(path); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error deleting identity OIDC client %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted identity OIDC client %q", path)
+
+	return nil
+}
+
+func identityOidcClientPath(name string) string {
+	return "identity/oidc/client/" + strings.Trim(name, "/")
+}
@@ -0,0 +1,68 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccIdentityOidcClient(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-client")
+	resourceName := "vault_identity_oidc_client.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityOidcResourceDestroy("vault_identity_oidc_client", identityOidcClientPath),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityOidcClientConfig(name, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "key", name),
+					resource.TestCheckResourceAttr(resourceName, "redirect_uris.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "assignments.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "id_token_ttl", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "access_token_ttl", "7200"),
+					resource.TestCheckResourceAttrSet(resourceName, "client_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "client_secret"),
+				),
+			},
+			{
+				Config: testAccIdentityOidcClientConfig(name, 1800),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id_token_ttl", "1800"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccIdentityOidcClientConfig(name string, idTokenTTL int) string {
+	return fmt.Sprintf(`
+resource "vault_identity_oidc_key" "test" {
+  name               = "%[1]s"
+  allowed_client_ids = ["*"]
+}
+
+resource "vault_identity_oidc_assignment" "test" {
+  name = "%[1]s"
+}
+
+resource "vault_identity_oidc_client" "test" {
+  name             = "%[1]s"
+  key              = vault_identity_oidc_key.test.name
+  redirect_uris    = ["http://127.0.0.1:9200/v1/auth-methods/oidc:authenticate:callback", "http://127.0.0.1:8251/callback"]
+  assignments      = [vault_identity_oidc_assignment.test.name]
+  id_token_ttl     = %[2]d
+  access_token_ttl = 7200
+}
+`, name, idTokenTTL)
+}
@@ -67,6 +67,12 @@ func identityOidcKey() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 			},
+
+			"rotate_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Arbitrary value that causes the key to be rotated whenever it changes.",
+			},
 		},
 	}
 }
@@ -119,6 +125,12 @@ func identityOidcKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
+	if d.HasChange("rotate_trigger") {
+		if err := identityOidcKeyApiRotate(name, d.Get("verification_ttl").(int), client); err != nil {
+			return err
+		}
+	}
+
 	return identityOidcKeyRead(d, meta)
 }
 
@@ -220,3 +232,18 @@ func identityOidcKeyApiWrite(name string, data map[string]interface{}, client *a
 
 	return nil
 }
+
+func identityOidcKeyApiRotate(name string, verificationTTL int, client *api.Client) error {
+	path := identityOidcKeyPath(name) + "/rotate"
+
+	log.Printf("[DEBUG] Rotating IdentityOidcKey %s at %s", name, path)
+	_, err := client.Logical().Write(path, map[string]interface{}{
+		"verification_ttl": verificationTTL,
+	})
+	if err != nil {
+		return fmt.Errorf("error rotating IdentityOidcKey %s: %s", name, err)
+	}
+	log.Printf("[DEBUG] Rotated IdentityOidcKey %q", name)
+
+	return nil
+}
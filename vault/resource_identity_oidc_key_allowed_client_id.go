@@ -3,6 +3,7 @@ package vault
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-provider-vault/util"
@@ -14,6 +15,9 @@ func identityOidcKeyAllowedClientId() *schema.Resource {
 		Create: identityOidcKeyAllowedClientIdWrite,
 		Read:   identityOidcKeyAllowedClientIdRead,
 		Delete: identityOidcKeyAllowedClientIdDelete,
+		Importer: &schema.ResourceImporter{
+			State: identityOidcKeyAllowedClientIdImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"key_name": {
@@ -90,6 +94,20 @@ func identityOidcKeyAllowedClientIdRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
+// identityOidcKeyAllowedClientIdImport parses the ID built by
+// identityOidcKeyAllowedClientIdStateId, key names can't contain a slash.
+func identityOidcKeyAllowedClientIdImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("invalid ID %q, expected <key_name>/<allowed_client_id>", d.Id())
+	}
+
+	d.Set("key_name", parts[0])
+	d.Set("allowed_client_id", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func identityOidcKeyAllowedClientIdDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 	name := d.Get("key_name").(string)
@@ -30,6 +30,11 @@ func TestAccIdentityOidcKeyAllowedClientId(t *testing.T) {
 					testAccIdentityOidcKeyAllowedClientIdCheckAttrs("vault_identity_oidc_key_allowed_client_id.role_three", 3),
 				),
 			},
+			{
+				ResourceName:      "vault_identity_oidc_key_allowed_client_id.role_one",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccIdentityOidcKeyAllowedClientIdRemove(name),
 				Check: resource.ComposeTestCheckFunc(
@@ -90,6 +90,57 @@ func TestAccIdentityOidcKeyUpdate(t *testing.T) {
 	})
 }
 
+func TestAccIdentityOidcKeyRotate(t *testing.T) {
+	key := acctest.RandomWithPrefix("test-key")
+
+	var keyCount int
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityOidcKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityOidcKeyConfigRotate(key, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccIdentityOidcKeyCheckAttrs(),
+					resource.TestCheckResourceAttr("vault_identity_oidc_key.key", "rotate_trigger", "1"),
+					testAccIdentityOidcKeyCountPublicKeys(&keyCount, false),
+				),
+			},
+			{
+				Config: testAccIdentityOidcKeyConfigRotate(key, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccIdentityOidcKeyCheckAttrs(),
+					resource.TestCheckResourceAttr("vault_identity_oidc_key.key", "rotate_trigger", "2"),
+					testAccIdentityOidcKeyCountPublicKeys(&keyCount, true),
+				),
+			},
+		},
+	})
+}
+
+// testAccIdentityOidcKeyCountPublicKeys stores the number of public keys
+// published by Vault in count, when rotated is true it also checks that a new
+// key has been published since the last call.
+func testAccIdentityOidcKeyCountPublicKeys(count *int, rotated bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testProvider.Meta().(*api.Client)
+
+		var jwks struct {
+			Keys []interface{} `json:"keys"`
+		}
+		if err := identityOidcRawRead(client, identityOidcPublicKeysPath, &jwks); err != nil {
+			return err
+		}
+
+		if rotated && len(jwks.Keys) <= *count {
+			return fmt.Errorf("expected more than %d public keys after rotation, got %d", *count, len(jwks.Keys))
+		}
+		*count = len(jwks.Keys)
+		return nil
+	}
+}
+
 func testAccCheckIdentityOidcKeyDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -236,3 +287,12 @@ resource "vault_identity_oidc_key" "key" {
 	allowed_client_ids = ["*"]
 }`, entityName)
 }
+
+func testAccIdentityOidcKeyConfigRotate(entityName, trigger string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_oidc_key" "key" {
+  name           = "%s"
+  algorithm      = "RS256"
+  rotate_trigger = "%s"
+}`, entityName, trigger)
+}
@@ -0,0 +1,157 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+var identityOidcProviderFields = []string{
+	"allowed_client_ids",
+	"scopes_supported",
+}
+
+func identityOidcProviderResource() *schema.Resource {
+	return &schema.Resource{
+		Create: identityOidcProviderWrite,
+		Update: identityOidcProviderWrite,
+		Read:   identityOidcProviderRead,
+		Delete: identityOidcProviderDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the provider.",
+			},
+			"https_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Set to true if the issuer endpoint uses HTTPS.",
+			},
+			"issuer_host": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The host:port component for the iss claim of ID tokens. Defaults to Vault's api_addr.",
+			},
+			"issuer": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The issuer of the OIDC provider, used in the iss claim of ID tokens.",
+			},
+			"allowed_client_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The client IDs that are permitted to use the provider. If \"*\" all clients are allowed.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"scopes_supported": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The scopes available for requesting on the provider.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func identityOidcProviderWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Get("name").(string)
+	path := identityOidcProviderPath(name)
+
+	data := map[string]interface{}{
+		"allowed_client_ids": d.Get("allowed_client_ids").(*schema.Set).List(),
+		"scopes_supported":   d.Get("scopes_supported").(*schema.Set).List(),
+	}
+	if v, ok := d.GetOk("issuer_host"); ok {
+		scheme := "http"
+		if d.Get("https_enabled").(bool) {
+			scheme = "https"
+		}
+		data["issuer"] = scheme + "://" + v.(string)
+	} else {
+		data["issuer"] = ""
+	}
+
+	log.Printf("[DEBUG] Writing identity OIDC provider %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing identity OIDC provider %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote identity OIDC provider %q", path)
+
+	d.SetId(name)
+
+	return identityOidcProviderRead(d, meta)
+}
+
+func identityOidcProviderRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := identityOidcProviderPath(d.Id())
+
+	log.Printf("[DEBUG] Reading identity OIDC provider %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading identity OIDC provider %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read identity OIDC provider %q", path)
+
+	if resp == nil {
+		log.Printf("[WARN] Identity OIDC provider %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", d.Id())
+	issuer, _ := resp.Data["issuer"].(string)
+	d.Set("issuer", issuer)
+	// the issuer host is only tracked when it was configured, otherwise it
+	// defaults to Vault's api_addr.
+	if _, ok := d.GetOk("issuer_host"); ok && issuer != "" {
+		u, err := url.Parse(issuer)
+		if err != nil {
+			return fmt.Errorf("error parsing issuer %q of identity OIDC provider %q: %s", issuer, path, err)
+		}
+		d.Set("issuer_host", u.Host)
+		d.Set("https_enabled", u.Scheme == "https")
+	}
+	for _, k := range identityOidcProviderFields {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return fmt.Errorf("error setting state key %q: %s", k, err)
+		}
+	}
+
+	return nil
+}
+
+func identityOidcProviderDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := identityOidcProviderPath(d.Id())
+
+	log.Printf("[DEBUG] Deleting identity OIDC provider %q", path)
+	if _, err := client.Logical().Delete(path); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error deleting identity OIDC provider %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted identity OIDC provider %q", path)
+
+	return nil
+}
+
+func identityOidcProviderPath(name string) string {
+	return "identity/oidc/provider/" + strings.Trim(name, "/")
+}
@@ -0,0 +1,73 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccIdentityOidcProvider(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-provider")
+	resourceName := "vault_identity_oidc_provider.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityOidcResourceDestroy("vault_identity_oidc_provider", identityOidcProviderPath),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityOidcProviderConfig(name, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "scopes_supported.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_client_ids.#", "1"),
+					resource.TestMatchResourceAttr(resourceName, "issuer", regexp.MustCompile("/v1/identity/oidc/provider/"+name+"$")),
+				),
+			},
+			{
+				Config: testAccIdentityOidcProviderConfig(name, `issuer_host = "example.com:8200"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "issuer_host", "example.com:8200"),
+					resource.TestCheckResourceAttr(resourceName, "https_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "issuer", "https://example.com:8200/v1/identity/oidc/provider/"+name),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"issuer_host", "https_enabled"},
+			},
+		},
+	})
+}
+
+func testAccIdentityOidcProviderConfig(name, extra string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_oidc_key" "test" {
+  name               = "%[1]s"
+  allowed_client_ids = ["*"]
+}
+
+resource "vault_identity_oidc_client" "test" {
+  name          = "%[1]s"
+  key           = vault_identity_oidc_key.test.name
+  redirect_uris = ["http://127.0.0.1:8251/callback"]
+}
+
+resource "vault_identity_oidc_scope" "test" {
+  name     = "%[1]s"
+  template = jsonencode({ groups = "{{identity.entity.groups.names}}" })
+}
+
+resource "vault_identity_oidc_provider" "test" {
+  name               = "%[1]s"
+  allowed_client_ids = [vault_identity_oidc_client.test.client_id]
+  scopes_supported   = [vault_identity_oidc_scope.test.name]
+  %[2]s
+}
+`, name, extra)
+}
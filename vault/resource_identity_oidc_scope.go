@@ -0,0 +1,114 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+var identityOidcScopeFields = []string{
+	"template",
+	"description",
+}
+
+func identityOidcScopeResource() *schema.Resource {
+	return &schema.Resource{
+		Create: identityOidcScopeWrite,
+		Update: identityOidcScopeWrite,
+		Read:   identityOidcScopeRead,
+		Delete: identityOidcScopeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the scope. The openid scope name is reserved.",
+			},
+			"template": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "The JSON template string for the scope. This may be provided as escaped JSON or base64 encoded JSON.",
+				DiffSuppressFunc: util.JsonDiffSuppress,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The scope's description.",
+			},
+		},
+	}
+}
+
+func identityOidcScopeWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Get("name").(string)
+	path := identityOidcScopePath(name)
+
+	data := map[string]interface{}{}
+	for _, k := range identityOidcScopeFields {
+		data[k] = d.Get(k)
+	}
+
+	log.Printf("[DEBUG] Writing identity OIDC scope %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing identity OIDC scope %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote identity OIDC scope %q", path)
+
+	d.SetId(name)
+
+	return identityOidcScopeRead(d, meta)
+}
+
+func identityOidcScopeRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := identityOidcScopePath(d.Id())
+
+	log.Printf("[DEBUG] Reading identity OIDC scope %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading identity OIDC scope %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read identity OIDC scope %q", path)
+
+	if resp == nil {
+		log.Printf("[WARN] Identity OIDC scope %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", d.Id())
+	for _, k := range identityOidcScopeFields {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return fmt.Errorf("error setting state key %q: %s", k, err)
+		}
+	}
+
+	return nil
+}
+
+func identityOidcScopeDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := identityOidcScopePath(d.Id())
+
+	log.Printf("[DEBUG] Deleting identity OIDC scope %q", path)
+	if _, err := client.Logical().Delete(path); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error deleting identity OIDC scope %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted identity OIDC scope %q", path)
+
+	return nil
+}
+
+func identityOidcScopePath(name string) string {
+	return "identity/oidc/scope/" + strings.Trim(name, "/")
+}
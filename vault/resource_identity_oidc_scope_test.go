@@ -0,0 +1,76 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccIdentityOidcScope(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-scope")
+	resourceName := "vault_identity_oidc_scope.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityOidcResourceDestroy("vault_identity_oidc_scope", identityOidcScopePath),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityOidcScopeConfig(name, "groups scope"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "description", "groups scope"),
+					resource.TestCheckResourceAttrSet(resourceName, "template"),
+				),
+			},
+			{
+				Config: testAccIdentityOidcScopeConfig(name, "updated groups scope"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "updated groups scope"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccCheckIdentityOidcResourceDestroy checks that the identity OIDC resources of
+// the given type were removed from Vault.
+func testAccCheckIdentityOidcResourceDestroy(resourceType string, pathFunc func(string) string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testProvider.Meta().(*api.Client)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+			path := pathFunc(rs.Primary.ID)
+			resp, err := client.Logical().Read(path)
+			if err != nil {
+				return fmt.Errorf("error checking for %s %q: %s", resourceType, path, err)
+			}
+			if resp != nil {
+				return fmt.Errorf("%s %q still exists", resourceType, path)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccIdentityOidcScopeConfig(name, description string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_oidc_scope" "test" {
+  name        = "%s"
+  template    = jsonencode({ groups = "{{identity.entity.groups.names}}" })
+  description = "%s"
+}
+`, name, description)
+}
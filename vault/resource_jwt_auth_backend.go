@@ -30,8 +30,8 @@ func jwtAuthBackendResource() *schema.Resource {
 				Optional:     true,
 				ForceNew:     true,
 				Description:  "path to mount the backend",
+				ValidateFunc: validation.All(validateNoTrailingSlash, validateMountPath),
 				Default:      "jwt",
-				ValidateFunc: validateNoTrailingSlash,
 			},
 
 			"type": {
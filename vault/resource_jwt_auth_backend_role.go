@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/terraform-provider-vault/util"
 	"github.com/hashicorp/vault/api"
 )
@@ -44,6 +45,12 @@ func jwtAuthBackendRoleResource() *schema.Resource {
 			Required:    true,
 			Description: "The claim to use to uniquely identify the user; this will be used as the name for the Identity entity alias created due to a successful login.",
 		},
+		"user_claim_json_pointer": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Specifies if the user_claim value uses JSON pointer syntax for referencing claims.",
+		},
 		"clock_skew_leeway": {
 			Type:        schema.TypeInt,
 			Optional:    true,
@@ -110,6 +117,18 @@ func jwtAuthBackendRoleResource() *schema.Resource {
 			Description: "A pattern of delimiters used to allow the groups_claim to live outside of the top-level JWT structure. For instance, a groups_claim of meta/user.name/groups with this field set to // will expect nested structures named meta, user.name, and groups. If this field was set to /./ the groups information would expect to be via nested structures of meta, user, name, and groups.",
 			Deprecated:  "`groups_claim_delimiter_pattern` has been removed since Vault 1.1. If the groups claim is not at the top level, it can now be specified as a JSONPointer.",
 		},
+		"max_age": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "Specifies the allowable elapsed time in seconds since the last time the user was actively authenticated with the OIDC provider. Only applicable with 'oidc' roles.",
+		},
+		"callback_mode": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "The callback mode of the role, either \"client\" (default), \"direct\" or \"device\". Only applicable with 'oidc' roles.",
+			ValidateFunc: validation.StringInSlice([]string{"client", "direct", "device"}, false),
+		},
 		"verbose_oidc_logging": {
 			Type:        schema.TypeBool,
 			Optional:    true,
@@ -172,7 +191,8 @@ func jwtAuthBackendRoleResource() *schema.Resource {
 			Optional:    true,
 			Description: "List of CIDRs valid as the source address for login requests. This value is also encoded into any resulting token.",
 			Elem: &schema.Schema{
-				Type: schema.TypeString,
+				Type:         schema.TypeString,
+				ValidateFunc: validateCIDR,
 			},
 			Deprecated:    "use `token_bound_cidrs` instead if you are running Vault >= 1.2",
 			ConflictsWith: []string{"token_bound_cidrs"},
@@ -413,6 +433,15 @@ func jwtAuthBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
 	if v, ok := resp.Data["verbose_oidc_logging"]; ok {
 		d.Set("verbose_oidc_logging", v)
 	}
+	if v, ok := resp.Data["user_claim_json_pointer"]; ok {
+		d.Set("user_claim_json_pointer", v)
+	}
+	if v, ok := resp.Data["max_age"]; ok {
+		d.Set("max_age", v)
+	}
+	if v, ok := resp.Data["callback_mode"]; ok {
+		d.Set("callback_mode", v)
+	}
 
 	d.Set("backend", backend)
 	d.Set("role_name", role)
@@ -571,6 +600,18 @@ func jwtAuthBackendRoleDataToWrite(d *schema.ResourceData, create bool) map[stri
 	data["not_before_leeway"] = d.Get("not_before_leeway").(int)
 
 	data["verbose_oidc_logging"] = d.Get("verbose_oidc_logging").(bool)
+	data["user_claim_json_pointer"] = d.Get("user_claim_json_pointer").(bool)
+
+	if v, ok := d.GetOk("max_age"); ok {
+		data["max_age"] = v.(int)
+	} else if !create && d.HasChange("max_age") {
+		// clear a previously configured value
+		data["max_age"] = 0
+	}
+
+	if v, ok := d.GetOk("callback_mode"); ok {
+		data["callback_mode"] = v.(string)
+	}
 
 	// Deprecated Fields
 	if dataList := util.TerraformSetToStringArray(d.Get("policies")); len(dataList) > 0 {
@@ -267,7 +267,7 @@ func TestAccJWTAuthBackendRoleOIDC_full(t *testing.T) {
 		CheckDestroy: testAccCheckJWTAuthBackendRoleDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccJWTAuthBackendRoleConfigOIDC_full(backend, role),
+				Config: testAccJWTAuthBackendRoleConfigOIDC_full(backend, role, true),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("vault_jwt_auth_backend_role.role",
 						"backend", backend),
@@ -321,6 +321,19 @@ func TestAccJWTAuthBackendRoleOIDC_full(t *testing.T) {
 						"claim_mappings.preferred_language", "language"),
 					resource.TestCheckResourceAttr("vault_jwt_auth_backend_role.role",
 						"verbose_oidc_logging", "true"),
+					resource.TestCheckResourceAttr("vault_jwt_auth_backend_role.role",
+						"user_claim_json_pointer", "false"),
+					resource.TestCheckResourceAttr("vault_jwt_auth_backend_role.role",
+						"max_age", "3600"),
+					resource.TestCheckResourceAttr("vault_jwt_auth_backend_role.role",
+						"callback_mode", "direct"),
+				),
+			},
+			{
+				Config: testAccJWTAuthBackendRoleConfigOIDC_full(backend, role, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_jwt_auth_backend_role.role",
+						"max_age", "0"),
 				),
 			},
 		},
@@ -616,7 +629,12 @@ resource "vault_jwt_auth_backend_role" "role" {
 }`, backend, role)
 }
 
-func testAccJWTAuthBackendRoleConfigOIDC_full(backend, role string) string {
+func testAccJWTAuthBackendRoleConfigOIDC_full(backend, role string, maxAge bool) string {
+	var maxAgeConfig string
+	if maxAge {
+		maxAgeConfig = "max_age = 3600"
+	}
+
 	return fmt.Sprintf(`
 resource "vault_jwt_auth_backend" "jwt" {
   type = "oidc"
@@ -656,8 +674,10 @@ resource "vault_jwt_auth_backend_role" "role" {
     group = "group"
   }
 
+  %s
+  callback_mode = "direct"
   verbose_oidc_logging = true
-}`, backend, role)
+}`, backend, role, maxAgeConfig)
 }
 
 func testAccJWTAuthBackendRoleConfig_fullUpdate(backend, role string) string {
@@ -0,0 +1,137 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+var kerberosAuthBackendConfigBackendFromPathRegex = regexp.MustCompile("^auth/(.+)/config$")
+
+func kerberosAuthBackendConfigResource() *schema.Resource {
+	return &schema.Resource{
+		Create: kerberosAuthBackendConfigWrite,
+		Read:   kerberosAuthBackendConfigRead,
+		Update: kerberosAuthBackendConfigWrite,
+		Delete: kerberosAuthBackendConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Unique name of the auth backend to configure.",
+				ForceNew:    true,
+				Default:     "kerberos",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"keytab": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Base64 encoded keytab of the service account Vault uses to verify SPNEGO tokens.",
+			},
+			"service_account": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the service account in the keytab, such as vault_svc.",
+			},
+			"remove_instance_name": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Remove instance names from the Kerberos service principal when looking up the keytab entry.",
+			},
+			"add_group_aliases": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Add group aliases to the entity for the LDAP groups of the user on login.",
+			},
+		},
+	}
+}
+
+func kerberosAuthBackendConfigWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	path := kerberosAuthBackendConfigPath(backend)
+
+	data := map[string]interface{}{
+		"keytab":               d.Get("keytab").(string),
+		"service_account":      d.Get("service_account").(string),
+		"remove_instance_name": d.Get("remove_instance_name").(bool),
+		"add_group_aliases":    d.Get("add_group_aliases").(bool),
+	}
+
+	log.Printf("[DEBUG] Writing Kerberos auth backend config to %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing Kerberos auth backend config to %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote Kerberos auth backend config to %q", path)
+
+	d.SetId(path)
+
+	return kerberosAuthBackendConfigRead(d, meta)
+}
+
+func kerberosAuthBackendConfigRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	backend, err := kerberosAuthBackendFromPath(kerberosAuthBackendConfigBackendFromPathRegex, path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q for Kerberos auth backend config: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading Kerberos auth backend config from %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading Kerberos auth backend config from %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read Kerberos auth backend config from %q", path)
+
+	if resp == nil {
+		log.Printf("[WARN] Kerberos auth backend config %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	// The keytab is never returned by Vault, so it is kept as configured.
+	d.Set("backend", backend)
+	for _, k := range []string{"service_account", "remove_instance_name", "add_group_aliases"} {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return fmt.Errorf("error setting state key %q: %s", k, err)
+		}
+	}
+
+	return nil
+}
+
+func kerberosAuthBackendConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	// The config can't be deleted, so removing the resource only removes it
+	// from state. Disabling the auth backend removes the config with it.
+	return nil
+}
+
+func kerberosAuthBackendConfigPath(backend string) string {
+	return "auth/" + strings.Trim(backend, "/") + "/config"
+}
+
+func kerberosAuthBackendFromPath(re *regexp.Regexp, path string) (string, error) {
+	res := re.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("no backend found")
+	}
+	return res[1], nil
+}
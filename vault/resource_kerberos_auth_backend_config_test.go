@@ -0,0 +1,63 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKerberosAuthBackendConfig_basic(t *testing.T) {
+	keytab := os.Getenv("KERBEROS_KEYTAB")
+	serviceAccount := os.Getenv("KERBEROS_SERVICE_ACCOUNT")
+	if keytab == "" || serviceAccount == "" {
+		t.Skip("KERBEROS_KEYTAB and KERBEROS_SERVICE_ACCOUNT must be set to test the Kerberos auth backend config")
+	}
+
+	backend := acctest.RandomWithPrefix("kerberos")
+	resName := "vault_kerberos_auth_backend_config.config"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKerberosAuthBackendConfig(backend, keytab, serviceAccount, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "backend", backend),
+					resource.TestCheckResourceAttr(resName, "service_account", serviceAccount),
+					resource.TestCheckResourceAttr(resName, "add_group_aliases", "false"),
+				),
+			},
+			{
+				Config: testAccKerberosAuthBackendConfig(backend, keytab, serviceAccount, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "add_group_aliases", "true"),
+				),
+			},
+			{
+				ResourceName:            resName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"keytab"},
+			},
+		},
+	})
+}
+
+func testAccKerberosAuthBackendConfig(backend, keytab, serviceAccount string, addGroupAliases bool) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "kerberos" {
+  type = "kerberos"
+  path = "%s"
+}
+
+resource "vault_kerberos_auth_backend_config" "config" {
+  backend           = vault_auth_backend.kerberos.path
+  keytab            = "%s"
+  service_account   = "%s"
+  add_group_aliases = %t
+}
+`, backend, keytab, serviceAccount, addGroupAliases)
+}
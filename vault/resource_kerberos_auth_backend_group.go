@@ -0,0 +1,133 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	kerberosAuthBackendGroupBackendFromPathRegex = regexp.MustCompile("^auth/(.+)/groups/.+$")
+	kerberosAuthBackendGroupNameFromPathRegex    = regexp.MustCompile("^auth/.+/groups/(.+)$")
+)
+
+func kerberosAuthBackendGroupResource() *schema.Resource {
+	return &schema.Resource{
+		Create: kerberosAuthBackendGroupWrite,
+		Update: kerberosAuthBackendGroupWrite,
+		Read:   kerberosAuthBackendGroupRead,
+		Delete: kerberosAuthBackendGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the LDAP group.",
+			},
+			"policies": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Policies granted to members of the LDAP group.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Unique name of the auth backend to configure.",
+				ForceNew:    true,
+				Default:     "kerberos",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+		},
+	}
+}
+
+func kerberosAuthBackendGroupWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+	path := kerberosAuthBackendGroupPath(backend, name)
+
+	data := map[string]interface{}{
+		"policies": util.TerraformSetToStringArray(d.Get("policies")),
+	}
+
+	log.Printf("[DEBUG] Writing Kerberos auth backend group %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing Kerberos auth backend group %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote Kerberos auth backend group %q", path)
+
+	d.SetId(path)
+
+	return kerberosAuthBackendGroupRead(d, meta)
+}
+
+func kerberosAuthBackendGroupRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	backend, err := kerberosAuthBackendFromPath(kerberosAuthBackendGroupBackendFromPathRegex, path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q for Kerberos auth backend group: %s", path, err)
+	}
+
+	res := kerberosAuthBackendGroupNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return fmt.Errorf("invalid path %q for Kerberos auth backend group: no group found", path)
+	}
+	name := res[1]
+
+	log.Printf("[DEBUG] Reading Kerberos auth backend group %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading Kerberos auth backend group %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read Kerberos auth backend group %q", path)
+
+	if resp == nil {
+		log.Printf("[WARN] Kerberos auth backend group %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("name", name)
+	if err := d.Set("policies", resp.Data["policies"]); err != nil {
+		return fmt.Errorf("error setting state key %q: %s", "policies", err)
+	}
+
+	return nil
+}
+
+func kerberosAuthBackendGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting Kerberos auth backend group %q", path)
+	if _, err := client.Logical().Delete(path); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error deleting Kerberos auth backend group %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted Kerberos auth backend group %q", path)
+
+	return nil
+}
+
+func kerberosAuthBackendGroupPath(backend, name string) string {
+	return "auth/" + strings.Trim(backend, "/") + "/groups/" + strings.Trim(name, "/")
+}
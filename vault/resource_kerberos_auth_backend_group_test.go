@@ -0,0 +1,75 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccKerberosAuthBackendGroup_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("kerberos")
+	name := acctest.RandomWithPrefix("group")
+	resName := "vault_kerberos_auth_backend_group.group"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccKerberosAuthBackendGroupCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKerberosAuthBackendGroup(backend, name, `["default"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "backend", backend),
+					resource.TestCheckResourceAttr(resName, "name", name),
+					resource.TestCheckResourceAttr(resName, "policies.#", "1"),
+				),
+			},
+			{
+				Config: testAccKerberosAuthBackendGroup(backend, name, `["default", "dev"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "policies.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccKerberosAuthBackendGroupCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_kerberos_auth_backend_group" {
+			continue
+		}
+		resp, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if resp != nil {
+			return fmt.Errorf("Kerberos auth backend group %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccKerberosAuthBackendGroup(backend, name, policies string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "kerberos" {
+  type = "kerberos"
+  path = "%s"
+}
+
+resource "vault_kerberos_auth_backend_group" "group" {
+  backend  = vault_auth_backend.kerberos.path
+  name     = "%s"
+  policies = %s
+}
+`, backend, name, policies)
+}
@@ -0,0 +1,251 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	kerberosAuthBackendLDAPConfigBackendFromPathRegex = regexp.MustCompile("^auth/(.+)/config/ldap$")
+
+	// fields of the LDAP config that are read back from Vault, bindpass is
+	// never returned.
+	kerberosAuthBackendLDAPConfigFields = []string{
+		"url",
+		"starttls",
+		"tls_min_version",
+		"tls_max_version",
+		"insecure_tls",
+		"certificate",
+		"binddn",
+		"userdn",
+		"userattr",
+		"upndomain",
+		"discoverdn",
+		"deny_null_bind",
+		"groupfilter",
+		"groupdn",
+		"groupattr",
+		"case_sensitive_names",
+		"use_token_groups",
+		"username_as_alias",
+	}
+)
+
+func kerberosAuthBackendLDAPConfigResource() *schema.Resource {
+	return &schema.Resource{
+		Create: kerberosAuthBackendLDAPConfigWrite,
+		Read:   kerberosAuthBackendLDAPConfigRead,
+		Update: kerberosAuthBackendLDAPConfigWrite,
+		Delete: kerberosAuthBackendLDAPConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Unique name of the auth backend to configure.",
+				ForceNew:    true,
+				Default:     "kerberos",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The LDAP server to connect to, such as ldaps://ldap.example.com. Multiple comma-separated URLs are tried in order.",
+			},
+			"starttls": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Issue a StartTLS command after establishing an unencrypted connection.",
+			},
+			"tls_min_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Minimum TLS version to use.",
+			},
+			"tls_max_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum TLS version to use.",
+			},
+			"insecure_tls": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Skip LDAP server SSL certificate verification.",
+			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "CA certificate to use when verifying the LDAP server certificate, PEM encoded.",
+			},
+			"binddn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Distinguished name of the object to bind with when performing the group search.",
+			},
+			"bindpass": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Password to use along with binddn when performing the group search.",
+			},
+			"userdn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Base DN under which to perform the user search.",
+			},
+			"userattr": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Attribute on user objects matching the username passed when authenticating.",
+				StateFunc: func(v interface{}) string {
+					return strings.ToLower(v.(string))
+				},
+			},
+			"upndomain": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The userPrincipalDomain used to construct the UPN string for the authenticating user.",
+			},
+			"discoverdn": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Use anonymous bind to discover the bind DN of a user.",
+			},
+			"deny_null_bind": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Prevent users from bypassing authentication when providing an empty password.",
+			},
+			"groupfilter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Go template used to construct the group membership query.",
+			},
+			"groupdn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Base DN under which to perform the group search.",
+			},
+			"groupattr": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "LDAP attribute to follow on objects returned by groupfilter to enumerate user group membership.",
+			},
+			"case_sensitive_names": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Treat user and group names as case sensitive.",
+			},
+			"use_token_groups": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Use the Active Directory tokenGroups constructed attribute of the user to find the group memberships.",
+			},
+			"username_as_alias": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Use the username as the alias name of the entity instead of the user's DN.",
+			},
+		},
+	}
+}
+
+func kerberosAuthBackendLDAPConfigWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	path := kerberosAuthBackendLDAPConfigPath(backend)
+
+	data := map[string]interface{}{}
+	for _, k := range kerberosAuthBackendLDAPConfigFields {
+		if v, ok := d.GetOkExists(k); ok {
+			data[k] = v
+		}
+	}
+	if v, ok := d.GetOk("bindpass"); ok {
+		data["bindpass"] = v.(string)
+	}
+
+	log.Printf("[DEBUG] Writing Kerberos auth backend LDAP config to %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing Kerberos auth backend LDAP config to %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote Kerberos auth backend LDAP config to %q", path)
+
+	d.SetId(path)
+
+	return kerberosAuthBackendLDAPConfigRead(d, meta)
+}
+
+func kerberosAuthBackendLDAPConfigRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	backend, err := kerberosAuthBackendFromPath(kerberosAuthBackendLDAPConfigBackendFromPathRegex, path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q for Kerberos auth backend LDAP config: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading Kerberos auth backend LDAP config from %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading Kerberos auth backend LDAP config from %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read Kerberos auth backend LDAP config from %q", path)
+
+	if resp == nil {
+		log.Printf("[WARN] Kerberos auth backend LDAP config %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	for _, k := range kerberosAuthBackendLDAPConfigFields {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting state key %q: %s", k, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func kerberosAuthBackendLDAPConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	// The LDAP config can't be deleted, so removing the resource only removes
+	// it from state.
+	return nil
+}
+
+func kerberosAuthBackendLDAPConfigPath(backend string) string {
+	return "auth/" + strings.Trim(backend, "/") + "/config/ldap"
+}
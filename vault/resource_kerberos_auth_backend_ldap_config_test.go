@@ -0,0 +1,61 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccKerberosAuthBackendLDAPConfig_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("kerberos")
+	resName := "vault_kerberos_auth_backend_ldap_config.ldap"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKerberosAuthBackendLDAPConfig(backend, "ldap://ldap.example.com", "ou=groups,dc=example,dc=com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "backend", backend),
+					resource.TestCheckResourceAttr(resName, "url", "ldap://ldap.example.com"),
+					resource.TestCheckResourceAttr(resName, "groupdn", "ou=groups,dc=example,dc=com"),
+					resource.TestCheckResourceAttr(resName, "userattr", "samaccountname"),
+				),
+			},
+			{
+				Config: testAccKerberosAuthBackendLDAPConfig(backend, "ldaps://ldap.example.com", "ou=teams,dc=example,dc=com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "url", "ldaps://ldap.example.com"),
+					resource.TestCheckResourceAttr(resName, "groupdn", "ou=teams,dc=example,dc=com"),
+				),
+			},
+			{
+				ResourceName:            resName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"bindpass"},
+			},
+		},
+	})
+}
+
+func testAccKerberosAuthBackendLDAPConfig(backend, url, groupDN string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "kerberos" {
+  type = "kerberos"
+  path = "%s"
+}
+
+resource "vault_kerberos_auth_backend_ldap_config" "ldap" {
+  backend  = vault_auth_backend.kerberos.path
+  url      = "%s"
+  binddn   = "cn=vault,ou=users,dc=example,dc=com"
+  bindpass = "s3cr3t"
+  userdn   = "ou=users,dc=example,dc=com"
+  userattr = "sAMAccountName"
+  groupdn  = "%s"
+}
+`, backend, url, groupDN)
+}
@@ -0,0 +1,159 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+var keymgmtDistributeKeyPathRegex = regexp.MustCompile("^(.+)/kms/([^/]+)/key/([^/]+)$")
+
+func keymgmtDistributeKeyResource() *schema.Resource {
+	return &schema.Resource{
+		Create: keymgmtDistributeKeyWrite,
+		Read:   keymgmtDistributeKeyRead,
+		Update: keymgmtDistributeKeyWrite,
+		Delete: keymgmtDistributeKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path the Key Management secrets engine is mounted at.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"kms_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the KMS provider to distribute the key to.",
+			},
+			"key_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the key to distribute.",
+			},
+			"purpose": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"encrypt", "decrypt", "sign", "verify", "wrap", "unwrap"}, false),
+				},
+				Required:    true,
+				Description: "Purposes for which the key can be used in the KMS provider.",
+			},
+			"protection": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "hsm",
+				Description:  "Protection level of the key in the KMS provider.",
+				ValidateFunc: validation.StringInSlice([]string{"hsm", "software"}, false),
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the key in the KMS provider.",
+			},
+			"versions": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "Map of key versions to their IDs in the KMS provider.",
+			},
+		},
+	}
+}
+
+func keymgmtDistributeKeyWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := keymgmtDistributeKeyPath(d.Get("backend").(string), d.Get("kms_name").(string), d.Get("key_name").(string))
+
+	data := map[string]interface{}{
+		"purpose":    d.Get("purpose").([]interface{}),
+		"protection": d.Get("protection").(string),
+	}
+
+	log.Printf("[DEBUG] Distributing Key Management key %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error distributing Key Management key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Distributed Key Management key %q", path)
+
+	d.SetId(path)
+	return keymgmtDistributeKeyRead(d, meta)
+}
+
+func keymgmtDistributeKeyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	res := keymgmtDistributeKeyPathRegex.FindStringSubmatch(path)
+	if len(res) != 4 {
+		return fmt.Errorf("unexpected Key Management key distribution path %q", path)
+	}
+
+	log.Printf("[DEBUG] Reading Key Management key distribution %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading Key Management key distribution %q: %s", path, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] Key Management key distribution %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+	log.Printf("[DEBUG] Read Key Management key distribution %q", path)
+
+	d.Set("backend", res[1])
+	d.Set("kms_name", res[2])
+	d.Set("key_name", res[3])
+
+	// Vault returns purpose as a comma separated string.
+	if v, ok := resp.Data["purpose"].(string); ok {
+		resp.Data["purpose"] = strings.Split(v, ",")
+	}
+	for _, k := range []string{"purpose", "protection", "key_id", "versions"} {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting %q for Key Management key distribution %q: %s", k, path, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func keymgmtDistributeKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	// This removes the key from the KMS provider.
+	log.Printf("[DEBUG] Removing Key Management key distribution %q", path)
+	if _, err := client.Logical().Delete(path); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error removing Key Management key distribution %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Removed Key Management key distribution %q", path)
+
+	return nil
+}
+
+func keymgmtDistributeKeyPath(backend, kmsName, keyName string) string {
+	return keymgmtKMSProviderPath(backend, kmsName) + "/key/" + strings.Trim(keyName, "/")
+}
@@ -0,0 +1,81 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+func TestAccKeymgmtDistributeKey_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("keymgmt")
+	accessKey, secretKey := getTestAWSCreds(t)
+	region := getTestAWSRegion(t)
+	resourceName := "vault_keymgmt_distribute_key.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { util.TestEntPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeymgmtDistributeKeyConfig(backend, region, accessKey, secretKey, `["encrypt", "decrypt"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "kms_name", "aws"),
+					resource.TestCheckResourceAttr(resourceName, "key_name", "test"),
+					resource.TestCheckResourceAttr(resourceName, "purpose.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "protection", "hsm"),
+					resource.TestCheckResourceAttrSet(resourceName, "key_id"),
+				),
+			},
+			{
+				Config: testKeymgmtDistributeKeyConfig(backend, region, accessKey, secretKey, `["encrypt"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "purpose.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "purpose.0", "encrypt"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testKeymgmtDistributeKeyConfig(backend, region, accessKey, secretKey, purpose string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "keymgmt" {
+  path = "%s"
+  type = "keymgmt"
+}
+
+resource "vault_keymgmt_key" "test" {
+  backend          = vault_mount.keymgmt.path
+  name             = "test"
+  type             = "aes256-gcm96"
+  deletion_allowed = true
+}
+
+resource "vault_keymgmt_kms_provider" "aws" {
+  backend        = vault_mount.keymgmt.path
+  name           = "aws"
+  kms_provider   = "awskms"
+  key_collection = "%s"
+  credentials = {
+    access_key = "%s"
+    secret_key = "%s"
+  }
+}
+
+resource "vault_keymgmt_distribute_key" "test" {
+  backend  = vault_mount.keymgmt.path
+  kms_name = vault_keymgmt_kms_provider.aws.name
+  key_name = vault_keymgmt_key.test.name
+  purpose  = %s
+}
+`, backend, region, accessKey, secretKey, purpose)
+}
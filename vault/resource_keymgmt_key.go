@@ -0,0 +1,204 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+var keymgmtKeyPathRegex = regexp.MustCompile("^(.+)/key/([^/]+)$")
+
+func keymgmtKeyResource() *schema.Resource {
+	return &schema.Resource{
+		Create: keymgmtKeyCreate,
+		Read:   keymgmtKeyRead,
+		Update: keymgmtKeyUpdate,
+		Delete: keymgmtKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path the Key Management secrets engine is mounted at.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the key.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "rsa-2048",
+				Description:  "The type of cryptographic key.",
+				ValidateFunc: validation.StringInSlice([]string{"aes256-gcm96", "rsa-2048", "rsa-3072", "rsa-4096", "ecdsa-p256", "ecdsa-p384", "ecdsa-p521"}, false),
+			},
+			"deletion_allowed": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the key is allowed to be deleted. Must be true before the key can be destroyed.",
+			},
+			"min_enabled_version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Minimum key version enabled for use. Older versions are disabled in the KMS providers the key is distributed to.",
+			},
+			"replica_regions": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Computed:    true,
+				Description: "AWS regions the key is replicated to when it is distributed to an AWS KMS provider. Requires Vault 1.12+.",
+			},
+			"rotate": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Arbitrary number that, when increased, rotates the key to a new version.",
+			},
+			"latest_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Latest version of the key.",
+			},
+		},
+	}
+}
+
+func keymgmtKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := keymgmtKeyPath(d.Get("backend").(string), d.Get("name").(string))
+
+	data := map[string]interface{}{
+		"type": d.Get("type").(string),
+	}
+
+	log.Printf("[DEBUG] Creating Key Management key %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error creating Key Management key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Created Key Management key %q", path)
+
+	d.SetId(path)
+
+	// The remaining fields can only be set on update.
+	if err := keymgmtKeyWriteConfig(client, d); err != nil {
+		return err
+	}
+
+	return keymgmtKeyRead(d, meta)
+}
+
+func keymgmtKeyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	res := keymgmtKeyPathRegex.FindStringSubmatch(path)
+	if len(res) != 3 {
+		return fmt.Errorf("unexpected Key Management key path %q", path)
+	}
+
+	log.Printf("[DEBUG] Reading Key Management key %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading Key Management key %q: %s", path, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] Key Management key %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+	log.Printf("[DEBUG] Read Key Management key %q", path)
+
+	d.Set("backend", res[1])
+	d.Set("name", res[2])
+	for _, k := range []string{"type", "deletion_allowed", "min_enabled_version", "replica_regions", "latest_version"} {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting %q for Key Management key %q: %s", k, path, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func keymgmtKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	if d.HasChange("rotate") {
+		log.Printf("[DEBUG] Rotating Key Management key %q", path)
+		if _, err := client.Logical().Write(path+"/rotate", nil); err != nil {
+			return fmt.Errorf("error rotating Key Management key %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Rotated Key Management key %q", path)
+	}
+
+	if d.HasChange("deletion_allowed") || d.HasChange("min_enabled_version") || d.HasChange("replica_regions") {
+		if err := keymgmtKeyWriteConfig(client, d); err != nil {
+			return err
+		}
+	}
+
+	return keymgmtKeyRead(d, meta)
+}
+
+func keymgmtKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting Key Management key %q", path)
+	if _, err := client.Logical().Delete(path); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error deleting Key Management key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted Key Management key %q", path)
+
+	return nil
+}
+
+func keymgmtKeyWriteConfig(client *api.Client, d *schema.ResourceData) error {
+	path := d.Id()
+
+	data := map[string]interface{}{
+		"deletion_allowed": d.Get("deletion_allowed").(bool),
+	}
+	if v, ok := d.GetOk("min_enabled_version"); ok {
+		data["min_enabled_version"] = v
+	}
+	if v, ok := d.GetOk("replica_regions"); ok {
+		data["replica_regions"] = v
+	}
+
+	log.Printf("[DEBUG] Updating Key Management key %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error updating Key Management key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Updated Key Management key %q", path)
+
+	return nil
+}
+
+func keymgmtKeyPath(backend, name string) string {
+	return strings.Trim(backend, "/") + "/key/" + strings.Trim(name, "/")
+}
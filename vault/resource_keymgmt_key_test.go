@@ -0,0 +1,61 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+func TestAccKeymgmtKey_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("keymgmt")
+	resourceName := "vault_keymgmt_key.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { util.TestEntPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeymgmtKeyConfig(backend, 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "name", "test"),
+					resource.TestCheckResourceAttr(resourceName, "type", "aes256-gcm96"),
+					resource.TestCheckResourceAttr(resourceName, "deletion_allowed", "true"),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "1"),
+				),
+			},
+			{
+				Config: testKeymgmtKeyConfig(backend, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rotate"},
+			},
+		},
+	})
+}
+
+func testKeymgmtKeyConfig(backend string, rotate int) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "keymgmt" {
+  path = "%s"
+  type = "keymgmt"
+}
+
+resource "vault_keymgmt_key" "test" {
+  backend          = vault_mount.keymgmt.path
+  name             = "test"
+  type             = "aes256-gcm96"
+  deletion_allowed = true
+  rotate           = %d
+}
+`, backend, rotate)
+}
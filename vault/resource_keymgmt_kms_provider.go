@@ -0,0 +1,142 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+var keymgmtKMSProviderPathRegex = regexp.MustCompile("^(.+)/kms/([^/]+)$")
+
+func keymgmtKMSProviderResource() *schema.Resource {
+	return &schema.Resource{
+		Create: keymgmtKMSProviderWrite,
+		Read:   keymgmtKMSProviderRead,
+		Update: keymgmtKMSProviderWrite,
+		Delete: keymgmtKMSProviderDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path the Key Management secrets engine is mounted at.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the KMS provider.",
+			},
+			"kms_provider": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The type of KMS provider.",
+				ValidateFunc: validation.StringInSlice([]string{"azurekeyvault", "awskms", "gcpckms"}, false),
+			},
+			"key_collection": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Refers to the location of the keys in the KMS provider, such as an Azure Key Vault name, AWS region or GCP key ring resource ID.",
+			},
+			"credentials": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The credentials used to authenticate to the KMS provider. The accepted keys depend on the provider.",
+			},
+		},
+	}
+}
+
+func keymgmtKMSProviderWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := keymgmtKMSProviderPath(d.Get("backend").(string), d.Get("name").(string))
+
+	data := map[string]interface{}{
+		"provider":       d.Get("kms_provider").(string),
+		"key_collection": d.Get("key_collection").(string),
+	}
+	if v, ok := d.GetOk("credentials"); ok {
+		data["credentials"] = v
+	}
+
+	log.Printf("[DEBUG] Writing Key Management KMS provider %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing Key Management KMS provider %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote Key Management KMS provider %q", path)
+
+	d.SetId(path)
+	return keymgmtKMSProviderRead(d, meta)
+}
+
+func keymgmtKMSProviderRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	res := keymgmtKMSProviderPathRegex.FindStringSubmatch(path)
+	if len(res) != 3 {
+		return fmt.Errorf("unexpected Key Management KMS provider path %q", path)
+	}
+
+	log.Printf("[DEBUG] Reading Key Management KMS provider %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading Key Management KMS provider %q: %s", path, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] Key Management KMS provider %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+	log.Printf("[DEBUG] Read Key Management KMS provider %q", path)
+
+	d.Set("backend", res[1])
+	d.Set("name", res[2])
+	// The credentials are never returned by Vault, so they are left as
+	// configured.
+	if err := d.Set("kms_provider", resp.Data["provider"]); err != nil {
+		return fmt.Errorf("error setting %q for Key Management KMS provider %q: %s", "kms_provider", path, err)
+	}
+	if err := d.Set("key_collection", resp.Data["key_collection"]); err != nil {
+		return fmt.Errorf("error setting %q for Key Management KMS provider %q: %s", "key_collection", path, err)
+	}
+
+	return nil
+}
+
+func keymgmtKMSProviderDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting Key Management KMS provider %q", path)
+	if _, err := client.Logical().Delete(path); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error deleting Key Management KMS provider %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted Key Management KMS provider %q", path)
+
+	return nil
+}
+
+func keymgmtKMSProviderPath(backend, name string) string {
+	return strings.Trim(backend, "/") + "/kms/" + strings.Trim(name, "/")
+}
@@ -0,0 +1,59 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+func TestAccKeymgmtKMSProvider_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("keymgmt")
+	accessKey, secretKey := getTestAWSCreds(t)
+	region := getTestAWSRegion(t)
+	resourceName := "vault_keymgmt_kms_provider.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { util.TestEntPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeymgmtKMSProviderConfig(backend, region, accessKey, secretKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "name", "aws"),
+					resource.TestCheckResourceAttr(resourceName, "kms_provider", "awskms"),
+					resource.TestCheckResourceAttr(resourceName, "key_collection", region),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"credentials"},
+			},
+		},
+	})
+}
+
+func testKeymgmtKMSProviderConfig(backend, region, accessKey, secretKey string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "keymgmt" {
+  path = "%s"
+  type = "keymgmt"
+}
+
+resource "vault_keymgmt_kms_provider" "test" {
+  backend        = vault_mount.keymgmt.path
+  name           = "aws"
+  kms_provider   = "awskms"
+  key_collection = "%s"
+  credentials = {
+    access_key = "%s"
+    secret_key = "%s"
+  }
+}
+`, backend, region, accessKey, secretKey)
+}
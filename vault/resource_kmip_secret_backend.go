@@ -0,0 +1,235 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+var kmipSecretBackendConfigFields = []string{
+	"listen_addrs",
+	"server_hostnames",
+	"server_ips",
+	"tls_ca_key_type",
+	"tls_ca_key_bits",
+	"tls_min_version",
+	"default_tls_client_key_type",
+	"default_tls_client_key_bits",
+	"default_tls_client_ttl",
+}
+
+func kmipSecretBackendResource() *schema.Resource {
+	return &schema.Resource{
+		Create: kmipSecretBackendCreate,
+		Read:   kmipSecretBackendRead,
+		Update: kmipSecretBackendUpdate,
+		Delete: kmipSecretBackendDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Path where the KMIP secrets engine will be mounted.",
+				ValidateFunc: validateMountPath,
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Human-friendly description of the mount.",
+			},
+			"listen_addrs": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Computed:    true,
+				Description: "Addresses the KMIP server should listen on (host:port).",
+			},
+			"server_hostnames": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Computed:    true,
+				Description: "Hostnames to include in the server's TLS certificate as SAN DNS names. The first will be used as the common name (CN).",
+			},
+			"server_ips": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Computed:    true,
+				Description: "IPs to include in the server's TLS certificate as SAN IP addresses.",
+			},
+			"tls_ca_key_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "CA key type, rsa or ec.",
+				ValidateFunc: validation.StringInSlice([]string{"rsa", "ec"}, false),
+			},
+			"tls_ca_key_bits": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "CA key bits, valid values depend on key type.",
+			},
+			"tls_min_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Minimum TLS version to accept.",
+				ValidateFunc: validation.StringInSlice([]string{"tls12", "tls13"}, false),
+			},
+			"default_tls_client_key_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Client certificate key type, rsa or ec.",
+				ValidateFunc: validation.StringInSlice([]string{"rsa", "ec"}, false),
+			},
+			"default_tls_client_key_bits": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Client certificate key bits, valid values depend on key type.",
+			},
+			"default_tls_client_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Client certificate TTL in seconds.",
+			},
+		},
+	}
+}
+
+func kmipSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := strings.Trim(d.Get("path").(string), "/")
+
+	log.Printf("[DEBUG] Mounting KMIP backend at %q", path)
+	if err := client.Sys().Mount(path, &api.MountInput{
+		Type:        "kmip",
+		Description: d.Get("description").(string),
+	}); err != nil {
+		return fmt.Errorf("error mounting to %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Mounted KMIP backend at %q", path)
+	d.SetId(path)
+
+	if err := kmipSecretBackendWriteConfig(client, d); err != nil {
+		return err
+	}
+
+	return kmipSecretBackendRead(d, meta)
+}
+
+func kmipSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading KMIP backend mount %q from Vault", path)
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return fmt.Errorf("error reading mount %q: %s", path, err)
+	}
+	mount, ok := mounts[path+"/"]
+	if !ok {
+		log.Printf("[WARN] Mount %q not found, removing from state.", path)
+		d.SetId("")
+		return nil
+	}
+	d.Set("path", path)
+	d.Set("description", mount.Description)
+
+	configPath := kmipSecretBackendConfigPath(path)
+	log.Printf("[DEBUG] Reading KMIP config %q", configPath)
+	resp, err := client.Logical().Read(configPath)
+	if err != nil {
+		return fmt.Errorf("error reading KMIP config %q: %s", configPath, err)
+	}
+	log.Printf("[DEBUG] Read KMIP config %q", configPath)
+	if resp == nil {
+		return nil
+	}
+
+	for _, k := range kmipSecretBackendConfigFields {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting %q for KMIP config %q: %s", k, configPath, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func kmipSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		log.Printf("[DEBUG] Updating description for %q", path)
+		if err := client.Sys().TuneMount(path, api.MountConfigInput{Description: &description}); err != nil {
+			return fmt.Errorf("error updating description for %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Updated description for %q", path)
+	}
+
+	if err := kmipSecretBackendWriteConfig(client, d); err != nil {
+		return err
+	}
+
+	return kmipSecretBackendRead(d, meta)
+}
+
+func kmipSecretBackendDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Unmounting KMIP backend %q", path)
+	if err := client.Sys().Unmount(path); err != nil {
+		return fmt.Errorf("error unmounting KMIP backend from %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Unmounted KMIP backend %q", path)
+
+	return nil
+}
+
+func kmipSecretBackendWriteConfig(client *api.Client, d *schema.ResourceData) error {
+	configPath := kmipSecretBackendConfigPath(d.Id())
+
+	data := make(map[string]interface{})
+	for _, k := range kmipSecretBackendConfigFields {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Writing KMIP config %q", configPath)
+	if _, err := client.Logical().Write(configPath, data); err != nil {
+		return fmt.Errorf("error writing KMIP config %q: %s", configPath, err)
+	}
+	log.Printf("[DEBUG] Wrote KMIP config %q", configPath)
+
+	return nil
+}
+
+func kmipSecretBackendConfigPath(path string) string {
+	return strings.Trim(path, "/") + "/config"
+}
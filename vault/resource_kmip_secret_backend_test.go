@@ -0,0 +1,85 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccKMIPSecretBackend_basic(t *testing.T) {
+	path := acctest.RandomWithPrefix("kmip")
+	lns := fmt.Sprintf("127.0.0.1:%d", acctest.RandIntRange(20000, 30000))
+	resourceName := "vault_kmip_secret_backend.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { util.TestEntPreCheck(t) },
+		CheckDestroy: testAccKMIPSecretBackendCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testKMIPSecretBackendConfig(path, lns, "ec", 256),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "path", path),
+					resource.TestCheckResourceAttr(resourceName, "description", "test description"),
+					resource.TestCheckResourceAttr(resourceName, "listen_addrs.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "listen_addrs.0", lns),
+					resource.TestCheckResourceAttr(resourceName, "tls_ca_key_type", "ec"),
+					resource.TestCheckResourceAttr(resourceName, "tls_ca_key_bits", "256"),
+					resource.TestCheckResourceAttr(resourceName, "default_tls_client_key_type", "ec"),
+					resource.TestCheckResourceAttr(resourceName, "default_tls_client_key_bits", "256"),
+				),
+			},
+			{
+				Config: testKMIPSecretBackendConfig(path, lns, "rsa", 2048),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "default_tls_client_key_type", "rsa"),
+					resource.TestCheckResourceAttr(resourceName, "default_tls_client_key_bits", "2048"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccKMIPSecretBackendCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_kmip_secret_backend" {
+			continue
+		}
+		if _, ok := mounts[rs.Primary.ID+"/"]; ok {
+			return fmt.Errorf("mount %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testKMIPSecretBackendConfig(path, listenAddr, clientKeyType string, clientKeyBits int) string {
+	return fmt.Sprintf(`
+resource "vault_kmip_secret_backend" "test" {
+  path                        = "%s"
+  description                 = "test description"
+  listen_addrs                = ["%s"]
+  tls_ca_key_type             = "ec"
+  tls_ca_key_bits             = 256
+  default_tls_client_key_type = "%s"
+  default_tls_client_key_bits = %d
+  default_tls_client_ttl      = 86400
+}
+`, path, listenAddr, clientKeyType, clientKeyBits)
+}
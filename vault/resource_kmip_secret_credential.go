@@ -0,0 +1,142 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func kmipSecretCredentialResource() *schema.Resource {
+	return &schema.Resource{
+		Create: kmipSecretCredentialCreate,
+		Read:   kmipSecretCredentialRead,
+		Delete: kmipSecretCredentialDelete,
+
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Path where the KMIP secrets engine is mounted.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"scope": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the scope.",
+			},
+			"role": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the role.",
+			},
+			"format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "pem",
+				Description:  "Format of the certificate and private key, pem, pem_bundle or der.",
+				ValidateFunc: validation.StringInSlice([]string{"pem", "pem_bundle", "der"}, false),
+			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The client certificate.",
+			},
+			"private_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The private key of the client certificate.",
+			},
+			"ca_chain": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "The CA chain of the client certificate.",
+			},
+			"serial_number": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The serial number of the client certificate.",
+			},
+		},
+	}
+}
+
+func kmipSecretCredentialCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	rolePath := kmipSecretRolePath(d.Get("path").(string), d.Get("scope").(string), d.Get("role").(string))
+	path := rolePath + "/credential/generate"
+
+	data := map[string]interface{}{
+		"format": d.Get("format").(string),
+	}
+
+	log.Printf("[DEBUG] Generating KMIP credential for %q", rolePath)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error generating KMIP credential for %q: %s", rolePath, err)
+	}
+	log.Printf("[DEBUG] Generated KMIP credential for %q", rolePath)
+
+	serialNumber, _ := resp.Data["serial_number"].(string)
+
+	d.SetId(rolePath + "/credential/" + serialNumber)
+	d.Set("certificate", resp.Data["certificate"])
+	d.Set("private_key", resp.Data["private_key"])
+	d.Set("ca_chain", resp.Data["ca_chain"])
+	d.Set("serial_number", serialNumber)
+
+	return kmipSecretCredentialRead(d, meta)
+}
+
+func kmipSecretCredentialRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	rolePath := kmipSecretRolePath(d.Get("path").(string), d.Get("scope").(string), d.Get("role").(string))
+	serialNumber := d.Get("serial_number").(string)
+
+	log.Printf("[DEBUG] Looking up KMIP credential %q for %q", serialNumber, rolePath)
+	resp, err := client.Logical().ReadWithData(rolePath+"/credential/lookup", map[string][]string{
+		"serial_number": {serialNumber},
+	})
+	if err != nil {
+		return fmt.Errorf("error looking up KMIP credential %q for %q: %s", serialNumber, rolePath, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] KMIP credential %q for %q not found, removing from state", serialNumber, rolePath)
+		d.SetId("")
+		return nil
+	}
+	log.Printf("[DEBUG] Looked up KMIP credential %q for %q", serialNumber, rolePath)
+
+	return nil
+}
+
+func kmipSecretCredentialDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	rolePath := kmipSecretRolePath(d.Get("path").(string), d.Get("scope").(string), d.Get("role").(string))
+	serialNumber := d.Get("serial_number").(string)
+
+	log.Printf("[DEBUG] Revoking KMIP credential %q for %q", serialNumber, rolePath)
+	if _, err := client.Logical().Write(rolePath+"/credential/revoke", map[string]interface{}{
+		"serial_number": serialNumber,
+	}); err != nil {
+		return fmt.Errorf("error revoking KMIP credential %q for %q: %s", serialNumber, rolePath, err)
+	}
+	log.Printf("[DEBUG] Revoked KMIP credential %q for %q", serialNumber, rolePath)
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+func TestAccKMIPSecretCredential_basic(t *testing.T) {
+	path := acctest.RandomWithPrefix("kmip")
+	lns := fmt.Sprintf("127.0.0.1:%d", acctest.RandIntRange(20000, 30000))
+	resourceName := "vault_kmip_secret_credential.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { util.TestEntPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKMIPSecretCredentialConfig(path, lns),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "path", path),
+					resource.TestCheckResourceAttr(resourceName, "scope", "scope-1"),
+					resource.TestCheckResourceAttr(resourceName, "role", "role-1"),
+					resource.TestCheckResourceAttr(resourceName, "format", "pem"),
+					resource.TestCheckResourceAttrSet(resourceName, "certificate"),
+					resource.TestCheckResourceAttrSet(resourceName, "private_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "serial_number"),
+				),
+			},
+		},
+	})
+}
+
+func testKMIPSecretCredentialConfig(path, listenAddr string) string {
+	return fmt.Sprintf(`
+resource "vault_kmip_secret_backend" "kmip" {
+  path         = "%s"
+  listen_addrs = ["%s"]
+}
+
+resource "vault_kmip_secret_scope" "scope" {
+  path  = vault_kmip_secret_backend.kmip.path
+  scope = "scope-1"
+  force = true
+}
+
+resource "vault_kmip_secret_role" "role" {
+  path          = vault_kmip_secret_scope.scope.path
+  scope         = vault_kmip_secret_scope.scope.scope
+  role          = "role-1"
+  operation_all = true
+}
+
+resource "vault_kmip_secret_credential" "test" {
+  path  = vault_kmip_secret_role.role.path
+  scope = vault_kmip_secret_role.role.scope
+  role  = vault_kmip_secret_role.role.role
+}
+`, path, listenAddr)
+}
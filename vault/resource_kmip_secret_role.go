@@ -0,0 +1,193 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	kmipSecretRolePathRegex = regexp.MustCompile("^(.+)/scope/([^/]+)/role/([^/]+)$")
+
+	kmipSecretRoleOperationFields = []string{
+		"operation_activate",
+		"operation_add_attribute",
+		"operation_all",
+		"operation_create",
+		"operation_destroy",
+		"operation_discover_versions",
+		"operation_get",
+		"operation_get_attribute_list",
+		"operation_get_attributes",
+		"operation_locate",
+		"operation_none",
+		"operation_register",
+		"operation_rekey",
+		"operation_revoke",
+	}
+)
+
+func kmipSecretRoleResource() *schema.Resource {
+	fields := map[string]*schema.Schema{
+		"path": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Path where the KMIP secrets engine is mounted.",
+			// standardise on no beginning or trailing slashes
+			StateFunc: func(v interface{}) string {
+				return strings.Trim(v.(string), "/")
+			},
+		},
+		"scope": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Name of the scope.",
+		},
+		"role": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Name of the role.",
+		},
+		"tls_client_key_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Client certificate key type, rsa or ec.",
+			ValidateFunc: validation.StringInSlice([]string{"rsa", "ec"}, false),
+		},
+		"tls_client_key_bits": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Client certificate key bits, valid values depend on key type.",
+		},
+		"tls_client_ttl": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Client certificate TTL in seconds.",
+		},
+	}
+	for _, k := range kmipSecretRoleOperationFields {
+		operation := strings.Replace(strings.TrimPrefix(k, "operation_"), "_", " ", -1)
+		fields[k] = &schema.Schema{
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: fmt.Sprintf("Grant permission to use the KMIP %s operation.", operation),
+		}
+	}
+	fields["operation_all"].Description = "Grant all permissions to this role. May not be specified with any other operation_* fields."
+	fields["operation_all"].ConflictsWith = util.CalculateConflictsWith("operation_all", kmipSecretRoleOperationFields)
+	fields["operation_none"].Description = "Remove all permissions from this role. May not be specified with any other operation_* fields."
+	fields["operation_none"].ConflictsWith = util.CalculateConflictsWith("operation_none", kmipSecretRoleOperationFields)
+
+	return &schema.Resource{
+		Create: kmipSecretRoleWrite,
+		Read:   kmipSecretRoleRead,
+		Update: kmipSecretRoleWrite,
+		Delete: kmipSecretRoleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: fields,
+	}
+}
+
+func kmipSecretRoleWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := kmipSecretRolePath(d.Get("path").(string), d.Get("scope").(string), d.Get("role").(string))
+
+	data := make(map[string]interface{})
+	for _, k := range []string{"tls_client_key_type", "tls_client_key_bits", "tls_client_ttl"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+	// Only the granted operations are sent, the role is written as a whole
+	// so any that are omitted are revoked.
+	for _, k := range kmipSecretRoleOperationFields {
+		if d.Get(k).(bool) {
+			data[k] = true
+		}
+	}
+
+	log.Printf("[DEBUG] Writing KMIP role %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing KMIP role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote KMIP role %q", path)
+
+	d.SetId(path)
+	return kmipSecretRoleRead(d, meta)
+}
+
+func kmipSecretRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	res := kmipSecretRolePathRegex.FindStringSubmatch(path)
+	if len(res) != 4 {
+		return fmt.Errorf("unexpected KMIP role path %q", path)
+	}
+
+	log.Printf("[DEBUG] Reading KMIP role %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading KMIP role %q: %s", path, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] KMIP role %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+	log.Printf("[DEBUG] Read KMIP role %q", path)
+
+	d.Set("path", res[1])
+	d.Set("scope", res[2])
+	d.Set("role", res[3])
+	for _, k := range []string{"tls_client_key_type", "tls_client_key_bits", "tls_client_ttl"} {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting %q for KMIP role %q: %s", k, path, err)
+			}
+		}
+	}
+	// Vault only returns the operations that have been granted.
+	for _, k := range kmipSecretRoleOperationFields {
+		v, _ := resp.Data[k].(bool)
+		if err := d.Set(k, v); err != nil {
+			return fmt.Errorf("error setting %q for KMIP role %q: %s", k, path, err)
+		}
+	}
+
+	return nil
+}
+
+func kmipSecretRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting KMIP role %q", path)
+	if _, err := client.Logical().Delete(path); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error deleting KMIP role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted KMIP role %q", path)
+
+	return nil
+}
+
+func kmipSecretRolePath(backend, scope, role string) string {
+	return kmipSecretScopePath(backend, scope) + "/role/" + strings.Trim(role, "/")
+}
@@ -0,0 +1,79 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+func TestAccKMIPSecretRole_basic(t *testing.T) {
+	path := acctest.RandomWithPrefix("kmip")
+	lns := fmt.Sprintf("127.0.0.1:%d", acctest.RandIntRange(20000, 30000))
+	resourceName := "vault_kmip_secret_role.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { util.TestEntPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKMIPSecretRoleConfig(path, lns, `
+  operation_activate = true
+  operation_get      = true
+  operation_create   = true
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "path", path),
+					resource.TestCheckResourceAttr(resourceName, "scope", "scope-1"),
+					resource.TestCheckResourceAttr(resourceName, "role", "role-1"),
+					resource.TestCheckResourceAttr(resourceName, "tls_client_key_type", "ec"),
+					resource.TestCheckResourceAttr(resourceName, "tls_client_key_bits", "256"),
+					resource.TestCheckResourceAttr(resourceName, "operation_activate", "true"),
+					resource.TestCheckResourceAttr(resourceName, "operation_get", "true"),
+					resource.TestCheckResourceAttr(resourceName, "operation_create", "true"),
+					resource.TestCheckResourceAttr(resourceName, "operation_destroy", "false"),
+					resource.TestCheckResourceAttr(resourceName, "operation_all", "false"),
+				),
+			},
+			{
+				Config: testKMIPSecretRoleConfig(path, lns, `
+  operation_all = true
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "operation_all", "true"),
+					resource.TestCheckResourceAttr(resourceName, "operation_activate", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testKMIPSecretRoleConfig(path, listenAddr, operations string) string {
+	return fmt.Sprintf(`
+resource "vault_kmip_secret_backend" "kmip" {
+  path         = "%s"
+  listen_addrs = ["%s"]
+}
+
+resource "vault_kmip_secret_scope" "scope" {
+  path  = vault_kmip_secret_backend.kmip.path
+  scope = "scope-1"
+  force = true
+}
+
+resource "vault_kmip_secret_role" "test" {
+  path                = vault_kmip_secret_scope.scope.path
+  scope               = vault_kmip_secret_scope.scope.scope
+  role                = "role-1"
+  tls_client_key_type = "ec"
+  tls_client_key_bits = 256
+%s}
+`, path, listenAddr, operations)
+}
@@ -0,0 +1,131 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+var kmipSecretScopePathRegex = regexp.MustCompile("^(.+)/scope/([^/]+)$")
+
+func kmipSecretScopeResource() *schema.Resource {
+	return &schema.Resource{
+		Create: kmipSecretScopeCreate,
+		Read:   kmipSecretScopeRead,
+		Update: kmipSecretScopeUpdate,
+		Delete: kmipSecretScopeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Path where the KMIP secrets engine is mounted.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"scope": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the scope.",
+			},
+			"force": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Force deletion of the scope, even if it contains managed objects.",
+			},
+		},
+	}
+}
+
+func kmipSecretScopeCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := kmipSecretScopePath(d.Get("path").(string), d.Get("scope").(string))
+
+	log.Printf("[DEBUG] Creating KMIP scope %q", path)
+	if _, err := client.Logical().Write(path, nil); err != nil {
+		return fmt.Errorf("error creating KMIP scope %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Created KMIP scope %q", path)
+
+	d.SetId(path)
+	return kmipSecretScopeRead(d, meta)
+}
+
+func kmipSecretScopeRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	res := kmipSecretScopePathRegex.FindStringSubmatch(path)
+	if len(res) != 3 {
+		return fmt.Errorf("unexpected KMIP scope path %q", path)
+	}
+	backend, scope := res[1], res[2]
+
+	// Scopes can't be read individually, so check that it is in the list.
+	log.Printf("[DEBUG] Listing KMIP scopes on %q", backend)
+	resp, err := client.Logical().List(backend + "/scope")
+	if err != nil {
+		return fmt.Errorf("error listing KMIP scopes on %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Listed KMIP scopes on %q", backend)
+
+	found := false
+	if resp != nil {
+		if keys, ok := resp.Data["keys"].([]interface{}); ok {
+			found, _ = util.SliceHasElement(keys, scope)
+		}
+	}
+	if !found {
+		log.Printf("[WARN] KMIP scope %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("path", backend)
+	d.Set("scope", scope)
+
+	return nil
+}
+
+// kmipSecretScopeUpdate only handles the force flag, which is used on
+// deletion.
+func kmipSecretScopeUpdate(d *schema.ResourceData, meta interface{}) error {
+	return kmipSecretScopeRead(d, meta)
+}
+
+func kmipSecretScopeDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	data := map[string][]string{}
+	if d.Get("force").(bool) {
+		data["force"] = []string{"true"}
+	}
+
+	log.Printf("[DEBUG] Deleting KMIP scope %q", path)
+	if _, err := client.Logical().DeleteWithData(path, data); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error deleting KMIP scope %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted KMIP scope %q", path)
+
+	return nil
+}
+
+func kmipSecretScopePath(backend, scope string) string {
+	return strings.Trim(backend, "/") + "/scope/" + strings.Trim(scope, "/")
+}
@@ -0,0 +1,58 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+func TestAccKMIPSecretScope_basic(t *testing.T) {
+	path := acctest.RandomWithPrefix("kmip")
+	lns := fmt.Sprintf("127.0.0.1:%d", acctest.RandIntRange(20000, 30000))
+	resourceName := "vault_kmip_secret_scope.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { util.TestEntPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKMIPSecretScopeConfig(path, lns, "scope-1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "path", path),
+					resource.TestCheckResourceAttr(resourceName, "scope", "scope-1"),
+					resource.TestCheckResourceAttr(resourceName, "force", "true"),
+				),
+			},
+			{
+				Config: testKMIPSecretScopeConfig(path, lns, "scope-2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "scope", "scope-2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force"},
+			},
+		},
+	})
+}
+
+func testKMIPSecretScopeConfig(path, listenAddr, scope string) string {
+	return fmt.Sprintf(`
+resource "vault_kmip_secret_backend" "kmip" {
+  path         = "%s"
+  listen_addrs = ["%s"]
+}
+
+resource "vault_kmip_secret_scope" "test" {
+  path  = vault_kmip_secret_backend.kmip.path
+  scope = "%s"
+  force = true
+}
+`, path, listenAddr, scope)
+}
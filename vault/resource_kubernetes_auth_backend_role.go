@@ -92,7 +92,8 @@ func kubernetesAuthBackendRoleResource() *schema.Resource {
 			Optional:    true,
 			Description: "List of CIDRs valid as the source address for login requests. This value is also encoded into any resulting token.",
 			Elem: &schema.Schema{
-				Type: schema.TypeString,
+				Type:         schema.TypeString,
+				ValidateFunc: validateCIDR,
 			},
 			Deprecated:    "use `token_bound_cidrs` instead if you are running Vault >= 1.2",
 			ConflictsWith: []string{"token_bound_cidrs"},
@@ -0,0 +1,237 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+// kubernetesSecretBackendConfigFields are the fields that are written to,
+// and read back from, the config endpoint of the Kubernetes secrets engine.
+var kubernetesSecretBackendConfigFields = []string{
+	"kubernetes_host",
+	"kubernetes_ca_cert",
+	"disable_local_ca_jwt",
+}
+
+func kubernetesSecretBackendResource() *schema.Resource {
+	fields := map[string]*schema.Schema{
+		"backend": {
+			Type:         schema.TypeString,
+			Default:      "kubernetes",
+			ForceNew:     true,
+			Optional:     true,
+			Description:  "The mount path for the Kubernetes backend.",
+			ValidateFunc: validateMountPath,
+			StateFunc: func(v interface{}) string {
+				return strings.Trim(v.(string), "/")
+			},
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Human-friendly description of the mount for the backend.",
+		},
+		"default_lease_ttl_seconds": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Default lease duration for secrets in seconds.",
+		},
+		"max_lease_ttl_seconds": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Maximum possible lease duration for secrets in seconds.",
+		},
+		"local": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "Mark the secrets engine as local-only. Local engines are not replicated or removed by replication.",
+		},
+		"kubernetes_host": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The Kubernetes API URL to connect to. Required if the standard pod environment variables KUBERNETES_SERVICE_HOST or KUBERNETES_SERVICE_PORT are not set on the host that Vault is running on.",
+		},
+		"kubernetes_ca_cert": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "A PEM-encoded CA certificate used by the secret engine to verify the Kubernetes API server certificate. Defaults to the local pod's CA if found, or otherwise the host's root CA set.",
+		},
+		"service_account_jwt": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			Description: "The JSON web token of the service account used by the secrets engine to manage Kubernetes roles. Defaults to the local pod's JWT if found.",
+		},
+		"disable_local_ca_jwt": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Disable defaulting to the local CA certificate and service account JWT when running in a Kubernetes pod.",
+		},
+	}
+	return &schema.Resource{
+		Create: kubernetesSecretBackendCreate,
+		Update: kubernetesSecretBackendUpdate,
+		Read:   kubernetesSecretBackendRead,
+		Delete: kubernetesSecretBackendDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: fields,
+	}
+}
+
+func kubernetesSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	backend := d.Get("backend").(string)
+	description := d.Get("description").(string)
+	defaultTTL := d.Get("default_lease_ttl_seconds").(int)
+	local := d.Get("local").(bool)
+	maxTTL := d.Get("max_lease_ttl_seconds").(int)
+
+	log.Printf("[DEBUG] Mounting Kubernetes backend at %q", backend)
+	err := client.Sys().Mount(backend, &api.MountInput{
+		Type:        "kubernetes",
+		Description: description,
+		Local:       local,
+		Config: api.MountConfigInput{
+			DefaultLeaseTTL: fmt.Sprintf("%ds", defaultTTL),
+			MaxLeaseTTL:     fmt.Sprintf("%ds", maxTTL),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error mounting to %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Mounted Kubernetes backend at %q", backend)
+	d.SetId(backend)
+
+	data := map[string]interface{}{}
+	for _, k := range append(kubernetesSecretBackendConfigFields, "service_account_jwt") {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+
+	configPath := kubernetesSecretBackendConfigPath(backend)
+	log.Printf("[DEBUG] Writing %q", configPath)
+	if _, err := client.Logical().Write(configPath, data); err != nil {
+		return fmt.Errorf("error writing %q: %s", configPath, err)
+	}
+	log.Printf("[DEBUG] Wrote %q", configPath)
+
+	return kubernetesSecretBackendRead(d, meta)
+}
+
+func kubernetesSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Reading %q", path)
+
+	mountResp, err := client.Sys().MountConfig(path)
+	if err != nil && util.Is404(err) {
+		log.Printf("[WARN] %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("error reading %q: %s", path, err)
+	}
+
+	d.Set("backend", path)
+	d.Set("default_lease_ttl_seconds", mountResp.DefaultLeaseTTL)
+	d.Set("max_lease_ttl_seconds", mountResp.MaxLeaseTTL)
+
+	configPath := kubernetesSecretBackendConfigPath(path)
+	log.Printf("[DEBUG] Reading %q", configPath)
+	resp, err := client.Logical().Read(configPath)
+	if err != nil {
+		return fmt.Errorf("error reading %q: %s", configPath, err)
+	}
+	log.Printf("[DEBUG] Read %q", configPath)
+	if resp == nil {
+		log.Printf("[WARN] %q not found, removing from state", configPath)
+		d.SetId("")
+		return nil
+	}
+
+	for _, k := range kubernetesSecretBackendConfigFields {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting state key %q: %s", k, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func kubernetesSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	backend := d.Id()
+
+	if d.HasChange("default_lease_ttl_seconds") || d.HasChange("max_lease_ttl_seconds") {
+		tune := api.MountConfigInput{
+			DefaultLeaseTTL: fmt.Sprintf("%ds", d.Get("default_lease_ttl_seconds")),
+			MaxLeaseTTL:     fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds")),
+		}
+
+		log.Printf("[DEBUG] Updating mount lease TTLs for %q", backend)
+		if err := client.Sys().TuneMount(backend, tune); err != nil {
+			return fmt.Errorf("error updating mount TTLs for %q: %s", backend, err)
+		}
+		log.Printf("[DEBUG] Updated lease TTLs for %q", backend)
+	}
+
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		log.Printf("[DEBUG] Updating description for %q", backend)
+		if err := client.Sys().TuneMount(backend, api.MountConfigInput{Description: &description}); err != nil {
+			return fmt.Errorf("error updating description for %q: %s", backend, err)
+		}
+		log.Printf("[DEBUG] Updated description for %q", backend)
+	}
+
+	data := map[string]interface{}{}
+	for _, k := range append(kubernetesSecretBackendConfigFields, "service_account_jwt") {
+		if v, ok := d.GetOk(k); ok || d.HasChange(k) {
+			data[k] = v
+		}
+	}
+
+	configPath := kubernetesSecretBackendConfigPath(backend)
+	log.Printf("[DEBUG] Updating %q", configPath)
+	if _, err := client.Logical().Write(configPath, data); err != nil {
+		return fmt.Errorf("error updating %q: %s", configPath, err)
+	}
+	log.Printf("[DEBUG] Updated %q", configPath)
+
+	return kubernetesSecretBackendRead(d, meta)
+}
+
+func kubernetesSecretBackendDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	backend := d.Id()
+
+	log.Printf("[DEBUG] Unmounting Kubernetes backend %q", backend)
+	if err := client.Sys().Unmount(backend); err != nil {
+		if util.Is404(err) {
+			log.Printf("[WARN] %q not found, removing from state", backend)
+			return nil
+		}
+		return fmt.Errorf("error unmounting Kubernetes backend from %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Unmounted Kubernetes backend %q", backend)
+
+	return nil
+}
+
+func kubernetesSecretBackendConfigPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config"
+}
@@ -0,0 +1,252 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	kubernetesSecretBackendFromRolePathRegex     = regexp.MustCompile("^(.+)/roles/.+$")
+	kubernetesSecretBackendRoleNameFromPathRegex = regexp.MustCompile("^.+/roles/(.+$)")
+)
+
+// kubernetesSecretBackendRoleModeFields are the mutually exclusive fields
+// that decide how Vault provisions the service account for a role.
+var kubernetesSecretBackendRoleModeFields = []string{
+	"service_account_name",
+	"kubernetes_role_name",
+	"generated_role_rules",
+}
+
+// kubernetesSecretBackendRoleFields are the fields of a role that are sent
+// to, and returned by, Vault as-is.
+var kubernetesSecretBackendRoleFields = []string{
+	"allowed_kubernetes_namespace_selector",
+	"token_max_ttl",
+	"token_default_ttl",
+	"service_account_name",
+	"kubernetes_role_name",
+	"kubernetes_role_type",
+	"generated_role_rules",
+	"name_template",
+	"extra_annotations",
+	"extra_labels",
+}
+
+func kubernetesSecretBackendRoleResource() *schema.Resource {
+	fields := map[string]*schema.Schema{
+		"backend": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The mount path for the Kubernetes backend.",
+			StateFunc: func(v interface{}) string {
+				return strings.Trim(v.(string), "/")
+			},
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Name of the role.",
+		},
+		"allowed_kubernetes_namespaces": {
+			Type:        schema.TypeSet,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Description: `The list of Kubernetes namespaces this role can generate credentials for. If set to "*" all namespaces are allowed.`,
+		},
+		"allowed_kubernetes_namespace_selector": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "A label selector for Kubernetes namespaces in which credentials can be generated, in JSON or YAML format. Requires Vault 1.14+.",
+		},
+		"token_max_ttl": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "The maximum TTL for generated Kubernetes tokens in seconds.",
+		},
+		"token_default_ttl": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "The default TTL for generated Kubernetes tokens in seconds.",
+		},
+		"service_account_name": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Description:   "The pre-existing service account to generate tokens for.",
+			ConflictsWith: []string{"kubernetes_role_name", "generated_role_rules"},
+		},
+		"kubernetes_role_name": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Description:   "The pre-existing Role or ClusterRole to bind a generated service account to.",
+			ConflictsWith: []string{"service_account_name", "generated_role_rules"},
+		},
+		"kubernetes_role_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "Role",
+			Description:  "Specifies whether the Kubernetes role is a Role or ClusterRole.",
+			ValidateFunc: validation.StringInSlice([]string{"Role", "ClusterRole"}, false),
+		},
+		"generated_role_rules": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Description:   "The Role or ClusterRole rules to use when generating a role, in JSON or YAML format.",
+			ConflictsWith: []string{"service_account_name", "kubernetes_role_name"},
+		},
+		"name_template": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The name template to use when generating service accounts, roles and role bindings.",
+		},
+		"extra_annotations": {
+			Type:        schema.TypeMap,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Description: "Additional annotations to apply to all generated Kubernetes objects.",
+		},
+		"extra_labels": {
+			Type:        schema.TypeMap,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Description: "Additional labels to apply to all generated Kubernetes objects.",
+		},
+	}
+	return &schema.Resource{
+		Create: kubernetesSecretBackendRoleWrite,
+		Update: kubernetesSecretBackendRoleWrite,
+		Read:   kubernetesSecretBackendRoleRead,
+		Delete: kubernetesSecretBackendRoleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: fields,
+	}
+}
+
+func kubernetesSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+	rolePath := kubernetesSecretBackendRolePath(backend, name)
+
+	var mode bool
+	for _, k := range kubernetesSecretBackendRoleModeFields {
+		if _, ok := d.GetOk(k); ok {
+			mode = true
+		}
+	}
+	if !mode {
+		return fmt.Errorf("one of %s must be set", strings.Join(kubernetesSecretBackendRoleModeFields, ", "))
+	}
+
+	_, namespacesOk := d.GetOk("allowed_kubernetes_namespaces")
+	_, selectorOk := d.GetOk("allowed_kubernetes_namespace_selector")
+	if !namespacesOk && !selectorOk {
+		return fmt.Errorf("one of allowed_kubernetes_namespaces, allowed_kubernetes_namespace_selector must be set")
+	}
+
+	data := map[string]interface{}{
+		"allowed_kubernetes_namespaces": d.Get("allowed_kubernetes_namespaces").(*schema.Set).List(),
+	}
+	for _, k := range kubernetesSecretBackendRoleFields {
+		if v, ok := d.GetOk(k); ok || d.HasChange(k) {
+			data[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Writing %q", rolePath)
+	if _, err := client.Logical().Write(rolePath, data); err != nil {
+		return fmt.Errorf("error writing %q: %s", rolePath, err)
+	}
+	d.SetId(rolePath)
+	log.Printf("[DEBUG] Wrote %q", rolePath)
+
+	return kubernetesSecretBackendRoleRead(d, meta)
+}
+
+func kubernetesSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	rolePath := d.Id()
+
+	backend, err := kubernetesSecretBackendFromRolePath(rolePath)
+	if err != nil {
+		return fmt.Errorf("invalid role ID %q: %s", rolePath, err)
+	}
+	name, err := kubernetesSecretBackendRoleNameFromPath(rolePath)
+	if err != nil {
+		return fmt.Errorf("invalid role ID %q: %s", rolePath, err)
+	}
+
+	log.Printf("[DEBUG] Reading %q", rolePath)
+	resp, err := client.Logical().Read(rolePath)
+	if err != nil {
+		return fmt.Errorf("error reading %q: %s", rolePath, err)
+	}
+	log.Printf("[DEBUG] Read %q", rolePath)
+	if resp == nil {
+		log.Printf("[WARN] %q not found, removing from state", rolePath)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("name", name)
+	for _, k := range append(kubernetesSecretBackendRoleFields, "allowed_kubernetes_namespaces") {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting state key %q: %s", k, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func kubernetesSecretBackendRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	rolePath := d.Id()
+
+	log.Printf("[DEBUG] Deleting %q", rolePath)
+	if _, err := client.Logical().Delete(rolePath); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error deleting %q: %s", rolePath, err)
+	}
+	log.Printf("[DEBUG] Deleted %q", rolePath)
+
+	return nil
+}
+
+func kubernetesSecretBackendRolePath(backend, name string) string {
+	return strings.Trim(backend, "/") + "/roles/" + strings.Trim(name, "/")
+}
+
+func kubernetesSecretBackendFromRolePath(path string) (string, error) {
+	if !kubernetesSecretBackendFromRolePathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := kubernetesSecretBackendFromRolePathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
+
+func kubernetesSecretBackendRoleNameFromPath(path string) (string, error) {
+	if !kubernetesSecretBackendRoleNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no role found")
+	}
+	res := kubernetesSecretBackendRoleNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for role", len(res))
+	}
+	return res[1], nil
+}
@@ -0,0 +1,136 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccKubernetesSecretBackendRole_serviceAccount(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-kubernetes")
+	resourceName := "vault_kubernetes_secret_backend_role.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccKubernetesSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testKubernetesSecretBackendRoleConfig(backend, `
+  allowed_kubernetes_namespaces = ["dev", "staging"]
+  service_account_name          = "existing-sa"
+  token_default_ttl             = 600
+  token_max_ttl                 = 1200
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", backend+"/roles/test"),
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "name", "test"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_kubernetes_namespaces.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "service_account_name", "existing-sa"),
+					resource.TestCheckResourceAttr(resourceName, "token_default_ttl", "600"),
+					resource.TestCheckResourceAttr(resourceName, "token_max_ttl", "1200"),
+				),
+			},
+			{
+				Config: testKubernetesSecretBackendRoleConfig(backend, `
+  allowed_kubernetes_namespaces = ["*"]
+  kubernetes_role_name          = "existing-role"
+  kubernetes_role_type          = "ClusterRole"
+  name_template                 = "vault-{{.RoleName}}-{{random 8}}"
+
+  extra_labels = {
+    team = "platform"
+  }
+
+  extra_annotations = {
+    owner = "vault"
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "allowed_kubernetes_namespaces.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "service_account_name", ""),
+					resource.TestCheckResourceAttr(resourceName, "kubernetes_role_name", "existing-role"),
+					resource.TestCheckResourceAttr(resourceName, "kubernetes_role_type", "ClusterRole"),
+					resource.TestCheckResourceAttr(resourceName, "name_template", "vault-{{.RoleName}}-{{random 8}}"),
+					resource.TestCheckResourceAttr(resourceName, "extra_labels.team", "platform"),
+					resource.TestCheckResourceAttr(resourceName, "extra_annotations.owner", "vault"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKubernetesSecretBackendRole_generatedRoleRules(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-kubernetes")
+	resourceName := "vault_kubernetes_secret_backend_role.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccKubernetesSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testKubernetesSecretBackendRoleConfig(backend, `
+  allowed_kubernetes_namespace_selector = jsonencode({
+    matchLabels = {
+      stage = "prod"
+    }
+  })
+  generated_role_rules = "rules:\n- apiGroups: [\"\"]\n  resources: [\"pods\"]\n  verbs: [\"list\"]\n"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "allowed_kubernetes_namespaces.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_kubernetes_namespace_selector", `{"matchLabels":{"stage":"prod"}}`),
+					resource.TestCheckResourceAttrSet(resourceName, "generated_role_rules"),
+					resource.TestCheckResourceAttr(resourceName, "kubernetes_role_type", "Role"),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubernetesSecretBackendRoleCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_kubernetes_secret_backend_role" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("role %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testKubernetesSecretBackendRoleConfig(backend, extra string) string {
+	return fmt.Sprintf(`
+resource "vault_kubernetes_secret_backend" "test" {
+  backend              = "%s"
+  kubernetes_host      = "https://127.0.0.1:6443"
+  kubernetes_ca_cert   = %q
+  service_account_jwt  = %q
+  disable_local_ca_jwt = true
+}
+
+resource "vault_kubernetes_secret_backend_role" "test" {
+  backend = vault_kubernetes_secret_backend.test.backend
+  name    = "test"
+%s
+}
+`, backend, kubernetesCAcert, kubernetesJWT, extra)
+}
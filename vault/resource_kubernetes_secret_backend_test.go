@@ -0,0 +1,79 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccKubernetesSecretBackend_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-kubernetes")
+	resourceName := "vault_kubernetes_secret_backend.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccKubernetesSecretBackendCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testKubernetesSecretBackendConfig(backend, "https://127.0.0.1:6443", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "description", "test description"),
+					resource.TestCheckResourceAttr(resourceName, "kubernetes_host", "https://127.0.0.1:6443"),
+					resource.TestCheckResourceAttr(resourceName, "kubernetes_ca_cert", kubernetesCAcert),
+					resource.TestCheckResourceAttr(resourceName, "disable_local_ca_jwt", "false"),
+				),
+			},
+			{
+				Config: testKubernetesSecretBackendConfig(backend, "https://kubernetes.example.com:443", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "kubernetes_host", "https://kubernetes.example.com:443"),
+					resource.TestCheckResourceAttr(resourceName, "disable_local_ca_jwt", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"service_account_jwt", "description", "local"},
+			},
+		},
+	})
+}
+
+func testAccKubernetesSecretBackendCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_kubernetes_secret_backend" {
+			continue
+		}
+		if _, ok := mounts[rs.Primary.ID+"/"]; ok {
+			return fmt.Errorf("mount %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testKubernetesSecretBackendConfig(backend, host string, disableLocalCAJWT bool) string {
+	return fmt.Sprintf(`
+resource "vault_kubernetes_secret_backend" "test" {
+  backend              = "%s"
+  description          = "test description"
+  kubernetes_host      = "%s"
+  kubernetes_ca_cert   = %q
+  service_account_jwt  = %q
+  disable_local_ca_jwt = %t
+}
+`, backend, host, kubernetesCAcert, kubernetesJWT, disableLocalCAJWT)
+}
@@ -117,6 +117,7 @@ func ldapAuthBackendResource() *schema.Resource {
 			StateFunc: func(v interface{}) string {
 				return strings.Trim(v.(string), "/")
 			},
+			ValidateFunc: validateMountPath,
 		},
 
 		"accessor": {
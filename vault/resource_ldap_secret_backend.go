@@ -0,0 +1,324 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+// ldapSecretBackendConfigFields are the fields that are written to, and
+// read back from, the config endpoint of the LDAP secrets engine.
+var ldapSecretBackendConfigFields = []string{
+	"binddn",
+	"certificate",
+	"client_tls_cert",
+	"client_tls_key",
+	"connection_timeout",
+	"insecure_tls",
+	"password_policy",
+	"request_timeout",
+	"schema",
+	"starttls",
+	"upndomain",
+	"url",
+	"userattr",
+	"userdn",
+}
+
+func ldapSecretBackendResource() *schema.Resource {
+	fields := map[string]*schema.Schema{
+		"backend": {
+			Type:         schema.TypeString,
+			Default:      "ldap",
+			ForceNew:     true,
+			Optional:     true,
+			Description:  "The mount path for the LDAP backend.",
+			ValidateFunc: validateMountPath,
+			StateFunc: func(v interface{}) string {
+				return strings.Trim(v.(string), "/")
+			},
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Human-friendly description of the mount for the backend.",
+		},
+		"default_lease_ttl_seconds": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Default lease duration for secrets in seconds.",
+		},
+		"max_lease_ttl_seconds": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Maximum possible lease duration for secrets in seconds.",
+		},
+		"local": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "Mark the secrets engine as local-only. Local engines are not replicated or removed by replication.",
+		},
+		"binddn": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Distinguished name of object to bind when performing user and group search.",
+		},
+		"bindpass": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Sensitive:   true,
+			Description: "LDAP password for searching for the user DN.",
+		},
+		"certificate": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "CA certificate to use when verifying LDAP server certificate, must be x509 PEM encoded.",
+		},
+		"client_tls_cert": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			Description: "Client certificate to provide to the LDAP server, must be x509 PEM encoded.",
+		},
+		"client_tls_key": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			Description: "Client certificate key to provide to the LDAP server, must be x509 PEM encoded.",
+		},
+		"connection_timeout": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Timeout, in seconds, when attempting to connect to the LDAP server before trying the next URL in the configuration.",
+		},
+		"insecure_tls": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "Skip LDAP server SSL Certificate verification - insecure and not recommended for production use.",
+		},
+		"password_policy": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Name of the password policy to use to generate passwords.",
+		},
+		"request_timeout": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Timeout, in seconds, for the connection when making requests against the server before returning back an error.",
+		},
+		"schema": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "openldap",
+			Description:  "The LDAP schema to use when storing entry passwords. Valid schemas include openldap, ad, and racf.",
+			ValidateFunc: validation.StringInSlice([]string{"openldap", "ad", "racf"}, false),
+		},
+		"starttls": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "Issue a StartTLS command after establishing unencrypted connection.",
+		},
+		"upndomain": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Enables userPrincipalDomain login with [username]@UPNDomain.",
+		},
+		"url": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "LDAP URL to connect to (default: ldap://127.0.0.1). Multiple URLs can be specified by concatenating them with commas; they will be tried in-order.",
+		},
+		"userattr": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Attribute used for users (default: cn)",
+		},
+		"userdn": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "LDAP domain to use for users (eg: ou=People,dc=example,dc=org)",
+		},
+	}
+	return &schema.Resource{
+		Create: ldapSecretBackendCreate,
+		Update: ldapSecretBackendUpdate,
+		Read:   ldapSecretBackendRead,
+		Delete: ldapSecretBackendDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: fields,
+	}
+}
+
+func ldapSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	backend := d.Get("backend").(string)
+	description := d.Get("description").(string)
+	defaultTTL := d.Get("default_lease_ttl_seconds").(int)
+	local := d.Get("local").(bool)
+	maxTTL := d.Get("max_lease_ttl_seconds").(int)
+
+	log.Printf("[DEBUG] Mounting LDAP backend at %q", backend)
+	err := client.Sys().Mount(backend, &api.MountInput{
+		Type:        "ldap",
+		Description: description,
+		Local:       local,
+		Config: api.MountConfigInput{
+			DefaultLeaseTTL: fmt.Sprintf("%ds", defaultTTL),
+			MaxLeaseTTL:     fmt.Sprintf("%ds", maxTTL),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error mounting to %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Mounted LDAP backend at %q", backend)
+	d.SetId(backend)
+
+	data := map[string]interface{}{
+		"bindpass": d.Get("bindpass"),
+	}
+	for _, k := range ldapSecretBackendConfigFields {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+
+	configPath := ldapSecretBackendConfigPath(backend)
+	log.Printf("[DEBUG] Writing %q", configPath)
+	if _, err := client.Logical().Write(configPath, data); err != nil {
+		return fmt.Errorf("error writing %q: %s", configPath, err)
+	}
+	log.Printf("[DEBUG] Wrote %q", configPath)
+
+	return ldapSecretBackendRead(d, meta)
+}
+
+func ldapSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Reading %q", path)
+
+	mountResp, err := client.Sys().MountConfig(path)
+	if err != nil && util.Is404(err) {
+		log.Printf("[WARN] %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("error reading %q: %s", path, err)
+	}
+
+	d.Set("backend", path)
+	d.Set("default_lease_ttl_seconds", mountResp.DefaultLeaseTTL)
+	d.Set("max_lease_ttl_seconds", mountResp.MaxLeaseTTL)
+
+	configPath := ldapSecretBackendConfigPath(path)
+	log.Printf("[DEBUG] Reading %q", configPath)
+	resp, err := client.Logical().Read(configPath)
+	if err != nil {
+		return fmt.Errorf("error reading %q: %s", configPath, err)
+	}
+	log.Printf("[DEBUG] Read %q", configPath)
+	if resp == nil {
+		log.Printf("[WARN] %q not found, removing from state", configPath)
+		d.SetId("")
+		return nil
+	}
+
+	for _, k := range ldapSecretBackendConfigFields {
+		// Vault does not return the client TLS material.
+		if k == "client_tls_cert" || k == "client_tls_key" {
+			continue
+		}
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting state key %q: %s", k, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func ldapSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	backend := d.Id()
+
+	if d.HasChange("default_lease_ttl_seconds") || d.HasChange("max_lease_ttl_seconds") {
+		tune := api.MountConfigInput{
+			DefaultLeaseTTL: fmt.Sprintf("%ds", d.Get("default_lease_ttl_seconds")),
+			MaxLeaseTTL:     fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds")),
+		}
+
+		log.Printf("[DEBUG] Updating mount lease TTLs for %q", backend)
+		if err := client.Sys().TuneMount(backend, tune); err != nil {
+			return fmt.Errorf("error updating mount TTLs for %q: %s", backend, err)
+		}
+		log.Printf("[DEBUG] Updated lease TTLs for %q", backend)
+	}
+
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		log.Printf("[DEBUG] Updating description for %q", backend)
+		if err := client.Sys().TuneMount(backend, api.MountConfigInput{Description: &description}); err != nil {
+			return fmt.Errorf("error updating description for %q: %s", backend, err)
+		}
+		log.Printf("[DEBUG] Updated description for %q", backend)
+	}
+
+	data := map[string]interface{}{
+		"bindpass": d.Get("bindpass"),
+	}
+	for _, k := range ldapSecretBackendConfigFields {
+		if v, ok := d.GetOk(k); ok || d.HasChange(k) {
+			data[k] = v
+		}
+	}
+
+	configPath := ldapSecretBackendConfigPath(backend)
+	log.Printf("[DEBUG] Updating %q", configPath)
+	if _, err := client.Logical().Write(configPath, data); err != nil {
+		return fmt.Errorf("error updating %q: %s", configPath, err)
+	}
+	log.Printf("[DEBUG] Updated %q", configPath)
+
+	return ldapSecretBackendRead(d, meta)
+}
+
+func ldapSecretBackendDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	backend := d.Id()
+
+	log.Printf("[DEBUG] Unmounting LDAP backend %q", backend)
+	if err := client.Sys().Unmount(backend); err != nil {
+		if util.Is404(err) {
+			log.Printf("[WARN] %q not found, removing from state", backend)
+			return nil
+		}
+		return fmt.Errorf("error unmounting LDAP backend from %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Unmounted LDAP backend %q", backend)
+
+	return nil
+}
+
+func ldapSecretBackendConfigPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config"
+}
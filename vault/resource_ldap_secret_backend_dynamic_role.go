@@ -0,0 +1,190 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	ldapSecretBackendFromDynamicRolePathRegex     = regexp.MustCompile("^(.+)/role/.+$")
+	ldapSecretBackendDynamicRoleNameFromPathRegex = regexp.MustCompile("^.+/role/(.+$)")
+)
+
+// ldapSecretBackendDynamicRoleFields are the fields of a dynamic role that
+// are sent to, and returned by, Vault as-is.
+var ldapSecretBackendDynamicRoleFields = []string{
+	"creation_ldif",
+	"deletion_ldif",
+	"rollback_ldif",
+	"username_template",
+	"default_ttl",
+	"max_ttl",
+}
+
+func ldapSecretBackendDynamicRoleResource() *schema.Resource {
+	fields := map[string]*schema.Schema{
+		"backend": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The mount path for the LDAP backend.",
+			StateFunc: func(v interface{}) string {
+				return strings.Trim(v.(string), "/")
+			},
+		},
+		"role_name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Name of the role.",
+		},
+		"creation_ldif": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "A templatized LDIF string used to create a user account. May contain multiple entries.",
+		},
+		"deletion_ldif": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "A templatized LDIF string used to delete the user account once its TTL has expired. May contain multiple entries.",
+		},
+		"rollback_ldif": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "A templatized LDIF string used to attempt to rollback any changes in the event that execution of the creation_ldif results in an error.",
+		},
+		"username_template": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "A template used to generate a dynamic username.",
+		},
+		"default_ttl": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Specifies the TTL for the leases associated with this role, in seconds.",
+		},
+		"max_ttl": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Specifies the maximum TTL for the leases associated with this role, in seconds.",
+		},
+	}
+	return &schema.Resource{
+		Create: ldapSecretBackendDynamicRoleWrite,
+		Update: ldapSecretBackendDynamicRoleWrite,
+		Read:   ldapSecretBackendDynamicRoleRead,
+		Delete: ldapSecretBackendDynamicRoleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: fields,
+	}
+}
+
+func ldapSecretBackendDynamicRoleWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	backend := d.Get("backend").(string)
+	role := d.Get("role_name").(string)
+	rolePath := ldapSecretBackendDynamicRolePath(backend, role)
+
+	data := map[string]interface{}{}
+	for _, k := range ldapSecretBackendDynamicRoleFields {
+		if v, ok := d.GetOk(k); ok || d.HasChange(k) {
+			data[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Writing %q", rolePath)
+	if _, err := client.Logical().Write(rolePath, data); err != nil {
+		return fmt.Errorf("error writing %q: %s", rolePath, err)
+	}
+	d.SetId(rolePath)
+	log.Printf("[DEBUG] Wrote %q", rolePath)
+
+	return ldapSecretBackendDynamicRoleRead(d, meta)
+}
+
+func ldapSecretBackendDynamicRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	rolePath := d.Id()
+
+	backend, err := ldapSecretBackendFromDynamicRolePath(rolePath)
+	if err != nil {
+		return fmt.Errorf("invalid dynamic role ID %q: %s", rolePath, err)
+	}
+	role, err := ldapSecretBackendDynamicRoleNameFromPath(rolePath)
+	if err != nil {
+		return fmt.Errorf("invalid dynamic role ID %q: %s", rolePath, err)
+	}
+
+	log.Printf("[DEBUG] Reading %q", rolePath)
+	resp, err := client.Logical().Read(rolePath)
+	if err != nil {
+		return fmt.Errorf("error reading %q: %s", rolePath, err)
+	}
+	log.Printf("[DEBUG] Read %q", rolePath)
+	if resp == nil {
+		log.Printf("[WARN] %q not found, removing from state", rolePath)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("role_name", role)
+	for _, k := range ldapSecretBackendDynamicRoleFields {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting state key %q: %s", k, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func ldapSecretBackendDynamicRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	rolePath := d.Id()
+
+	log.Printf("[DEBUG] Deleting %q", rolePath)
+	if _, err := client.Logical().Delete(rolePath); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error deleting %q: %s", rolePath, err)
+	}
+	log.Printf("[DEBUG] Deleted %q", rolePath)
+
+	return nil
+}
+
+func ldapSecretBackendDynamicRolePath(backend, role string) string {
+	return strings.Trim(backend, "/") + "/role/" + strings.Trim(role, "/")
+}
+
+func ldapSecretBackendFromDynamicRolePath(path string) (string, error) {
+	if !ldapSecretBackendFromDynamicRolePathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := ldapSecretBackendFromDynamicRolePathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
+
+func ldapSecretBackendDynamicRoleNameFromPath(path string) (string, error) {
+	if !ldapSecretBackendDynamicRoleNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no role found")
+	}
+	res := ldapSecretBackendDynamicRoleNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for role", len(res))
+	}
+	return res[1], nil
+}
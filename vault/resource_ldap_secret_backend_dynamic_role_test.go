@@ -0,0 +1,110 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccLDAPSecretBackendDynamicRole_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-ldap")
+	bindDN, bindPass, url := util.GetTestLDAPCreds(t)
+	resourceName := "vault_ldap_secret_backend_dynamic_role.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { util.TestAccPreCheck(t) },
+		CheckDestroy: testAccLDAPSecretBackendDynamicRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testLDAPSecretBackendDynamicRoleConfig(backend, bindDN, bindPass, url, 3600, 7200),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", backend+"/role/dynamic"),
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "role_name", "dynamic"),
+					resource.TestCheckResourceAttr(resourceName, "username_template", "v_{{.RoleName}}_{{random 10}}"),
+					resource.TestCheckResourceAttr(resourceName, "default_ttl", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "max_ttl", "7200"),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_ldif"),
+					resource.TestCheckResourceAttrSet(resourceName, "deletion_ldif"),
+					resource.TestCheckResourceAttrSet(resourceName, "rollback_ldif"),
+				),
+			},
+			{
+				Config: testLDAPSecretBackendDynamicRoleConfig(backend, bindDN, bindPass, url, 1800, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "default_ttl", "1800"),
+					resource.TestCheckResourceAttr(resourceName, "max_ttl", "3600"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccLDAPSecretBackendDynamicRoleCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_ldap_secret_backend_dynamic_role" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("dynamic role %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testLDAPSecretBackendDynamicRoleConfig(backend, bindDN, bindPass, url string, defaultTTL, maxTTL int) string {
+	return fmt.Sprintf(`
+resource "vault_ldap_secret_backend" "config" {
+  backend      = "%s"
+  binddn       = "%s"
+  bindpass     = "%s"
+  url          = "%s"
+  insecure_tls = true
+  userdn       = "ou=users,dc=example,dc=org"
+}
+
+resource "vault_ldap_secret_backend_dynamic_role" "test" {
+  backend           = vault_ldap_secret_backend.config.backend
+  role_name         = "dynamic"
+  username_template = "v_{{.RoleName}}_{{random 10}}"
+  default_ttl       = %d
+  max_ttl           = %d
+
+  creation_ldif = <<EOT
+dn: cn={{.Username}},ou=users,dc=example,dc=org
+objectClass: person
+objectClass: top
+cn: learn
+sn: {{.Password | utf16le | base64}}
+userPassword: {{.Password}}
+EOT
+
+  deletion_ldif = <<EOT
+dn: cn={{.Username}},ou=users,dc=example,dc=org
+changetype: delete
+EOT
+
+  rollback_ldif = <<EOT
+dn: cn={{.Username}},ou=users,dc=example,dc=org
+changetype: delete
+EOT
+}
+`, backend, bindDN, bindPass, url, defaultTTL, maxTTL)
+}
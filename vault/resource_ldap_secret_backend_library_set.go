@@ -0,0 +1,173 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	ldapSecretBackendFromLibraryPathRegex    = regexp.MustCompile("^(.+)/library/.+$")
+	ldapSecretBackendLibrarySetFromPathRegex = regexp.MustCompile("^.+/library/(.+$)")
+)
+
+func ldapSecretBackendLibrarySetResource() *schema.Resource {
+	fields := map[string]*schema.Schema{
+		"backend": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The mount path for the LDAP backend.",
+			StateFunc: func(v interface{}) string {
+				return strings.Trim(v.(string), "/")
+			},
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The name of the set of service accounts.",
+		},
+		"service_account_names": {
+			Type:        schema.TypeList,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Required:    true,
+			Description: "The names of all the service accounts that can be checked out from this set. These service accounts must already exist in the LDAP directory.",
+		},
+		"ttl": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "The amount of time, in seconds, a single check-out lasts before Vault automatically checks it back in.",
+		},
+		"max_ttl": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "The maximum amount of time, in seconds, a check-out last with renewal before Vault automatically checks it back in.",
+		},
+		"disable_check_in_enforcement": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Disable enforcing that service accounts must be checked in by the entity or client token that checked them out.",
+		},
+	}
+	return &schema.Resource{
+		Create: ldapSecretBackendLibrarySetWrite,
+		Update: ldapSecretBackendLibrarySetWrite,
+		Read:   ldapSecretBackendLibrarySetRead,
+		Delete: ldapSecretBackendLibrarySetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: fields,
+	}
+}
+
+func ldapSecretBackendLibrarySetWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+	setPath := ldapSecretBackendLibrarySetPath(backend, name)
+
+	data := map[string]interface{}{
+		"service_account_names":        d.Get("service_account_names"),
+		"disable_check_in_enforcement": d.Get("disable_check_in_enforcement"),
+	}
+	for _, k := range []string{"ttl", "max_ttl"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Writing %q", setPath)
+	if _, err := client.Logical().Write(setPath, data); err != nil {
+		return fmt.Errorf("error writing %q: %s", setPath, err)
+	}
+	d.SetId(setPath)
+	log.Printf("[DEBUG] Wrote %q", setPath)
+
+	return ldapSecretBackendLibrarySetRead(d, meta)
+}
+
+func ldapSecretBackendLibrarySetRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	setPath := d.Id()
+
+	backend, err := ldapSecretBackendFromLibraryPath(setPath)
+	if err != nil {
+		return fmt.Errorf("invalid library ID %q: %s", setPath, err)
+	}
+	name, err := ldapSecretBackendLibrarySetFromPath(setPath)
+	if err != nil {
+		return fmt.Errorf("invalid library ID %q: %s", setPath, err)
+	}
+
+	log.Printf("[DEBUG] Reading %q", setPath)
+	resp, err := client.Logical().Read(setPath)
+	if err != nil {
+		return fmt.Errorf("error reading %q: %s", setPath, err)
+	}
+	log.Printf("[DEBUG] Read %q", setPath)
+	if resp == nil {
+		log.Printf("[WARN] %q not found, removing from state", setPath)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("name", name)
+	for _, k := range []string{"service_account_names", "ttl", "max_ttl", "disable_check_in_enforcement"} {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting state key %q: %s", k, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func ldapSecretBackendLibrarySetDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	setPath := d.Id()
+
+	log.Printf("[DEBUG] Deleting %q", setPath)
+	if _, err := client.Logical().Delete(setPath); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error deleting %q: %s", setPath, err)
+	}
+	log.Printf("[DEBUG] Deleted %q", setPath)
+
+	return nil
+}
+
+func ldapSecretBackendLibrarySetPath(backend, name string) string {
+	return strings.Trim(backend, "/") + "/library/" + strings.Trim(name, "/")
+}
+
+func ldapSecretBackendFromLibraryPath(path string) (string, error) {
+	if !ldapSecretBackendFromLibraryPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := ldapSecretBackendFromLibraryPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
+
+func ldapSecretBackendLibrarySetFromPath(path string) (string, error) {
+	if !ldapSecretBackendLibrarySetFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no name found")
+	}
+	res := ldapSecretBackendLibrarySetFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for name", len(res))
+	}
+	return res[1], nil
+}
@@ -0,0 +1,94 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccLDAPSecretBackendLibrarySet_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-ldap")
+	bindDN, bindPass, url := util.GetTestLDAPCreds(t)
+	resourceName := "vault_ldap_secret_backend_library_set.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { util.TestAccPreCheck(t) },
+		CheckDestroy: testAccLDAPSecretBackendLibrarySetCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testLDAPSecretBackendLibrarySetConfig(backend, bindDN, bindPass, url, `"bob","mary"`, 60, 120, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", backend+"/library/qa"),
+					resource.TestCheckResourceAttr(resourceName, "name", "qa"),
+					resource.TestCheckResourceAttr(resourceName, "service_account_names.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "service_account_names.0", "bob"),
+					resource.TestCheckResourceAttr(resourceName, "service_account_names.1", "mary"),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "60"),
+					resource.TestCheckResourceAttr(resourceName, "max_ttl", "120"),
+					resource.TestCheckResourceAttr(resourceName, "disable_check_in_enforcement", "false"),
+				),
+			},
+			{
+				Config: testLDAPSecretBackendLibrarySetConfig(backend, bindDN, bindPass, url, `"bob"`, 120, 240, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "service_account_names.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "service_account_names.0", "bob"),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "120"),
+					resource.TestCheckResourceAttr(resourceName, "max_ttl", "240"),
+					resource.TestCheckResourceAttr(resourceName, "disable_check_in_enforcement", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccLDAPSecretBackendLibrarySetCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_ldap_secret_backend_library_set" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("library set %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testLDAPSecretBackendLibrarySetConfig(backend, bindDN, bindPass, url, serviceAccountNames string, ttl, maxTTL int, disable bool) string {
+	return fmt.Sprintf(`
+resource "vault_ldap_secret_backend" "config" {
+  backend      = "%s"
+  binddn       = "%s"
+  bindpass     = "%s"
+  url          = "%s"
+  insecure_tls = true
+  userdn       = "ou=users,dc=example,dc=org"
+}
+
+resource "vault_ldap_secret_backend_library_set" "test" {
+  backend                      = vault_ldap_secret_backend.config.backend
+  name                         = "qa"
+  service_account_names        = [%s]
+  ttl                          = %d
+  max_ttl                      = %d
+  disable_check_in_enforcement = %t
+}
+`, backend, bindDN, bindPass, url, serviceAccountNames, ttl, maxTTL, disable)
+}
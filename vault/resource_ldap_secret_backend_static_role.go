@@ -0,0 +1,180 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	ldapSecretBackendFromStaticRolePathRegex     = regexp.MustCompile("^(.+)/static-role/.+$")
+	ldapSecretBackendStaticRoleNameFromPathRegex = regexp.MustCompile("^.+/static-role/(.+$)")
+)
+
+func ldapSecretBackendStaticRoleResource() *schema.Resource {
+	fields := map[string]*schema.Schema{
+		"backend": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The mount path for the LDAP backend.",
+			StateFunc: func(v interface{}) string {
+				return strings.Trim(v.(string), "/")
+			},
+		},
+		"role_name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Name of the role.",
+		},
+		"username": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The username of the existing LDAP entry to manage password rotation for.",
+		},
+		"dn": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Distinguished name (DN) of the existing LDAP entry to manage password rotation for.",
+		},
+		"rotation_period": {
+			Type:         schema.TypeInt,
+			Required:     true,
+			Description:  "How often Vault should rotate the password of the user entry, in seconds.",
+			ValidateFunc: validation.IntAtLeast(5),
+		},
+		"skip_import_rotation": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "Skip the initial password rotation when the role is created. Requires Vault 1.16+.",
+		},
+		"last_vault_rotation": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Last time Vault rotated this entry's password.",
+		},
+	}
+	return &schema.Resource{
+		Create: ldapSecretBackendStaticRoleWrite,
+		Update: ldapSecretBackendStaticRoleWrite,
+		Read:   ldapSecretBackendStaticRoleRead,
+		Delete: ldapSecretBackendStaticRoleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: fields,
+	}
+}
+
+func ldapSecretBackendStaticRoleWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	backend := d.Get("backend").(string)
+	role := d.Get("role_name").(string)
+	rolePath := ldapSecretBackendStaticRolePath(backend, role)
+
+	data := map[string]interface{}{
+		"username":        d.Get("username"),
+		"dn":              d.Get("dn"),
+		"rotation_period": d.Get("rotation_period"),
+	}
+	if d.IsNewResource() {
+		if v, ok := d.GetOkExists("skip_import_rotation"); ok {
+			data["skip_import_rotation"] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Writing %q", rolePath)
+	if _, err := client.Logical().Write(rolePath, data); err != nil {
+		return fmt.Errorf("error writing %q: %s", rolePath, err)
+	}
+	d.SetId(rolePath)
+	log.Printf("[DEBUG] Wrote %q", rolePath)
+
+	return ldapSecretBackendStaticRoleRead(d, meta)
+}
+
+func ldapSecretBackendStaticRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	rolePath := d.Id()
+
+	backend, err := ldapSecretBackendFromStaticRolePath(rolePath)
+	if err != nil {
+		return fmt.Errorf("invalid static role ID %q: %s", rolePath, err)
+	}
+	role, err := ldapSecretBackendStaticRoleNameFromPath(rolePath)
+	if err != nil {
+		return fmt.Errorf("invalid static role ID %q: %s", rolePath, err)
+	}
+
+	log.Printf("[DEBUG] Reading %q", rolePath)
+	resp, err := client.Logical().Read(rolePath)
+	if err != nil {
+		return fmt.Errorf("error reading %q: %s", rolePath, err)
+	}
+	log.Printf("[DEBUG] Read %q", rolePath)
+	if resp == nil {
+		log.Printf("[WARN] %q not found, removing from state", rolePath)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("role_name", role)
+	for _, k := range []string{"username", "dn", "rotation_period", "last_vault_rotation"} {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting state key %q: %s", k, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func ldapSecretBackendStaticRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	rolePath := d.Id()
+
+	log.Printf("[DEBUG] Deleting %q", rolePath)
+	if _, err := client.Logical().Delete(rolePath); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error deleting %q: %s", rolePath, err)
+	}
+	log.Printf("[DEBUG] Deleted %q", rolePath)
+
+	return nil
+}
+
+func ldapSecretBackendStaticRolePath(backend, role string) string {
+	return strings.Trim(backend, "/") + "/static-role/" + strings.Trim(role, "/")
+}
+
+func ldapSecretBackendFromStaticRolePath(path string) (string, error) {
+	if !ldapSecretBackendFromStaticRolePathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := ldapSecretBackendFromStaticRolePathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
+
+func ldapSecretBackendStaticRoleNameFromPath(path string) (string, error) {
+	if !ldapSecretBackendStaticRoleNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no role found")
+	}
+	res := ldapSecretBackendStaticRoleNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for role", len(res))
+	}
+	return res[1], nil
+}
@@ -0,0 +1,89 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccLDAPSecretBackendStaticRole_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-ldap")
+	bindDN, bindPass, url := util.GetTestLDAPCreds(t)
+	resourceName := "vault_ldap_secret_backend_static_role.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { util.TestAccPreCheck(t) },
+		CheckDestroy: testAccLDAPSecretBackendStaticRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testLDAPSecretBackendStaticRoleConfig(backend, bindDN, bindPass, url, "alice", 60),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", backend+"/static-role/alice"),
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "role_name", "alice"),
+					resource.TestCheckResourceAttr(resourceName, "username", "alice"),
+					resource.TestCheckResourceAttr(resourceName, "dn", "cn=alice,ou=users,dc=example,dc=org"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_period", "60"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_vault_rotation"),
+				),
+			},
+			{
+				Config: testLDAPSecretBackendStaticRoleConfig(backend, bindDN, bindPass, url, "alice", 120),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rotation_period", "120"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_import_rotation"},
+			},
+		},
+	})
+}
+
+func testAccLDAPSecretBackendStaticRoleCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_ldap_secret_backend_static_role" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("static role %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testLDAPSecretBackendStaticRoleConfig(backend, bindDN, bindPass, url, username string, rotationPeriod int) string {
+	return fmt.Sprintf(`
+resource "vault_ldap_secret_backend" "config" {
+  backend      = "%s"
+  binddn       = "%s"
+  bindpass     = "%s"
+  url          = "%s"
+  insecure_tls = true
+  userdn       = "ou=users,dc=example,dc=org"
+}
+
+resource "vault_ldap_secret_backend_static_role" "test" {
+  backend         = vault_ldap_secret_backend.config.backend
+  role_name       = "%[5]s"
+  username        = "%[5]s"
+  dn              = "cn=%[5]s,ou=users,dc=example,dc=org"
+  rotation_period = %[6]d
+}
+`, backend, bindDN, bindPass, url, username, rotationPeriod)
+}
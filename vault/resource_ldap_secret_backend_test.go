@@ -0,0 +1,86 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccLDAPSecretBackend_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-ldap")
+	bindDN, bindPass, url := util.GetTestLDAPCreds(t)
+	resourceName := "vault_ldap_secret_backend.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { util.TestAccPreCheck(t) },
+		CheckDestroy: testAccLDAPSecretBackendCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testLDAPSecretBackendConfig(backend, bindDN, bindPass, url, "openldap", 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "description", "test description"),
+					resource.TestCheckResourceAttr(resourceName, "default_lease_ttl_seconds", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "binddn", bindDN),
+					resource.TestCheckResourceAttr(resourceName, "url", url),
+					resource.TestCheckResourceAttr(resourceName, "schema", "openldap"),
+					resource.TestCheckResourceAttr(resourceName, "userdn", "ou=users,dc=example,dc=org"),
+				),
+			},
+			{
+				Config: testLDAPSecretBackendConfig(backend, bindDN, bindPass, url, "racf", 7200),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "default_lease_ttl_seconds", "7200"),
+					resource.TestCheckResourceAttr(resourceName, "schema", "racf"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"bindpass", "description", "local"},
+			},
+		},
+	})
+}
+
+func testAccLDAPSecretBackendCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_ldap_secret_backend" {
+			continue
+		}
+		if _, ok := mounts[rs.Primary.ID+"/"]; ok {
+			return fmt.Errorf("mount %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testLDAPSecretBackendConfig(backend, bindDN, bindPass, url, schema string, defaultTTL int) string {
+	return fmt.Sprintf(`
+resource "vault_ldap_secret_backend" "test" {
+  backend                   = "%s"
+  description               = "test description"
+  default_lease_ttl_seconds = %d
+  binddn                    = "%s"
+  bindpass                  = "%s"
+  url                       = "%s"
+  insecure_tls              = true
+  schema                    = "%s"
+  userdn                    = "ou=users,dc=example,dc=org"
+}
+`, backend, defaultTTL, bindDN, bindPass, url, schema)
+}
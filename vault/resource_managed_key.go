@@ -0,0 +1,190 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+// managedKeyCommonFields are the fields supported by every managed key type.
+var managedKeyCommonFields = []string{
+	"allow_generate_key",
+	"allow_replace_key",
+	"allow_store_key",
+	"any_mount",
+	"usages",
+}
+
+// managedKeyResource returns a resource managing a single managed key of the
+// given type. Each field of keySchema is passed to Vault as the key parameter
+// of the same name, alongside the fields common to all key types. Sensitive
+// fields aren't returned by Vault and are never read back.
+func managedKeyResource(keyType string, keySchema map[string]*schema.Schema) *schema.Resource {
+	s := map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Name of the managed key.",
+		},
+		"allow_generate_key": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Allow Vault to generate the key in the backend if it doesn't exist.",
+		},
+		"allow_replace_key": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Allow Vault to replace an existing key in the backend.",
+		},
+		"allow_store_key": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Allow Vault to store a key in the backend.",
+		},
+		"any_mount": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Allow any mount to use the key, rather than only those listing it in allowed_managed_keys.",
+		},
+		"usages": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Computed:    true,
+			Description: "Operations the key may be used for.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{
+					"encrypt", "decrypt", "sign", "verify", "wrap", "unwrap", "generate_random",
+				}, false),
+			},
+		},
+	}
+
+	key := &managedKey{
+		keyType: keyType,
+		schema:  s,
+		fields:  append([]string{}, managedKeyCommonFields...),
+	}
+	for k, v := range keySchema {
+		s[k] = v
+		key.fields = append(key.fields, k)
+	}
+
+	return &schema.Resource{
+		Create: key.write,
+		Read:   key.read,
+		Update: key.write,
+		Delete: key.delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: s,
+	}
+}
+
+// managedKey implements the CRUD operations for a typed managed key.
+type managedKey struct {
+	keyType string
+	schema  map[string]*schema.Schema
+	// fields are the fields passed to Vault as key parameters
+	fields []string
+}
+
+func (m *managedKey) path(name string) string {
+	return "sys/managed-keys/" + m.keyType + "/" + name
+}
+
+func (m *managedKey) write(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Get("name").(string)
+
+	data := map[string]interface{}{}
+	for _, k := range m.fields {
+		switch v := d.Get(k).(type) {
+		case *schema.Set:
+			if v.Len() > 0 {
+				data[k] = v.List()
+			}
+		case string:
+			// unset parameters are left to Vault's defaults
+			if v != "" {
+				data[k] = v
+			}
+		default:
+			data[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Writing %s managed key %q", m.keyType, name)
+	if _, err := client.Logical().Write(m.path(name), data); err != nil {
+		return fmt.Errorf("error writing %s managed key %q: %s", m.keyType, name, err)
+	}
+	d.SetId(name)
+	log.Printf("[DEBUG] Wrote %s managed key %q", m.keyType, name)
+
+	return m.read(d, meta)
+}
+
+func (m *managedKey) read(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Id()
+
+	log.Printf("[DEBUG] Reading %s managed key %q", m.keyType, name)
+	resp, err := client.Logical().Read(m.path(name))
+	if err != nil {
+		return fmt.Errorf("error reading %s managed key %q: %s", m.keyType, name, err)
+	}
+	log.Printf("[DEBUG] Read %s managed key %q", m.keyType, name)
+
+	if resp == nil {
+		log.Printf("[WARN] Managed key %q not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+
+	for _, k := range m.fields {
+		if m.schema[k].Sensitive {
+			continue
+		}
+		v, ok := resp.Data[k]
+		if !ok {
+			continue
+		}
+		if m.schema[k].Type == schema.TypeString {
+			v = fmt.Sprint(v)
+		}
+		if err := d.Set(k, v); err != nil {
+			return fmt.Errorf("error setting state key %q: %s", k, err)
+		}
+	}
+
+	return nil
+}
+
+func (m *managedKey) delete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Id()
+
+	log.Printf("[DEBUG] Deleting %s managed key %q", m.keyType, name)
+	if _, err := client.Logical().Delete(m.path(name)); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error deleting %s managed key %q: %s", m.keyType, name, err)
+	}
+	log.Printf("[DEBUG] Deleted %s managed key %q", m.keyType, name)
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package vault
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func managedKeyAWSResource() *schema.Resource {
+	return managedKeyResource("awskms", map[string]*schema.Schema{
+		"access_key": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Sensitive:   true,
+			Description: "AWS access key ID used to authenticate to KMS.",
+		},
+		"secret_key": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Sensitive:   true,
+			Description: "AWS secret access key used to authenticate to KMS.",
+		},
+		"kms_key": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "ID or alias of the KMS key.",
+		},
+		"key_bits": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Size in bits of the key, such as 2048 for RSA keys or 256 for EC keys.",
+		},
+		"key_type": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Type of the key, such as RSA or EC.",
+		},
+		"curve": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Curve of an EC key, such as P256.",
+		},
+		"endpoint": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Custom endpoint of the KMS API.",
+		},
+		"region": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "AWS region of the KMS key.",
+		},
+	})
+}
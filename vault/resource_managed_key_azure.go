@@ -0,0 +1,58 @@
+package vault
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func managedKeyAzureResource() *schema.Resource {
+	return managedKeyResource("azurekeyvault", map[string]*schema.Schema{
+		"tenant_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Azure tenant ID.",
+		},
+		"client_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Client ID of the service principal used to access Key Vault.",
+		},
+		"client_secret": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Sensitive:   true,
+			Description: "Client secret of the service principal used to access Key Vault.",
+		},
+		"vault_name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the Key Vault holding the key.",
+		},
+		"key_name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the key in Key Vault.",
+		},
+		"key_type": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Type of the key, such as RSA-HSM.",
+		},
+		"key_bits": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Size in bits of an RSA key, such as 2048.",
+		},
+		"environment": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Azure cloud environment, such as AZUREPUBLICCLOUD.",
+		},
+		"resource": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Azure Key Vault resource domain, such as vault.azure.net.",
+		},
+	})
+}
@@ -0,0 +1,47 @@
+package vault
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func managedKeyGCPResource() *schema.Resource {
+	return managedKeyResource("gcpckms", map[string]*schema.Schema{
+		"credentials": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Sensitive:   true,
+			Description: "JSON service account credentials used to access Cloud KMS. Defaults to the Vault server's application default credentials.",
+		},
+		"project": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "GCP project holding the key ring.",
+		},
+		"region": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Location of the key ring, such as global or us-east1.",
+		},
+		"key_ring": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the key ring holding the key.",
+		},
+		"crypto_key": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the crypto key.",
+		},
+		"crypto_key_version": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Version of the crypto key to use. Defaults to the primary version.",
+		},
+		"algorithm": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Algorithm of a key generated by Vault, such as ec_sign_p256_sha256.",
+		},
+	})
+}
@@ -0,0 +1,70 @@
+package vault
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func managedKeyPKCS11Resource() *schema.Resource {
+	return managedKeyResource("pkcs11", map[string]*schema.Schema{
+		"library": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the kms_library stanza in the Vault server configuration naming the PKCS#11 library.",
+		},
+		"key_label": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Label of the key in the HSM.",
+		},
+		"key_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "ID of the key in the HSM.",
+		},
+		"mechanism": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Encryption or signing mechanism used with the key, such as 0x0001.",
+		},
+		"pin": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Sensitive:   true,
+			Description: "PIN used to log in to the HSM.",
+		},
+		"slot": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Description:   "Slot number of the HSM token holding the key.",
+			ConflictsWith: []string{"token_label"},
+		},
+		"token_label": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Description:   "Label of the HSM token holding the key.",
+			ConflictsWith: []string{"slot"},
+		},
+		"curve": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Curve of an EC key, such as P256.",
+		},
+		"key_bits": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Size in bits of the key, such as 2048 for RSA keys.",
+		},
+		"force_rw_session": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Force all operations to open a read-write session to the HSM.",
+		},
+		"max_parallel": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "Maximum number of concurrent requests to the HSM.",
+		},
+	})
+}
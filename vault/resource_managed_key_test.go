@@ -0,0 +1,153 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestManagedKeyAWS(t *testing.T) {
+	isEnterprise := os.Getenv("TF_ACC_ENTERPRISE")
+	if isEnterprise == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+
+	accessKey, secretKey := getTestAWSCreds(t)
+	region := getTestAWSRegion(t)
+	kmsKey := os.Getenv("AWS_KMS_KEY_ID")
+	if kmsKey == "" {
+		t.Skip("AWS_KMS_KEY_ID not set")
+	}
+
+	name := acctest.RandomWithPrefix("tf-test-key")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testManagedKeyCheckDestroy("vault_managed_key_aws", "awskms"),
+		Steps: []resource.TestStep{
+			{
+				Config: testManagedKeyAWS_config(name, accessKey, secretKey, region, kmsKey, `["sign"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_managed_key_aws.test", "name", name),
+					resource.TestCheckResourceAttr("vault_managed_key_aws.test", "kms_key", kmsKey),
+					resource.TestCheckResourceAttr("vault_managed_key_aws.test", "region", region),
+					resource.TestCheckResourceAttr("vault_managed_key_aws.test", "usages.#", "1"),
+				),
+			},
+			{
+				Config: testManagedKeyAWS_config(name, accessKey, secretKey, region, kmsKey, `["sign", "verify"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_managed_key_aws.test", "name", name),
+					resource.TestCheckResourceAttr("vault_managed_key_aws.test", "usages.#", "2"),
+				),
+			},
+			{
+				ResourceName:            "vault_managed_key_aws.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"access_key", "secret_key"},
+			},
+		},
+	})
+}
+
+func TestManagedKeyGCP(t *testing.T) {
+	isEnterprise := os.Getenv("TF_ACC_ENTERPRISE")
+	if isEnterprise == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+
+	credentials, keyRing := getTestGCPKMSKeyRing(t)
+	cryptoKey := os.Getenv("GOOGLE_KMS_CRYPTO_KEY")
+	if cryptoKey == "" {
+		t.Skip("GOOGLE_KMS_CRYPTO_KEY not set")
+	}
+
+	// GOOGLE_KMS_KEY_RING is the full resource name of the key ring, in the
+	// form projects/<project>/locations/<region>/keyRings/<name>.
+	parts := strings.Split(keyRing, "/")
+	if len(parts) != 6 {
+		t.Fatalf("GOOGLE_KMS_KEY_RING %q is not a full key ring resource name", keyRing)
+	}
+
+	name := acctest.RandomWithPrefix("tf-test-key")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testManagedKeyCheckDestroy("vault_managed_key_gcp", "gcpckms"),
+		Steps: []resource.TestStep{
+			{
+				Config: testManagedKeyGCP_config(name, credentials, parts[1], parts[3], parts[5], cryptoKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_managed_key_gcp.test", "name", name),
+					resource.TestCheckResourceAttr("vault_managed_key_gcp.test", "project", parts[1]),
+					resource.TestCheckResourceAttr("vault_managed_key_gcp.test", "region", parts[3]),
+					resource.TestCheckResourceAttr("vault_managed_key_gcp.test", "key_ring", parts[5]),
+					resource.TestCheckResourceAttr("vault_managed_key_gcp.test", "crypto_key", cryptoKey),
+				),
+			},
+			{
+				ResourceName:            "vault_managed_key_gcp.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"credentials"},
+			},
+		},
+	})
+}
+
+func testManagedKeyCheckDestroy(resourceType, keyType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testProvider.Meta().(*api.Client)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+			resp, err := client.Logical().Read("sys/managed-keys/" + keyType + "/" + rs.Primary.ID)
+			if err != nil {
+				return fmt.Errorf("error checking for managed key %q: %s", rs.Primary.ID, err)
+			}
+			if resp != nil {
+				return fmt.Errorf("managed key %q still exists", rs.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
+
+func testManagedKeyAWS_config(name, accessKey, secretKey, region, kmsKey, usages string) string {
+	return fmt.Sprintf(`
+resource "vault_managed_key_aws" "test" {
+  name       = "%s"
+  access_key = "%s"
+  secret_key = "%s"
+  region     = "%s"
+  kms_key    = "%s"
+  key_bits   = "2048"
+  key_type   = "RSA"
+  usages     = %s
+}
+`, name, accessKey, secretKey, region, kmsKey, usages)
+}
+
+func testManagedKeyGCP_config(name, credentials, project, region, keyRing, cryptoKey string) string {
+	return fmt.Sprintf(`
+resource "vault_managed_key_gcp" "test" {
+  name        = "%s"
+  credentials = <<EOT
+%s
+EOT
+  project     = "%s"
+  region      = "%s"
+  key_ring    = "%s"
+  crypto_key  = "%s"
+}
+`, name, credentials, project, region, keyRing, cryptoKey)
+}
@@ -0,0 +1,215 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+func mongodbAtlasSecretBackendResource() *schema.Resource {
+	fields := map[string]*schema.Schema{
+		"backend": {
+			Type:         schema.TypeString,
+			Default:      "mongodbatlas",
+			ForceNew:     true,
+			Optional:     true,
+			Description:  "The mount path for the MongoDB Atlas backend.",
+			ValidateFunc: validateMountPath,
+			StateFunc: func(v interface{}) string {
+				return strings.Trim(v.(string), "/")
+			},
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Human-friendly description of the mount for the backend.",
+		},
+		"default_lease_ttl_seconds": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Default lease duration for secrets in seconds.",
+		},
+		"max_lease_ttl_seconds": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Maximum possible lease duration for secrets in seconds.",
+		},
+		"local": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "Mark the secrets engine as local-only. Local engines are not replicated or removed by replication.",
+		},
+		"public_key": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The Public Programmatic API Key used to authenticate with the MongoDB Atlas API.",
+		},
+		"private_key": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Sensitive:   true,
+			Description: "The Private Programmatic API Key used to connect with MongoDB Atlas API.",
+		},
+	}
+	return &schema.Resource{
+		Create: mongodbAtlasSecretBackendCreate,
+		Update: mongodbAtlasSecretBackendUpdate,
+		Read:   mongodbAtlasSecretBackendRead,
+		Delete: mongodbAtlasSecretBackendDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: fields,
+	}
+}
+
+func mongodbAtlasSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	backend := d.Get("backend").(string)
+	description := d.Get("description").(string)
+	defaultTTL := d.Get("default_lease_ttl_seconds").(int)
+	local := d.Get("local").(bool)
+	maxTTL := d.Get("max_lease_ttl_seconds").(int)
+
+	log.Printf("[DEBUG] Mounting MongoDB Atlas backend at %q", backend)
+	err := client.Sys().Mount(backend, &api.MountInput{
+		Type:        "mongodbatlas",
+		Description: description,
+		Local:       local,
+		Config: api.MountConfigInput{
+			DefaultLeaseTTL: fmt.Sprintf("%ds", defaultTTL),
+			MaxLeaseTTL:     fmt.Sprintf("%ds", maxTTL),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error mounting to %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Mounted MongoDB Atlas backend at %q", backend)
+	d.SetId(backend)
+
+	if err := mongodbAtlasSecretBackendWriteConfig(d, client, backend); err != nil {
+		return err
+	}
+
+	return mongodbAtlasSecretBackendRead(d, meta)
+}
+
+func mongodbAtlasSecretBackendWriteConfig(d *schema.ResourceData, client *api.Client, backend string) error {
+	data := map[string]interface{}{
+		"public_key":  d.Get("public_key"),
+		"private_key": d.Get("private_key"),
+	}
+
+	configPath := mongodbAtlasSecretBackendConfigPath(backend)
+	log.Printf("[DEBUG] Writing %q", configPath)
+	if _, err := client.Logical().Write(configPath, data); err != nil {
+		return fmt.Errorf("error writing %q: %s", configPath, err)
+	}
+	log.Printf("[DEBUG] Wrote %q", configPath)
+
+	return nil
+}
+
+func mongodbAtlasSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	log.Printf("[DEBUG] Reading %q", path)
+
+	mountResp, err := client.Sys().MountConfig(path)
+	if err != nil && util.Is404(err) {
+		log.Printf("[WARN] %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("error reading %q: %s", path, err)
+	}
+
+	d.Set("backend", path)
+	d.Set("default_lease_ttl_seconds", mountResp.DefaultLeaseTTL)
+	d.Set("max_lease_ttl_seconds", mountResp.MaxLeaseTTL)
+
+	configPath := mongodbAtlasSecretBackendConfigPath(path)
+	log.Printf("[DEBUG] Reading %q", configPath)
+	resp, err := client.Logical().Read(configPath)
+	if err != nil {
+		return fmt.Errorf("error reading %q: %s", configPath, err)
+	}
+	log.Printf("[DEBUG] Read %q", configPath)
+	if resp == nil {
+		log.Printf("[WARN] %q not found, removing from state", configPath)
+		d.SetId("")
+		return nil
+	}
+
+	if v, ok := resp.Data["public_key"]; ok {
+		if err := d.Set("public_key", v); err != nil {
+			return fmt.Errorf("error setting state key 'public_key': %s", err)
+		}
+	}
+
+	return nil
+}
+
+func mongodbAtlasSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	backend := d.Id()
+
+	if d.HasChange("default_lease_ttl_seconds") || d.HasChange("max_lease_ttl_seconds") {
+		tune := api.MountConfigInput{
+			DefaultLeaseTTL: fmt.Sprintf("%ds", d.Get("default_lease_ttl_seconds")),
+			MaxLeaseTTL:     fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds")),
+		}
+
+		log.Printf("[DEBUG] Updating mount lease TTLs for %q", backend)
+		if err := client.Sys().TuneMount(backend, tune); err != nil {
+			return fmt.Errorf("error updating mount TTLs for %q: %s", backend, err)
+		}
+		log.Printf("[DEBUG] Updated lease TTLs for %q", backend)
+	}
+
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		log.Printf("[DEBUG] Updating description for %q", backend)
+		if err := client.Sys().TuneMount(backend, api.MountConfigInput{Description: &description}); err != nil {
+			return fmt.Errorf("error updating description for %q: %s", backend, err)
+		}
+		log.Printf("[DEBUG] Updated description for %q", backend)
+	}
+
+	if d.HasChange("public_key") || d.HasChange("private_key") {
+		if err := mongodbAtlasSecretBackendWriteConfig(d, client, backend); err != nil {
+			return err
+		}
+	}
+
+	return mongodbAtlasSecretBackendRead(d, meta)
+}
+
+func mongodbAtlasSecretBackendDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	backend := d.Id()
+
+	log.Printf("[DEBUG] Unmounting MongoDB Atlas backend %q", backend)
+	if err := client.Sys().Unmount(backend); err != nil {
+		if util.Is404(err) {
+			log.Printf("[WARN] %q not found, removing from state", backend)
+			return nil
+		}
+		return fmt.Errorf("error unmounting MongoDB Atlas backend from %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Unmounted MongoDB Atlas backend %q", backend)
+
+	return nil
+}
+
+func mongodbAtlasSecretBackendConfigPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config"
+}
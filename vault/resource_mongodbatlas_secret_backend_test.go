@@ -0,0 +1,78 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccMongoDBAtlasSecretBackend_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-mongodbatlas")
+	resourceName := "vault_mongodbatlas_secret_backend.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccMongoDBAtlasSecretBackendCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testMongoDBAtlasSecretBackendConfig(backend, "public-key", "private-key", 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "public_key", "public-key"),
+					resource.TestCheckResourceAttr(resourceName, "private_key", "private-key"),
+					resource.TestCheckResourceAttr(resourceName, "default_lease_ttl_seconds", "3600"),
+				),
+			},
+			{
+				Config: testMongoDBAtlasSecretBackendConfig(backend, "public-key-updated", "private-key-updated", 7200),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "public_key", "public-key-updated"),
+					resource.TestCheckResourceAttr(resourceName, "private_key", "private-key-updated"),
+					resource.TestCheckResourceAttr(resourceName, "default_lease_ttl_seconds", "7200"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"private_key", "description", "local"},
+			},
+		},
+	})
+}
+
+func testAccMongoDBAtlasSecretBackendCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_mongodbatlas_secret_backend" {
+			continue
+		}
+		if _, ok := mounts[rs.Primary.ID+"/"]; ok {
+			return fmt.Errorf("mount %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testMongoDBAtlasSecretBackendConfig(backend, publicKey, privateKey string, defaultTTL int) string {
+	return fmt.Sprintf(`
+resource "vault_mongodbatlas_secret_backend" "test" {
+  backend                   = "%s"
+  description               = "test description"
+  default_lease_ttl_seconds = %d
+  public_key                = "%s"
+  private_key               = "%s"
+}
+`, backend, defaultTTL, publicKey, privateKey)
+}
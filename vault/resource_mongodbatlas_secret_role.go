@@ -0,0 +1,214 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	mongodbAtlasSecretBackendFromRolePathRegex     = regexp.MustCompile("^(.+)/roles/.+$")
+	mongodbAtlasSecretBackendRoleNameFromPathRegex = regexp.MustCompile("^.+/roles/(.+$)")
+)
+
+// mongodbAtlasSecretRoleListFields are the list fields of a role, which Vault
+// accepts as lists and returns as lists.
+var mongodbAtlasSecretRoleListFields = []string{
+	"roles",
+	"ip_addresses",
+	"cidr_blocks",
+	"project_roles",
+}
+
+func mongodbAtlasSecretRoleResource() *schema.Resource {
+	fields := map[string]*schema.Schema{
+		"backend": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The mount path for the MongoDB Atlas backend.",
+			StateFunc: func(v interface{}) string {
+				return strings.Trim(v.(string), "/")
+			},
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Name of the role.",
+		},
+		"organization_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "ID for the organization to which the target API Key belongs.",
+		},
+		"project_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "ID for the project to which the target API Key belongs.",
+		},
+		"roles": {
+			Type:        schema.TypeList,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Required:    true,
+			Description: "List of roles that the API Key needs to have.",
+		},
+		"ip_addresses": {
+			Type:        schema.TypeList,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Description: "IP address to be added to the whitelist for the API key.",
+		},
+		"cidr_blocks": {
+			Type:        schema.TypeList,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Description: "Whitelist entry in CIDR notation to be added for the API key.",
+		},
+		"project_roles": {
+			Type:        schema.TypeList,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Description: "Roles assigned when an org API key is assigned to a project API key.",
+		},
+		"ttl": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Duration in seconds after which the issued credential should expire.",
+		},
+		"max_ttl": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "The maximum allowed lifetime of credentials issued using this role, in seconds.",
+		},
+	}
+	return &schema.Resource{
+		Create: mongodbAtlasSecretRoleWrite,
+		Update: mongodbAtlasSecretRoleWrite,
+		Read:   mongodbAtlasSecretRoleRead,
+		Delete: mongodbAtlasSecretRoleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: fields,
+	}
+}
+
+func mongodbAtlasSecretRoleWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+	rolePath := mongodbAtlasSecretRolePath(backend, name)
+
+	_, orgOk := d.GetOk("organization_id")
+	_, projectOk := d.GetOk("project_id")
+	if !orgOk && !projectOk {
+		return fmt.Errorf("one of organization_id, project_id must be set")
+	}
+
+	data := map[string]interface{}{
+		"organization_id": d.Get("organization_id"),
+		"project_id":      d.Get("project_id"),
+	}
+	for _, k := range mongodbAtlasSecretRoleListFields {
+		data[k] = d.Get(k)
+	}
+	for _, k := range []string{"ttl", "max_ttl"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Writing %q", rolePath)
+	if _, err := client.Logical().Write(rolePath, data); err != nil {
+		return fmt.Errorf("error writing %q: %s", rolePath, err)
+	}
+	d.SetId(rolePath)
+	log.Printf("[DEBUG] Wrote %q", rolePath)
+
+	return mongodbAtlasSecretRoleRead(d, meta)
+}
+
+func mongodbAtlasSecretRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	rolePath := d.Id()
+
+	backend, err := mongodbAtlasSecretBackendFromRolePath(rolePath)
+	if err != nil {
+		return fmt.Errorf("invalid role ID %q: %s", rolePath, err)
+	}
+	name, err := mongodbAtlasSecretRoleNameFromPath(rolePath)
+	if err != nil {
+		return fmt.Errorf("invalid role ID %q: %s", rolePath, err)
+	}
+
+	log.Printf("[DEBUG] Reading %q", rolePath)
+	resp, err := client.Logical().Read(rolePath)
+	if err != nil {
+		return fmt.Errorf("error reading %q: %s", rolePath, err)
+	}
+	log.Printf("[DEBUG] Read %q", rolePath)
+	if resp == nil {
+		log.Printf("[WARN] %q not found, removing from state", rolePath)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("name", name)
+	for _, k := range append([]string{"organization_id", "project_id", "ttl", "max_ttl"}, mongodbAtlasSecretRoleListFields...) {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting state key %q: %s", k, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func mongodbAtlasSecretRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	rolePath := d.Id()
+
+	log.Printf("[DEBUG] Deleting %q", rolePath)
+	if _, err := client.Logical().Delete(rolePath); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error deleting %q: %s", rolePath, err)
+	}
+	log.Printf("[DEBUG] Deleted %q", rolePath)
+
+	return nil
+}
+
+func mongodbAtlasSecretRolePath(backend, name string) string {
+	return strings.Trim(backend, "/") + "/roles/" + strings.Trim(name, "/")
+}
+
+func mongodbAtlasSecretBackendFromRolePath(path string) (string, error) {
+	if !mongodbAtlasSecretBackendFromRolePathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := mongodbAtlasSecretBackendFromRolePathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
+
+func mongodbAtlasSecretRoleNameFromPath(path string) (string, error) {
+	if !mongodbAtlasSecretBackendRoleNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no role found")
+	}
+	res := mongodbAtlasSecretBackendRoleNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for role", len(res))
+	}
+	return res[1], nil
+}
@@ -0,0 +1,104 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccMongoDBAtlasSecretRole_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-mongodbatlas")
+	resourceName := "vault_mongodbatlas_secret_role.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccMongoDBAtlasSecretRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testMongoDBAtlasSecretRoleConfig(backend, `
+  organization_id = "7cf5a45a9ccf6400e60981b7"
+  roles           = ["ORG_MEMBER"]
+  ip_addresses    = ["192.168.1.5", "192.168.1.6"]
+  cidr_blocks     = ["192.168.1.3/32"]
+  ttl             = 60
+  max_ttl         = 120
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", backend+"/roles/test"),
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "name", "test"),
+					resource.TestCheckResourceAttr(resourceName, "organization_id", "7cf5a45a9ccf6400e60981b7"),
+					resource.TestCheckResourceAttr(resourceName, "roles.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "roles.0", "ORG_MEMBER"),
+					resource.TestCheckResourceAttr(resourceName, "ip_addresses.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "cidr_blocks.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cidr_blocks.0", "192.168.1.3/32"),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "60"),
+					resource.TestCheckResourceAttr(resourceName, "max_ttl", "120"),
+				),
+			},
+			{
+				Config: testMongoDBAtlasSecretRoleConfig(backend, `
+  project_id    = "5cf5a45a9ccf6400e60981b6"
+  roles         = ["GROUP_CLUSTER_MANAGER"]
+  project_roles = ["GROUP_READ_ONLY"]
+  ttl           = 120
+  max_ttl       = 240
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "organization_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "project_id", "5cf5a45a9ccf6400e60981b6"),
+					resource.TestCheckResourceAttr(resourceName, "roles.0", "GROUP_CLUSTER_MANAGER"),
+					resource.TestCheckResourceAttr(resourceName, "project_roles.0", "GROUP_READ_ONLY"),
+					resource.TestCheckResourceAttr(resourceName, "ip_addresses.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "120"),
+					resource.TestCheckResourceAttr(resourceName, "max_ttl", "240"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccMongoDBAtlasSecretRoleCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_mongodbatlas_secret_role" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("role %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testMongoDBAtlasSecretRoleConfig(backend, extra string) string {
+	return fmt.Sprintf(`
+resource "vault_mongodbatlas_secret_backend" "test" {
+  backend     = "%s"
+  public_key  = "public-key"
+  private_key = "private-key"
+}
+
+resource "vault_mongodbatlas_secret_role" "test" {
+  backend = vault_mongodbatlas_secret_backend.test.backend
+  name    = "test"
+%s
+}
+`, backend, extra)
+}
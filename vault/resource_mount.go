@@ -21,10 +21,11 @@ func MountResource() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"path": {
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    false,
-				Description: "Where the secret backend will be mounted",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     false,
+				Description:  "Where the secret backend will be mounted",
+				ValidateFunc: validateMountPath,
 			},
 
 			"type": {
@@ -100,6 +101,32 @@ func MountResource() *schema.Resource {
 				ForceNew:    true,
 				Description: "Enable the secrets engine to access Vault's external entropy source",
 			},
+
+			"plugin_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Version of the plugin the mount is pinned to. The plugin is reloaded when it changes",
+			},
+
+			"allowed_managed_keys": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Managed keys the mount is allowed to use",
+			},
+
+			"delegated_auth_accessors": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Accessors of the auth mounts the mount may delegate authentication to",
+			},
+
+			"identity_token_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Key used to sign plugin identity tokens for the mount",
+			},
 		},
 	}
 }
@@ -130,6 +157,10 @@ func mountWrite(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(path)
 
+	if err := mountTuneExtra(client, path, d, false); err != nil {
+		return err
+	}
+
 	return mountRead(d, meta)
 }
 
@@ -169,6 +200,10 @@ func mountUpdate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error updating Vault: %s", err)
 	}
 
+	if err := mountTuneExtra(client, path, d, true); err != nil {
+		return err
+	}
+
 	return mountRead(d, meta)
 }
 
@@ -234,7 +269,7 @@ func mountRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("seal_wrap", mount.SealWrap)
 	d.Set("external_entropy_access", mount.ExternalEntropyAccess)
 
-	return nil
+	return mountReadExtra(client, path, d)
 }
 
 func opts(d *schema.ResourceData) map[string]string {
@@ -246,3 +281,89 @@ func opts(d *schema.ResourceData) map[string]string {
 	}
 	return options
 }
+
+// mountTuneFields are tunable mount settings that aren't supported by the
+// API client's MountConfigInput, so they are tuned separately.
+var mountTuneFields = []string{
+	"plugin_version",
+	"allowed_managed_keys",
+	"delegated_auth_accessors",
+	"identity_token_key",
+}
+
+// mountTuneExtra tunes the settings in mountTuneFields. On update only the
+// changed settings are sent, otherwise only those that are set. The plugin
+// of the mount is reloaded when its version changes.
+func mountTuneExtra(client *api.Client, path string, d *schema.ResourceData, update bool) error {
+	data := map[string]interface{}{}
+	for _, k := range mountTuneFields {
+		v, ok := d.GetOk(k)
+		if update {
+			if !d.HasChange(k) {
+				continue
+			}
+		} else if !ok {
+			continue
+		}
+
+		if set, ok := v.(*schema.Set); ok {
+			v = set.List()
+		}
+		data[k] = v
+	}
+	if len(data) == 0 {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Tuning mount %s in Vault", path)
+	if _, err := client.Logical().Write(fmt.Sprintf("sys/mounts/%s/tune", strings.Trim(path, "/")), data); err != nil {
+		return fmt.Errorf("error tuning mount %s: %s", path, err)
+	}
+
+	if _, ok := data["plugin_version"]; ok {
+		log.Printf("[DEBUG] Reloading plugin of mount %s", path)
+		if _, err := client.Logical().Write("sys/plugins/reload/backend", map[string]interface{}{
+			"mounts": []string{strings.Trim(path, "/") + "/"},
+		}); err != nil {
+			return fmt.Errorf("error reloading plugin of mount %s: %s", path, err)
+		}
+	}
+
+	return nil
+}
+
+// mountReadExtra reads the settings in mountTuneFields. Vault omits settings
+// that aren't set. The settings are only read when one of them is managed, so
+// that tokens without access to the tune endpoint can still manage the mount.
+func mountReadExtra(client *api.Client, path string, d *schema.ResourceData) error {
+	if !mountTuneFieldsManaged(d) {
+		return nil
+	}
+
+	resp, err := client.Logical().Read(fmt.Sprintf("sys/mounts/%s/tune", strings.Trim(path, "/")))
+	if err != nil {
+		return fmt.Errorf("error reading tune of mount %s: %s", path, err)
+	}
+	if resp == nil {
+		return nil
+	}
+
+	for _, k := range mountTuneFields {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return fmt.Errorf("error setting state key %q: %s", k, err)
+		}
+	}
+
+	return nil
+}
+
+// mountTuneFieldsManaged returns whether any of the settings in
+// mountTuneFields is configured or in the state.
+func mountTuneFieldsManaged(d *schema.ResourceData) bool {
+	for _, k := range mountTuneFields {
+		if _, ok := d.GetOk(k); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 	"github.com/hashicorp/vault/api"
 )
@@ -170,6 +171,51 @@ func TestResourceMount_ExternalEntropyAccess(t *testing.T) {
 	})
 }
 
+func TestResourceMount_Tune(t *testing.T) {
+	path := acctest.RandomWithPrefix("example")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceMount_ConfigTune(path, "vault_auth_backend.test1.accessor"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_mount.test", "delegated_auth_accessors.#", "1"),
+					resource.TestCheckResourceAttrPair("vault_mount.test", "delegated_auth_accessors.0", "vault_auth_backend.test1", "accessor"),
+				),
+			},
+			{
+				Config: testResourceMount_ConfigTune(path, "vault_auth_backend.test1.accessor, vault_auth_backend.test2.accessor"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_mount.test", "delegated_auth_accessors.#", "2"),
+					resource.TestCheckResourceAttrPair("vault_mount.test", "delegated_auth_accessors.1", "vault_auth_backend.test2", "accessor"),
+				),
+			},
+		},
+	})
+}
+
+func TestMountTuneFieldsManaged(t *testing.T) {
+	r := MountResource()
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"path": "kv",
+		"type": "kv",
+	})
+	if mountTuneFieldsManaged(d) {
+		t.Error("expected no tune fields to be managed")
+	}
+
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"path":           "kv",
+		"type":           "kv",
+		"plugin_version": "v0.13.0",
+	})
+	if !mountTuneFieldsManaged(d) {
+		t.Error("expected plugin_version to be managed")
+	}
+}
+
 func testResourceMount_initialConfig(cfg mountConfig) string {
 	return fmt.Sprintf(`
 resource "vault_mount" "test" {
@@ -540,6 +586,26 @@ resource "vault_mount" "test" {
 `, path, externalEntropyAccess)
 }
 
+func testResourceMount_ConfigTune(path, accessors string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "test1" {
+  path = "%s-1"
+  type = "userpass"
+}
+
+resource "vault_auth_backend" "test2" {
+  path = "%s-2"
+  type = "userpass"
+}
+
+resource "vault_mount" "test" {
+  path                     = "%s"
+  type                     = "kv"
+  delegated_auth_accessors = [%s]
+}
+`, path, path, path, accessors)
+}
+
 func findMount(path string) (*api.MountOutput, error) {
 	client := testProvider.Meta().(*api.Client)
 
@@ -14,11 +14,12 @@ import (
 func nomadSecretAccessBackendResource() *schema.Resource {
 	fields := map[string]*schema.Schema{
 		"backend": {
-			Type:        schema.TypeString,
-			Default:     "nomad",
-			ForceNew:    true,
-			Optional:    true,
-			Description: "The mount path for the Nomad backend.",
+			Type:         schema.TypeString,
+			Default:      "nomad",
+			ForceNew:     true,
+			Optional:     true,
+			Description:  "The mount path for the Nomad backend.",
+			ValidateFunc: validateMountPath,
 			StateFunc: func(v interface{}) string {
 				return strings.Trim(v.(string), "/")
 			},
@@ -60,7 +61,8 @@ func nomadSecretAccessBackendResource() *schema.Resource {
 			Type:        schema.TypeBool,
 			Required:    false,
 			Optional:    true,
-			Description: `Mark the secrets engine as local-only. Local engines are not replicated or removed by replication. Tolerance duration to use when checking the last rotation time.`,
+			ForceNew:    true,
+			Description: `Mark the secrets engine as local-only. Local engines are not replicated or removed by replication.`,
 		},
 		"max_lease_ttl_seconds": {
 			Type:        schema.TypeInt,
@@ -291,6 +293,15 @@ func updateNomadAccessConfigResource(d *schema.ResourceData, meta interface{}) e
 		log.Printf("[DEBUG] Updated lease TTLs for %q", backend)
 	}
 
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		log.Printf("[DEBUG] Updating description for %q", backend)
+		if err := client.Sys().TuneMount(backend, api.MountConfigInput{Description: &description}); err != nil {
+			return fmt.Errorf("error updating description for %q: %s", backend, err)
+		}
+		log.Printf("[DEBUG] Updated description for %q", backend)
+	}
+
 	configPath := fmt.Sprintf("%s/config/access", backend)
 	log.Printf("[DEBUG] Updating %q", configPath)
 
@@ -310,7 +321,7 @@ func updateNomadAccessConfigResource(d *schema.ResourceData, meta interface{}) e
 		data["client_key"] = raw
 	}
 
-	if raw, ok := d.GetOk("max_token_name_length"); ok {
+	if raw, ok := d.GetOk("max_token_name_length"); ok || d.HasChange("max_token_name_length") {
 		data["max_token_name_length"] = raw
 	}
 
@@ -353,7 +364,7 @@ func deleteNomadAccessConfigResource(d *schema.ResourceData, meta interface{}) e
 	if err != nil && util.Is404(err) {
 		log.Printf("[WARN] %q not found, removing from state", vaultPath)
 		d.SetId("")
-		return fmt.Errorf("error unmounting Nomad backend from %q: %s", vaultPath, err)
+		return nil
 	} else if err != nil {
 		return fmt.Errorf("error unmounting Nomad backend from %q: %s", vaultPath, err)
 	}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/terraform-provider-vault/util"
 	"github.com/hashicorp/vault/api"
 )
@@ -47,10 +48,11 @@ func nomadSecretBackendRoleResource() *schema.Resource {
 			Description: `Comma separated list of Nomad policies the token is going to be created against. These need to be created beforehand in Nomad.`,
 		},
 		"type": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Computed:    true,
-			Description: `Specifies the type of token to create when using this role. Valid values are "client" or "management".`,
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  `Specifies the type of token to create when using this role. Valid values are "client" or "management".`,
+			ValidateFunc: validation.StringInSlice([]string{"client", "management"}, false),
 		},
 	}
 	return &schema.Resource{
@@ -180,7 +182,7 @@ func updateNomadRoleResource(d *schema.ResourceData, meta interface{}) error {
 	data := map[string]interface{}{}
 	data["type"] = roleType
 
-	if raw, ok := d.GetOk("global"); ok {
+	if raw, ok := d.GetOkExists("global"); ok {
 		data["global"] = raw
 	}
 	if raw, ok := d.GetOk("policies"); ok {
@@ -189,7 +191,7 @@ func updateNomadRoleResource(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if roleType == "client" && data["policies"] == "" {
+	if roleType == "client" && data["policies"] == nil {
 		return fmt.Errorf("error updating role %s: policies are required when role type is 'client'", roleName)
 	}
 
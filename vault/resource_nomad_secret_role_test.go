@@ -30,6 +30,14 @@ func TestAccNomadSecretBackendRoleClientBasic(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_nomad_secret_role.test", "type", "client"),
 				),
 			},
+			{
+				Config: testNomadSecretBackendRoleClientConfig(backend, address, token, "bob", "readonly", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_nomad_secret_role.test", "role", "bob"),
+					resource.TestCheckResourceAttr("vault_nomad_secret_role.test", "global", "false"),
+					resource.TestCheckResourceAttr("vault_nomad_secret_role.test", "type", "client"),
+				),
+			},
 		},
 	})
 }
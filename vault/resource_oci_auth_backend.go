@@ -0,0 +1,146 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+const ociAuthType = "oci"
+
+func ociAuthBackendResource() *schema.Resource {
+	return &schema.Resource{
+		Create: ociAuthBackendCreate,
+		Read:   ociAuthBackendRead,
+		Update: ociAuthBackendUpdate,
+		Delete: ociAuthBackendDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      ociAuthType,
+				Description:  "Path to mount the OCI auth backend.",
+				ValidateFunc: validateMountPath,
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Description of the auth backend.",
+			},
+			"home_tenancy_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The OCID of the tenancy that Vault is allowed to authenticate identities from.",
+			},
+			"accessor": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The accessor of the OCI auth backend.",
+			},
+		},
+	}
+}
+
+func ociAuthBackendCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Get("path").(string)
+
+	log.Printf("[DEBUG] Enabling OCI auth backend %q", path)
+	err := client.Sys().EnableAuthWithOptions(path, &api.EnableAuthOptions{
+		Type:        ociAuthType,
+		Description: d.Get("description").(string),
+	})
+	if err != nil {
+		return fmt.Errorf("error enabling OCI auth backend %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Enabled OCI auth backend %q", path)
+
+	d.SetId(path)
+
+	return ociAuthBackendUpdate(d, meta)
+}
+
+func ociAuthBackendUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := ociAuthBackendConfigPath(d.Id())
+
+	data := map[string]interface{}{
+		"home_tenancy_id": d.Get("home_tenancy_id").(string),
+	}
+
+	log.Printf("[DEBUG] Writing OCI auth backend config %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing OCI auth backend config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote OCI auth backend config %q", path)
+
+	return ociAuthBackendRead(d, meta)
+}
+
+func ociAuthBackendRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	auths, err := client.Sys().ListAuth()
+	if err != nil {
+		return fmt.Errorf("error reading from auth mounts: %s", err)
+	}
+
+	mount := auths[path+"/"]
+	if mount == nil {
+		log.Printf("[WARN] OCI auth backend %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	configPath := ociAuthBackendConfigPath(path)
+	log.Printf("[DEBUG] Reading OCI auth backend config %q", configPath)
+	resp, err := client.Logical().Read(configPath)
+	if err != nil {
+		return fmt.Errorf("error reading OCI auth backend config %q: %s", configPath, err)
+	}
+	log.Printf("[DEBUG] Read OCI auth backend config %q", configPath)
+
+	if resp == nil {
+		log.Printf("[WARN] OCI auth backend config %q not found, removing from state", configPath)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("path", path)
+	d.Set("description", mount.Description)
+	d.Set("accessor", mount.Accessor)
+	d.Set("home_tenancy_id", resp.Data["home_tenancy_id"])
+
+	return nil
+}
+
+func ociAuthBackendDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	log.Printf("[DEBUG] Disabling OCI auth backend %q", path)
+	if err := client.Sys().DisableAuth(path); err != nil {
+		return fmt.Errorf("error disabling OCI auth backend %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Disabled OCI auth backend %q", path)
+
+	return nil
+}
+
+func ociAuthBackendConfigPath(path string) string {
+	return "auth/" + strings.Trim(path, "/") + "/config"
+}
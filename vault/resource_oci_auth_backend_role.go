@@ -0,0 +1,133 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	ociAuthBackendRoleBackendFromPathRegex = regexp.MustCompile("^auth/(.+)/role/.+$")
+	ociAuthBackendRoleNameFromPathRegex    = regexp.MustCompile("^auth/.+/role/(.+)$")
+)
+
+func ociAuthBackendRoleResource() *schema.Resource {
+	fields := map[string]*schema.Schema{
+		"path": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Path where the OCI auth backend is mounted.",
+			StateFunc: func(v interface{}) string {
+				return strings.Trim(v.(string), "/")
+			},
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Name of the role.",
+		},
+		"ocid_list": {
+			Type:        schema.TypeList,
+			Required:    true,
+			MinItems:    1,
+			Description: "List of OCIDs of the groups and dynamic groups allowed to login with the role. Dynamic groups are used for instance principals.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+	}
+
+	addTokenFields(fields, &addTokenFieldsConfig{})
+
+	return &schema.Resource{
+		Create: ociAuthBackendRoleWrite,
+		Read:   ociAuthBackendRoleRead,
+		Update: ociAuthBackendRoleWrite,
+		Delete: ociAuthBackendRoleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: fields,
+	}
+}
+
+func ociAuthBackendRoleWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := ociAuthBackendRolePath(d.Get("path").(string), d.Get("name").(string))
+
+	data := map[string]interface{}{
+		"ocid_list": d.Get("ocid_list"),
+	}
+	updateTokenFields(d, data, d.IsNewResource())
+
+	log.Printf("[DEBUG] Writing OCI auth backend role %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing OCI auth backend role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote OCI auth backend role %q", path)
+
+	d.SetId(path)
+
+	return ociAuthBackendRoleRead(d, meta)
+}
+
+func ociAuthBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	backend := ociAuthBackendRoleBackendFromPathRegex.FindStringSubmatch(path)
+	name := ociAuthBackendRoleNameFromPathRegex.FindStringSubmatch(path)
+	if len(backend) != 2 || len(name) != 2 {
+		return fmt.Errorf("invalid path %q for OCI auth backend role", path)
+	}
+
+	log.Printf("[DEBUG] Reading OCI auth backend role %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading OCI auth backend role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read OCI auth backend role %q", path)
+
+	if resp == nil {
+		log.Printf("[WARN] OCI auth backend role %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("path", backend[1])
+	d.Set("name", name[1])
+	if err := readTokenFields(d, resp); err != nil {
+		return err
+	}
+	if err := d.Set("ocid_list", resp.Data["ocid_list"]); err != nil {
+		return fmt.Errorf("error setting state key %q: %s", "ocid_list", err)
+	}
+
+	return nil
+}
+
+func ociAuthBackendRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting OCI auth backend role %q", path)
+	if _, err := client.Logical().Delete(path); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error deleting OCI auth backend role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted OCI auth backend role %q", path)
+
+	return nil
+}
+
+func ociAuthBackendRolePath(backend, name string) string {
+	return "auth/" + strings.Trim(backend, "/") + "/role/" + strings.Trim(name, "/")
+}
@@ -0,0 +1,77 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccOCIAuthBackendRole_basic(t *testing.T) {
+	path := acctest.RandomWithPrefix("oci")
+	name := acctest.RandomWithPrefix("role")
+	resName := "vault_oci_auth_backend_role.test"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccOCIAuthBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOCIAuthBackendRoleConfig(path, name, `["ocid1.group.oc1..aaaaaaaabmyiinfq32y5aha3r2yo4exampleo4yg3fjk2sbne4567tropaa"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "path", path),
+					resource.TestCheckResourceAttr(resName, "name", name),
+					resource.TestCheckResourceAttr(resName, "ocid_list.#", "1"),
+					resource.TestCheckResourceAttr(resName, "token_policies.#", "1"),
+				),
+			},
+			{
+				Config: testAccOCIAuthBackendRoleConfig(path, name, `["ocid1.group.oc1..aaaaaaaabmyiinfq32y5aha3r2yo4exampleo4yg3fjk2sbne4567tropaa", "ocid1.dynamicgroup.oc1..aaaaaaaabvfwct33xri5examplet3ivksm2kxulz3qqv2vqqbwlmg3bejqwuq"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "ocid_list.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccOCIAuthBackendRoleCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_oci_auth_backend_role" {
+			continue
+		}
+		resp, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if resp != nil {
+			return fmt.Errorf("OCI auth backend role %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccOCIAuthBackendRoleConfig(path, name, ocidList string) string {
+	return fmt.Sprintf(`
+resource "vault_oci_auth_backend" "test" {
+  path            = "%s"
+  home_tenancy_id = "ocid1.tenancy.oc1..aaaaaaaah7zkvaffv26pzyauoe2zbnionqvhvsexamplee557wakiofi4ysgqq"
+}
+
+resource "vault_oci_auth_backend_role" "test" {
+  path           = vault_oci_auth_backend.test.path
+  name           = "%s"
+  ocid_list      = %s
+  token_policies = ["default"]
+}
+`, path, name, ocidList)
+}
@@ -0,0 +1,54 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccOCIAuthBackend_basic(t *testing.T) {
+	path := acctest.RandomWithPrefix("oci")
+	resName := "vault_oci_auth_backend.test"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccOCIAuthBackendCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOCIAuthBackendConfig(path, "ocid1.tenancy.oc1..aaaaaaaah7zkvaffv26pzyauoe2zbnionqvhvsexamplee557wakiofi4ysgqq"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "path", path),
+					resource.TestCheckResourceAttr(resName, "home_tenancy_id", "ocid1.tenancy.oc1..aaaaaaaah7zkvaffv26pzyauoe2zbnionqvhvsexamplee557wakiofi4ysgqq"),
+					resource.TestCheckResourceAttrSet(resName, "accessor"),
+				),
+			},
+			{
+				Config: testAccOCIAuthBackendConfig(path, "ocid1.tenancy.oc1..aaaaaaaaba3pv6wkcr4jqae5f15p2bcmdyt2j6rx32uzr4h25vqstifsfdsq"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "home_tenancy_id", "ocid1.tenancy.oc1..aaaaaaaaba3pv6wkcr4jqae5f15p2bcmdyt2j6rx32uzr4h25vqstifsfdsq"),
+				),
+			},
+			{
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccOCIAuthBackendCheckDestroy(s *terraform.State) error {
+	return testAccCheckAuthMountDestroy(s, "vault_oci_auth_backend")
+}
+
+func testAccOCIAuthBackendConfig(path, tenancy string) string {
+	return fmt.Sprintf(`
+resource "vault_oci_auth_backend" "test" {
+  path            = "%s"
+  home_tenancy_id = "%s"
+}
+`, path, tenancy)
+}
@@ -17,6 +17,9 @@ func oktaAuthBackendUserResource() *schema.Resource {
 		Read:   oktaAuthBackendUserRead,
 		Update: oktaAuthBackendUserWrite,
 		Delete: oktaAuthBackendUserDelete,
+		Importer: &schema.ResourceImporter{
+			State: oktaAuthBackendUserImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"path": {
@@ -141,6 +144,21 @@ func oktaAuthBackendUserRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// oktaAuthBackendUserImport parses the ID of the user, made of the path of the
+// backend and the username separated by a slash.
+func oktaAuthBackendUserImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	i := strings.LastIndex(id, "/")
+	if i <= 0 || i == len(id)-1 {
+		return nil, fmt.Errorf("invalid ID %q, expected <path>/<username>", id)
+	}
+
+	d.Set("path", id[:i])
+	d.Set("username", id[i+1:])
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func oktaAuthBackendUserDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 
@@ -27,6 +27,11 @@ func TestAccOktaAuthBackendUser(t *testing.T) {
 					testAccOktaAuthBackend_UsersCheck(path, "user_test", []string{"one", "two"}, []string{"three"}),
 				),
 			},
+			{
+				ResourceName:      "vault_okta_auth_backend_user.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
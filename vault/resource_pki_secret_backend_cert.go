@@ -75,10 +75,11 @@ func pkiSecretBackendCertResource() *schema.Resource {
 				},
 			},
 			"ttl": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				ForceNew:    false,
-				Description: "Time to live.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     false,
+				Description:  "Time to live.",
+				ValidateFunc: validateVaultDuration,
 			},
 			"format": {
 				Type:         schema.TypeString,
@@ -118,6 +119,10 @@ func pkiSecretBackendCertResource() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 				Description: "The certicate.",
+				// It only changes when pkiSecretBackendCertDiff plans a
+				// renewal, which issues a brand new certificate, so plan it as
+				// a replacement rather than an in-place update.
+				ForceNew: true,
 			},
 			"issuing_ca": {
 				Type:        schema.TypeString,
@@ -150,6 +155,11 @@ func pkiSecretBackendCertResource() *schema.Resource {
 				Computed:    true,
 				Description: "The certificate expiration.",
 			},
+			"renew_pending": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Initially false, and then set to true during refresh once the expiration is less than min_seconds_remaining in the future.",
+			},
 		},
 	}
 }
@@ -270,20 +280,21 @@ func pkiSecretBackendCertDiff(d *schema.ResourceDiff, meta interface{}) error {
 }
 
 func pkiSecretBackendCertRead(d *schema.ResourceData, meta interface{}) error {
-	return nil
-}
-
-func pkiSecretBackendCertUpdate(d *schema.ResourceData, m interface{}) error {
 	minSeconds := 0
 	if v, ok := d.GetOk("min_seconds_remaining"); ok {
 		minSeconds = v.(int)
 	}
-	if pkiSecretBackendCertNeedsRenewed(d.Get("auto_renew").(bool), d.Get("expiration").(int), minSeconds) {
-		return pkiSecretBackendCertCreate(d, m)
-	}
+	d.Set("renew_pending", pkiSecretBackendCertNeedsRenewed(d.Get("auto_renew").(bool), d.Get("expiration").(int), minSeconds))
+
 	return nil
 }
 
+func pkiSecretBackendCertUpdate(d *schema.ResourceData, m interface{}) error {
+	// Renewals are handled by replacing the resource, see
+	// pkiSecretBackendCertDiff, so only the renewal settings can change here.
+	return pkiSecretBackendCertRead(d, m)
+}
+
 func pkiSecretBackendCertDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
@@ -0,0 +1,176 @@
+package vault
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+var pkiSecretBackendCertRevocationIDRegex = regexp.MustCompile("^(.+)/cert/([^/]+)$")
+
+func pkiSecretBackendCertRevocationResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendCertRevocationCreate,
+		Read:   pkiSecretBackendCertRevocationRead,
+		Delete: pkiSecretBackendCertRevocationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the PKI secret backend the resource belongs to.",
+			},
+			"serial_number": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Description:   "The serial number of the certificate to revoke, in hyphen-separated or colon-separated hexadecimal.",
+				ConflictsWith: []string{"certificate"},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return pkiSecretBackendNormalizeSerial(old) == pkiSecretBackendNormalizeSerial(new)
+				},
+			},
+			"certificate": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "The PEM encoded certificate to revoke.",
+				ConflictsWith: []string{"serial_number"},
+			},
+			"private_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "The PEM encoded private key of the certificate. When set, the certificate is revoked using proof of possession of the key instead of the revoke permission on the backend.",
+			},
+			"revocation_time": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The time at which the certificate was revoked, in unix epoch format.",
+			},
+			"revocation_time_rfc3339": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time at which the certificate was revoked, in RFC 3339 format.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendCertRevocationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+
+	serial := d.Get("serial_number").(string)
+	data := map[string]interface{}{}
+	if v, ok := d.GetOk("certificate"); ok {
+		data["certificate"] = v
+		var err error
+		serial, err = pkiSecretBackendCertSerialNumber(v.(string))
+		if err != nil {
+			return err
+		}
+	} else if serial != "" {
+		data["serial_number"] = serial
+	} else {
+		return fmt.Errorf("one of serial_number or certificate must be set")
+	}
+
+	path := strings.Trim(backend, "/") + "/revoke"
+	if v, ok := d.GetOk("private_key"); ok {
+		path = strings.Trim(backend, "/") + "/revoke-with-key"
+		data["private_key"] = v
+	}
+
+	log.Printf("[DEBUG] Revoking certificate %q on PKI secret backend %q", serial, backend)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error revoking certificate %q on PKI secret backend %q: %s", serial, backend, err)
+	}
+	log.Printf("[DEBUG] Revoked certificate %q on PKI secret backend %q", serial, backend)
+
+	d.SetId(pkiSecretBackendCertLookupPath(backend, serial))
+	return pkiSecretBackendCertRevocationRead(d, meta)
+}
+
+func pkiSecretBackendCertRevocationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	res := pkiSecretBackendCertRevocationIDRegex.FindStringSubmatch(path)
+	if len(res) != 3 {
+		return fmt.Errorf("invalid certificate revocation ID %q: expected ID in the format <backend>/cert/<serial_number>", path)
+	}
+	backend, serial := res[1], res[2]
+
+	log.Printf("[DEBUG] Reading certificate %q from PKI secret backend %q", serial, backend)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading certificate %q from PKI secret backend %q: %s", serial, backend, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] Certificate %q not found in PKI secret backend %q, removing from state", serial, backend)
+		d.SetId("")
+		return nil
+	}
+	log.Printf("[DEBUG] Read certificate %q from PKI secret backend %q", serial, backend)
+
+	d.Set("backend", backend)
+	// Keep the serial number as it was configured, unless it's another
+	// certificate's, e.g. when importing.
+	if pkiSecretBackendNormalizeSerial(d.Get("serial_number").(string)) != serial {
+		d.Set("serial_number", serial)
+	}
+	d.Set("revocation_time", resp.Data["revocation_time"])
+	d.Set("revocation_time_rfc3339", resp.Data["revocation_time_rfc3339"])
+
+	return nil
+}
+
+func pkiSecretBackendCertRevocationDelete(d *schema.ResourceData, meta interface{}) error {
+	// Revocation can't be undone, removing the resource only removes it
+	// from state.
+	return nil
+}
+
+func pkiSecretBackendCertLookupPath(backend string, serial string) string {
+	return strings.Trim(backend, "/") + "/cert/" + pkiSecretBackendNormalizeSerial(serial)
+}
+
+// pkiSecretBackendNormalizeSerial formats a serial number the same way as
+// Vault does, in lowercase colon-separated hexadecimal.
+func pkiSecretBackendNormalizeSerial(serial string) string {
+	return strings.ToLower(strings.Replace(serial, "-", ":", -1))
+}
+
+// pkiSecretBackendCertSerialNumber returns the serial number of a PEM
+// encoded certificate, formatted the same way as Vault does.
+func pkiSecretBackendCertSerialNumber(certificate string) (string, error) {
+	block, _ := pem.Decode([]byte(certificate))
+	if block == nil {
+		return "", fmt.Errorf("no PEM data found in certificate")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return "", fmt.Errorf("error parsing certificate: %s", err)
+	}
+
+	serial := cert.SerialNumber.Bytes()
+	parts := make([]string, len(serial))
+	for i, b := range serial {
+		parts[i] = fmt.Sprintf("%02x", b)
+	}
+	return strings.Join(parts, ":"), nil
+}
@@ -0,0 +1,173 @@
+package vault
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestPkiSecretBackendCertRevocation_basic(t *testing.T) {
+	backend := "pki-" + strconv.Itoa(acctest.RandInt())
+	resourceName := "vault_pki_secret_backend_cert_revocation.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendCertRevocationConfig_basic(backend, "serial_number = vault_pki_secret_backend_cert.test.serial_number"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttrPair(resourceName, "serial_number", "vault_pki_secret_backend_cert.test", "serial_number"),
+					resource.TestCheckResourceAttrSet(resourceName, "revocation_time"),
+					resource.TestCheckResourceAttr("data.vault_pki_secret_backend_cert.test", "revoked", "true"),
+					resource.TestCheckResourceAttrPair("data.vault_pki_secret_backend_cert.test", "certificate", "vault_pki_secret_backend_cert.test", "certificate"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestPkiSecretBackendCertRevocation_withKey(t *testing.T) {
+	backend := "pki-" + strconv.Itoa(acctest.RandInt())
+	resourceName := "vault_pki_secret_backend_cert_revocation.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendCertRevocationConfig_basic(backend, `
+  certificate = vault_pki_secret_backend_cert.test.certificate
+  private_key = vault_pki_secret_backend_cert.test.private_key`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "serial_number", "vault_pki_secret_backend_cert.test", "serial_number"),
+					resource.TestCheckResourceAttrSet(resourceName, "revocation_time"),
+				),
+			},
+		},
+	})
+}
+
+func TestPkiSecretBackendCertRevocation_hyphenated(t *testing.T) {
+	backend := "pki-" + strconv.Itoa(acctest.RandInt())
+	resourceName := "vault_pki_secret_backend_cert_revocation.test"
+	config := testPkiSecretBackendCertRevocationConfig_basic(backend, `serial_number = upper(replace(vault_pki_secret_backend_cert.test.serial_number, ":", "-"))`)
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, "serial_number", regexp.MustCompile("^[0-9A-F]{2}(-[0-9A-F]{2})+$")),
+					resource.TestCheckResourceAttrSet(resourceName, "revocation_time"),
+					resource.TestCheckResourceAttr("data.vault_pki_secret_backend_cert.test", "revoked", "true"),
+				),
+			},
+			{
+				// The serial number read back from Vault is colon-separated,
+				// which mustn't replace the revocation.
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+		},
+	})
+}
+
+func TestPkiSecretBackendCertSerialNumber(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(0x0102abcd),
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certificate := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+
+	serial, err := pkiSecretBackendCertSerialNumber(certificate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "01:02:ab:cd"; serial != expected {
+		t.Errorf("expected serial number %q, got %q", expected, serial)
+	}
+
+	if _, err := pkiSecretBackendCertSerialNumber("not a certificate"); err == nil {
+		t.Error("expected an error for invalid certificate")
+	}
+
+	if path := pkiSecretBackendCertLookupPath("/pki/", "01-02-AB-CD"); path != "pki/cert/01:02:ab:cd" {
+		t.Errorf("unexpected lookup path %q", path)
+	}
+	if serial := pkiSecretBackendNormalizeSerial("01-02-AB-CD"); serial != "01:02:ab:cd" {
+		t.Errorf("unexpected normalized serial number %q", serial)
+	}
+}
+
+func testPkiSecretBackendCertRevocationConfig_basic(backend, revoke string) string {
+	return fmt.Sprintf(`
+resource "vault_pki_secret_backend" "test" {
+  path = "%s"
+}
+
+resource "vault_pki_secret_backend_root_cert" "test" {
+  backend     = vault_pki_secret_backend.test.path
+  type        = "internal"
+  common_name = "my.domain"
+  ttl         = "86400"
+}
+
+resource "vault_pki_secret_backend_role" "test" {
+  backend          = vault_pki_secret_backend.test.path
+  name             = "test"
+  allowed_domains  = ["test.my.domain"]
+  allow_subdomains = true
+  max_ttl          = "3600"
+
+  depends_on = [vault_pki_secret_backend_root_cert.test]
+}
+
+resource "vault_pki_secret_backend_cert" "test" {
+  backend            = vault_pki_secret_backend.test.path
+  name               = vault_pki_secret_backend_role.test.name
+  common_name        = "cert.test.my.domain"
+  ttl                = "1h"
+  private_key_format = "pkcs8"
+}
+
+resource "vault_pki_secret_backend_cert_revocation" "test" {
+  backend = vault_pki_secret_backend.test.path
+  %s
+}
+
+data "vault_pki_secret_backend_cert" "test" {
+  backend       = vault_pki_secret_backend.test.path
+  serial_number = vault_pki_secret_backend_cert_revocation.test.serial_number
+}
+`, backend, revoke)
+}
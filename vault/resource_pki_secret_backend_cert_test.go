@@ -157,6 +157,7 @@ func TestPkiSecretBackendCert_renew(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_pki_secret_backend_cert.test", "ttl", "1h"),
 					resource.TestCheckResourceAttr("vault_pki_secret_backend_cert.test", "min_seconds_remaining", "3595"),
 					resource.TestCheckResourceAttrSet("vault_pki_secret_backend_cert.test", "expiration"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_cert.test", "renew_pending", "false"),
 				),
 			},
 			{
@@ -189,6 +190,72 @@ func TestPkiSecretBackendCert_renew(t *testing.T) {
 	})
 }
 
+func TestPkiSecretBackendCertNeedsRenewed(t *testing.T) {
+	now := int(time.Now().Unix())
+
+	tests := []struct {
+		autoRenew  bool
+		expiration int
+		minSeconds int
+		expected   bool
+	}{
+		{false, now - 60, 0, false},
+		{true, now - 60, 0, true},
+		{true, now + 3600, 60, false},
+		{true, now + 3600, 7200, true},
+	}
+
+	for i, test := range tests {
+		if actual := pkiSecretBackendCertNeedsRenewed(test.autoRenew, test.expiration, test.minSeconds); actual != test.expected {
+			t.Errorf("test %d: expected %t, got %t", i, test.expected, actual)
+		}
+	}
+}
+
+func TestPkiSecretBackendCertDiff_renew(t *testing.T) {
+	now := time.Now().Unix()
+
+	tests := []struct {
+		expiration  int64
+		requiresNew bool
+	}{
+		{now + 3600, false},
+		{now + 60, true},
+	}
+
+	for i, test := range tests {
+		state := &terraform.InstanceState{
+			ID: "cert",
+			Attributes: map[string]string{
+				"backend":               "pki",
+				"name":                  "test",
+				"common_name":           "cert.test.my.domain",
+				"auto_renew":            "true",
+				"min_seconds_remaining": "600",
+				"format":                "pem",
+				"private_key_format":    "der",
+				"expiration":            strconv.FormatInt(test.expiration, 10),
+				"certificate":           "cert",
+			},
+		}
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"backend":               "pki",
+			"name":                  "test",
+			"common_name":           "cert.test.my.domain",
+			"auto_renew":            true,
+			"min_seconds_remaining": 600,
+		})
+
+		diff, err := pkiSecretBackendCertResource().Diff(state, config, nil)
+		if err != nil {
+			t.Fatalf("test %d: %s", i, err)
+		}
+		if actual := diff != nil && diff.RequiresNew(); actual != test.requiresNew {
+			t.Errorf("test %d: expected RequiresNew %t, got %t", i, test.requiresNew, actual)
+		}
+	}
+}
+
 func testPkiSecretBackendCertConfig_renew(rootPath string) string {
 	return fmt.Sprintf(`
 resource "vault_pki_secret_backend" "test-root" {
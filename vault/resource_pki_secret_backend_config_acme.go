@@ -0,0 +1,165 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+var pkiSecretBackendConfigACMEFields = []string{
+	"enabled",
+	"allowed_issuers",
+	"allowed_roles",
+	"allow_role_ext_key_usage",
+	"default_directory_policy",
+	"dns_resolver",
+	"eab_policy",
+}
+
+func pkiSecretBackendConfigACMEResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendConfigACMEWrite,
+		Read:   pkiSecretBackendConfigACMERead,
+		Update: pkiSecretBackendConfigACMEWrite,
+		Delete: pkiSecretBackendConfigACMEDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the PKI secret backend the resource belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Specifies whether ACME is enabled.",
+			},
+			"allowed_issuers": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies which issuers are allowed for use with ACME.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"allowed_roles": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies which roles are allowed for use with ACME.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"allow_role_ext_key_usage": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the ExtKeyUsage field from a role is used.",
+			},
+			"default_directory_policy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Specifies the policy to be used for non-role-qualified ACME requests, either \"forbid\", \"sign-verbatim\" or \"role:<role_name>\".",
+			},
+			"dns_resolver": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "DNS resolver to use for domain resolution on this mount, in the format <host>:<port>.",
+			},
+			"eab_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Specifies the policy to use for external account binding (EAB) tokens.",
+				ValidateFunc: validation.StringInSlice([]string{"not-required", "new-account-required", "always-required"}, false),
+			},
+		},
+	}
+}
+
+func pkiSecretBackendConfigACMEWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	path := pkiSecretBackendConfigACMEPath(backend)
+
+	data := map[string]interface{}{
+		"enabled": d.Get("enabled").(bool),
+	}
+	for _, k := range pkiSecretBackendConfigACMEFields {
+		if k == "enabled" {
+			continue
+		}
+		if v, ok := d.GetOkExists(k); ok {
+			data[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Writing ACME config on PKI secret backend %q", backend)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing ACME config on PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Wrote ACME config on PKI secret backend %q", backend)
+
+	d.SetId(path)
+	return pkiSecretBackendConfigACMERead(d, meta)
+}
+
+func pkiSecretBackendConfigACMERead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	backend := strings.TrimSuffix(path, "/config/acme")
+
+	log.Printf("[DEBUG] Reading ACME config from PKI secret backend %q", backend)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading ACME config from PKI secret backend %q: %s", backend, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] ACME config not found on PKI secret backend %q, removing from state", backend)
+		d.SetId("")
+		return nil
+	}
+	log.Printf("[DEBUG] Read ACME config from PKI secret backend %q", backend)
+
+	d.Set("backend", backend)
+	for _, k := range pkiSecretBackendConfigACMEFields {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return fmt.Errorf("error setting %q for ACME config on PKI secret backend %q: %s", k, backend, err)
+		}
+	}
+
+	return nil
+}
+
+func pkiSecretBackendConfigACMEDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	// The ACME config can't be removed, disable it instead.
+	log.Printf("[DEBUG] Disabling ACME config %q", path)
+	if _, err := client.Logical().Write(path, map[string]interface{}{"enabled": false}); err != nil {
+		return fmt.Errorf("error disabling ACME config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Disabled ACME config %q", path)
+
+	return nil
+}
+
+func pkiSecretBackendConfigACMEPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config/acme"
+}
@@ -0,0 +1,77 @@
+package vault
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestPkiSecretBackendConfigACME_basic(t *testing.T) {
+	backend := "pki-" + strconv.Itoa(acctest.RandInt())
+	resourceName := "vault_pki_secret_backend_config_acme.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendConfigACMEConfig_basic(backend, true, "not-required"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_issuers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_issuers.0", "*"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_roles.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_roles.0", "test"),
+					resource.TestCheckResourceAttr(resourceName, "default_directory_policy", "role:test"),
+					resource.TestCheckResourceAttr(resourceName, "eab_policy", "not-required"),
+				),
+			},
+			{
+				Config: testPkiSecretBackendConfigACMEConfig_basic(backend, false, "always-required"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "eab_policy", "always-required"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendConfigACMEConfig_basic(backend string, enabled bool, eabPolicy string) string {
+	return fmt.Sprintf(`
+resource "vault_pki_secret_backend" "test" {
+  path = "%s"
+}
+
+resource "vault_pki_secret_backend_config_cluster" "test" {
+  backend  = vault_pki_secret_backend.test.path
+  path     = "http://127.0.0.1:8200/v1/${vault_pki_secret_backend.test.path}"
+  aia_path = "http://127.0.0.1:8200/v1/${vault_pki_secret_backend.test.path}"
+}
+
+resource "vault_pki_secret_backend_role" "test" {
+  backend = vault_pki_secret_backend.test.path
+  name    = "test"
+}
+
+resource "vault_pki_secret_backend_config_acme" "test" {
+  backend                  = vault_pki_secret_backend.test.path
+  enabled                  = %t
+  allowed_issuers          = ["*"]
+  allowed_roles            = [vault_pki_secret_backend_role.test.name]
+  default_directory_policy = "role:${vault_pki_secret_backend_role.test.name}"
+  eab_policy               = "%s"
+
+  depends_on = [vault_pki_secret_backend_config_cluster.test]
+}
+`, backend, enabled, eabPolicy)
+}
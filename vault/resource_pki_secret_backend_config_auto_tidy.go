@@ -0,0 +1,207 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	pkiSecretBackendTidyBoolFields = []string{
+		"tidy_cert_store",
+		"tidy_revoked_certs",
+		"tidy_revoked_cert_issuer_associations",
+		"tidy_expired_issuers",
+	}
+	pkiSecretBackendTidyIntFields = []string{
+		"safety_buffer",
+		"issuer_safety_buffer",
+	}
+)
+
+func pkiSecretBackendConfigAutoTidyResource() *schema.Resource {
+	fields := map[string]*schema.Schema{
+		"backend": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The path of the PKI secret backend the resource belongs to.",
+			// standardise on no beginning or trailing slashes
+			StateFunc: func(v interface{}) string {
+				return strings.Trim(v.(string), "/")
+			},
+		},
+		"enabled": {
+			Type:        schema.TypeBool,
+			Required:    true,
+			Description: "Specifies whether automatic tidy is enabled.",
+		},
+		"interval_duration": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Interval in seconds at which to run an auto-tidy operation.",
+		},
+	}
+	for k, v := range pkiSecretBackendTidySchema(false) {
+		fields[k] = v
+	}
+
+	return &schema.Resource{
+		Create: pkiSecretBackendConfigAutoTidyWrite,
+		Read:   pkiSecretBackendConfigAutoTidyRead,
+		Update: pkiSecretBackendConfigAutoTidyWrite,
+		Delete: pkiSecretBackendConfigAutoTidyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: fields,
+	}
+}
+
+// pkiSecretBackendTidySchema returns the fields shared by the auto-tidy
+// configuration and the one-time tidy operation.
+func pkiSecretBackendTidySchema(forceNew bool) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"tidy_cert_store": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    !forceNew,
+			ForceNew:    forceNew,
+			Description: "Specifies whether to tidy up the certificate store.",
+		},
+		"tidy_revoked_certs": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    !forceNew,
+			ForceNew:    forceNew,
+			Description: "Specifies whether to remove all invalid and expired certificates from storage.",
+		},
+		"tidy_revoked_cert_issuer_associations": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    !forceNew,
+			ForceNew:    forceNew,
+			Description: "Specifies whether to associate revoked certificates with their corresponding issuers. Requires Vault 1.11+.",
+		},
+		"tidy_expired_issuers": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    !forceNew,
+			ForceNew:    forceNew,
+			Description: "Specifies whether to remove expired issuers. Requires Vault 1.12+.",
+		},
+		"safety_buffer": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    !forceNew,
+			ForceNew:    forceNew,
+			Description: "Duration in seconds to ensure that certificates have been expired before removing them.",
+		},
+		"issuer_safety_buffer": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    !forceNew,
+			ForceNew:    forceNew,
+			Description: "Duration in seconds to ensure that issuers have been expired before removing them. Requires Vault 1.12+.",
+		},
+	}
+}
+
+func pkiSecretBackendConfigAutoTidyWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	path := pkiSecretBackendConfigAutoTidyPath(backend)
+
+	data := pkiSecretBackendTidyRequestData(d)
+	data["enabled"] = d.Get("enabled").(bool)
+	if v, ok := d.GetOk("interval_duration"); ok {
+		data["interval_duration"] = v
+	}
+
+	log.Printf("[DEBUG] Writing auto-tidy config on PKI secret backend %q", backend)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing auto-tidy config on PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Wrote auto-tidy config on PKI secret backend %q", backend)
+
+	d.SetId(path)
+	return pkiSecretBackendConfigAutoTidyRead(d, meta)
+}
+
+func pkiSecretBackendConfigAutoTidyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	backend := strings.TrimSuffix(path, "/config/auto-tidy")
+
+	log.Printf("[DEBUG] Reading auto-tidy config from PKI secret backend %q", backend)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading auto-tidy config from PKI secret backend %q: %s", backend, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] Auto-tidy config not found on PKI secret backend %q, removing from state", backend)
+		d.SetId("")
+		return nil
+	}
+	log.Printf("[DEBUG] Read auto-tidy config from PKI secret backend %q", backend)
+
+	d.Set("backend", backend)
+	for _, k := range []string{"enabled", "interval_duration"} {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return fmt.Errorf("error setting %q for auto-tidy config on PKI secret backend %q: %s", k, backend, err)
+		}
+	}
+	for _, k := range append(pkiSecretBackendTidyBoolFields, pkiSecretBackendTidyIntFields...) {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting %q for auto-tidy config on PKI secret backend %q: %s", k, backend, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func pkiSecretBackendConfigAutoTidyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	// The auto-tidy config can't be removed, disable it instead.
+	log.Printf("[DEBUG] Disabling auto-tidy config %q", path)
+	if _, err := client.Logical().Write(path, map[string]interface{}{"enabled": false}); err != nil {
+		return fmt.Errorf("error disabling auto-tidy config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Disabled auto-tidy config %q", path)
+
+	return nil
+}
+
+// pkiSecretBackendTidyRequestData only includes the tidy fields that have
+// been configured, so that older Vault versions aren't sent parameters
+// they don't know about.
+func pkiSecretBackendTidyRequestData(d *schema.ResourceData) map[string]interface{} {
+	data := make(map[string]interface{})
+	for _, k := range pkiSecretBackendTidyBoolFields {
+		if v, ok := d.GetOkExists(k); ok {
+			data[k] = v
+		}
+	}
+	for _, k := range pkiSecretBackendTidyIntFields {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+	return data
+}
+
+func pkiSecretBackendConfigAutoTidyPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config/auto-tidy"
+}
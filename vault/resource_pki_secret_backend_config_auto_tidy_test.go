@@ -0,0 +1,62 @@
+package vault
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestPkiSecretBackendConfigAutoTidy_basic(t *testing.T) {
+	backend := "pki-" + strconv.Itoa(acctest.RandInt())
+	resourceName := "vault_pki_secret_backend_config_auto_tidy.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendConfigAutoTidyConfig_basic(backend, true, 43200),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "interval_duration", "43200"),
+					resource.TestCheckResourceAttr(resourceName, "tidy_cert_store", "true"),
+					resource.TestCheckResourceAttr(resourceName, "tidy_revoked_certs", "true"),
+					resource.TestCheckResourceAttr(resourceName, "safety_buffer", "86400"),
+				),
+			},
+			{
+				Config: testPkiSecretBackendConfigAutoTidyConfig_basic(backend, false, 86400),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "interval_duration", "86400"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendConfigAutoTidyConfig_basic(backend string, enabled bool, interval int) string {
+	return fmt.Sprintf(`
+resource "vault_pki_secret_backend" "test" {
+  path = "%s"
+}
+
+resource "vault_pki_secret_backend_config_auto_tidy" "test" {
+  backend            = vault_pki_secret_backend.test.path
+  enabled            = %t
+  interval_duration  = %d
+  tidy_cert_store    = true
+  tidy_revoked_certs = true
+  safety_buffer      = 86400
+}
+`, backend, enabled, interval)
+}
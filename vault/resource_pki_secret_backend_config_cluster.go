@@ -0,0 +1,107 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+var pkiSecretBackendConfigClusterFields = []string{
+	"path",
+	"aia_path",
+}
+
+func pkiSecretBackendConfigClusterResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendConfigClusterWrite,
+		Read:   pkiSecretBackendConfigClusterRead,
+		Update: pkiSecretBackendConfigClusterWrite,
+		Delete: pkiSecretBackendConfigClusterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the PKI secret backend the resource belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Specifies the path to this performance replication cluster's API mount path.",
+			},
+			"aia_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Specifies the path to this performance replication cluster's AIA distribution point.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendConfigClusterWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	path := pkiSecretBackendConfigClusterPath(backend)
+
+	data := map[string]interface{}{}
+	for _, k := range pkiSecretBackendConfigClusterFields {
+		data[k] = d.Get(k)
+	}
+
+	log.Printf("[DEBUG] Writing cluster config on PKI secret backend %q", backend)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing cluster config on PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Wrote cluster config on PKI secret backend %q", backend)
+
+	d.SetId(path)
+	return pkiSecretBackendConfigClusterRead(d, meta)
+}
+
+func pkiSecretBackendConfigClusterRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	backend := strings.TrimSuffix(path, "/config/cluster")
+
+	log.Printf("[DEBUG] Reading cluster config from PKI secret backend %q", backend)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading cluster config from PKI secret backend %q: %s", backend, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] Cluster config not found on PKI secret backend %q, removing from state", backend)
+		d.SetId("")
+		return nil
+	}
+	log.Printf("[DEBUG] Read cluster config from PKI secret backend %q", backend)
+
+	d.Set("backend", backend)
+	for _, k := range pkiSecretBackendConfigClusterFields {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return fmt.Errorf("error setting %q for cluster config on PKI secret backend %q: %s", k, backend, err)
+		}
+	}
+
+	return nil
+}
+
+func pkiSecretBackendConfigClusterDelete(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func pkiSecretBackendConfigClusterPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config/cluster"
+}
@@ -0,0 +1,55 @@
+package vault
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestPkiSecretBackendConfigCluster_basic(t *testing.T) {
+	backend := "pki-" + strconv.Itoa(acctest.RandInt())
+	resourceName := "vault_pki_secret_backend_config_cluster.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendConfigClusterConfig_basic(backend, "https://vault.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "path", "https://vault.example.com/v1/"+backend),
+					resource.TestCheckResourceAttr(resourceName, "aia_path", "http://vault.example.com/v1/"+backend),
+				),
+			},
+			{
+				Config: testPkiSecretBackendConfigClusterConfig_basic(backend, "https://vault-dr.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "path", "https://vault-dr.example.com/v1/"+backend),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendConfigClusterConfig_basic(backend, addr string) string {
+	return fmt.Sprintf(`
+resource "vault_pki_secret_backend" "test" {
+  path = "%s"
+}
+
+resource "vault_pki_secret_backend_config_cluster" "test" {
+  backend  = vault_pki_secret_backend.test.path
+  path     = "%s/v1/${vault_pki_secret_backend.test.path}"
+  aia_path = "http://vault.example.com/v1/${vault_pki_secret_backend.test.path}"
+}
+`, backend, addr)
+}
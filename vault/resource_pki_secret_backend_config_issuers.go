@@ -0,0 +1,115 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func pkiSecretBackendConfigIssuersResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendConfigIssuersWrite,
+		Read:   pkiSecretBackendConfigIssuersRead,
+		Update: pkiSecretBackendConfigIssuersWrite,
+		Delete: pkiSecretBackendConfigIssuersDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the PKI secret backend the resource belongs to.",
+			},
+			"default": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Reference to the issuer to use as the default, either by name or by ID.",
+			},
+			"default_follows_latest_issuer": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the default issuer should automatically follow the latest generated or imported issuer.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendConfigIssuersWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	path := pkiSecretBackendConfigIssuersPath(backend)
+
+	data := map[string]interface{}{
+		"default": d.Get("default").(string),
+	}
+	if v, ok := d.GetOkExists("default_follows_latest_issuer"); ok {
+		data["default_follows_latest_issuer"] = v
+	}
+
+	log.Printf("[DEBUG] Writing issuers config on PKI secret backend %q", backend)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing issuers config on PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Wrote issuers config on PKI secret backend %q", backend)
+
+	d.SetId(path)
+	return pkiSecretBackendConfigIssuersRead(d, meta)
+}
+
+func pkiSecretBackendConfigIssuersRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	backend := strings.TrimSuffix(path, "/config/issuers")
+
+	log.Printf("[DEBUG] Reading issuers config from PKI secret backend %q", backend)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading issuers config from PKI secret backend %q: %s", backend, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] Issuers config not found on PKI secret backend %q, removing from state", backend)
+		d.SetId("")
+		return nil
+	}
+	log.Printf("[DEBUG] Read issuers config from PKI secret backend %q", backend)
+
+	d.Set("backend", backend)
+	d.Set("default_follows_latest_issuer", resp.Data["default_follows_latest_issuer"])
+
+	// Vault always returns the ID of the default issuer, whereas it may
+	// have been configured by name. Only update the state when the
+	// configured reference no longer resolves to the default issuer.
+	defaultID, _ := resp.Data["default"].(string)
+	current := d.Get("default").(string)
+	if current != defaultID {
+		currentID := ""
+		if current != "" {
+			currentID, err = pkiSecretBackendResolveIssuerID(client, backend, current)
+			if err != nil {
+				log.Printf("[WARN] Unable to resolve issuer %q: %s", current, err)
+			}
+		}
+		if currentID != defaultID {
+			d.Set("default", defaultID)
+		}
+	}
+
+	return nil
+}
+
+func pkiSecretBackendConfigIssuersDelete(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func pkiSecretBackendConfigIssuersPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config/issuers"
+}
@@ -0,0 +1,77 @@
+package vault
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestPkiSecretBackendConfigIssuers_basic(t *testing.T) {
+	backend := "pki-" + strconv.Itoa(acctest.RandInt())
+	resourceName := "vault_pki_secret_backend_config_issuers.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendConfigIssuersConfig_basic(backend, "vault_pki_secret_backend_root_cert.first.issuer_id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttrPair(resourceName, "default", "vault_pki_secret_backend_root_cert.first", "issuer_id"),
+					resource.TestCheckResourceAttr(resourceName, "default_follows_latest_issuer", "false"),
+				),
+			},
+			{
+				// Referencing the issuer by name must not cause a perpetual diff.
+				Config: testPkiSecretBackendConfigIssuersConfig_basic(backend, `"second"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "default", "second"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"default"},
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendConfigIssuersConfig_basic(backend, defaultRef string) string {
+	return fmt.Sprintf(`
+resource "vault_pki_secret_backend" "test" {
+  path = "%s"
+}
+
+resource "vault_pki_secret_backend_root_cert" "first" {
+  backend     = vault_pki_secret_backend.test.path
+  type        = "internal"
+  common_name = "test Root CA"
+  ttl         = "86400"
+  issuer_name = "first"
+}
+
+resource "vault_pki_secret_backend_root_cert" "second" {
+  backend     = vault_pki_secret_backend.test.path
+  type        = "internal"
+  common_name = "test Root CA"
+  ttl         = "86400"
+  issuer_name = "second"
+
+  depends_on = [vault_pki_secret_backend_root_cert.first]
+}
+
+resource "vault_pki_secret_backend_config_issuers" "test" {
+  backend                       = vault_pki_secret_backend.test.path
+  default                       = %s
+  default_follows_latest_issuer = false
+
+  depends_on = [vault_pki_secret_backend_root_cert.second]
+}
+`, backend, defaultRef)
+}
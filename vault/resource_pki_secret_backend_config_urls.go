@@ -9,12 +9,21 @@ import (
 	"github.com/hashicorp/vault/api"
 )
 
+// pkiSecretBackendConfigUrlsVaultFeatures gates templated AIA URLs.
+var pkiSecretBackendConfigUrlsVaultFeatures = map[string]vaultFeature{
+	"enable_templating": {MinVersion: "1.14"},
+}
+
 func pkiSecretBackendConfigUrlsResource() *schema.Resource {
 	return &schema.Resource{
 		Create: pkiSecretBackendConfigUrlsCreate,
 		Read:   pkiSecretBackendConfigUrlsRead,
 		Update: pkiSecretBackendConfigUrlsUpdate,
 		Delete: pkiSecretBackendConfigUrlsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: vaultVersionCustomizeDiff(pkiSecretBackendConfigUrlsVaultFeatures),
 
 		Schema: map[string]*schema.Schema{
 			"backend": {
@@ -40,6 +49,11 @@ func pkiSecretBackendConfigUrlsResource() *schema.Resource {
 				Description: "Specifies the URL values for the OCSP Servers field.",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"enable_templating": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Specifies that the AIA URL values should be templated. Requires Vault 1.14+.",
+			},
 		},
 	}
 }
@@ -60,6 +74,9 @@ func pkiSecretBackendConfigUrlsCreate(d *schema.ResourceData, meta interface{})
 		"crl_distribution_points": crlDistributionsPoints,
 		"ocsp_servers":            ocspServers,
 	}
+	if v, ok := d.GetOkExists("enable_templating"); ok {
+		data["enable_templating"] = v
+	}
 
 	log.Printf("[DEBUG] Creating URL config on PKI secret backend %q", backend)
 	_, err := client.Logical().Write(path, data)
@@ -76,7 +93,7 @@ func pkiSecretBackendConfigUrlsRead(d *schema.ResourceData, meta interface{}) er
 	client := meta.(*api.Client)
 
 	path := d.Id()
-	backend := pkiSecretBackendConfigUrlsPath(path)
+	backend := strings.TrimSuffix(path, "/config/urls")
 
 	log.Printf("[DEBUG] Reading URL config from PKI secret backend %q", backend)
 	config, err := client.Logical().Read(path)
@@ -94,6 +111,8 @@ func pkiSecretBackendConfigUrlsRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("issuing_certificates", config.Data["issuing_certificates"])
 	d.Set("crl_distribution_points", config.Data["crl_distribution_points"])
 	d.Set("ocsp_servers", config.Data["ocsp_servers"])
+	d.Set("enable_templating", config.Data["enable_templating"])
+	d.Set("backend", backend)
 
 	return nil
 }
@@ -114,6 +133,9 @@ func pkiSecretBackendConfigUrlsUpdate(d *schema.ResourceData, meta interface{})
 		"crl_distribution_points": crlDistributionsPoints,
 		"ocsp_servers":            ocspServers,
 	}
+	if v, ok := d.GetOkExists("enable_templating"); ok {
+		data["enable_templating"] = v
+	}
 
 	log.Printf("[DEBUG] Updating URL config on PKI secret backend %q", backend)
 	_, err := client.Logical().Write(path, data)
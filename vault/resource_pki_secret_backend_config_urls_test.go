@@ -38,6 +38,18 @@ func TestPkiSecretBackendConfigUrls_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_urls.test", "ocsp_servers.0", ocspServers),
 				),
 			},
+			{
+				Config: testPkiSecretBackendCertConfigUrlsConfig_templating(rootPath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_urls.test", "enable_templating", "true"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_urls.test", "issuing_certificates.0", "{{cluster_aia_path}}/issuer/{{issuer_id}}/der"),
+				),
+			},
+			{
+				ResourceName:      "vault_pki_secret_backend_config_urls.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -127,3 +139,23 @@ resource "vault_pki_secret_backend_config_urls" "test" {
 
 `, rootPath, issuingCertificates, crlDistributionPoints, ocspServers)
 }
+
+func testPkiSecretBackendCertConfigUrlsConfig_templating(rootPath string) string {
+	return fmt.Sprintf(`
+resource "vault_pki_secret_backend" "test-root" {
+  path = "%s"
+  description = "test root"
+  default_lease_ttl_seconds = "8640000"
+  max_lease_ttl_seconds = "8640000"
+}
+
+resource "vault_pki_secret_backend_config_urls" "test" {
+  backend = vault_pki_secret_backend.test-root.path
+
+  enable_templating       = true
+  issuing_certificates    = ["{{cluster_aia_path}}/issuer/{{issuer_id}}/der"]
+  crl_distribution_points = ["{{cluster_aia_path}}/issuer/{{issuer_id}}/crl/der"]
+  ocsp_servers            = ["{{cluster_path}}/ocsp"]
+}
+`, rootPath)
+}
@@ -9,12 +9,48 @@ import (
 	"github.com/hashicorp/vault/api"
 )
 
+var (
+	pkiSecretBackendCrlConfigStringFields = []string{
+		"expiry",
+		"ocsp_expiry",
+		"auto_rebuild_grace_period",
+		"delta_rebuild_interval",
+	}
+	pkiSecretBackendCrlConfigBoolFields = []string{
+		"disable",
+		"ocsp_disable",
+		"auto_rebuild",
+		"enable_delta",
+		"cross_cluster_revocation",
+		"unified_crl",
+		"unified_crl_on_existing_paths",
+	}
+)
+
+// pkiSecretBackendCrlConfigVaultFeatures gates the OCSP and CRL rebuilding
+// settings added in Vault 1.12, and the Enterprise only unified CRL settings.
+var pkiSecretBackendCrlConfigVaultFeatures = map[string]vaultFeature{
+	"ocsp_disable":                  {MinVersion: "1.12"},
+	"ocsp_expiry":                   {MinVersion: "1.12"},
+	"auto_rebuild":                  {MinVersion: "1.12"},
+	"auto_rebuild_grace_period":     {MinVersion: "1.12"},
+	"enable_delta":                  {MinVersion: "1.12"},
+	"delta_rebuild_interval":        {MinVersion: "1.12"},
+	"cross_cluster_revocation":      {MinVersion: "1.13", Enterprise: true},
+	"unified_crl":                   {MinVersion: "1.13", Enterprise: true},
+	"unified_crl_on_existing_paths": {MinVersion: "1.13", Enterprise: true},
+}
+
 func pkiSecretBackendCrlConfigResource() *schema.Resource {
 	return &schema.Resource{
 		Create: pkiSecretBackendCrlConfigCreate,
 		Read:   pkiSecretBackendCrlConfigRead,
 		Update: pkiSecretBackendCrlConfigUpdate,
 		Delete: pkiSecretBackendCrlConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: vaultVersionCustomizeDiff(pkiSecretBackendCrlConfigVaultFeatures),
 
 		Schema: map[string]*schema.Schema{
 			"backend": {
@@ -28,15 +64,68 @@ func pkiSecretBackendCrlConfigResource() *schema.Resource {
 				},
 			},
 			"expiry": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Specifies the time until expiration.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Specifies the time until expiration.",
+				ValidateFunc: validateVaultDuration,
 			},
 			"disable": {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Description: "Disables or enables CRL building",
 			},
+			"ocsp_disable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Disables or enables the OCSP responder in Vault. Requires Vault 1.12+.",
+			},
+			"ocsp_expiry": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The amount of time an OCSP response will be valid. Requires Vault 1.12+.",
+				ValidateFunc: validateVaultDuration,
+			},
+			"auto_rebuild": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Enables or disables periodic rebuilding of the CRL upon expiry. Requires Vault 1.12+.",
+			},
+			"auto_rebuild_grace_period": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Grace period before CRL expiry to attempt rebuild of CRL. Requires Vault 1.12+.",
+				ValidateFunc: validateVaultDuration,
+			},
+			"enable_delta": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Enables or disables building of delta CRLs with up-to-date revocation information. Requires Vault 1.12+.",
+			},
+			"delta_rebuild_interval": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Interval to check for new revocations on, to regenerate the delta CRL. Requires Vault 1.12+.",
+				ValidateFunc: validateVaultDuration,
+			},
+			"cross_cluster_revocation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Enable cross-cluster revocation request queues. Vault Enterprise only, requires Vault 1.13+.",
+			},
+			"unified_crl": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Enables unified CRL and OCSP building. Vault Enterprise only, requires Vault 1.13+.",
+			},
+			"unified_crl_on_existing_paths": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Enables serving the unified CRL and OCSP on the existing, previously cluster-local paths. Vault Enterprise only, requires Vault 1.13+.",
+			},
 		},
 	}
 }
@@ -47,13 +136,7 @@ func pkiSecretBackendCrlConfigCreate(d *schema.ResourceData, meta interface{}) e
 	backend := d.Get("backend").(string)
 	path := pkiSecretBackendCrlConfigPath(backend)
 
-	data := make(map[string]interface{})
-	if expiry, ok := d.GetOk("expiry"); ok {
-		data["expiry"] = expiry
-	}
-	if disable, ok := d.GetOk("disable"); ok {
-		data["disable"] = disable
-	}
+	data := pkiSecretBackendCrlConfigRequestData(d)
 
 	log.Printf("[DEBUG] Creating CRL config on PKI secret backend %q", backend)
 	_, err := client.Logical().Write(path, data)
@@ -70,7 +153,7 @@ func pkiSecretBackendCrlConfigRead(d *schema.ResourceData, meta interface{}) err
 	client := meta.(*api.Client)
 
 	path := d.Id()
-	backend := pkiSecretBackendCrlConfigPath(path)
+	backend := strings.TrimSuffix(path, "/config/crl")
 
 	log.Printf("[DEBUG] Reading CRL config from PKI secret backend %q", backend)
 	config, err := client.Logical().Read(path)
@@ -81,8 +164,19 @@ func pkiSecretBackendCrlConfigRead(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("invalid path ID %q: %s", path, err)
 	}
 
-	d.Set("expiry", config.Data["expiry"])
-	d.Set("disable", config.Data["disable"])
+	if config == nil {
+		log.Printf("[WARN] CRL config not found on PKI secret backend %q, removing from state", backend)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	for _, k := range pkiSecretBackendCrlConfigStringFields {
+		d.Set(k, config.Data[k])
+	}
+	for _, k := range pkiSecretBackendCrlConfigBoolFields {
+		d.Set(k, config.Data[k])
+	}
 
 	return nil
 }
@@ -91,15 +185,9 @@ func pkiSecretBackendCrlConfigUpdate(d *schema.ResourceData, meta interface{}) e
 	client := meta.(*api.Client)
 
 	path := d.Id()
-	backend := pkiSecretBackendCrlConfigPath(path)
+	backend := d.Get("backend").(string)
 
-	data := make(map[string]interface{})
-	if expiry, ok := d.GetOk("expiry"); ok {
-		data["expiry"] = expiry
-	}
-	if disable, ok := d.GetOk("disable"); ok {
-		data["disable"] = disable
-	}
+	data := pkiSecretBackendCrlConfigRequestData(d)
 
 	log.Printf("[DEBUG] Updating CRL config on PKI secret backend %q", backend)
 	_, err := client.Logical().Write(path, data)
@@ -116,6 +204,24 @@ func pkiSecretBackendCrlConfigDelete(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
+// pkiSecretBackendCrlConfigRequestData only includes the fields that have
+// been configured, so that older Vault versions aren't sent parameters
+// they don't know about.
+func pkiSecretBackendCrlConfigRequestData(d *schema.ResourceData) map[string]interface{} {
+	data := make(map[string]interface{})
+	for _, k := range pkiSecretBackendCrlConfigStringFields {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+	for _, k := range pkiSecretBackendCrlConfigBoolFields {
+		if v, ok := d.GetOkExists(k); ok {
+			data[k] = v
+		}
+	}
+	return data
+}
+
 func pkiSecretBackendCrlConfigPath(backend string) string {
 	return strings.Trim(backend, "/") + "/config/crl"
 }
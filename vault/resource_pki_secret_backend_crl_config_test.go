@@ -31,6 +31,44 @@ func TestPkiSecretBackendCrlConfig_basic(t *testing.T) {
 	})
 }
 
+func TestPkiSecretBackendCrlConfig_ocspAndDelta(t *testing.T) {
+	rootPath := "pki-root-" + strconv.Itoa(acctest.RandInt())
+	resourceName := "vault_pki_secret_backend_crl_config.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testPkiSecretBackendCrlConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendCrlConfigConfig_ocspAndDelta(rootPath, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "expiry", "72h"),
+					resource.TestCheckResourceAttr(resourceName, "disable", "false"),
+					resource.TestCheckResourceAttr(resourceName, "ocsp_disable", "false"),
+					resource.TestCheckResourceAttr(resourceName, "ocsp_expiry", "12h"),
+					resource.TestCheckResourceAttr(resourceName, "auto_rebuild", "true"),
+					resource.TestCheckResourceAttr(resourceName, "auto_rebuild_grace_period", "24h"),
+					resource.TestCheckResourceAttr(resourceName, "enable_delta", "true"),
+					resource.TestCheckResourceAttr(resourceName, "delta_rebuild_interval", "30m"),
+				),
+			},
+			{
+				Config: testPkiSecretBackendCrlConfigConfig_ocspAndDelta(rootPath, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "auto_rebuild", "false"),
+					resource.TestCheckResourceAttr(resourceName, "enable_delta", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testPkiSecretBackendCrlConfigDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -90,3 +128,24 @@ resource "vault_pki_secret_backend_crl_config" "test" {
 
 `, rootPath)
 }
+
+func testPkiSecretBackendCrlConfigConfig_ocspAndDelta(rootPath string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test-root" {
+  path = "%s"
+  type = "pki"
+}
+
+resource "vault_pki_secret_backend_crl_config" "test" {
+  backend                   = vault_mount.test-root.path
+  expiry                    = "72h"
+  disable                   = false
+  ocsp_disable              = false
+  ocsp_expiry               = "12h"
+  auto_rebuild              = %[2]t
+  auto_rebuild_grace_period = "24h"
+  enable_delta              = %[2]t
+  delta_rebuild_interval    = "30m"
+}
+`, rootPath, enabled)
+}
@@ -1,6 +1,8 @@
 package vault
 
 import (
+	"bytes"
+	"encoding/pem"
 	"fmt"
 	"log"
 	"strings"
@@ -25,9 +27,32 @@ func pkiSecretBackendIntermediateSetSignedResource() *schema.Resource {
 			"certificate": {
 				Type:        schema.TypeString,
 				Required:    true,
-				Description: "The certificate.",
+				Description: "The certificate, optionally followed by the PEM encoded chain of the signing CA.",
 				ForceNew:    true,
 			},
+			"imported_issuers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The IDs of the issuers imported from the certificate. Only set on Vault 1.11+.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"imported_keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The IDs of the keys imported from the certificate. Only set on Vault 1.11+.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"issuer_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the imported issuer of the leaf certificate. Only set on Vault 1.11+.",
+			},
+			"ca_chain": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The CA chain of the installed certificate, as known by Vault.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
@@ -44,17 +69,97 @@ func pkiSecretBackendIntermediateSetSignedCreate(d *schema.ResourceData, meta in
 	}
 
 	log.Printf("[DEBUG] Creating intermediate set-signed on PKI secret backend %q", backend)
-	_, err := client.Logical().Write(path, data)
+	resp, err := client.Logical().Write(path, data)
 	if err != nil {
 		return fmt.Errorf("error creating intermediate set-signed on PKI secret backend %q: %s", backend, err)
 	}
 	log.Printf("[DEBUG] Created intermediate set-signed on PKI secret backend %q", backend)
 
+	if resp != nil {
+		for _, k := range []string{"imported_issuers", "imported_keys"} {
+			if err := d.Set(k, resp.Data[k]); err != nil {
+				return fmt.Errorf("error setting %q for intermediate set-signed on PKI secret backend %q: %s", k, backend, err)
+			}
+		}
+	}
+
+	issuerID, _, err := pkiSecretBackendLeafIssuerID(client, backend, d)
+	if err != nil {
+		return err
+	}
+	d.Set("issuer_id", issuerID)
+
 	d.SetId(path)
 	return pkiSecretBackendIntermediateSetSignedRead(d, meta)
 }
 
 func pkiSecretBackendIntermediateSetSignedRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+
+	// On Vault 1.11+ the signed certificate is stored as its own issuer,
+	// older versions only ever have a single CA per mount.
+	issuerID := d.Get("issuer_id").(string)
+	if issuerID == "" {
+		var multiIssuer bool
+		var err error
+		if issuerID, multiIssuer, err = pkiSecretBackendLeafIssuerID(client, backend, d); err != nil {
+			return err
+		}
+		if multiIssuer && issuerID == "" {
+			log.Printf("[WARN] No issuer of PKI secret backend %q has the signed certificate, removing from state", backend)
+			d.SetId("")
+			return nil
+		}
+		d.Set("issuer_id", issuerID)
+	}
+
+	var path string
+	if issuerID != "" {
+		path = pkiSecretBackendIssuerPath(backend, issuerID)
+	} else {
+		path = strings.Trim(backend, "/") + "/cert/ca_chain"
+	}
+
+	log.Printf("[DEBUG] Reading installed CA certificate from %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading installed CA certificate from %q: %s", path, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] CA certificate %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+	log.Printf("[DEBUG] Read installed CA certificate from %q", path)
+
+	installed, _ := resp.Data["certificate"].(string)
+	if installed == "" {
+		log.Printf("[WARN] No CA certificate installed on PKI secret backend %q, removing from state", backend)
+		d.SetId("")
+		return nil
+	}
+
+	// Only compare the leaf certificate, the configured value may also
+	// carry the signing chain which Vault stores separately.
+	if !pkiSecretBackendCertificatesMatch(d.Get("certificate").(string), installed) {
+		log.Printf("[WARN] Installed CA certificate on PKI secret backend %q no longer matches state", backend)
+		d.Set("certificate", installed)
+	}
+
+	var chain []string
+	if v, ok := resp.Data["ca_chain"].([]interface{}); ok {
+		for _, c := range v {
+			chain = append(chain, strings.TrimSpace(c.(string)))
+		}
+	} else {
+		chain = pkiSecretBackendSplitPEMBundle(installed)
+	}
+	if err := d.Set("ca_chain", chain); err != nil {
+		return fmt.Errorf("error setting ca_chain for PKI secret backend %q: %s", backend, err)
+	}
+
 	return nil
 }
 
@@ -62,6 +167,78 @@ func pkiSecretBackendIntermediateSetSignedDelete(d *schema.ResourceData, meta in
 	return nil
 }
 
+// pkiSecretBackendLeafIssuerID returns the ID of the issuer whose certificate
+// is the leaf of the configured bundle, and whether the backend supports
+// multiple issuers. A bundle carrying its chain imports several issuers, in
+// no particular order. No issuers are imported when the certificate was
+// already installed, or in states created before issuer_id was tracked, in
+// which case all the issuers of the backend are searched.
+func pkiSecretBackendLeafIssuerID(client *api.Client, backend string, d *schema.ResourceData) (string, bool, error) {
+	issuerIDs := d.Get("imported_issuers").([]interface{})
+	if len(issuerIDs) == 0 {
+		path := strings.Trim(backend, "/") + "/issuers"
+
+		log.Printf("[DEBUG] Listing issuers of PKI secret backend %q", backend)
+		resp, err := client.Logical().List(path)
+		if err != nil {
+			return "", false, fmt.Errorf("error listing issuers of PKI secret backend %q: %s", backend, err)
+		}
+		log.Printf("[DEBUG] Listed issuers of PKI secret backend %q", backend)
+		if resp == nil {
+			// Pre-1.11 Vault has a single CA per mount.
+			return "", false, nil
+		}
+		issuerIDs, _ = resp.Data["keys"].([]interface{})
+	}
+
+	for _, v := range issuerIDs {
+		path := pkiSecretBackendIssuerPath(backend, v.(string))
+
+		log.Printf("[DEBUG] Reading issuer %q", path)
+		resp, err := client.Logical().Read(path)
+		if err != nil {
+			return "", true, fmt.Errorf("error reading issuer %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Read issuer %q", path)
+		if resp == nil {
+			continue
+		}
+
+		if certificate, _ := resp.Data["certificate"].(string); pkiSecretBackendCertificatesMatch(d.Get("certificate").(string), certificate) {
+			return v.(string), true, nil
+		}
+	}
+
+	return "", true, nil
+}
+
 func pkiSecretBackendIntermediateSetSignedCreatePath(backend string) string {
 	return strings.Trim(backend, "/") + "/intermediate/set-signed"
 }
+
+// pkiSecretBackendCertificatesMatch reports whether the first certificates
+// of the two PEM bundles are identical.
+func pkiSecretBackendCertificatesMatch(a, b string) bool {
+	blockA, _ := pem.Decode([]byte(a))
+	blockB, _ := pem.Decode([]byte(b))
+	if blockA == nil || blockB == nil {
+		return strings.TrimSpace(a) == strings.TrimSpace(b)
+	}
+	return bytes.Equal(blockA.Bytes, blockB.Bytes)
+}
+
+// pkiSecretBackendSplitPEMBundle splits a PEM bundle into its individual
+// PEM encoded blocks.
+func pkiSecretBackendSplitPEMBundle(bundle string) []string {
+	var blocks []string
+	rest := []byte(bundle)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		blocks = append(blocks, strings.TrimSpace(string(pem.EncodeToMemory(block))))
+	}
+	return blocks
+}
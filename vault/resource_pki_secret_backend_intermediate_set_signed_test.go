@@ -1,7 +1,10 @@
 package vault
 
 import (
+	"encoding/pem"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 
@@ -25,12 +28,130 @@ func TestPkiSecretBackendIntermediateSetSigned_basic(t *testing.T) {
 				Config: testPkiSecretBackendIntermediateSetSignedConfig_basic(rootPath, intermediatePath),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("vault_pki_secret_backend_intermediate_set_signed.test", "backend", intermediatePath),
+					resource.TestCheckResourceAttrSet("vault_pki_secret_backend_intermediate_set_signed.test", "ca_chain.0"),
 				),
 			},
 		},
 	})
 }
 
+func TestPkiSecretBackendCertificatesMatch(t *testing.T) {
+	leaf := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("leaf")}))
+	ca := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("ca")}))
+
+	tests := []struct {
+		a, b     string
+		expected bool
+	}{
+		{leaf, leaf, true},
+		{leaf + ca, leaf, true},
+		{leaf, leaf + "\n" + ca, true},
+		{ca + leaf, leaf, false},
+		{leaf, ca, false},
+	}
+
+	for i, test := range tests {
+		if actual := pkiSecretBackendCertificatesMatch(test.a, test.b); actual != test.expected {
+			t.Errorf("test %d: expected %t, got %t", i, test.expected, actual)
+		}
+	}
+
+	if chain := pkiSecretBackendSplitPEMBundle(leaf + ca); len(chain) != 2 {
+		t.Errorf("expected 2 blocks in bundle, got %d", len(chain))
+	}
+}
+
+func TestPkiSecretBackendLeafIssuerID(t *testing.T) {
+	leaf := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("leaf")}))
+	ca := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("ca")}))
+
+	// the issuer of the signing CA is listed first
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/pki/issuer/ca-issuer", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"data": {"certificate": %q}}`, ca)
+	})
+	mux.HandleFunc("/v1/pki/issuer/leaf-issuer", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"data": {"certificate": %q}}`, leaf)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	config := api.DefaultConfig()
+	config.Address = server.URL
+	client, err := api.NewClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := pkiSecretBackendIntermediateSetSignedResource().TestResourceData()
+	d.Set("certificate", leaf+ca)
+	d.Set("imported_issuers", []string{"ca-issuer", "leaf-issuer"})
+
+	issuerID, multiIssuer, err := pkiSecretBackendLeafIssuerID(client, "pki", d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !multiIssuer {
+		t.Error("expected the backend to support multiple issuers")
+	}
+	if issuerID != "leaf-issuer" {
+		t.Errorf("expected issuer %q, got %q", "leaf-issuer", issuerID)
+	}
+}
+
+func TestPkiSecretBackendLeafIssuerID_list(t *testing.T) {
+	leaf := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("leaf")}))
+	root := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("root")}))
+
+	// the default issuer of the mount is another root
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/pki/issuers", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data": {"keys": ["root-issuer", "leaf-issuer"]}}`)
+	})
+	mux.HandleFunc("/v1/pki/issuer/root-issuer", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"data": {"certificate": %q}}`, root)
+	})
+	mux.HandleFunc("/v1/pki/issuer/leaf-issuer", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"data": {"certificate": %q}}`, leaf)
+	})
+	mux.HandleFunc("/v1/legacy/issuers", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"errors": []}`)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	config := api.DefaultConfig()
+	config.Address = server.URL
+	client, err := api.NewClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := pkiSecretBackendIntermediateSetSignedResource().TestResourceData()
+	d.Set("certificate", leaf)
+
+	issuerID, multiIssuer, err := pkiSecretBackendLeafIssuerID(client, "pki", d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !multiIssuer {
+		t.Error("expected the backend to support multiple issuers")
+	}
+	if issuerID != "leaf-issuer" {
+		t.Errorf("expected issuer %q, got %q", "leaf-issuer", issuerID)
+	}
+
+	// pre-1.11 backends don't have issuers
+	issuerID, multiIssuer, err = pkiSecretBackendLeafIssuerID(client, "legacy", d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if multiIssuer || issuerID != "" {
+		t.Errorf("expected no issuer support, got %q, %t", issuerID, multiIssuer)
+	}
+}
+
 func testPkiSecretBackendIntermediateSetSignedDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
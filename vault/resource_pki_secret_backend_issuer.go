@@ -0,0 +1,334 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+var pkiSecretBackendIssuerIDRegex = regexp.MustCompile("^(.+)/issuer/([^/]+)$")
+
+var pkiSecretBackendIssuerUsages = []string{
+	"read-only",
+	"issuing-certificates",
+	"crl-signing",
+	"ocsp-signing",
+}
+
+func pkiSecretBackendIssuerResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendIssuerCreate,
+		Read:   pkiSecretBackendIssuerRead,
+		Update: pkiSecretBackendIssuerUpdate,
+		Delete: pkiSecretBackendIssuerDelete,
+		Importer: &schema.ResourceImporter{
+			State: pkiSecretBackendIssuerImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the PKI secret backend the resource belongs to.",
+			},
+			"issuer_ref": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Reference to an existing issuer, either by name or by ID.",
+				// an issuer referenced by name is the same as one referenced by ID
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					if old == "" || new == "" {
+						return false
+					}
+					return new == d.Get("issuer_id").(string) || new == d.Get("issuer_name").(string)
+				},
+			},
+			"issuer_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the issuer.",
+			},
+			"leaf_not_after_behavior": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Behavior of a leaf's NotAfter field when it exceeds the issuer's validity. One of \"err\", \"truncate\" or \"permit\".",
+				ValidateFunc: validation.StringInSlice([]string{"err", "truncate", "permit"}, false),
+			},
+			"manual_chain": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Chain of issuer references to build this issuer's computed CAChain field from, when non-empty.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"usage": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Description: "Allowed usages for this issuer.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(pkiSecretBackendIssuerUsages, false),
+				},
+			},
+			"revocation_signature_algorithm": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Signature algorithm to use when building CRLs.",
+			},
+			"issuing_certificates": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Specifies the URL values for the Issuing Certificate field specific to this issuer.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"crl_distribution_points": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Specifies the URL values for the CRL Distribution Points field specific to this issuer.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"ocsp_servers": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Specifies the URL values for the OCSP Servers field specific to this issuer.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"issuer_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the issuer.",
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the key used by the issuer.",
+			},
+		},
+	}
+}
+
+var pkiSecretBackendIssuerFields = []string{
+	"issuer_name",
+	"leaf_not_after_behavior",
+	"revocation_signature_algorithm",
+}
+
+var pkiSecretBackendIssuerListFields = []string{
+	"manual_chain",
+	"issuing_certificates",
+	"crl_distribution_points",
+	"ocsp_servers",
+}
+
+func pkiSecretBackendIssuerCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	issuerRef := d.Get("issuer_ref").(string)
+
+	issuerID, err := pkiSecretBackendResolveIssuerID(client, backend, issuerRef)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(pkiSecretBackendIssuerPath(backend, issuerID))
+
+	return pkiSecretBackendIssuerWrite(d, meta, true)
+}
+
+func pkiSecretBackendIssuerRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	backend, issuerID, err := pkiSecretBackendIssuerParseID(path)
+	if err != nil {
+		return fmt.Errorf("invalid issuer ID %q: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading issuer %q from PKI secret backend %q", issuerID, backend)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading issuer %q from PKI secret backend %q: %s", issuerID, backend, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] Issuer %q not found in PKI secret backend %q, removing from state", issuerID, backend)
+		d.SetId("")
+		return nil
+	}
+	log.Printf("[DEBUG] Read issuer %q from PKI secret backend %q", issuerID, backend)
+
+	d.Set("backend", backend)
+	d.Set("issuer_id", issuerID)
+	d.Set("key_id", resp.Data["key_id"])
+
+	for _, k := range pkiSecretBackendIssuerFields {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return fmt.Errorf("error setting %q for issuer %q: %s", k, path, err)
+		}
+	}
+
+	// Vault returns the full computed chain when no manual chain is set,
+	// only track it when it was explicitly configured.
+	for _, k := range pkiSecretBackendIssuerListFields {
+		v := resp.Data[k]
+		if k == "manual_chain" && len(d.Get(k).([]interface{})) == 0 {
+			continue
+		}
+		if err := d.Set(k, v); err != nil {
+			return fmt.Errorf("error setting %q for issuer %q: %s", k, path, err)
+		}
+	}
+
+	usage := []string{}
+	if v, ok := resp.Data["usage"].(string); ok && v != "" {
+		usage = strings.Split(v, ",")
+	}
+	if err := d.Set("usage", usage); err != nil {
+		return fmt.Errorf("error setting usage for issuer %q: %s", path, err)
+	}
+
+	return nil
+}
+
+func pkiSecretBackendIssuerUpdate(d *schema.ResourceData, meta interface{}) error {
+	return pkiSecretBackendIssuerWrite(d, meta, false)
+}
+
+// pkiSecretBackendIssuerWrite writes the configuration of the issuer. Vault
+// replaces the whole configuration of an issuer on write, so the current one
+// is read first and only the configured fields are changed.
+func pkiSecretBackendIssuerWrite(d *schema.ResourceData, meta interface{}, create bool) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading issuer %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading issuer %q: %s", path, err)
+	}
+	if resp == nil {
+		return fmt.Errorf("issuer %q not found", path)
+	}
+	log.Printf("[DEBUG] Read issuer %q", path)
+
+	data := pkiSecretBackendIssuerDataToWrite(d, resp.Data, create)
+
+	log.Printf("[DEBUG] Updating issuer %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error updating issuer %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Updated issuer %q", path)
+
+	return pkiSecretBackendIssuerRead(d, meta)
+}
+
+// pkiSecretBackendIssuerDataToWrite merges the fields of d into the current
+// configuration of the issuer. On create only the configured fields are
+// taken from d, so that adopting an existing issuer keeps the rest of its
+// configuration, afterwards only the changed ones.
+func pkiSecretBackendIssuerDataToWrite(d *schema.ResourceData, current map[string]interface{}, create bool) map[string]interface{} {
+	data := map[string]interface{}{}
+	for _, k := range append(append([]string{"usage"}, pkiSecretBackendIssuerFields...), pkiSecretBackendIssuerListFields...) {
+		if v, ok := current[k]; ok && v != nil {
+			data[k] = v
+		}
+	}
+
+	for _, k := range pkiSecretBackendIssuerFields {
+		if v, ok := d.GetOk(k); (create && ok) || (!create && d.HasChange(k)) {
+			data[k] = v
+		}
+	}
+	for _, k := range pkiSecretBackendIssuerListFields {
+		if _, ok := d.GetOk(k); (create && ok) || (!create && d.HasChange(k)) {
+			data[k] = d.Get(k)
+		}
+	}
+	if v, ok := d.GetOk("usage"); (create && ok) || (!create && d.HasChange("usage")) {
+		usage := util.TerraformSetToStringArray(v)
+		sort.Strings(usage)
+		data["usage"] = strings.Join(usage, ",")
+	}
+
+	return data
+}
+
+func pkiSecretBackendIssuerDelete(d *schema.ResourceData, meta interface{}) error {
+	// The issuer's lifecycle is owned by the resource that created it
+	// (e.g. vault_pki_secret_backend_root_cert), this resource only
+	// manages its configuration.
+	return nil
+}
+
+func pkiSecretBackendIssuerImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	backend, issuerID, err := pkiSecretBackendIssuerParseID(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("invalid issuer ID %q: %s", d.Id(), err)
+	}
+
+	client := meta.(*api.Client)
+
+	log.Printf("[DEBUG] Reading issuer %q from PKI secret backend %q", issuerID, backend)
+	resp, err := client.Logical().Read(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("error reading issuer %q from PKI secret backend %q: %s", issuerID, backend, err)
+	}
+	if resp == nil {
+		return nil, fmt.Errorf("issuer %q not found in PKI secret backend %q", issuerID, backend)
+	}
+	log.Printf("[DEBUG] Read issuer %q from PKI secret backend %q", issuerID, backend)
+
+	// Issuers are usually referenced by name in configuration, so prefer it
+	// over the ID to avoid replacing the imported issuer.
+	issuerRef := issuerID
+	if name, ok := resp.Data["issuer_name"].(string); ok && name != "" {
+		issuerRef = name
+	}
+
+	d.Set("backend", backend)
+	d.Set("issuer_ref", issuerRef)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// pkiSecretBackendResolveIssuerID looks up the ID of the issuer referenced
+// by issuerRef, which may be either an issuer name or ID.
+func pkiSecretBackendResolveIssuerID(client *api.Client, backend, issuerRef string) (string, error) {
+	path := pkiSecretBackendIssuerPath(backend, issuerRef)
+
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return "", fmt.Errorf("error reading issuer %q from PKI secret backend %q: %s", issuerRef, backend, err)
+	}
+	if resp == nil {
+		return "", fmt.Errorf("issuer %q not found in PKI secret backend %q", issuerRef, backend)
+	}
+
+	issuerID, ok := resp.Data["issuer_id"].(string)
+	if !ok || issuerID == "" {
+		return "", fmt.Errorf("no issuer_id returned for issuer %q in PKI secret backend %q", issuerRef, backend)
+	}
+
+	return issuerID, nil
+}
+
+func pkiSecretBackendIssuerParseID(id string) (string, string, error) {
+	res := pkiSecretBackendIssuerIDRegex.FindStringSubmatch(id)
+	if len(res) != 3 {
+		return "", "", fmt.Errorf("expected ID in the format <backend>/issuer/<issuer_id>")
+	}
+	return res[1], res[2], nil
+}
@@ -0,0 +1,200 @@
+package vault
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestPkiSecretBackendIssuer_basic(t *testing.T) {
+	backend := "pki-" + strconv.Itoa(acctest.RandInt())
+	resourceName := "vault_pki_secret_backend_issuer.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendIssuerConfig_basic(backend, "err", `["read-only", "issuing-certificates", "crl-signing"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "issuer_name", "test-issuer"),
+					resource.TestCheckResourceAttr(resourceName, "leaf_not_after_behavior", "err"),
+					resource.TestCheckResourceAttr(resourceName, "usage.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "issuing_certificates.0", "http://127.0.0.1:8200/v1/"+backend+"/ca"),
+					resource.TestCheckResourceAttrPair(resourceName, "issuer_id", "vault_pki_secret_backend_root_cert.test", "issuer_id"),
+				),
+			},
+			{
+				Config: testPkiSecretBackendIssuerConfig_basic(backend, "truncate", `["read-only", "issuing-certificates"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "leaf_not_after_behavior", "truncate"),
+					resource.TestCheckResourceAttr(resourceName, "usage.#", "2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"issuer_ref"},
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendIssuerConfig_basic(backend, leafNotAfterBehavior, usage string) string {
+	return fmt.Sprintf(`
+resource "vault_pki_secret_backend" "test" {
+  path = "%s"
+}
+
+resource "vault_pki_secret_backend_root_cert" "test" {
+  backend     = vault_pki_secret_backend.test.path
+  type        = "internal"
+  common_name = "test Root CA"
+  ttl         = "86400"
+  issuer_name = "test-issuer"
+}
+
+resource "vault_pki_secret_backend_issuer" "test" {
+  backend                 = vault_pki_secret_backend.test.path
+  issuer_ref              = vault_pki_secret_backend_root_cert.test.issuer_id
+  issuer_name             = "test-issuer"
+  leaf_not_after_behavior = "%s"
+  usage                   = %s
+  issuing_certificates    = ["http://127.0.0.1:8200/v1/%[1]s/ca"]
+}
+`, backend, leafNotAfterBehavior, usage)
+}
+
+func TestPkiSecretBackendIssuer_adopt(t *testing.T) {
+	backend := "pki-" + strconv.Itoa(acctest.RandInt())
+	resourceName := "vault_pki_secret_backend_issuer.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendIssuerConfig_adopt(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "issuer_name", "test-issuer"),
+					resource.TestCheckResourceAttr(resourceName, "leaf_not_after_behavior", "truncate"),
+					testPkiSecretBackendIssuerCheckUsage(resourceName, "issuing-certificates"),
+				),
+			},
+			{
+				Config:   testPkiSecretBackendIssuerConfig_adopt(backend),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendIssuerConfig_adopt(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_pki_secret_backend" "test" {
+  path = "%s"
+}
+
+resource "vault_pki_secret_backend_root_cert" "test" {
+  backend     = vault_pki_secret_backend.test.path
+  type        = "internal"
+  common_name = "test Root CA"
+  ttl         = "86400"
+  issuer_name = "test-issuer"
+}
+
+resource "vault_pki_secret_backend_issuer" "test" {
+  backend                 = vault_pki_secret_backend.test.path
+  issuer_ref              = vault_pki_secret_backend_root_cert.test.issuer_name
+  leaf_not_after_behavior = "truncate"
+}
+`, backend)
+}
+
+// testPkiSecretBackendIssuerCheckUsage checks the usage of the issuer in
+// Vault includes usage.
+func testPkiSecretBackendIssuerCheckUsage(resourceName, usage string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %q not found in state", resourceName)
+		}
+
+		client := testProvider.Meta().(*api.Client)
+		resp, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error reading issuer %q: %s", rs.Primary.ID, err)
+		}
+		if resp == nil {
+			return fmt.Errorf("issuer %q not found", rs.Primary.ID)
+		}
+
+		actual, _ := resp.Data["usage"].(string)
+		for _, v := range strings.Split(actual, ",") {
+			if v == usage {
+				return nil
+			}
+		}
+		return fmt.Errorf("expected usage of issuer %q to include %q, got %q", rs.Primary.ID, usage, actual)
+	}
+}
+
+func TestPkiSecretBackendIssuerDataToWrite_create(t *testing.T) {
+	r := pkiSecretBackendIssuerResource()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"backend":                 "pki",
+		"issuer_ref":              "test-issuer",
+		"leaf_not_after_behavior": "truncate",
+	})
+
+	current := map[string]interface{}{
+		"issuer_name":                    "test-issuer",
+		"leaf_not_after_behavior":        "err",
+		"revocation_signature_algorithm": "",
+		"usage":                          "read-only,issuing-certificates,crl-signing",
+		"manual_chain":                   nil,
+		"issuing_certificates":           []interface{}{"http://127.0.0.1:8200/v1/pki/ca"},
+		"crl_distribution_points":        []interface{}{},
+		"ocsp_servers":                   []interface{}{},
+	}
+
+	expected := map[string]interface{}{
+		"issuer_name":                    "test-issuer",
+		"leaf_not_after_behavior":        "truncate",
+		"revocation_signature_algorithm": "",
+		"usage":                          "read-only,issuing-certificates,crl-signing",
+		"issuing_certificates":           []interface{}{"http://127.0.0.1:8200/v1/pki/ca"},
+		"crl_distribution_points":        []interface{}{},
+		"ocsp_servers":                   []interface{}{},
+	}
+	if actual := pkiSecretBackendIssuerDataToWrite(d, current, true); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected data %#v, got %#v", expected, actual)
+	}
+}
+
+func TestPkiSecretBackendIssuerParseID(t *testing.T) {
+	backend, issuerID, err := pkiSecretBackendIssuerParseID("pki/issuer/f6ab8b8a-9f0c-4b6a-a3c3-aa2e3c4b5d6e")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if backend != "pki" {
+		t.Fatalf("expected backend %q, got %q", "pki", backend)
+	}
+	if issuerID != "f6ab8b8a-9f0c-4b6a-a3c3-aa2e3c4b5d6e" {
+		t.Fatalf("expected issuer ID %q, got %q", "f6ab8b8a-9f0c-4b6a-a3c3-aa2e3c4b5d6e", issuerID)
+	}
+
+	if _, _, err := pkiSecretBackendIssuerParseID("pki/issuer/"); err == nil {
+		t.Fatal("expected an error parsing an invalid ID")
+	}
+}
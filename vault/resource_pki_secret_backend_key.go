@@ -0,0 +1,193 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+var pkiSecretBackendKeyIDRegex = regexp.MustCompile("^(.+)/key/([^/]+)$")
+
+func pkiSecretBackendKeyResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendKeyCreate,
+		Read:   pkiSecretBackendKeyRead,
+		Update: pkiSecretBackendKeyUpdate,
+		Delete: pkiSecretBackendKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the PKI secret backend the resource belongs to.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Type of key to generate. Must be one of \"exported\", \"internal\" or \"kms\".",
+				ValidateFunc: validation.StringInSlice([]string{"exported", "internal", "kms"}, false),
+			},
+			"key_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Human-friendly name of the key.",
+			},
+			"key_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "rsa",
+				Description:  "The desired key type.",
+				ValidateFunc: validation.StringInSlice([]string{"rsa", "ec", "ed25519"}, false),
+			},
+			"key_bits": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+				Description: "The number of bits to use. Defaults to the recommended size for key_type.",
+			},
+			"managed_key_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "The managed key's configured name, required when type is \"kms\".",
+				ConflictsWith: []string{"managed_key_id"},
+			},
+			"managed_key_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "The managed key's UUID, alternative to managed_key_name when type is \"kms\".",
+				ConflictsWith: []string{"managed_key_name"},
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the generated key.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	keyType := d.Get("type").(string)
+
+	path := strings.Trim(backend, "/") + "/keys/generate/" + keyType
+
+	data := map[string]interface{}{
+		"key_type": d.Get("key_type").(string),
+	}
+	for _, k := range []string{"key_name", "key_bits", "managed_key_name", "managed_key_id"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Generating key on PKI secret backend %q", backend)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error generating key on PKI secret backend %q: %s", backend, err)
+	}
+	if resp == nil {
+		return fmt.Errorf("no key returned when generating key on PKI secret backend %q", backend)
+	}
+	log.Printf("[DEBUG] Generated key on PKI secret backend %q", backend)
+
+	keyID := resp.Data["key_id"].(string)
+	d.SetId(pkiSecretBackendKeyPath(backend, keyID))
+
+	return pkiSecretBackendKeyRead(d, meta)
+}
+
+func pkiSecretBackendKeyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	backend, keyID, err := pkiSecretBackendKeyParseID(path)
+	if err != nil {
+		return fmt.Errorf("invalid key ID %q: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading key %q from PKI secret backend %q", keyID, backend)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading key %q from PKI secret backend %q: %s", keyID, backend, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] Key %q not found in PKI secret backend %q, removing from state", keyID, backend)
+		d.SetId("")
+		return nil
+	}
+	log.Printf("[DEBUG] Read key %q from PKI secret backend %q", keyID, backend)
+
+	d.Set("backend", backend)
+	d.Set("key_id", keyID)
+	d.Set("key_name", resp.Data["key_name"])
+	d.Set("key_type", resp.Data["key_type"])
+	if v, ok := resp.Data["managed_key_id"]; ok {
+		d.Set("managed_key_id", v)
+	}
+	if v, ok := resp.Data["managed_key_name"]; ok {
+		d.Set("managed_key_name", v)
+	}
+
+	return nil
+}
+
+func pkiSecretBackendKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	data := map[string]interface{}{
+		"key_name": d.Get("key_name").(string),
+	}
+
+	log.Printf("[DEBUG] Updating key %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error updating key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Updated key %q", path)
+
+	return pkiSecretBackendKeyRead(d, meta)
+}
+
+func pkiSecretBackendKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting key %q", path)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return fmt.Errorf("error deleting key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted key %q", path)
+
+	return nil
+}
+
+func pkiSecretBackendKeyPath(backend string, keyRef string) string {
+	return strings.Trim(backend, "/") + "/key/" + strings.Trim(keyRef, "/")
+}
+
+func pkiSecretBackendKeyParseID(id string) (string, string, error) {
+	res := pkiSecretBackendKeyIDRegex.FindStringSubmatch(id)
+	if len(res) != 3 {
+		return "", "", fmt.Errorf("expected ID in the format <backend>/key/<key_id>")
+	}
+	return res[1], res[2], nil
+}
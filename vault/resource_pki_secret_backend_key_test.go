@@ -0,0 +1,99 @@
+package vault
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestPkiSecretBackendKey_basic(t *testing.T) {
+	backend := "pki-" + strconv.Itoa(acctest.RandInt())
+	resourceName := "vault_pki_secret_backend_key.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testPkiSecretBackendKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendKeyConfig_basic(backend, "test-key"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "type", "internal"),
+					resource.TestCheckResourceAttr(resourceName, "key_name", "test-key"),
+					resource.TestCheckResourceAttr(resourceName, "key_type", "ec"),
+					resource.TestCheckResourceAttrSet(resourceName, "key_id"),
+				),
+			},
+			{
+				Config: testPkiSecretBackendKeyConfig_basic(backend, "test-key-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "key_name", "test-key-updated"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"type", "key_bits"},
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendKeyDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_pki_secret_backend_key" {
+			continue
+		}
+		resp, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			// The mount may already be gone.
+			continue
+		}
+		if resp != nil {
+			return fmt.Errorf("key %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testPkiSecretBackendKeyConfig_basic(backend, keyName string) string {
+	return fmt.Sprintf(`
+resource "vault_pki_secret_backend" "test" {
+  path = "%s"
+}
+
+resource "vault_pki_secret_backend_key" "test" {
+  backend  = vault_pki_secret_backend.test.path
+  type     = "internal"
+  key_name = "%s"
+  key_type = "ec"
+  key_bits = 256
+}
+`, backend, keyName)
+}
+
+func TestPkiSecretBackendKeyParseID(t *testing.T) {
+	backend, keyID, err := pkiSecretBackendKeyParseID("pki/nested/key/f6ab8b8a-9f0c-4b6a-a3c3-aa2e3c4b5d6e")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if backend != "pki/nested" {
+		t.Fatalf("expected backend %q, got %q", "pki/nested", backend)
+	}
+	if keyID != "f6ab8b8a-9f0c-4b6a-a3c3-aa2e3c4b5d6e" {
+		t.Fatalf("expected key ID %q, got %q", "f6ab8b8a-9f0c-4b6a-a3c3-aa2e3c4b5d6e", keyID)
+	}
+
+	if _, _, err := pkiSecretBackendKeyParseID("pki/issuer/foo"); err == nil {
+		t.Fatal("expected an error parsing an invalid ID")
+	}
+}
@@ -43,19 +43,21 @@ func pkiSecretBackendRoleResource() *schema.Resource {
 				Description: "Unique name for the role.",
 			},
 			"ttl": {
-				Type:        schema.TypeString,
-				Required:    false,
-				Optional:    true,
-				Description: "The TTL.",
+				Type:         schema.TypeString,
+				Required:     false,
+				Optional:     true,
+				Description:  "The TTL.",
+				ValidateFunc: validateVaultDuration,
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					return old == "0"
 				},
 			},
 			"max_ttl": {
-				Type:        schema.TypeString,
-				Required:    false,
-				Optional:    true,
-				Description: "The maximum TTL.",
+				Type:         schema.TypeString,
+				Required:     false,
+				Optional:     true,
+				Description:  "The maximum TTL.",
+				ValidateFunc: validateVaultDuration,
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 					return old == "0"
 				},
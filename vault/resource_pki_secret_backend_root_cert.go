@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-provider-vault/util"
 	"github.com/hashicorp/vault/api"
 )
 
@@ -27,9 +28,9 @@ func pkiSecretBackendRootCertResource() *schema.Resource {
 			"type": {
 				Type:         schema.TypeString,
 				Required:     true,
-				Description:  "Type of intermediate to create. Must be either \"exported\" or \"internal\".",
+				Description:  "Type of root to create. Must be one of \"exported\", \"internal\" or \"existing\".",
 				ForceNew:     true,
-				ValidateFunc: validation.StringInSlice([]string{"exported", "internal"}, false),
+				ValidateFunc: validation.StringInSlice([]string{"exported", "internal", "existing"}, false),
 			},
 			"common_name": {
 				Type:        schema.TypeString,
@@ -74,10 +75,11 @@ func pkiSecretBackendRootCertResource() *schema.Resource {
 				},
 			},
 			"ttl": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				ForceNew:    false,
-				Description: "Time to live.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     false,
+				Description:  "Time to live.",
+				ValidateFunc: validateVaultDuration,
 			},
 			"format": {
 				Type:         schema.TypeString,
@@ -174,6 +176,35 @@ func pkiSecretBackendRootCertResource() *schema.Resource {
 				Description: "The postal code.",
 				ForceNew:    true,
 			},
+			"issuer_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the issuer to create. Requires Vault 1.11+.",
+				ForceNew:    true,
+				Computed:    true,
+			},
+			"key_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the key to create. Requires Vault 1.11+.",
+				ForceNew:    true,
+			},
+			"key_ref": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name or ID of an existing key to use when type is \"existing\". Requires Vault 1.11+.",
+				ForceNew:    true,
+			},
+			"issuer_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the generated issuer. Only set on Vault 1.11+.",
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the generated key. Only set on Vault 1.11+.",
+			},
 			"certificate": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -269,6 +300,20 @@ func pkiSecretBackendRootCertCreate(d *schema.ResourceData, meta interface{}) er
 		data["permitted_dns_domains"] = strings.Join(permittedDNSDomains, ",")
 	}
 
+	// The multi-issuer fields are only understood by Vault 1.11+, so
+	// they are only sent when explicitly configured.
+	for _, k := range []string{"issuer_name", "key_name", "key_ref"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+
+	if rootType == "existing" {
+		// The key material comes from key_ref.
+		delete(data, "key_type")
+		delete(data, "key_bits")
+	}
+
 	log.Printf("[DEBUG] Creating root cert on PKI secret backend %q", backend)
 	resp, err := client.Logical().Write(path, data)
 	if err != nil {
@@ -280,11 +325,42 @@ func pkiSecretBackendRootCertCreate(d *schema.ResourceData, meta interface{}) er
 	d.Set("issuing_ca", resp.Data["issuing_ca"])
 	d.Set("serial", resp.Data["serial_number"])
 
+	// Vault 1.11+ keeps every generated root as a separate issuer
+	// alongside any existing ones, which allows for declarative rotation.
+	d.Set("issuer_id", resp.Data["issuer_id"])
+	d.Set("key_id", resp.Data["key_id"])
+
 	d.SetId(path)
 	return pkiSecretBackendRootCertRead(d, meta)
 }
 
 func pkiSecretBackendRootCertRead(d *schema.ResourceData, meta interface{}) error {
+	issuerID := d.Get("issuer_id").(string)
+	if issuerID == "" {
+		// Pre-1.11 Vault has no way to look up the generated root.
+		return nil
+	}
+
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	path := pkiSecretBackendIssuerPath(backend, issuerID)
+
+	log.Printf("[DEBUG] Reading issuer %q of root cert from PKI secret backend %q", issuerID, backend)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading issuer %q from PKI secret backend %q: %s", issuerID, backend, err)
+	}
+	if secret == nil {
+		log.Printf("[WARN] Issuer %q not found in PKI secret backend %q, removing root cert from state", issuerID, backend)
+		d.SetId("")
+		return nil
+	}
+	log.Printf("[DEBUG] Read issuer %q of root cert from PKI secret backend %q", issuerID, backend)
+
+	d.Set("issuer_name", secret.Data["issuer_name"])
+	d.Set("key_id", secret.Data["key_id"])
+
 	return nil
 }
 
@@ -298,12 +374,41 @@ func pkiSecretBackendRootCertDelete(d *schema.ResourceData, meta interface{}) er
 	backend := d.Get("backend").(string)
 
 	path := pkiSecretBackendIntermediateSetSignedDeletePath(backend)
+	if issuerID := d.Get("issuer_id").(string); issuerID != "" {
+		// Only remove this root's issuer so that other issuers on the
+		// mount remain in place during a rotation.
+		path = pkiSecretBackendIssuerPath(backend, issuerID)
+	}
 
 	log.Printf("[DEBUG] Deleting root cert from PKI secret backend %q", path)
 	if _, err := client.Logical().Delete(path); err != nil {
 		return fmt.Errorf("error deleting root cert from PKI secret backend %q: %s", path, err)
 	}
 	log.Printf("[DEBUG] Deleted root cert from PKI secret backend %q", path)
+
+	// The key of an "existing" root isn't managed by this resource.
+	keyID := d.Get("key_id").(string)
+	if d.Get("issuer_id").(string) == "" || keyID == "" || d.Get("type").(string) == "existing" {
+		return nil
+	}
+
+	// Other issuers may have been created from the same key, e.g. when the
+	// root was re-issued or imported, Vault refuses to delete it then.
+	inUse, err := pkiSecretBackendKeyInUse(client, backend, keyID)
+	if err != nil {
+		return err
+	}
+	if inUse {
+		log.Printf("[DEBUG] Key %q of root cert is used by other issuers of PKI secret backend %q, not deleting it", keyID, backend)
+		return nil
+	}
+
+	path = pkiSecretBackendKeyPath(backend, keyID)
+	log.Printf("[DEBUG] Deleting key of root cert from PKI secret backend %q", path)
+	if _, err := client.Logical().Delete(path); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error deleting key of root cert from PKI secret backend %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted key of root cert from PKI secret backend %q", path)
 	return nil
 }
 
@@ -314,3 +419,37 @@ func pkiSecretBackendIntermediateSetSignedReadPath(backend string, rootType stri
 func pkiSecretBackendIntermediateSetSignedDeletePath(backend string) string {
 	return strings.Trim(backend, "/") + "/root"
 }
+
+// pkiSecretBackendKeyInUse reports whether any issuer of the backend still
+// references the key with the given ID.
+func pkiSecretBackendKeyInUse(client *api.Client, backend string, keyID string) (bool, error) {
+	path := strings.Trim(backend, "/") + "/issuers"
+
+	log.Printf("[DEBUG] Listing issuers of PKI secret backend %q", backend)
+	resp, err := client.Logical().List(path)
+	if err != nil {
+		return false, fmt.Errorf("error listing issuers of PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Listed issuers of PKI secret backend %q", backend)
+	if resp == nil {
+		return false, nil
+	}
+
+	issuerIDs, _ := resp.Data["keys"].([]interface{})
+	for _, issuerID := range issuerIDs {
+		path := pkiSecretBackendIssuerPath(backend, issuerID.(string))
+		issuer, err := client.Logical().Read(path)
+		if err != nil {
+			return false, fmt.Errorf("error reading issuer %q: %s", path, err)
+		}
+		if issuer != nil && issuer.Data["key_id"] == keyID {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func pkiSecretBackendIssuerPath(backend string, issuerRef string) string {
+	return strings.Trim(backend, "/") + "/issuer/" + strings.Trim(issuerRef, "/")
+}
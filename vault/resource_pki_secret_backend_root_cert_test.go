@@ -2,6 +2,8 @@ package vault
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 
@@ -43,6 +45,104 @@ func TestPkiSecretBackendRootCertificate_basic(t *testing.T) {
 	})
 }
 
+func TestPkiSecretBackendRootCertificate_rotation(t *testing.T) {
+	path := "pki-" + strconv.Itoa(acctest.RandInt())
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testPkiSecretBackendRootCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendRootCertificateConfig_rotation(path, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_root_cert.current", "issuer_name", "root-2021"),
+					resource.TestCheckResourceAttrSet("vault_pki_secret_backend_root_cert.current", "issuer_id"),
+					resource.TestCheckResourceAttrSet("vault_pki_secret_backend_root_cert.current", "key_id"),
+				),
+			},
+			{
+				Config: testPkiSecretBackendRootCertificateConfig_rotation(path, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_root_cert.current", "issuer_name", "root-2021"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_root_cert.next", "issuer_name", "root-2022"),
+					resource.TestCheckResourceAttrSet("vault_pki_secret_backend_root_cert.next", "issuer_id"),
+					testPkiSecretBackendRootCertificateIssuerCount(path, 2),
+				),
+			},
+		},
+	})
+}
+
+func TestPkiSecretBackendRootCertDelete_sharedKey(t *testing.T) {
+	tests := []struct {
+		otherIssuerKeyID string
+		deletesKey       bool
+	}{
+		{"other-key", true},
+		{"root-key", false},
+	}
+
+	for i, test := range tests {
+		var deletedKey bool
+		mux := http.NewServeMux()
+		mux.HandleFunc("/v1/pki/issuer/root", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		})
+		mux.HandleFunc("/v1/pki/issuers", func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, `{"data": {"keys": ["other"]}}`)
+		})
+		mux.HandleFunc("/v1/pki/issuer/other", func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `{"data": {"issuer_id": "other", "key_id": %q}}`, test.otherIssuerKeyID)
+		})
+		mux.HandleFunc("/v1/pki/key/root-key", func(w http.ResponseWriter, r *http.Request) {
+			deletedKey = r.Method == http.MethodDelete
+			w.WriteHeader(http.StatusNoContent)
+		})
+		server := httptest.NewServer(mux)
+
+		config := api.DefaultConfig()
+		config.Address = server.URL
+		client, err := api.NewClient(config)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		d := pkiSecretBackendRootCertResource().TestResourceData()
+		d.Set("backend", "pki")
+		d.Set("type", "internal")
+		d.Set("issuer_id", "root")
+		d.Set("key_id", "root-key")
+
+		if err := pkiSecretBackendRootCertDelete(d, client); err != nil {
+			t.Errorf("test %d: %s", i, err)
+		}
+		if deletedKey != test.deletesKey {
+			t.Errorf("test %d: expected key deleted %t, got %t", i, test.deletesKey, deletedKey)
+		}
+		server.Close()
+	}
+}
+
+func testPkiSecretBackendRootCertificateIssuerCount(path string, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testProvider.Meta().(*api.Client)
+
+		resp, err := client.Logical().List(path + "/issuers")
+		if err != nil {
+			return err
+		}
+		if resp == nil {
+			return fmt.Errorf("no issuers found in %q", path)
+		}
+		keys := resp.Data["keys"].([]interface{})
+		if len(keys) != expected {
+			return fmt.Errorf("expected %d issuers in %q, got %d", expected, path, len(keys))
+		}
+		return nil
+	}
+}
+
 func testPkiSecretBackendRootCertificateDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -93,3 +193,39 @@ resource "vault_pki_secret_backend_root_cert" "test" {
   province = "test"
 }`, path)
 }
+
+func testPkiSecretBackendRootCertificateConfig_rotation(path string, rotate bool) string {
+	config := fmt.Sprintf(`
+resource "vault_pki_secret_backend" "test" {
+  path = "%s"
+  default_lease_ttl_seconds = "86400"
+  max_lease_ttl_seconds     = "86400"
+}
+
+resource "vault_pki_secret_backend_root_cert" "current" {
+  backend     = vault_pki_secret_backend.test.path
+  type        = "internal"
+  common_name = "test Root CA"
+  ttl         = "86400"
+  issuer_name = "root-2021"
+  key_name    = "root-2021"
+}
+`, path)
+
+	if rotate {
+		config += `
+resource "vault_pki_secret_backend_root_cert" "next" {
+  backend     = vault_pki_secret_backend.test.path
+  type        = "internal"
+  common_name = "test Root CA"
+  ttl         = "86400"
+  issuer_name = "root-2022"
+  key_name    = "root-2022"
+
+  depends_on = [vault_pki_secret_backend_root_cert.current]
+}
+`
+	}
+
+	return config
+}
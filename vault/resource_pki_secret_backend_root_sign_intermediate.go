@@ -73,10 +73,11 @@ func pkiSecretBackendRootSignIntermediateResource() *schema.Resource {
 				},
 			},
 			"ttl": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				ForceNew:    false,
-				Description: "Time to live.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     false,
+				Description:  "Time to live.",
+				ValidateFunc: validateVaultDuration,
 			},
 			"format": {
 				Type:         schema.TypeString,
@@ -80,10 +80,11 @@ func pkiSecretBackendSignResource() *schema.Resource {
 				},
 			},
 			"ttl": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				ForceNew:    false,
-				Description: "Time to live.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     false,
+				Description:  "Time to live.",
+				ValidateFunc: validateVaultDuration,
 			},
 			"format": {
 				Type:         schema.TypeString,
@@ -0,0 +1,72 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func pkiSecretBackendTidyResource() *schema.Resource {
+	fields := map[string]*schema.Schema{
+		"backend": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The path of the PKI secret backend the resource belongs to.",
+			// standardise on no beginning or trailing slashes
+			StateFunc: func(v interface{}) string {
+				return strings.Trim(v.(string), "/")
+			},
+		},
+		"triggers": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "Arbitrary map of values that, when changed, will trigger a new tidy operation.",
+		},
+	}
+	for k, v := range pkiSecretBackendTidySchema(true) {
+		fields[k] = v
+	}
+
+	return &schema.Resource{
+		Create: pkiSecretBackendTidyCreate,
+		Read:   pkiSecretBackendTidyRead,
+		Delete: pkiSecretBackendTidyDelete,
+
+		Schema: fields,
+	}
+}
+
+func pkiSecretBackendTidyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	path := pkiSecretBackendTidyPath(backend)
+
+	data := pkiSecretBackendTidyRequestData(d)
+
+	log.Printf("[DEBUG] Starting tidy operation on PKI secret backend %q", backend)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error starting tidy operation on PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Started tidy operation on PKI secret backend %q", backend)
+
+	d.SetId(path)
+	return pkiSecretBackendTidyRead(d, meta)
+}
+
+func pkiSecretBackendTidyRead(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func pkiSecretBackendTidyDelete(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func pkiSecretBackendTidyPath(backend string) string {
+	return strings.Trim(backend, "/") + "/tidy"
+}
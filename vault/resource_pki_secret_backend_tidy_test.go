@@ -0,0 +1,64 @@
+package vault
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestPkiSecretBackendTidy_basic(t *testing.T) {
+	backend := "pki-" + strconv.Itoa(acctest.RandInt())
+	resourceName := "vault_pki_secret_backend_tidy.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendTidyConfig_basic(backend, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "tidy_cert_store", "true"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.run", "1"),
+				),
+			},
+			{
+				Config: testPkiSecretBackendTidyConfig_basic(backend, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "triggers.run", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendTidyConfig_basic(backend, run string) string {
+	return fmt.Sprintf(`
+resource "vault_pki_secret_backend" "test" {
+  path = "%s"
+}
+
+resource "vault_pki_secret_backend_root_cert" "test" {
+  backend     = vault_pki_secret_backend.test.path
+  type        = "internal"
+  common_name = "test Root CA"
+  ttl         = "86400"
+}
+
+resource "vault_pki_secret_backend_tidy" "test" {
+  backend            = vault_pki_secret_backend.test.path
+  tidy_cert_store    = true
+  tidy_revoked_certs = true
+  safety_buffer      = 3600
+
+  triggers = {
+    run = "%s"
+  }
+
+  depends_on = [vault_pki_secret_backend_root_cert.test]
+}
+`, backend, run)
+}
@@ -0,0 +1,185 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+const pluginCatalogPath = "sys/plugins/catalog"
+
+func pluginResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pluginWrite,
+		Read:   pluginRead,
+		Update: pluginWrite,
+		Delete: pluginDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Type of the plugin. Valid values are auth, secret and database.",
+				ValidateFunc: validation.StringInSlice([]string{"auth", "secret", "database"}, false),
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the plugin.",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Semantic version of the plugin, such as v1.0.0.",
+			},
+			"sha256": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "SHA256 sum of the plugin binary, or of the OCI image manifest when oci_image is set.",
+			},
+			"command": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Command used to run the plugin, relative to the plugin directory. Defaults to the plugin name when oci_image is set.",
+			},
+			"args": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arguments passed to the plugin command.",
+			},
+			"env": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Environment variables of the plugin, in KEY=VALUE form.",
+			},
+			"oci_image": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "OCI image to run the plugin in a container from. Requires Vault 1.15+.",
+			},
+			"runtime": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the plugin runtime used to run the container.",
+			},
+		},
+	}
+}
+
+func pluginWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	pluginType := d.Get("type").(string)
+	name := d.Get("name").(string)
+	version := d.Get("version").(string)
+
+	data := map[string]interface{}{
+		"sha256": d.Get("sha256"),
+	}
+	for _, k := range []string{"version", "command", "args", "env", "oci_image", "runtime"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Registering %s plugin %q", pluginType, name)
+	if _, err := client.Logical().Write(pluginCatalogPath+"/"+pluginType+"/"+name, data); err != nil {
+		return fmt.Errorf("error registering %s plugin %q: %s", pluginType, name, err)
+	}
+	log.Printf("[DEBUG] Registered %s plugin %q", pluginType, name)
+
+	id := pluginType + "/" + name
+	if version != "" {
+		id += "/" + version
+	}
+	d.SetId(id)
+
+	return pluginRead(d, meta)
+}
+
+func pluginRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	pluginType, name, version, err := pluginParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Reading %s plugin %q", pluginType, name)
+	resp, err := client.Logical().ReadWithData(pluginCatalogPath+"/"+pluginType+"/"+name, pluginVersionData(version))
+	if err != nil {
+		return fmt.Errorf("error reading %s plugin %q: %s", pluginType, name, err)
+	}
+	log.Printf("[DEBUG] Read %s plugin %q", pluginType, name)
+	if resp == nil {
+		log.Printf("[WARN] %s plugin %q not found, removing from state", pluginType, name)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("type", pluginType)
+	d.Set("name", name)
+	d.Set("version", version)
+
+	// The environment isn't returned by Vault.
+	for _, k := range []string{"sha256", "command", "args", "oci_image", "runtime"} {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting state key %q: %s", k, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func pluginDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	pluginType, name, version, err := pluginParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deregistering %s plugin %q", pluginType, name)
+	if _, err := client.Logical().DeleteWithData(pluginCatalogPath+"/"+pluginType+"/"+name, pluginVersionData(version)); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error deregistering %s plugin %q: %s", pluginType, name, err)
+	}
+	log.Printf("[DEBUG] Deregistered %s plugin %q", pluginType, name)
+
+	return nil
+}
+
+// pluginParseID splits an ID of the form <type>/<name>[/<version>].
+func pluginParseID(id string) (string, string, string, error) {
+	parts := strings.Split(id, "/")
+	switch len(parts) {
+	case 2:
+		return parts[0], parts[1], "", nil
+	case 3:
+		return parts[0], parts[1], parts[2], nil
+	}
+	return "", "", "", fmt.Errorf("expected ID in the form <type>/<name>[/<version>], got %q", id)
+}
+
+func pluginVersionData(version string) map[string][]string {
+	data := map[string][]string{}
+	if version != "" {
+		data["version"] = []string{version}
+	}
+	return data
+}
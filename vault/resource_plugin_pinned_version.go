@@ -0,0 +1,106 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+const pluginPinsPath = "sys/plugins/pins"
+
+func pluginPinnedVersionResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pluginPinnedVersionWrite,
+		Read:   pluginPinnedVersionRead,
+		Update: pluginPinnedVersionWrite,
+		Delete: pluginPinnedVersionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Type of the plugin. Valid values are auth, secret and database.",
+				ValidateFunc: validation.StringInSlice([]string{"auth", "secret", "database"}, false),
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the plugin.",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Version the plugin is pinned to, such as v1.0.0.",
+			},
+		},
+	}
+}
+
+func pluginPinnedVersionWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	id := d.Get("type").(string) + "/" + d.Get("name").(string)
+
+	log.Printf("[DEBUG] Pinning plugin %q", id)
+	if _, err := client.Logical().Write(pluginPinsPath+"/"+id, map[string]interface{}{
+		"version": d.Get("version"),
+	}); err != nil {
+		return fmt.Errorf("error pinning plugin %q: %s", id, err)
+	}
+	d.SetId(id)
+	log.Printf("[DEBUG] Pinned plugin %q", id)
+
+	return pluginPinnedVersionRead(d, meta)
+}
+
+func pluginPinnedVersionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	id := d.Id()
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 {
+		return fmt.Errorf("expected ID in the form <type>/<name>, got %q", id)
+	}
+
+	log.Printf("[DEBUG] Reading pinned version of plugin %q", id)
+	resp, err := client.Logical().Read(pluginPinsPath + "/" + id)
+	if err != nil {
+		return fmt.Errorf("error reading pinned version of plugin %q: %s", id, err)
+	}
+	log.Printf("[DEBUG] Read pinned version of plugin %q", id)
+	if resp == nil {
+		log.Printf("[WARN] Pinned version of plugin %q not found, removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("type", parts[0])
+	d.Set("name", parts[1])
+	d.Set("version", resp.Data["version"])
+
+	return nil
+}
+
+func pluginPinnedVersionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	id := d.Id()
+
+	log.Printf("[DEBUG] Unpinning plugin %q", id)
+	if _, err := client.Logical().Delete(pluginPinsPath + "/" + id); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error unpinning plugin %q: %s", id, err)
+	}
+	log.Printf("[DEBUG] Unpinned plugin %q", id)
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestResourcePluginPinnedVersion(t *testing.T) {
+	// Only versions registered in the plugin catalog can be pinned.
+	command := os.Getenv("VAULT_PLUGIN_COMMAND")
+	sha256 := os.Getenv("VAULT_PLUGIN_SHA256")
+	if command == "" || sha256 == "" {
+		t.Skip("VAULT_PLUGIN_COMMAND and VAULT_PLUGIN_SHA256 must be set to test plugin pinning")
+	}
+
+	resourceName := "vault_plugin_pinned_version.test"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testResourcePluginPinnedVersion_destroyCheck,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourcePluginPinnedVersion_config(command, sha256, "vault_plugin.v1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", "secret"),
+					resource.TestCheckResourceAttr(resourceName, "name", "tf-test-plugin"),
+					resource.TestCheckResourceAttr(resourceName, "version", "v1.0.0"),
+				),
+			},
+			{
+				Config: testResourcePluginPinnedVersion_config(command, sha256, "vault_plugin.v2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "version", "v2.0.0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testResourcePluginPinnedVersion_destroyCheck(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_plugin_pinned_version" {
+			continue
+		}
+		resp, err := client.Logical().Read(pluginPinsPath + "/" + rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error checking for pinned version of plugin %q: %s", rs.Primary.ID, err)
+		}
+		if resp != nil {
+			return fmt.Errorf("plugin %q is still pinned", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testResourcePluginPinnedVersion_config(command, sha256, plugin string) string {
+	return fmt.Sprintf(`
+resource "vault_plugin" "v1" {
+  type    = "secret"
+  name    = "tf-test-plugin"
+  version = "v1.0.0"
+  command = "%s"
+  sha256  = "%s"
+}
+
+resource "vault_plugin" "v2" {
+  type    = "secret"
+  name    = "tf-test-plugin"
+  version = "v2.0.0"
+  command = "%s"
+  sha256  = "%s"
+}
+
+resource "vault_plugin_pinned_version" "test" {
+  type    = %s.type
+  name    = %s.name
+  version = %s.version
+}
+`, command, sha256, command, sha256, plugin, plugin, plugin)
+}
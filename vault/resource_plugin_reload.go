@@ -0,0 +1,105 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func pluginReloadResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pluginReloadCreate,
+		Read:   pluginReloadRead,
+		Delete: pluginReloadDelete,
+
+		Schema: map[string]*schema.Schema{
+			"plugin": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "Name of the plugin to reload on all of its mounts.",
+				ConflictsWith: []string{"mounts"},
+			},
+			"mounts": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ForceNew:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Description:   "Mounts whose plugins are reloaded.",
+				ConflictsWith: []string{"plugin"},
+			},
+			"scope": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "Scope of the reload. Set to global to reload the plugin on all nodes of the cluster.",
+				ValidateFunc: validation.StringInSlice([]string{"global"}, false),
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary map of values that, when changed, reloads the plugin again.",
+			},
+			"reload_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of a global reload.",
+			},
+		},
+	}
+}
+
+func pluginReloadCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	data := map[string]interface{}{}
+	var id string
+	if v, ok := d.GetOk("plugin"); ok {
+		data["plugin"] = v
+		id = v.(string)
+	} else if v, ok := d.GetOk("mounts"); ok {
+		var mounts []string
+		for _, m := range v.([]interface{}) {
+			mounts = append(mounts, m.(string))
+		}
+		data["mounts"] = mounts
+		id = strings.Join(mounts, ",")
+	} else {
+		return fmt.Errorf("one of plugin or mounts must be set")
+	}
+	if v, ok := d.GetOk("scope"); ok {
+		data["scope"] = v
+	}
+
+	log.Printf("[DEBUG] Reloading plugin %q", id)
+	resp, err := client.Logical().Write("sys/plugins/reload/backend", data)
+	if err != nil {
+		return fmt.Errorf("error reloading plugin %q: %s", id, err)
+	}
+	log.Printf("[DEBUG] Reloaded plugin %q", id)
+
+	d.SetId(id)
+	if resp != nil {
+		d.Set("reload_id", resp.Data["reload_id"])
+	}
+
+	return nil
+}
+
+func pluginReloadRead(d *schema.ResourceData, meta interface{}) error {
+	// A reload has no state in Vault to read back.
+	return nil
+}
+
+func pluginReloadDelete(d *schema.ResourceData, meta interface{}) error {
+	// A reload can't be undone, so removing the resource only removes it
+	// from state.
+	log.Printf("[DEBUG] Removing plugin reload %q from state", d.Id())
+	return nil
+}
@@ -0,0 +1,51 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestResourcePluginReload(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-kv")
+	resourceName := "vault_plugin_reload.test"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testResourcePluginReload_config(path, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", path),
+					resource.TestCheckResourceAttr(resourceName, "mounts.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.version", "1"),
+				),
+			},
+			{
+				Config: testResourcePluginReload_config(path, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "triggers.version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testResourcePluginReload_config(path, version string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "kv"
+}
+
+resource "vault_plugin_reload" "test" {
+  mounts = [vault_mount.test.path]
+
+  triggers = {
+    version = "%s"
+  }
+}
+`, path, version)
+}
@@ -0,0 +1,143 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+const pluginRuntimeCatalogPath = "sys/plugins/runtimes/catalog"
+
+func pluginRuntimeResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pluginRuntimeWrite,
+		Read:   pluginRuntimeRead,
+		Update: pluginRuntimeWrite,
+		Delete: pluginRuntimeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "container",
+				Description:  "Type of the plugin runtime. The only valid value is container.",
+				ValidateFunc: validation.StringInSlice([]string{"container"}, false),
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the plugin runtime.",
+			},
+			"oci_runtime": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "OCI runtime used to run the containers, such as runsc.",
+			},
+			"cgroup_parent": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Parent cgroup of the containers.",
+			},
+			"cpu_nanos": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "CPU limit of each container, in billionths of a CPU.",
+			},
+			"memory_bytes": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Memory limit of each container, in bytes.",
+			},
+			"rootless": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the container runtime runs as a non-root user.",
+			},
+		},
+	}
+}
+
+var pluginRuntimeFields = []string{"oci_runtime", "cgroup_parent", "cpu_nanos", "memory_bytes", "rootless"}
+
+func pluginRuntimeWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	runtimeType := d.Get("type").(string)
+	name := d.Get("name").(string)
+
+	data := map[string]interface{}{}
+	for _, k := range pluginRuntimeFields {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Registering %s plugin runtime %q", runtimeType, name)
+	if _, err := client.Logical().Write(pluginRuntimeCatalogPath+"/"+runtimeType+"/"+name, data); err != nil {
+		return fmt.Errorf("error registering %s plugin runtime %q: %s", runtimeType, name, err)
+	}
+	d.SetId(runtimeType + "/" + name)
+	log.Printf("[DEBUG] Registered %s plugin runtime %q", runtimeType, name)
+
+	return pluginRuntimeRead(d, meta)
+}
+
+func pluginRuntimeRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	id := d.Id()
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 {
+		return fmt.Errorf("expected ID in the form <type>/<name>, got %q", id)
+	}
+
+	log.Printf("[DEBUG] Reading plugin runtime %q", id)
+	resp, err := client.Logical().Read(pluginRuntimeCatalogPath + "/" + id)
+	if err != nil {
+		return fmt.Errorf("error reading plugin runtime %q: %s", id, err)
+	}
+	log.Printf("[DEBUG] Read plugin runtime %q", id)
+	if resp == nil {
+		log.Printf("[WARN] Plugin runtime %q not found, removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("type", parts[0])
+	d.Set("name", parts[1])
+	for _, k := range pluginRuntimeFields {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting state key %q: %s", k, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func pluginRuntimeDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	id := d.Id()
+
+	log.Printf("[DEBUG] Deregistering plugin runtime %q", id)
+	if _, err := client.Logical().Delete(pluginRuntimeCatalogPath + "/" + id); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error deregistering plugin runtime %q: %s", id, err)
+	}
+	log.Printf("[DEBUG] Deregistered plugin runtime %q", id)
+
+	return nil
+}
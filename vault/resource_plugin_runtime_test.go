@@ -0,0 +1,75 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestResourcePluginRuntime(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-test-runtime")
+	resourceName := "vault_plugin_runtime.test"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testResourcePluginRuntime_destroyCheck,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourcePluginRuntime_config(name, 536870912),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", "container"),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "oci_runtime", "runsc"),
+					resource.TestCheckResourceAttr(resourceName, "cpu_nanos", "1000000000"),
+					resource.TestCheckResourceAttr(resourceName, "memory_bytes", "536870912"),
+					resource.TestCheckResourceAttr(resourceName, "rootless", "true"),
+				),
+			},
+			{
+				Config: testResourcePluginRuntime_config(name, 1073741824),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "memory_bytes", "1073741824"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testResourcePluginRuntime_destroyCheck(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_plugin_runtime" {
+			continue
+		}
+		resp, err := client.Logical().Read(pluginRuntimeCatalogPath + "/" + rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error checking for plugin runtime %q: %s", rs.Primary.ID, err)
+		}
+		if resp != nil {
+			return fmt.Errorf("plugin runtime %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testResourcePluginRuntime_config(name string, memory int) string {
+	return fmt.Sprintf(`
+resource "vault_plugin_runtime" "test" {
+  name         = "%s"
+  oci_runtime  = "runsc"
+  cpu_nanos    = 1000000000
+  memory_bytes = %d
+  rootless     = true
+}
+`, name, memory)
+}
@@ -0,0 +1,282 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+func pluginSecretBackendResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pluginSecretBackendCreate,
+		Read:   pluginSecretBackendRead,
+		Update: pluginSecretBackendUpdate,
+		Delete: pluginSecretBackendDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Path to mount the plugin at.",
+				ValidateFunc: validateMountPath,
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"plugin_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the secrets engine plugin, as registered in the plugin catalog.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Human-friendly description of the mount for the backend.",
+			},
+			"default_lease_ttl_seconds": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Default lease duration for secrets in seconds.",
+			},
+			"max_lease_ttl_seconds": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum possible lease duration for secrets in seconds.",
+			},
+			"local": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Mark the secrets engine as local-only. Local engines are not replicated or removed by replication.",
+			},
+			"options": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Plugin specific options that are passed to the backend when it is mounted.",
+			},
+			"config_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "config",
+				Description: "Path relative to the mount of the plugin's configuration endpoint.",
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			// Config is passed as JSON so that any plugin can be configured,
+			// rather than forcing e.g. all values to be strings.
+			"config_json": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				Description:  "JSON-encoded configuration to write to the config_path.",
+				StateFunc:    NormalizeDataJSON,
+				ValidateFunc: ValidateDataJSON,
+			},
+			"disable_config_read": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Don't read the configuration back from Vault, for plugins that don't support it or return secrets; drift won't be detected.",
+			},
+		},
+	}
+}
+
+func pluginSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Get("path").(string)
+	pluginName := d.Get("plugin_name").(string)
+
+	log.Printf("[DEBUG] Mounting %s plugin backend at %q", pluginName, path)
+	err := client.Sys().Mount(path, &api.MountInput{
+		Type:        pluginName,
+		Description: d.Get("description").(string),
+		Local:       d.Get("local").(bool),
+		Options:     opts(d),
+		Config: api.MountConfigInput{
+			DefaultLeaseTTL: fmt.Sprintf("%ds", d.Get("default_lease_ttl_seconds")),
+			MaxLeaseTTL:     fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds")),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error mounting to %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Mounted %s plugin backend at %q", pluginName, path)
+	d.SetId(path)
+
+	if err := pluginSecretBackendWriteConfig(d, client); err != nil {
+		return err
+	}
+
+	return pluginSecretBackendRead(d, meta)
+}
+
+func pluginSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading mount %q", path)
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return fmt.Errorf("error reading mount %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read mount %q", path)
+
+	mount, ok := mounts[strings.Trim(path, "/")+"/"]
+	if !ok {
+		log.Printf("[WARN] Mount %q not found, removing from state.", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("path", path)
+	d.Set("plugin_name", mount.Type)
+	d.Set("description", mount.Description)
+	d.Set("default_lease_ttl_seconds", mount.Config.DefaultLeaseTTL)
+	d.Set("max_lease_ttl_seconds", mount.Config.MaxLeaseTTL)
+	d.Set("local", mount.Local)
+	d.Set("options", mount.Options)
+
+	if _, ok := d.GetOk("config_json"); !ok || d.Get("disable_config_read").(bool) {
+		return nil
+	}
+
+	configPath := pluginSecretBackendConfigPath(d)
+	log.Printf("[DEBUG] Reading %q", configPath)
+	resp, err := client.Logical().Read(configPath)
+	if err != nil {
+		return fmt.Errorf("error reading %q: %s", configPath, err)
+	}
+	log.Printf("[DEBUG] Read %q", configPath)
+	if resp == nil {
+		log.Printf("[WARN] %q not found, configuration will be written again", configPath)
+		d.Set("config_json", "")
+		return nil
+	}
+
+	configJSON, err := pluginSecretBackendSuppliedJSON(d.Get("config_json").(string), resp.Data)
+	if err != nil {
+		return fmt.Errorf("error reading %q: %s", configPath, err)
+	}
+	d.Set("config_json", configJSON)
+
+	return nil
+}
+
+func pluginSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	if d.HasChange("default_lease_ttl_seconds") || d.HasChange("max_lease_ttl_seconds") || d.HasChange("options") {
+		tune := api.MountConfigInput{
+			DefaultLeaseTTL: fmt.Sprintf("%ds", d.Get("default_lease_ttl_seconds")),
+			MaxLeaseTTL:     fmt.Sprintf("%ds", d.Get("max_lease_ttl_seconds")),
+			Options:         opts(d),
+		}
+
+		log.Printf("[DEBUG] Updating mount %q", path)
+		if err := client.Sys().TuneMount(path, tune); err != nil {
+			return fmt.Errorf("error updating mount %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Updated mount %q", path)
+	}
+
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		log.Printf("[DEBUG] Updating description for %q", path)
+		if err := client.Sys().TuneMount(path, api.MountConfigInput{Description: &description}); err != nil {
+			return fmt.Errorf("error updating description for %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Updated description for %q", path)
+	}
+
+	if d.HasChange("config_json") || d.HasChange("config_path") {
+		if err := pluginSecretBackendWriteConfig(d, client); err != nil {
+			return err
+		}
+	}
+
+	return pluginSecretBackendRead(d, meta)
+}
+
+func pluginSecretBackendDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	log.Printf("[DEBUG] Unmounting plugin backend %q", path)
+	if err := client.Sys().Unmount(path); err != nil {
+		if util.Is404(err) {
+			log.Printf("[WARN] %q not found, removing from state", path)
+			return nil
+		}
+		return fmt.Errorf("error unmounting plugin backend from %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Unmounted plugin backend %q", path)
+
+	return nil
+}
+
+func pluginSecretBackendWriteConfig(d *schema.ResourceData, client *api.Client) error {
+	v, ok := d.GetOk("config_json")
+	if !ok {
+		return nil
+	}
+
+	var data map[string]interface{}
+	if err := json.Unmarshal([]byte(v.(string)), &data); err != nil {
+		return fmt.Errorf("config_json syntax error: %s", err)
+	}
+
+	configPath := pluginSecretBackendConfigPath(d)
+	log.Printf("[DEBUG] Writing %q", configPath)
+	if _, err := client.Logical().Write(configPath, data); err != nil {
+		return fmt.Errorf("error writing %q: %s", configPath, err)
+	}
+	log.Printf("[DEBUG] Wrote %q", configPath)
+
+	return nil
+}
+
+func pluginSecretBackendConfigPath(d *schema.ResourceData) string {
+	return strings.Trim(d.Id(), "/") + "/" + strings.Trim(d.Get("config_path").(string), "/")
+}
+
+// pluginSecretBackendSuppliedJSON returns the fields of data that are also
+// present in the supplied JSON, encoded as JSON. Plugins commonly return
+// computed fields, and never return secrets, so only comparing the fields
+// that were written avoids perpetual diffs while still detecting drift.
+func pluginSecretBackendSuppliedJSON(supplied string, data map[string]interface{}) (string, error) {
+	var suppliedData map[string]interface{}
+	if err := json.Unmarshal([]byte(supplied), &suppliedData); err != nil {
+		return "", fmt.Errorf("JSON syntax error: %s", err)
+	}
+
+	for k := range suppliedData {
+		if v, ok := data[k]; ok {
+			suppliedData[k] = v
+		}
+	}
+
+	jsonData, err := json.Marshal(suppliedData)
+	if err != nil {
+		return "", fmt.Errorf("error marshaling JSON: %s", err)
+	}
+
+	return string(jsonData), nil
+}
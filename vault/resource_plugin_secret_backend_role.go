@@ -0,0 +1,152 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+func pluginSecretBackendRoleResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pluginSecretBackendRoleWrite,
+		Read:   pluginSecretBackendRoleRead,
+		Update: pluginSecretBackendRoleWrite,
+		Delete: pluginSecretBackendRoleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the plugin secret backend the role belongs to.",
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the role.",
+			},
+			"role_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "roles",
+				Description: "Path relative to the mount under which the plugin manages roles.",
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"data_json": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				Description:  "JSON-encoded role data to write.",
+				StateFunc:    NormalizeDataJSON,
+				ValidateFunc: ValidateDataJSON,
+			},
+			"disable_read": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Don't read the role back from Vault, for plugins that don't support it; drift won't be detected.",
+			},
+		},
+	}
+}
+
+func pluginSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	rolePath := d.Get("role_path").(string)
+	name := d.Get("name").(string)
+	path := strings.Trim(backend, "/") + "/" + strings.Trim(rolePath, "/") + "/" + name
+
+	var data map[string]interface{}
+	if err := json.Unmarshal([]byte(d.Get("data_json").(string)), &data); err != nil {
+		return fmt.Errorf("data_json syntax error: %s", err)
+	}
+
+	log.Printf("[DEBUG] Writing %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing %q: %s", path, err)
+	}
+	d.SetId(path)
+	log.Printf("[DEBUG] Wrote %q", path)
+
+	return pluginSecretBackendRoleRead(d, meta)
+}
+
+func pluginSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	// the role path is assumed to be a single path segment when importing
+	pieces := strings.Split(path, "/")
+	if len(pieces) < 3 {
+		return fmt.Errorf("invalid role ID %q; must be {backend}/{role_path}/{name}", path)
+	}
+	d.Set("backend", strings.Join(pieces[:len(pieces)-2], "/"))
+	d.Set("role_path", pieces[len(pieces)-2])
+	d.Set("name", pieces[len(pieces)-1])
+
+	if d.Get("disable_read").(bool) {
+		log.Printf("[WARN] %q does not refresh when disable_read is set to true", path)
+		return nil
+	}
+
+	log.Printf("[DEBUG] Reading %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read %q", path)
+	if resp == nil {
+		log.Printf("[WARN] %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	// an imported role has no supplied data yet, so all fields are kept
+	supplied := d.Get("data_json").(string)
+	if supplied == "" {
+		jsonData, err := json.Marshal(resp.Data)
+		if err != nil {
+			return fmt.Errorf("error marshaling JSON for %q: %s", path, err)
+		}
+		d.Set("data_json", string(jsonData))
+		return nil
+	}
+
+	dataJSON, err := pluginSecretBackendSuppliedJSON(supplied, resp.Data)
+	if err != nil {
+		return fmt.Errorf("error reading %q: %s", path, err)
+	}
+	d.Set("data_json", dataJSON)
+
+	return nil
+}
+
+func pluginSecretBackendRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting %q", path)
+	if _, err := client.Logical().Delete(path); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error deleting %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted %q", path)
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+// The builtin kv version 1 plugin is used, since any path below the mount
+// can be written and read back.
+func TestAccPluginSecretBackendRole_basic(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-plugin")
+	name := acctest.RandomWithPrefix("tf-test-role")
+	resourceName := "vault_plugin_secret_backend_role.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccPluginSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testPluginSecretBackendRoleConfig(path, name, "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", path),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "role_path", "teams"),
+					resource.TestCheckResourceAttr(resourceName, "data_json", `{"policies":["foo"],"ttl":3600}`),
+				),
+			},
+			{
+				Config: testPluginSecretBackendRoleConfig(path, name, "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "data_json", `{"policies":["bar"],"ttl":3600}`),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPluginSecretBackendRoleCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_plugin_secret_backend_role" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("role %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testPluginSecretBackendRoleConfig(path, name, policy string) string {
+	return fmt.Sprintf(`
+resource "vault_plugin_secret_backend" "test" {
+  path        = "%s"
+  plugin_name = "kv"
+}
+
+resource "vault_plugin_secret_backend_role" "test" {
+  backend   = vault_plugin_secret_backend.test.path
+  name      = "%s"
+  role_path = "teams"
+
+  data_json = jsonencode({
+    policies = ["%s"]
+    ttl      = 3600
+  })
+}
+`, path, name, policy)
+}
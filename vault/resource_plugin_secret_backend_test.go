@@ -0,0 +1,91 @@
+package vault
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+// The builtin kv plugin is used, since it is always in the plugin catalog and
+// has a config endpoint.
+func TestAccPluginSecretBackend_basic(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-plugin")
+	resourceName := "vault_plugin_secret_backend.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccPluginSecretBackendCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testPluginSecretBackendConfig(path, "test description", 5),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "path", path),
+					resource.TestCheckResourceAttr(resourceName, "plugin_name", "kv"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test description"),
+					resource.TestCheckResourceAttr(resourceName, "options.version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "config_json", `{"max_versions":5}`),
+				),
+			},
+			{
+				Config: testPluginSecretBackendConfig(path, "updated description", 10),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "updated description"),
+					resource.TestCheckResourceAttr(resourceName, "config_json", `{"max_versions":10}`),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"config_json", "config_path", "disable_config_read"},
+			},
+		},
+	})
+}
+
+func testAccPluginSecretBackendCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	mounts, err := client.Sys().ListMounts()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_plugin_secret_backend" {
+			continue
+		}
+		for path := range mounts {
+			path = strings.Trim(path, "/")
+			rsPath := strings.Trim(rs.Primary.Attributes["path"], "/")
+			if path == rsPath {
+				return fmt.Errorf("mount %q still exists", path)
+			}
+		}
+	}
+	return nil
+}
+
+func testPluginSecretBackendConfig(path, description string, maxVersions int) string {
+	return fmt.Sprintf(`
+resource "vault_plugin_secret_backend" "test" {
+  path        = "%s"
+  plugin_name = "kv"
+  description = "%s"
+
+  options = {
+    version = "2"
+  }
+
+  config_json = jsonencode({
+    max_versions = %d
+  })
+}
+`, path, description, maxVersions)
+}
@@ -0,0 +1,83 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestResourcePlugin(t *testing.T) {
+	// Registering a plugin requires its binary to be in the plugin
+	// directory of the Vault server.
+	command := os.Getenv("VAULT_PLUGIN_COMMAND")
+	sha256 := os.Getenv("VAULT_PLUGIN_SHA256")
+	if command == "" || sha256 == "" {
+		t.Skip("VAULT_PLUGIN_COMMAND and VAULT_PLUGIN_SHA256 must be set to test plugin registration")
+	}
+
+	resourceName := "vault_plugin.test"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testResourcePlugin_destroyCheck,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourcePlugin_config(command, sha256, `["-debug"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", "secret"),
+					resource.TestCheckResourceAttr(resourceName, "name", "tf-test-plugin"),
+					resource.TestCheckResourceAttr(resourceName, "command", command),
+					resource.TestCheckResourceAttr(resourceName, "sha256", sha256),
+					resource.TestCheckResourceAttr(resourceName, "args.#", "1"),
+				),
+			},
+			{
+				Config: testResourcePlugin_config(command, sha256, `[]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "args.#", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"env"},
+			},
+		},
+	})
+}
+
+func testResourcePlugin_destroyCheck(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_plugin" {
+			continue
+		}
+		resp, err := client.Logical().Read(pluginCatalogPath + "/" + rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error checking for plugin %q: %s", rs.Primary.ID, err)
+		}
+		if resp != nil {
+			return fmt.Errorf("plugin %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testResourcePlugin_config(command, sha256, args string) string {
+	return fmt.Sprintf(`
+resource "vault_plugin" "test" {
+  type    = "secret"
+  name    = "tf-test-plugin"
+  command = "%s"
+  sha256  = "%s"
+  args    = %s
+  env     = ["TF_TEST=true"]
+}
+`, command, sha256, args)
+}
@@ -27,9 +27,10 @@ func policyResource() *schema.Resource {
 			},
 
 			"policy": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The policy document",
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The policy document",
+				ValidateFunc: validatePolicyHCL,
 			},
 		},
 	}
@@ -0,0 +1,110 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+const quotaConfigPath = "sys/quotas/config"
+
+func quotaConfigResource() *schema.Resource {
+	return &schema.Resource{
+		Create: quotaConfigWrite,
+		Read:   quotaConfigRead,
+		Update: quotaConfigWrite,
+		Delete: quotaConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"rate_limit_exempt_paths": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Paths that are exempt from all rate limit quotas.",
+			},
+			"enable_rate_limit_audit_logging": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Log requests that are rejected by rate limit quotas in the audit log.",
+			},
+			"enable_rate_limit_response_headers": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Add rate limit headers, such as X-Ratelimit-Remaining, to all responses.",
+			},
+		},
+	}
+}
+
+func quotaConfigWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	data := map[string]interface{}{
+		"enable_rate_limit_audit_logging":    d.Get("enable_rate_limit_audit_logging"),
+		"enable_rate_limit_response_headers": d.Get("enable_rate_limit_response_headers"),
+	}
+	if v, ok := d.GetOk("rate_limit_exempt_paths"); ok {
+		data["rate_limit_exempt_paths"] = v.(*schema.Set).List()
+	}
+
+	log.Printf("[DEBUG] Writing quota config")
+	if _, err := client.Logical().Write(quotaConfigPath, data); err != nil {
+		return fmt.Errorf("error writing quota config: %s", err)
+	}
+	d.SetId(quotaConfigPath)
+	log.Printf("[DEBUG] Wrote quota config")
+
+	return quotaConfigRead(d, meta)
+}
+
+func quotaConfigRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	log.Printf("[DEBUG] Reading quota config")
+	resp, err := client.Logical().Read(quotaConfigPath)
+	if err != nil {
+		return fmt.Errorf("error reading quota config: %s", err)
+	}
+	log.Printf("[DEBUG] Read quota config")
+	if resp == nil {
+		log.Printf("[WARN] Quota config not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+
+	for _, k := range []string{"rate_limit_exempt_paths", "enable_rate_limit_audit_logging", "enable_rate_limit_response_headers"} {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting state key %q: %s", k, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func quotaConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	// The quota config can't be deleted, so the settings are disabled again.
+	// The exempt paths are left as they are, as Vault's defaults can't be
+	// restored.
+	log.Printf("[DEBUG] Resetting quota config")
+	if _, err := client.Logical().Write(quotaConfigPath, map[string]interface{}{
+		"enable_rate_limit_audit_logging":    false,
+		"enable_rate_limit_response_headers": false,
+	}); err != nil {
+		return fmt.Errorf("error resetting quota config: %s", err)
+	}
+	log.Printf("[DEBUG] Reset quota config")
+
+	return nil
+}
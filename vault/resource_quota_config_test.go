@@ -0,0 +1,73 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestQuotaConfig(t *testing.T) {
+	resourceName := "vault_quota_config.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testQuotaConfigCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testQuotaConfig_config(true, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enable_rate_limit_audit_logging", "true"),
+					resource.TestCheckResourceAttr(resourceName, "enable_rate_limit_response_headers", "false"),
+					resource.TestCheckResourceAttr(resourceName, "rate_limit_exempt_paths.#", "1"),
+				),
+			},
+			{
+				Config: testQuotaConfig_config(false, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enable_rate_limit_audit_logging", "false"),
+					resource.TestCheckResourceAttr(resourceName, "enable_rate_limit_response_headers", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rate_limit_exempt_paths.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testQuotaConfigCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	resp, err := client.Logical().Read(quotaConfigPath)
+	if err != nil {
+		return err
+	}
+	if resp == nil {
+		return nil
+	}
+
+	for _, k := range []string{"enable_rate_limit_audit_logging", "enable_rate_limit_response_headers"} {
+		if v, ok := resp.Data[k].(bool); ok && v {
+			return fmt.Errorf("quota config %s is still enabled", k)
+		}
+	}
+
+	return nil
+}
+
+func testQuotaConfig_config(auditLogging, responseHeaders bool) string {
+	return fmt.Sprintf(`
+resource "vault_quota_config" "test" {
+  rate_limit_exempt_paths            = ["sys/health"]
+  enable_rate_limit_audit_logging    = %t
+  enable_rate_limit_response_headers = %t
+}
+`, auditLogging, responseHeaders)
+}
@@ -13,6 +13,13 @@ func quotaLeaseCountPath(name string) string {
 	return "sys/quotas/lease-count/" + name
 }
 
+// quotaLeaseCountOptionalFields are only sent to Vault when set, as they
+// aren't supported by all versions of Vault.
+var quotaLeaseCountOptionalFields = []string{
+	"role",
+	"inheritable",
+}
+
 func quotaLeaseCountResource() *schema.Resource {
 	return &schema.Resource{
 		Create: quotaLeaseCountCreate,
@@ -44,6 +51,17 @@ func quotaLeaseCountResource() *schema.Resource {
 				Description:  "The maximum number of leases to be allowed by the quota rule. The max_leases must be positive.",
 				ValidateFunc: validation.IntAtLeast(0),
 			},
+			"role": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "If set on a quota where path is set to an auth mount with a concept of roles (such as /auth/approle/), this will make the quota restrict login requests to that mount that are made with the specified role. Requires Vault 1.12+.",
+			},
+			"inheritable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "If set to true on a quota where path is set to a namespace, the same quota will be cumulatively applied to all child namespace. Requires Vault 1.15+.",
+			},
 		},
 	}
 }
@@ -60,6 +78,11 @@ func quotaLeaseCountCreate(d *schema.ResourceData, meta interface{}) error {
 	data := map[string]interface{}{}
 	data["path"] = d.Get("path").(string)
 	data["max_leases"] = d.Get("max_leases").(int)
+	for _, k := range quotaLeaseCountOptionalFields {
+		if v, ok := d.GetOkExists(k); ok {
+			data[k] = v
+		}
+	}
 
 	_, err := client.Logical().Write(path, data)
 	if err != nil {
@@ -89,7 +112,7 @@ func quotaLeaseCountRead(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
-	for _, k := range []string{"path", "max_leases"} {
+	for _, k := range append([]string{"path", "max_leases"}, quotaLeaseCountOptionalFields...) {
 		v, ok := resp.Data[k]
 		if ok {
 			if err := d.Set(k, v); err != nil {
@@ -112,6 +135,11 @@ func quotaLeaseCountUpdate(d *schema.ResourceData, meta interface{}) error {
 	data := map[string]interface{}{}
 	data["path"] = d.Get("path").(string)
 	data["max_leases"] = d.Get("max_leases").(int)
+	for _, k := range quotaLeaseCountOptionalFields {
+		if v, ok := d.GetOkExists(k); ok {
+			data[k] = v
+		}
+	}
 
 	_, err := client.Logical().Write(path, data)
 	if err != nil {
@@ -13,6 +13,17 @@ func quotaRateLimitPath(name string) string {
 	return "sys/quotas/rate-limit/" + name
 }
 
+// quotaRateLimitOptionalFields are only sent to Vault when set, as they
+// aren't supported by all versions of Vault.
+var quotaRateLimitOptionalFields = []string{
+	"role",
+	"inheritable",
+	"interval",
+	"block_interval",
+	"group_by",
+	"secondary_rate",
+}
+
 func quotaRateLimitResource() *schema.Resource {
 	return &schema.Resource{
 		Create: quotaRateLimitCreate,
@@ -43,6 +54,44 @@ func quotaRateLimitResource() *schema.Resource {
 				Description:  "The maximum number of requests at any given second to be allowed by the quota rule. The rate must be positive.",
 				ValidateFunc: validation.FloatAtLeast(0.0),
 			},
+			"role": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "If set on a quota where path is set to an auth mount with a concept of roles (such as /auth/approle/), this will make the quota restrict login requests to that mount that are made with the specified role. Requires Vault 1.12+.",
+			},
+			"inheritable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "If set to true on a quota where path is set to a namespace, the same quota will be cumulatively applied to all child namespace. Requires Vault 1.15+.",
+			},
+			"interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The duration in seconds to enforce rate limiting for.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"block_interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "If set, when a client reaches a rate limit threshold, the client will be prohibited from any further requests until after the 'block_interval' in seconds has elapsed.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"group_by": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Attribute used to group requests for rate limiting. Valid values are ip, none, entity_then_ip and entity_then_none. Requires Vault Enterprise 1.17+.",
+				ValidateFunc: validation.StringInSlice([]string{"ip", "none", "entity_then_ip", "entity_then_none"}, false),
+			},
+			"secondary_rate": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Rate limit for requests that can't be grouped by entity when group_by is entity_then_ip or entity_then_none. Requires Vault Enterprise 1.17+.",
+				ValidateFunc: validation.FloatAtLeast(0.0),
+			},
 		},
 	}
 }
@@ -59,6 +108,11 @@ func quotaRateLimitCreate(d *schema.ResourceData, meta interface{}) error {
 	data := map[string]interface{}{}
 	data["path"] = d.Get("path").(string)
 	data["rate"] = d.Get("rate").(float64)
+	for _, k := range quotaRateLimitOptionalFields {
+		if v, ok := d.GetOkExists(k); ok {
+			data[k] = v
+		}
+	}
 
 	_, err := client.Logical().Write(path, data)
 	if err != nil {
@@ -88,7 +142,7 @@ func quotaRateLimitRead(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
-	for _, k := range []string{"path", "rate"} {
+	for _, k := range append([]string{"path", "rate"}, quotaRateLimitOptionalFields...) {
 		v, ok := resp.Data[k]
 		if ok {
 			if err := d.Set(k, v); err != nil {
@@ -111,6 +165,11 @@ func quotaRateLimitUpdate(d *schema.ResourceData, meta interface{}) error {
 	data := map[string]interface{}{}
 	data["path"] = d.Get("path").(string)
 	data["rate"] = d.Get("rate").(float64)
+	for _, k := range quotaRateLimitOptionalFields {
+		if v, ok := d.GetOkExists(k); ok {
+			data[k] = v
+		}
+	}
 
 	_, err := client.Logical().Write(path, data)
 	if err != nil {
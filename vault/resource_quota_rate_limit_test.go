@@ -57,6 +57,40 @@ func TestQuotaRateLimit(t *testing.T) {
 	})
 }
 
+func TestQuotaRateLimit_role(t *testing.T) {
+	name := acctest.RandomWithPrefix("tf-test")
+	backend := acctest.RandomWithPrefix("approle")
+	rateLimit := randomQuotaRateString()
+	resourceName := "vault_quota_rate_limit.foobar"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testQuotaRateLimitCheckDestroy([]string{name}),
+		Steps: []resource.TestStep{
+			{
+				Config: testQuotaRateLimit_roleConfig(name, backend, rateLimit, 30, 60),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "path", "auth/"+backend+"/"),
+					resource.TestCheckResourceAttr(resourceName, "role", "test"),
+					resource.TestCheckResourceAttr(resourceName, "rate", rateLimit),
+					resource.TestCheckResourceAttr(resourceName, "interval", "30"),
+					resource.TestCheckResourceAttr(resourceName, "block_interval", "60"),
+				),
+			},
+			{
+				Config: testQuotaRateLimit_roleConfig(name, backend, rateLimit, 60, 120),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "role", "test"),
+					resource.TestCheckResourceAttr(resourceName, "interval", "60"),
+					resource.TestCheckResourceAttr(resourceName, "block_interval", "120"),
+				),
+			},
+		},
+	})
+}
+
 func testQuotaRateLimitCheckDestroy(rateLimits []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		client := testProvider.Meta().(*api.Client)
@@ -87,3 +121,26 @@ resource "vault_quota_rate_limit" "foobar" {
 }
 `, name, path, rate)
 }
+
+func testQuotaRateLimit_roleConfig(name, backend, rate string, interval, blockInterval int) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "approle" {
+  type = "approle"
+  path = "%s"
+}
+
+resource "vault_approle_auth_backend_role" "test" {
+  backend   = vault_auth_backend.approle.path
+  role_name = "test"
+}
+
+resource "vault_quota_rate_limit" "foobar" {
+  name           = "%s"
+  path           = "auth/${vault_auth_backend.approle.path}/"
+  role           = vault_approle_auth_backend_role.test.role_name
+  rate           = %s
+  interval       = %d
+  block_interval = %d
+}
+`, backend, name, rate, interval, blockInterval)
+}
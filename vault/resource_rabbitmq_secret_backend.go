@@ -21,11 +21,12 @@ func rabbitmqSecretBackendResource() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"path": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "rabbitmq",
-				ForceNew:    true,
-				Description: "The path of the RabbitMQ Secret Backend where the connection should be configured",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "rabbitmq",
+				ForceNew:     true,
+				Description:  "The path of the RabbitMQ Secret Backend where the connection should be configured",
+				ValidateFunc: validateMountPath,
 				// standardise on no beginning or trailing slashes
 				StateFunc: func(v interface{}) string {
 					return strings.Trim(v.(string), "/")
@@ -77,6 +78,16 @@ func rabbitmqSecretBackendResource() *schema.Resource {
 				ForceNew:    true,
 				Description: "Specifies whether to verify connection URI, username, and password.",
 			},
+			"password_policy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Specifies a password policy to use when creating dynamic credentials. Defaults to generating an alphanumeric password if not set.",
+			},
+			"username_template": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Template describing how dynamic usernames are generated.",
+			},
 		},
 	}
 }
@@ -120,6 +131,8 @@ func rabbitmqSecretBackendCreate(d *schema.ResourceData, meta interface{}) error
 		"username":          username,
 		"password":          password,
 		"verify_connection": verifyConnection,
+		"password_policy":   d.Get("password_policy").(string),
+		"username_template": d.Get("username_template").(string),
 	}
 	_, err = client.Logical().Write(path+"/config/connection", data)
 	if err != nil {
@@ -130,6 +143,8 @@ func rabbitmqSecretBackendCreate(d *schema.ResourceData, meta interface{}) error
 	d.SetPartial("username")
 	d.SetPartial("password")
 	d.SetPartial("verify_connection")
+	d.SetPartial("password_policy")
+	d.SetPartial("username_template")
 	d.Partial(false)
 	return rabbitmqSecretBackendRead(d, meta)
 }
@@ -182,13 +197,16 @@ func rabbitmqSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error
 		d.SetPartial("default_lease_ttl_seconds")
 		d.SetPartial("max_lease_ttl_seconds")
 	}
-	if d.HasChange("connection_uri") || d.HasChange("username") || d.HasChange("password") || d.HasChange("verify_connection") {
+	if d.HasChange("connection_uri") || d.HasChange("username") || d.HasChange("password") || d.HasChange("verify_connection") ||
+		d.HasChange("password_policy") || d.HasChange("username_template") {
 		log.Printf("[DEBUG] Updating connecion credentials at %q", path+"/config/connection")
 		data := map[string]interface{}{
 			"connection_uri":    d.Get("connection_uri").(string),
 			"username":          d.Get("username").(string),
 			"password":          d.Get("password").(string),
 			"verify_connection": d.Get("verify_connection").(bool),
+			"password_policy":   d.Get("password_policy").(string),
+			"username_template": d.Get("username_template").(string),
 		}
 		_, err := client.Logical().Write(path+"/config/connection", data)
 		if err != nil {
@@ -199,6 +217,8 @@ func rabbitmqSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error
 		d.SetPartial("username")
 		d.SetPartial("password")
 		d.SetPartial("verify_connection")
+		d.SetPartial("password_policy")
+		d.SetPartial("username_template")
 	}
 	d.Partial(false)
 	return rabbitmqSecretBackendRead(d, meta)
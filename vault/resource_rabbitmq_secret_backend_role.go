@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -69,6 +70,44 @@ func rabbitmqSecretBackendRoleResource() *schema.Resource {
 					},
 				},
 			},
+			"vhost_topic": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Specifies a map of virtual hosts and exchanges to topic permissions.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The vhost to set topic permissions for.",
+						},
+						"vhost": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Description: "The topic permissions for exchanges within this vhost.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"topic": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The exchange to set permissions for.",
+									},
+									"read": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The read permissions for this exchange.",
+									},
+									"write": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The write permissions for this exchange.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -107,9 +146,30 @@ func rabbitmqSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) er
 
 	log.Printf("[DEBUG] vhosts as JSON: %+v", vhostsJSON)
 
+	vhostTopic := d.Get("vhost_topic").([]interface{})
+	vhostTopics := make(map[string]interface{}, len(vhostTopic))
+	for _, host := range vhostTopic {
+		h := host.(map[string]interface{})
+		topics := map[string]interface{}{}
+		for _, topic := range h["vhost"].([]interface{}) {
+			t := topic.(map[string]interface{})
+			topics[t["topic"].(string)] = map[string]interface{}{
+				"read":  t["read"],
+				"write": t["write"],
+			}
+		}
+		vhostTopics[h["host"].(string)] = topics
+	}
+
+	vhostTopicsJSON, err := json.Marshal(vhostTopics)
+	if err != nil {
+		return fmt.Errorf("error serializing vhost_topics: %s", err)
+	}
+
 	data := map[string]interface{}{
-		"tags":   tags,
-		"vhosts": string(vhostsJSON),
+		"tags":         tags,
+		"vhosts":       string(vhostsJSON),
+		"vhost_topics": string(vhostTopicsJSON),
 	}
 	log.Printf("[DEBUG] Creating role %q on Rabbitmq backend %q", name, backend)
 	_, err = client.Logical().Write(backend+"/roles/"+name, data)
@@ -146,11 +206,28 @@ func rabbitmqSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) err
 		d.SetId("")
 		return nil
 	}
+	// Vault returns the vhosts and topics as maps, keep them in the order of
+	// the state and sort any others so the lists don't change on every read.
+	priorTopics := map[string][]string{}
+	var priorHostOrder []string
+	for _, v := range d.Get("vhost").([]interface{}) {
+		priorHostOrder = append(priorHostOrder, v.(map[string]interface{})["host"].(string))
+	}
+	var priorTopicHostOrder []string
+	for _, v := range d.Get("vhost_topic").([]interface{}) {
+		h := v.(map[string]interface{})
+		host := h["host"].(string)
+		priorTopicHostOrder = append(priorTopicHostOrder, host)
+		for _, t := range h["vhost"].([]interface{}) {
+			priorTopics[host] = append(priorTopics[host], t.(map[string]interface{})["topic"].(string))
+		}
+	}
+
 	var vhosts []map[string]interface{}
 	if v, ok := secret.Data["vhosts"]; ok && v != nil {
 		hosts := v.(map[string]interface{})
-		for id, val := range hosts {
-			vals := val.(map[string]interface{})
+		for _, id := range rabbitmqSecretBackendRoleOrderedKeys(hosts, priorHostOrder) {
+			vals := hosts[id].(map[string]interface{})
 			vhosts = append(vhosts, map[string]interface{}{
 				"host":      id,
 				"configure": vals["configure"],
@@ -159,15 +236,61 @@ func rabbitmqSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) err
 			})
 		}
 	}
+	var vhostTopics []map[string]interface{}
+	if v, ok := secret.Data["vhost_topics"]; ok && v != nil {
+		hosts := v.(map[string]interface{})
+		for _, id := range rabbitmqSecretBackendRoleOrderedKeys(hosts, priorTopicHostOrder) {
+			val := hosts[id].(map[string]interface{})
+			var topics []map[string]interface{}
+			for _, topic := range rabbitmqSecretBackendRoleOrderedKeys(val, priorTopics[id]) {
+				vals := val[topic].(map[string]interface{})
+				topics = append(topics, map[string]interface{}{
+					"topic": topic,
+					"write": vals["write"],
+					"read":  vals["read"],
+				})
+			}
+			vhostTopics = append(vhostTopics, map[string]interface{}{
+				"host":  id,
+				"vhost": topics,
+			})
+		}
+	}
 	d.Set("tags", secret.Data["tags"])
 	if err := d.Set("vhost", vhosts); err != nil {
 		return fmt.Errorf("Error setting vhosts in state: %s", err)
 	}
+	if err := d.Set("vhost_topic", vhostTopics); err != nil {
+		return fmt.Errorf("Error setting vhost_topics in state: %s", err)
+	}
 	d.Set("backend", strings.Join(pathPieces[:len(pathPieces)-2], "/"))
 	d.Set("name", pathPieces[len(pathPieces)-1])
 	return nil
 }
 
+// rabbitmqSecretBackendRoleOrderedKeys returns the keys of m in the order
+// they appear in prior, followed by the remaining keys sorted.
+func rabbitmqSecretBackendRoleOrderedKeys(m map[string]interface{}, prior []string) []string {
+	keys := make([]string, 0, len(m))
+	seen := map[string]bool{}
+	for _, k := range prior {
+		if _, ok := m[k]; ok && !seen[k] {
+			keys = append(keys, k)
+			seen[k] = true
+		}
+	}
+
+	var rest []string
+	for k := range m {
+		if !seen[k] {
+			rest = append(rest, k)
+		}
+	}
+	sort.Strings(rest)
+
+	return append(keys, rest...)
+}
+
 func rabbitmqSecretBackendRoleDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 
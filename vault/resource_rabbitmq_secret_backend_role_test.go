@@ -2,6 +2,7 @@ package vault
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
@@ -45,6 +46,10 @@ func TestAccRabbitmqSecretBackendRole_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "vhost.0.configure", ".*"),
 					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "vhost.0.read", ".*"),
 					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "vhost.0.write", ".*"),
+					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "vhost_topic.0.host", "/"),
+					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "vhost_topic.0.vhost.0.topic", "amq.topic"),
+					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "vhost_topic.0.vhost.0.read", ".*"),
+					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "vhost_topic.0.vhost.0.write", ".*"),
 				),
 			},
 		},
@@ -112,12 +117,35 @@ func TestAccRabbitmqSecretBackendRole_nested(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "vhost.0.configure", ".*"),
 					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "vhost.0.read", ".*"),
 					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "vhost.0.write", ".*"),
+					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "vhost_topic.0.host", "/"),
+					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "vhost_topic.0.vhost.0.topic", "amq.topic"),
+					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "vhost_topic.0.vhost.0.read", ".*"),
+					resource.TestCheckResourceAttr("vault_rabbitmq_secret_backend_role.test", "vhost_topic.0.vhost.0.write", ".*"),
 				),
 			},
 		},
 	})
 }
 
+func TestRabbitmqSecretBackendRoleOrderedKeys(t *testing.T) {
+	m := map[string]interface{}{"/": nil, "dev": nil, "prod": nil, "qa": nil}
+
+	tests := []struct {
+		prior    []string
+		expected []string
+	}{
+		{nil, []string{"/", "dev", "prod", "qa"}},
+		{[]string{"qa", "dev"}, []string{"qa", "dev", "/", "prod"}},
+		{[]string{"removed", "prod"}, []string{"prod", "/", "dev", "qa"}},
+	}
+
+	for i, test := range tests {
+		if actual := rabbitmqSecretBackendRoleOrderedKeys(m, test.prior); !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("test %d: expected %v, got %v", i, test.expected, actual)
+		}
+	}
+}
+
 func testAccRabbitmqSecretBackendRoleCheckDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -184,6 +212,14 @@ resource "vault_rabbitmq_secret_backend_role" "test" {
     read = ".*"
     write = ".*"
   }
+  vhost_topic {
+    host = "/"
+    vhost {
+      topic = "amq.topic"
+      read = ".*"
+      write = ".*"
+    }
+  }
 }
 `, path, connectionUri, username, password, name, testAccRabbitmqSecretBackendRoleTags_updated)
 }
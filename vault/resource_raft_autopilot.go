@@ -0,0 +1,159 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+const raftAutopilotPath = "sys/storage/raft/autopilot/configuration"
+
+// raftAutopilotDefaults are Vault's default autopilot settings, which are
+// restored when the resource is destroyed.
+var raftAutopilotDefaults = map[string]interface{}{
+	"cleanup_dead_servers":               false,
+	"last_contact_threshold":             "10s",
+	"dead_server_last_contact_threshold": "24h",
+	"max_trailing_logs":                  1000,
+	"min_quorum":                         3,
+	"server_stabilization_time":          "10s",
+	"disable_upgrade_migration":          false,
+}
+
+func raftAutopilotResource() *schema.Resource {
+	return &schema.Resource{
+		Create: raftAutopilotWrite,
+		Read:   raftAutopilotRead,
+		Update: raftAutopilotWrite,
+		Delete: raftAutopilotDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cleanup_dead_servers": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     raftAutopilotDefaults["cleanup_dead_servers"],
+				Description: "Remove dead servers from the Raft peer list periodically.",
+			},
+			"last_contact_threshold": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          raftAutopilotDefaults["last_contact_threshold"],
+				Description:      "Time after the last contact with a server before it is considered unhealthy.",
+				ValidateFunc:     validateDuration,
+				DiffSuppressFunc: raftAutopilotDurationDiffSuppress,
+			},
+			"dead_server_last_contact_threshold": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          raftAutopilotDefaults["dead_server_last_contact_threshold"],
+				Description:      "Time after the last contact with a server before it is considered dead and cleaned up.",
+				ValidateFunc:     validateDuration,
+				DiffSuppressFunc: raftAutopilotDurationDiffSuppress,
+			},
+			"max_trailing_logs": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     raftAutopilotDefaults["max_trailing_logs"],
+				Description: "Maximum number of log entries a server may trail the leader by before it is considered unhealthy.",
+			},
+			"min_quorum": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     raftAutopilotDefaults["min_quorum"],
+				Description: "Minimum number of servers before dead servers are cleaned up.",
+			},
+			"server_stabilization_time": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          raftAutopilotDefaults["server_stabilization_time"],
+				Description:      "Time a new server must be healthy before it is promoted to a voter.",
+				ValidateFunc:     validateDuration,
+				DiffSuppressFunc: raftAutopilotDurationDiffSuppress,
+			},
+			"disable_upgrade_migration": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     raftAutopilotDefaults["disable_upgrade_migration"],
+				Description: "Disable automated upgrade migrations. Requires Vault Enterprise.",
+			},
+		},
+	}
+}
+
+func raftAutopilotWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	data := map[string]interface{}{}
+	for k := range raftAutopilotDefaults {
+		data[k] = d.Get(k)
+	}
+
+	log.Printf("[DEBUG] Writing raft autopilot config")
+	if _, err := client.Logical().Write(raftAutopilotPath, data); err != nil {
+		return fmt.Errorf("error writing raft autopilot config: %s", err)
+	}
+	d.SetId(raftAutopilotPath)
+	log.Printf("[DEBUG] Wrote raft autopilot config")
+
+	return raftAutopilotRead(d, meta)
+}
+
+func raftAutopilotRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	log.Printf("[DEBUG] Reading raft autopilot config")
+	resp, err := client.Logical().Read(raftAutopilotPath)
+	if err != nil {
+		return fmt.Errorf("error reading raft autopilot config: %s", err)
+	}
+	log.Printf("[DEBUG] Read raft autopilot config")
+	if resp == nil {
+		log.Printf("[WARN] Raft autopilot config not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+
+	for k := range raftAutopilotDefaults {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting state key %q: %s", k, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func raftAutopilotDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	// The autopilot config can't be deleted, so Vault's defaults are
+	// restored instead.
+	log.Printf("[DEBUG] Resetting raft autopilot config")
+	if _, err := client.Logical().Write(raftAutopilotPath, raftAutopilotDefaults); err != nil {
+		return fmt.Errorf("error resetting raft autopilot config: %s", err)
+	}
+	log.Printf("[DEBUG] Reset raft autopilot config")
+
+	return nil
+}
+
+// raftAutopilotDurationDiffSuppress suppresses differences between equal
+// durations, as Vault returns them normalized, e.g. 24h0m0s for 24h.
+func raftAutopilotDurationDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	o, err := time.ParseDuration(old)
+	if err != nil {
+		return false
+	}
+	n, err := time.ParseDuration(new)
+	if err != nil {
+		return false
+	}
+	return o == n
+}
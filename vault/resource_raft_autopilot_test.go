@@ -0,0 +1,57 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestResourceRaftAutopilot(t *testing.T) {
+	resourceName := "vault_raft_autopilot.test"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t); testAccRaftPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceRaftAutopilot_config(true, "12h"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "cleanup_dead_servers", "true"),
+					resource.TestCheckResourceAttr(resourceName, "dead_server_last_contact_threshold", "12h"),
+					resource.TestCheckResourceAttr(resourceName, "last_contact_threshold", "10s"),
+					resource.TestCheckResourceAttr(resourceName, "max_trailing_logs", "1000"),
+					resource.TestCheckResourceAttr(resourceName, "min_quorum", "3"),
+				),
+			},
+			{
+				Config: testResourceRaftAutopilot_config(false, "48h"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "cleanup_dead_servers", "false"),
+					resource.TestCheckResourceAttr(resourceName, "dead_server_last_contact_threshold", "48h"),
+				),
+			},
+		},
+	})
+}
+
+// testAccRaftPreCheck skips tests of the raft endpoints unless Vault uses
+// integrated storage.
+func testAccRaftPreCheck(t *testing.T) {
+	status, err := testProvider.Meta().(*api.Client).Sys().SealStatus()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.StorageType != "raft" {
+		t.Skip("Vault isn't using integrated storage")
+	}
+}
+
+func testResourceRaftAutopilot_config(cleanup bool, deadServerThreshold string) string {
+	return fmt.Sprintf(`
+resource "vault_raft_autopilot" "test" {
+  cleanup_dead_servers               = %t
+  dead_server_last_contact_threshold = "%s"
+}
+`, cleanup, deadServerThreshold)
+}
@@ -0,0 +1,281 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+const raftSnapshotAgentConfigPath = "sys/storage/raft/snapshot-auto/config"
+
+func raftSnapshotAgentConfigResource() *schema.Resource {
+	return &schema.Resource{
+		Create: raftSnapshotAgentConfigWrite,
+		Read:   raftSnapshotAgentConfigRead,
+		Update: raftSnapshotAgentConfigWrite,
+		Delete: raftSnapshotAgentConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the snapshot agent configuration.",
+			},
+			"interval_seconds": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Number of seconds between snapshots.",
+			},
+			"retain": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1,
+				Description: "Number of snapshots to keep. Older snapshots are deleted.",
+			},
+			"path_prefix": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Directory or bucket prefix the snapshots are written to.",
+			},
+			"file_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "vault-snapshot",
+				Description: "Prefix of the snapshot file names.",
+			},
+			"storage_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Type of storage the snapshots are written to. Valid values are local, aws-s3, azure-blob and google-gcs.",
+				ValidateFunc: validation.StringInSlice([]string{"local", "aws-s3", "azure-blob", "google-gcs"}, false),
+			},
+			"local_max_space": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Maximum space in bytes the snapshots may use. Only used with local storage.",
+			},
+			"aws_s3_bucket": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "S3 bucket the snapshots are written to.",
+			},
+			"aws_s3_region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "AWS region of the bucket.",
+			},
+			"aws_access_key_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "AWS access key ID used to write to the bucket.",
+			},
+			"aws_secret_access_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "AWS secret access key used to write to the bucket.",
+			},
+			"aws_session_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "AWS session token used to write to the bucket.",
+			},
+			"aws_s3_endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Custom endpoint of the S3 API.",
+			},
+			"aws_s3_disable_tls": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Disable TLS for the S3 endpoint.",
+			},
+			"aws_s3_force_path_style": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Use path style rather than virtual hosted style S3 requests.",
+			},
+			"aws_s3_enable_kms": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Encrypt the snapshots with KMS.",
+			},
+			"aws_s3_kms_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "KMS key used to encrypt the snapshots.",
+			},
+			"aws_s3_server_side_encryption": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Encrypt the snapshots with S3 managed keys.",
+			},
+			"azure_container_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Azure container the snapshots are written to.",
+			},
+			"azure_account_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Azure storage account name.",
+			},
+			"azure_account_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Azure storage account key.",
+			},
+			"azure_blob_environment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Azure blob storage environment.",
+			},
+			"azure_endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Custom endpoint of the Azure blob storage API.",
+			},
+			"google_gcs_bucket": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "GCS bucket the snapshots are written to.",
+			},
+			"google_service_account_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "JSON service account key used to write to the bucket.",
+			},
+			"google_endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Custom endpoint of the GCS API.",
+			},
+			"google_disable_tls": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Disable TLS for the GCS endpoint.",
+			},
+		},
+	}
+}
+
+func raftSnapshotAgentConfigWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Get("name").(string)
+	storageType := d.Get("storage_type").(string)
+
+	data := map[string]interface{}{
+		"interval":     d.Get("interval_seconds"),
+		"retain":       d.Get("retain"),
+		"path_prefix":  d.Get("path_prefix"),
+		"file_prefix":  d.Get("file_prefix"),
+		"storage_type": storageType,
+	}
+	// Only the fields of the selected storage type are sent.
+	for _, k := range raftSnapshotAgentStorageFields(storageType) {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Writing raft snapshot agent config %q", name)
+	if _, err := client.Logical().Write(raftSnapshotAgentConfigPath+"/"+name, data); err != nil {
+		return fmt.Errorf("error writing raft snapshot agent config %q: %s", name, err)
+	}
+	d.SetId(name)
+	log.Printf("[DEBUG] Wrote raft snapshot agent config %q", name)
+
+	return raftSnapshotAgentConfigRead(d, meta)
+}
+
+func raftSnapshotAgentConfigRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Id()
+
+	log.Printf("[DEBUG] Reading raft snapshot agent config %q", name)
+	resp, err := client.Logical().Read(raftSnapshotAgentConfigPath + "/" + name)
+	if err != nil {
+		return fmt.Errorf("error reading raft snapshot agent config %q: %s", name, err)
+	}
+	log.Printf("[DEBUG] Read raft snapshot agent config %q", name)
+	if resp == nil {
+		log.Printf("[WARN] Raft snapshot agent config %q not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	d.Set("interval_seconds", resp.Data["interval"])
+
+	storageType, _ := resp.Data["storage_type"].(string)
+	fields := append([]string{"retain", "path_prefix", "file_prefix", "storage_type"}, raftSnapshotAgentStorageFields(storageType)...)
+	s := raftSnapshotAgentConfigResource().Schema
+	for _, k := range fields {
+		// credentials aren't returned by Vault
+		if s[k].Sensitive {
+			continue
+		}
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting state key %q: %s", k, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func raftSnapshotAgentConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Id()
+
+	log.Printf("[DEBUG] Deleting raft snapshot agent config %q", name)
+	if _, err := client.Logical().Delete(raftSnapshotAgentConfigPath + "/" + name); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error deleting raft snapshot agent config %q: %s", name, err)
+	}
+	log.Printf("[DEBUG] Deleted raft snapshot agent config %q", name)
+
+	return nil
+}
+
+// raftSnapshotAgentStorageFields returns the fields specific to the given
+// storage type, which share a prefix in the schema.
+func raftSnapshotAgentStorageFields(storageType string) []string {
+	var prefix string
+	switch storageType {
+	case "local":
+		prefix = "local_"
+	case "aws-s3":
+		prefix = "aws_"
+	case "azure-blob":
+		prefix = "azure_"
+	case "google-gcs":
+		prefix = "google_"
+	default:
+		return nil
+	}
+
+	var fields []string
+	for k := range raftSnapshotAgentConfigResource().Schema {
+		if strings.HasPrefix(k, prefix) {
+			fields = append(fields, k)
+		}
+	}
+	return fields
+}
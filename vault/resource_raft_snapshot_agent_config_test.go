@@ -0,0 +1,83 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestResourceRaftSnapshotAgentConfig(t *testing.T) {
+	isEnterprise := os.Getenv("TF_ACC_ENTERPRISE")
+	if isEnterprise == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+
+	name := acctest.RandomWithPrefix("tf-test-snapshot")
+	resourceName := "vault_raft_snapshot_agent_config.test"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t); testAccRaftPreCheck(t) },
+		CheckDestroy: testResourceRaftSnapshotAgentConfig_destroyCheck,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceRaftSnapshotAgentConfig_config(name, 3600, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "interval_seconds", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "retain", "1"),
+					resource.TestCheckResourceAttr(resourceName, "storage_type", "local"),
+					resource.TestCheckResourceAttr(resourceName, "path_prefix", "/tmp"),
+					resource.TestCheckResourceAttr(resourceName, "local_max_space", "1048576"),
+				),
+			},
+			{
+				Config: testResourceRaftSnapshotAgentConfig_config(name, 7200, 5),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "interval_seconds", "7200"),
+					resource.TestCheckResourceAttr(resourceName, "retain", "5"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testResourceRaftSnapshotAgentConfig_destroyCheck(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_raft_snapshot_agent_config" {
+			continue
+		}
+		resp, err := client.Logical().Read(raftSnapshotAgentConfigPath + "/" + rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error checking for raft snapshot agent config %q: %s", rs.Primary.ID, err)
+		}
+		if resp != nil {
+			return fmt.Errorf("raft snapshot agent config %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testResourceRaftSnapshotAgentConfig_config(name string, interval, retain int) string {
+	return fmt.Sprintf(`
+resource "vault_raft_snapshot_agent_config" "test" {
+  name             = "%s"
+  interval_seconds = %d
+  retain           = %d
+  storage_type     = "local"
+  path_prefix      = "/tmp"
+  local_max_space  = 1048576
+}
+`, name, interval, retain)
+}
@@ -0,0 +1,127 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+func rawResource() *schema.Resource {
+	return &schema.Resource{
+		Create: rawWrite,
+		Read:   rawRead,
+		Update: rawWrite,
+		Delete: rawDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Storage key of the entry, relative to sys/raw.",
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"value": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Value of the storage entry.",
+			},
+			"disable_delete": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Don't delete the storage entry from Vault when the resource is destroyed.",
+			},
+		},
+	}
+}
+
+func rawWrite(d *schema.ResourceData, meta interface{}) error {
+	if err := rawEndpointCheck(meta); err != nil {
+		return err
+	}
+
+	client := meta.(*api.Client)
+
+	path := strings.Trim(d.Get("path").(string), "/")
+	rawPath := rawEndpointPath(path)
+
+	log.Printf("[DEBUG] Writing raw storage entry %q", path)
+	if _, err := client.Logical().Write(rawPath, map[string]interface{}{
+		"value": d.Get("value").(string),
+	}); err != nil {
+		return fmt.Errorf("error writing raw storage entry %q: %s", path, err)
+	}
+	d.SetId(path)
+	log.Printf("[DEBUG] Wrote raw storage entry %q", path)
+
+	return rawRead(d, meta)
+}
+
+func rawRead(d *schema.ResourceData, meta interface{}) error {
+	if err := rawEndpointCheck(meta); err != nil {
+		return err
+	}
+
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading raw storage entry %q", path)
+	resp, err := client.Logical().Read(rawEndpointPath(path))
+	if err != nil {
+		return fmt.Errorf("error reading raw storage entry %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read raw storage entry %q", path)
+	if resp == nil {
+		log.Printf("[WARN] Raw storage entry %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("path", path)
+	d.Set("value", resp.Data["value"])
+
+	return nil
+}
+
+func rawDelete(d *schema.ResourceData, meta interface{}) error {
+	if d.Get("disable_delete").(bool) {
+		return nil
+	}
+
+	if err := rawEndpointCheck(meta); err != nil {
+		return err
+	}
+
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting raw storage entry %q", path)
+	if _, err := client.Logical().Delete(rawEndpointPath(path)); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error deleting raw storage entry %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted raw storage entry %q", path)
+
+	return nil
+}
+
+func rawEndpointCheck(meta interface{}) error {
+	if !getProviderOptions(meta).rawEndpointEnabled {
+		return fmt.Errorf("the sys/raw endpoint is disabled; set enable_raw_endpoint in the provider configuration to use vault_raw")
+	}
+	return nil
+}
+
+func rawEndpointPath(path string) string {
+	return "sys/raw/" + strings.Trim(path, "/")
+}
@@ -0,0 +1,99 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestResourceRaw(t *testing.T) {
+	if os.Getenv("VAULT_RAW_STORAGE_ENDPOINT") == "" {
+		t.Skip("VAULT_RAW_STORAGE_ENDPOINT is not set, test requires raw_storage_endpoint to be enabled on the Vault server")
+	}
+
+	path := acctest.RandomWithPrefix("tf-test-raw")
+	resourceName := "vault_raw.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testResourceRaw_destroyCheck,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceRaw_config(path, "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "path", path),
+					resource.TestCheckResourceAttr(resourceName, "value", "foo"),
+				),
+			},
+			{
+				Config: testResourceRaw_config(path, "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "path", path),
+					resource.TestCheckResourceAttr(resourceName, "value", "bar"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"disable_delete"},
+			},
+		},
+	})
+}
+
+func testResourceRaw_destroyCheck(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_raw" {
+			continue
+		}
+		resp, err := client.Logical().Read(rawEndpointPath(rs.Primary.ID))
+		if err != nil {
+			return fmt.Errorf("error checking for raw storage entry %q: %s", rs.Primary.ID, err)
+		}
+		if resp != nil {
+			return fmt.Errorf("raw storage entry %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func TestRawEndpointCheck(t *testing.T) {
+	// Each provider alias has its own client, whose options are independent.
+	enabled, disabled := &api.Client{}, &api.Client{}
+	providerOptionsByClient.Store(enabled, providerOptions{rawEndpointEnabled: true})
+	providerOptionsByClient.Store(disabled, providerOptions{})
+	defer providerOptionsByClient.Delete(enabled)
+	defer providerOptionsByClient.Delete(disabled)
+
+	if err := rawEndpointCheck(enabled); err != nil {
+		t.Errorf("expected the raw endpoint to be enabled, got %s", err)
+	}
+	if err := rawEndpointCheck(disabled); err == nil {
+		t.Error("expected the raw endpoint to be disabled")
+	}
+	if err := rawEndpointCheck(&api.Client{}); err == nil {
+		t.Error("expected the raw endpoint to be disabled for an unconfigured provider")
+	}
+}
+
+func testResourceRaw_config(path, value string) string {
+	return fmt.Sprintf(`
+provider "vault" {
+  enable_raw_endpoint = true
+}
+
+resource "vault_raw" "test" {
+  path  = "%s"
+  value = "%s"
+}
+`, path, value)
+}
@@ -0,0 +1,107 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+func replicationPathsFilterResource() *schema.Resource {
+	return &schema.Resource{
+		Create: replicationPathsFilterWrite,
+		Read:   replicationPathsFilterRead,
+		Update: replicationPathsFilterWrite,
+		Delete: replicationPathsFilterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"secondary_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Identifier of the performance secondary the filter applies to.",
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Whether the paths are the only ones replicated (allow) or the ones excluded (deny).",
+				ValidateFunc: validation.StringInSlice([]string{"allow", "deny"}, false),
+			},
+			"paths": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Mount paths and namespaces to filter.",
+			},
+		},
+	}
+}
+
+func replicationPathsFilterPath(id string) string {
+	return replicationPath("performance", "primary/paths-filter/"+id)
+}
+
+func replicationPathsFilterWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	id := d.Get("secondary_id").(string)
+
+	log.Printf("[DEBUG] Writing replication paths filter for %q", id)
+	if _, err := client.Logical().Write(replicationPathsFilterPath(id), map[string]interface{}{
+		"mode":  d.Get("mode"),
+		"paths": d.Get("paths").(*schema.Set).List(),
+	}); err != nil {
+		return fmt.Errorf("error writing replication paths filter for %q: %s", id, err)
+	}
+	d.SetId(id)
+	log.Printf("[DEBUG] Wrote replication paths filter for %q", id)
+
+	return replicationPathsFilterRead(d, meta)
+}
+
+func replicationPathsFilterRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	id := d.Id()
+
+	log.Printf("[DEBUG] Reading replication paths filter for %q", id)
+	resp, err := client.Logical().Read(replicationPathsFilterPath(id))
+	if err != nil {
+		return fmt.Errorf("error reading replication paths filter for %q: %s", id, err)
+	}
+	log.Printf("[DEBUG] Read replication paths filter for %q", id)
+	if resp == nil {
+		log.Printf("[WARN] Replication paths filter for %q not found, removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("secondary_id", id)
+	for _, k := range []string{"mode", "paths"} {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return fmt.Errorf("error setting state key %q: %s", k, err)
+		}
+	}
+
+	return nil
+}
+
+func replicationPathsFilterDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	id := d.Id()
+
+	log.Printf("[DEBUG] Deleting replication paths filter for %q", id)
+	if _, err := client.Logical().Delete(replicationPathsFilterPath(id)); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error deleting replication paths filter for %q: %s", id, err)
+	}
+	log.Printf("[DEBUG] Deleted replication paths filter for %q", id)
+
+	return nil
+}
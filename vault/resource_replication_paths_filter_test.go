@@ -0,0 +1,71 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestResourceReplicationPathsFilter(t *testing.T) {
+	isEnterprise := os.Getenv("TF_ACC_ENTERPRISE")
+	if isEnterprise == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+
+	id := acctest.RandomWithPrefix("secondary")
+	backend := acctest.RandomWithPrefix("kv")
+	resourceName := "vault_replication_paths_filter.test"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testReplicationPrimaryDestroyCheck("performance"),
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceReplicationPathsFilter_config(id, backend, "deny"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "secondary_id", id),
+					resource.TestCheckResourceAttr(resourceName, "mode", "deny"),
+					resource.TestCheckResourceAttr(resourceName, "paths.#", "1"),
+				),
+			},
+			{
+				Config: testResourceReplicationPathsFilter_config(id, backend, "allow"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "mode", "allow"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testResourceReplicationPathsFilter_config(id, backend, mode string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "kv"
+}
+
+resource "vault_replication_primary" "test" {
+  mode = "performance"
+}
+
+resource "vault_replication_secondary_token" "test" {
+  mode         = vault_replication_primary.test.mode
+  secondary_id = "%s"
+}
+
+resource "vault_replication_paths_filter" "test" {
+  secondary_id = vault_replication_secondary_token.test.secondary_id
+  mode         = "%s"
+  paths        = [vault_mount.test.path]
+}
+`, backend, id, mode)
+}
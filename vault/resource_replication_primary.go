@@ -0,0 +1,93 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func replicationPrimaryResource() *schema.Resource {
+	return &schema.Resource{
+		Create: replicationPrimaryCreate,
+		Read:   replicationPrimaryRead,
+		Delete: replicationPrimaryDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"mode": replicationModeSchema(),
+			"primary_cluster_addr": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Cluster address secondaries use to connect to the primary. Defaults to the cluster_addr of the Vault server.",
+			},
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the replication cluster.",
+			},
+		},
+	}
+}
+
+func replicationPrimaryCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	mode := d.Get("mode").(string)
+
+	data := map[string]interface{}{}
+	if v, ok := d.GetOk("primary_cluster_addr"); ok {
+		data["primary_cluster_addr"] = v
+	}
+
+	log.Printf("[DEBUG] Enabling %s replication primary", mode)
+	if _, err := client.Logical().Write(replicationPath(mode, "primary/enable"), data); err != nil {
+		return fmt.Errorf("error enabling %s replication primary: %s", mode, err)
+	}
+	d.SetId(mode)
+	log.Printf("[DEBUG] Enabled %s replication primary", mode)
+
+	return replicationPrimaryRead(d, meta)
+}
+
+func replicationPrimaryRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	mode := d.Id()
+
+	status, err := replicationStatus(client, mode)
+	if err != nil {
+		return err
+	}
+	if status["mode"] != "primary" {
+		log.Printf("[WARN] Cluster isn't a %s replication primary, removing from state", mode)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("mode", mode)
+	d.Set("cluster_id", status["cluster_id"])
+	if v, ok := status["primary_cluster_addr"]; ok && v != "" {
+		d.Set("primary_cluster_addr", v)
+	}
+
+	return nil
+}
+
+func replicationPrimaryDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	mode := d.Id()
+
+	log.Printf("[DEBUG] Disabling %s replication primary", mode)
+	if _, err := client.Logical().Write(replicationPath(mode, "primary/disable"), nil); err != nil {
+		return fmt.Errorf("error disabling %s replication primary: %s", mode, err)
+	}
+	log.Printf("[DEBUG] Disabled %s replication primary", mode)
+
+	return nil
+}
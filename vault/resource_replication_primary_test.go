@@ -0,0 +1,63 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestResourceReplicationPrimary(t *testing.T) {
+	isEnterprise := os.Getenv("TF_ACC_ENTERPRISE")
+	if isEnterprise == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+
+	resourceName := "vault_replication_primary.test"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testReplicationPrimaryDestroyCheck("dr"),
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceReplicationPrimary_config("dr"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "mode", "dr"),
+					resource.TestCheckResourceAttrSet(resourceName, "cluster_id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"primary_cluster_addr"},
+			},
+		},
+	})
+}
+
+func testReplicationPrimaryDestroyCheck(mode string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testProvider.Meta().(*api.Client)
+
+		status, err := replicationStatus(client, mode)
+		if err != nil {
+			return err
+		}
+		if status["mode"] == "primary" {
+			return fmt.Errorf("%s replication primary is still enabled", mode)
+		}
+		return nil
+	}
+}
+
+func testResourceReplicationPrimary_config(mode string) string {
+	return fmt.Sprintf(`
+resource "vault_replication_primary" "test" {
+  mode = "%s"
+}
+`, mode)
+}
@@ -0,0 +1,121 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func replicationSecondaryResource() *schema.Resource {
+	return &schema.Resource{
+		Create: replicationSecondaryCreate,
+		Read:   replicationSecondaryRead,
+		Delete: replicationSecondaryDelete,
+
+		Schema: map[string]*schema.Schema{
+			"mode": replicationModeSchema(),
+			"token": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "Activation token generated by the primary.",
+			},
+			"primary_api_addr": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "API address of the primary. Defaults to the address embedded in the activation token.",
+			},
+			"ca_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Path on the secondary to a CA certificate used to verify the primary's API address.",
+			},
+			"ca_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Path on the secondary to a directory of CA certificates used to verify the primary's API address.",
+			},
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the replication cluster.",
+			},
+		},
+	}
+}
+
+func replicationSecondaryCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	mode := d.Get("mode").(string)
+
+	data := map[string]interface{}{
+		"token": d.Get("token"),
+	}
+	for _, k := range []string{"primary_api_addr", "ca_file", "ca_path"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Enabling %s replication secondary", mode)
+	if _, err := client.Logical().Write(replicationPath(mode, "secondary/enable"), data); err != nil {
+		return fmt.Errorf("error enabling %s replication secondary: %s", mode, err)
+	}
+	d.SetId(mode)
+	log.Printf("[DEBUG] Enabled %s replication secondary", mode)
+
+	// Enabling a secondary wipes its storage, including the token the provider
+	// is using, so state is set from the request and a failure to read the
+	// status must not taint the now enabled secondary.
+	d.Set("mode", mode)
+	status, err := replicationStatusUnauthenticated(client, mode)
+	if err != nil {
+		log.Printf("[WARN] Unable to read the status of the %s replication secondary: %s", mode, err)
+		return nil
+	}
+	d.Set("cluster_id", status["cluster_id"])
+
+	return nil
+}
+
+func replicationSecondaryRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	mode := d.Id()
+
+	status, err := replicationStatus(client, mode)
+	if err != nil {
+		return err
+	}
+	if status["mode"] != "secondary" {
+		log.Printf("[WARN] Cluster isn't a %s replication secondary, removing from state", mode)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("mode", mode)
+	d.Set("cluster_id", status["cluster_id"])
+
+	return nil
+}
+
+func replicationSecondaryDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	mode := d.Id()
+
+	log.Printf("[DEBUG] Disabling %s replication secondary", mode)
+	if _, err := client.Logical().Write(replicationPath(mode, "secondary/disable"), nil); err != nil {
+		return fmt.Errorf("error disabling %s replication secondary: %s", mode, err)
+	}
+	log.Printf("[DEBUG] Disabled %s replication secondary", mode)
+
+	return nil
+}
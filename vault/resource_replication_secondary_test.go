@@ -0,0 +1,47 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestResourceReplicationSecondary(t *testing.T) {
+	isEnterprise := os.Getenv("TF_ACC_ENTERPRISE")
+	if isEnterprise == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+
+	// Activating a DR secondary requires a token generated by a separate
+	// primary cluster.
+	token := os.Getenv("VAULT_DR_SECONDARY_TOKEN")
+	if token == "" {
+		t.Skip("VAULT_DR_SECONDARY_TOKEN not set")
+	}
+
+	resourceName := "vault_replication_secondary.test"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceReplicationSecondary_config(token),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "mode", "dr"),
+					resource.TestCheckResourceAttrSet(resourceName, "cluster_id"),
+				),
+			},
+		},
+	})
+}
+
+func testResourceReplicationSecondary_config(token string) string {
+	return fmt.Sprintf(`
+resource "vault_replication_secondary" "test" {
+  mode  = "dr"
+  token = "%s"
+}
+`, token)
+}
@@ -0,0 +1,133 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func replicationSecondaryTokenResource() *schema.Resource {
+	return &schema.Resource{
+		Create: replicationSecondaryTokenCreate,
+		Read:   replicationSecondaryTokenRead,
+		Delete: replicationSecondaryTokenDelete,
+
+		Schema: map[string]*schema.Schema{
+			"mode": replicationModeSchema(),
+			"secondary_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Identifier of the secondary, unique to the primary.",
+			},
+			"ttl": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "30m",
+				Description: "TTL of the response wrapped activation token.",
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Response wrapped activation token for the secondary.",
+			},
+			"accessor": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Accessor of the activation token.",
+			},
+		},
+	}
+}
+
+func replicationSecondaryTokenCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	mode := d.Get("mode").(string)
+	id := d.Get("secondary_id").(string)
+
+	log.Printf("[DEBUG] Generating %s replication secondary token for %q", mode, id)
+	resp, err := client.Logical().Write(replicationPath(mode, "primary/secondary-token"), map[string]interface{}{
+		"id":  id,
+		"ttl": d.Get("ttl"),
+	})
+	if err != nil {
+		return fmt.Errorf("error generating %s replication secondary token for %q: %s", mode, id, err)
+	}
+	log.Printf("[DEBUG] Generated %s replication secondary token for %q", mode, id)
+
+	if resp == nil || resp.WrapInfo == nil {
+		return fmt.Errorf("no %s replication secondary token returned for %q", mode, id)
+	}
+
+	d.SetId(mode + "/" + id)
+	d.Set("token", resp.WrapInfo.Token)
+	d.Set("accessor", resp.WrapInfo.Accessor)
+
+	return replicationSecondaryTokenRead(d, meta)
+}
+
+func replicationSecondaryTokenRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	mode, id, err := replicationSecondaryTokenParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	status, err := replicationStatus(client, mode)
+	if err != nil {
+		return err
+	}
+
+	known := false
+	secondaries, _ := status["known_secondaries"].([]interface{})
+	for _, v := range secondaries {
+		if v == id {
+			known = true
+			break
+		}
+	}
+	if !known {
+		log.Printf("[WARN] Secondary %q not known to the %s replication primary, removing from state", id, mode)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("mode", mode)
+	d.Set("secondary_id", id)
+
+	return nil
+}
+
+func replicationSecondaryTokenDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	mode, id, err := replicationSecondaryTokenParseID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Revoking %s replication secondary %q", mode, id)
+	if _, err := client.Logical().Write(replicationPath(mode, "primary/revoke-secondary"), map[string]interface{}{
+		"id": id,
+	}); err != nil {
+		return fmt.Errorf("error revoking %s replication secondary %q: %s", mode, id, err)
+	}
+	log.Printf("[DEBUG] Revoked %s replication secondary %q", mode, id)
+
+	return nil
+}
+
+func replicationSecondaryTokenParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("expected ID in the form <mode>/<secondary_id>, got %q", id)
+	}
+	return parts[0], parts[1], nil
+}
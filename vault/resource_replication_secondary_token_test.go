@@ -0,0 +1,50 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestResourceReplicationSecondaryToken(t *testing.T) {
+	isEnterprise := os.Getenv("TF_ACC_ENTERPRISE")
+	if isEnterprise == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+
+	id := acctest.RandomWithPrefix("secondary")
+	resourceName := "vault_replication_secondary_token.test"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testReplicationPrimaryDestroyCheck("performance"),
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceReplicationSecondaryToken_config(id),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "mode", "performance"),
+					resource.TestCheckResourceAttr(resourceName, "secondary_id", id),
+					resource.TestCheckResourceAttrSet(resourceName, "token"),
+					resource.TestCheckResourceAttrSet(resourceName, "accessor"),
+				),
+			},
+		},
+	})
+}
+
+func testResourceReplicationSecondaryToken_config(id string) string {
+	return fmt.Sprintf(`
+resource "vault_replication_primary" "test" {
+  mode = "performance"
+}
+
+resource "vault_replication_secondary_token" "test" {
+  mode         = vault_replication_primary.test.mode
+  secondary_id = "%s"
+  ttl          = "10m"
+}
+`, id)
+}
@@ -13,7 +13,7 @@ func rgpPolicyResource() *schema.Resource {
 		Delete: rgpPolicyDelete,
 		Read:   rgpPolicyRead,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: sentinelPolicyImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -24,6 +24,8 @@ func rgpPolicyResource() *schema.Resource {
 				Description: "Name of the policy",
 			},
 
+			"namespace": sentinelPolicyNamespaceSchema(),
+
 			"enforcement_level": {
 				Type:         schema.TypeString,
 				Required:     true,
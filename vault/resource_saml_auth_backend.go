@@ -0,0 +1,208 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+const samlAuthType = "saml"
+
+var samlAuthBackendConfigFields = []string{
+	"idp_metadata_url",
+	"idp_sso_url",
+	"idp_entity_id",
+	"idp_cert",
+	"entity_id",
+	"acs_urls",
+	"default_role",
+	"verbose_logging",
+}
+
+func samlAuthBackendResource() *schema.Resource {
+	return &schema.Resource{
+		Create: samlAuthBackendCreate,
+		Read:   samlAuthBackendRead,
+		Update: samlAuthBackendUpdate,
+		Delete: samlAuthBackendDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      samlAuthType,
+				Description:  "Path to mount the SAML auth backend.",
+				ValidateFunc: validateMountPath,
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Description of the auth backend.",
+			},
+			"idp_metadata_url": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "The metadata URL of the identity provider.",
+				ConflictsWith: []string{"idp_sso_url", "idp_entity_id", "idp_cert"},
+			},
+			"idp_sso_url": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "The SSO URL of the identity provider. Mutually exclusive with idp_metadata_url.",
+				ConflictsWith: []string{"idp_metadata_url"},
+			},
+			"idp_entity_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "The entity ID of the identity provider. Mutually exclusive with idp_metadata_url.",
+				ConflictsWith: []string{"idp_metadata_url"},
+			},
+			"idp_cert": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "The PEM encoded certificate of the identity provider. Mutually exclusive with idp_metadata_url.",
+				ConflictsWith: []string{"idp_metadata_url"},
+			},
+			"entity_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The entity ID of the SAML authentication service provider.",
+			},
+			"acs_urls": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "The well-formatted URLs of the assertion consumer service (ACS) that should receive a response from the identity provider.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"default_role": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The role to use if no role is provided during login.",
+			},
+			"verbose_logging": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Log additional, potentially sensitive, information during the SAML exchange. Not recommended in production.",
+			},
+			"accessor": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The accessor of the SAML auth backend.",
+			},
+		},
+	}
+}
+
+func samlAuthBackendCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Get("path").(string)
+
+	log.Printf("[DEBUG] Enabling SAML auth backend %q", path)
+	err := client.Sys().EnableAuthWithOptions(path, &api.EnableAuthOptions{
+		Type:        samlAuthType,
+		Description: d.Get("description").(string),
+	})
+	if err != nil {
+		return fmt.Errorf("error enabling SAML auth backend %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Enabled SAML auth backend %q", path)
+
+	d.SetId(path)
+
+	return samlAuthBackendUpdate(d, meta)
+}
+
+func samlAuthBackendUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := samlAuthBackendConfigPath(d.Id())
+
+	data := map[string]interface{}{}
+	for _, k := range samlAuthBackendConfigFields {
+		if v, ok := d.GetOkExists(k); ok || d.HasChange(k) {
+			data[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Writing SAML auth backend config %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing SAML auth backend config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote SAML auth backend config %q", path)
+
+	return samlAuthBackendRead(d, meta)
+}
+
+func samlAuthBackendRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	auths, err := client.Sys().ListAuth()
+	if err != nil {
+		return fmt.Errorf("error reading from auth mounts: %s", err)
+	}
+
+	mount := auths[path+"/"]
+	if mount == nil {
+		log.Printf("[WARN] SAML auth backend %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	configPath := samlAuthBackendConfigPath(path)
+	log.Printf("[DEBUG] Reading SAML auth backend config %q", configPath)
+	resp, err := client.Logical().Read(configPath)
+	if err != nil {
+		return fmt.Errorf("error reading SAML auth backend config %q: %s", configPath, err)
+	}
+	log.Printf("[DEBUG] Read SAML auth backend config %q", configPath)
+
+	if resp == nil {
+		log.Printf("[WARN] SAML auth backend config %q not found, removing from state", configPath)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("path", path)
+	d.Set("description", mount.Description)
+	d.Set("accessor", mount.Accessor)
+	for _, k := range samlAuthBackendConfigFields {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return fmt.Errorf("error setting state key %q: %s", k, err)
+		}
+	}
+
+	return nil
+}
+
+func samlAuthBackendDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	log.Printf("[DEBUG] Disabling SAML auth backend %q", path)
+	if err := client.Sys().DisableAuth(path); err != nil {
+		return fmt.Errorf("error disabling SAML auth backend %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Disabled SAML auth backend %q", path)
+
+	return nil
+}
+
+func samlAuthBackendConfigPath(path string) string {
+	return "auth/" + strings.Trim(path, "/") + "/config"
+}
@@ -0,0 +1,173 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	samlAuthBackendRoleBackendFromPathRegex = regexp.MustCompile("^auth/(.+)/role/.+$")
+	samlAuthBackendRoleNameFromPathRegex    = regexp.MustCompile("^auth/.+/role/(.+)$")
+
+	samlAuthBackendRoleFields = []string{
+		"bound_subjects",
+		"bound_subjects_type",
+		"bound_attributes",
+		"bound_attributes_type",
+		"groups_attribute",
+	}
+)
+
+func samlAuthBackendRoleResource() *schema.Resource {
+	fields := map[string]*schema.Schema{
+		"path": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Path where the SAML auth backend is mounted.",
+			StateFunc: func(v interface{}) string {
+				return strings.Trim(v.(string), "/")
+			},
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Name of the role.",
+		},
+		"bound_subjects": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "List of subjects being asserted for SAML authentication.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"bound_subjects_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "How to interpret the bound_subjects, either \"string\" or \"glob\".",
+			ValidateFunc: validation.StringInSlice([]string{"string", "glob"}, false),
+		},
+		"bound_attributes": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Description: "Mapping of attribute names to values that are expected to exist in the SAML assertion.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"bound_attributes_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "How to interpret the bound_attributes values, either \"string\" or \"glob\".",
+			ValidateFunc: validation.StringInSlice([]string{"string", "glob"}, false),
+		},
+		"groups_attribute": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The attribute of the SAML assertion to use as the names of the identity group aliases created for the user.",
+		},
+	}
+
+	addTokenFields(fields, &addTokenFieldsConfig{})
+
+	return &schema.Resource{
+		Create: samlAuthBackendRoleWrite,
+		Read:   samlAuthBackendRoleRead,
+		Update: samlAuthBackendRoleWrite,
+		Delete: samlAuthBackendRoleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: fields,
+	}
+}
+
+func samlAuthBackendRoleWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := samlAuthBackendRolePath(d.Get("path").(string), d.Get("name").(string))
+
+	data := map[string]interface{}{}
+	updateTokenFields(d, data, d.IsNewResource())
+	for _, k := range samlAuthBackendRoleFields {
+		if v, ok := d.GetOk(k); ok || d.HasChange(k) {
+			data[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Writing SAML auth backend role %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing SAML auth backend role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote SAML auth backend role %q", path)
+
+	d.SetId(path)
+
+	return samlAuthBackendRoleRead(d, meta)
+}
+
+func samlAuthBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	backend := samlAuthBackendRoleBackendFromPathRegex.FindStringSubmatch(path)
+	name := samlAuthBackendRoleNameFromPathRegex.FindStringSubmatch(path)
+	if len(backend) != 2 || len(name) != 2 {
+		return fmt.Errorf("invalid path %q for SAML auth backend role", path)
+	}
+
+	log.Printf("[DEBUG] Reading SAML auth backend role %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading SAML auth backend role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read SAML auth backend role %q", path)
+
+	if resp == nil {
+		log.Printf("[WARN] SAML auth backend role %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("path", backend[1])
+	d.Set("name", name[1])
+	if err := readTokenFields(d, resp); err != nil {
+		return err
+	}
+	for _, k := range samlAuthBackendRoleFields {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return fmt.Errorf("error setting state key %q: %s", k, err)
+		}
+	}
+
+	return nil
+}
+
+func samlAuthBackendRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting SAML auth backend role %q", path)
+	if _, err := client.Logical().Delete(path); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error deleting SAML auth backend role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted SAML auth backend role %q", path)
+
+	return nil
+}
+
+func samlAuthBackendRolePath(backend, name string) string {
+	return "auth/" + strings.Trim(backend, "/") + "/role/" + strings.Trim(name, "/")
+}
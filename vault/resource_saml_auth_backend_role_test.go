@@ -0,0 +1,98 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccSAMLAuthBackendRole_basic(t *testing.T) {
+	isEnterprise := os.Getenv("TF_ACC_ENTERPRISE")
+	if isEnterprise == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+
+	path := acctest.RandomWithPrefix("saml")
+	name := acctest.RandomWithPrefix("role")
+	resName := "vault_saml_auth_backend_role.test"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccSAMLAuthBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSAMLAuthBackendRoleConfig(path, name, "string", "group"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "path", path),
+					resource.TestCheckResourceAttr(resName, "name", name),
+					resource.TestCheckResourceAttr(resName, "bound_subjects.#", "1"),
+					resource.TestCheckResourceAttr(resName, "bound_subjects_type", "string"),
+					resource.TestCheckResourceAttr(resName, "bound_attributes.group", "admins"),
+					resource.TestCheckResourceAttr(resName, "groups_attribute", "group"),
+					resource.TestCheckResourceAttr(resName, "token_policies.#", "1"),
+				),
+			},
+			{
+				Config: testAccSAMLAuthBackendRoleConfig(path, name, "glob", "groups"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "bound_subjects_type", "glob"),
+					resource.TestCheckResourceAttr(resName, "groups_attribute", "groups"),
+				),
+			},
+			{
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSAMLAuthBackendRoleCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_saml_auth_backend_role" {
+			continue
+		}
+		resp, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if resp != nil {
+			return fmt.Errorf("SAML auth backend role %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccSAMLAuthBackendRoleConfig(path, name, subjectsType, groupsAttribute string) string {
+	return fmt.Sprintf(`
+resource "vault_saml_auth_backend" "test" {
+  path          = "%s"
+  idp_sso_url   = "https://idp.example.com/sso"
+  idp_entity_id = "https://idp.example.com"
+  idp_cert      = <<EOT
+%s
+EOT
+  entity_id     = "https://sp.example.com/saml"
+  acs_urls      = ["https://vault.example.com/v1/auth/%s/callback"]
+}
+
+resource "vault_saml_auth_backend_role" "test" {
+  path                = vault_saml_auth_backend.test.path
+  name                = "%s"
+  bound_subjects      = ["*@example.com"]
+  bound_subjects_type = "%s"
+  bound_attributes = {
+    group = "admins"
+  }
+  groups_attribute = "%s"
+  token_policies   = ["default"]
+}
+`, path, testCertificate, path, name, subjectsType, groupsAttribute)
+}
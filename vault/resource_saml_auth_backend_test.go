@@ -0,0 +1,72 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccSAMLAuthBackend_basic(t *testing.T) {
+	isEnterprise := os.Getenv("TF_ACC_ENTERPRISE")
+	if isEnterprise == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+
+	path := acctest.RandomWithPrefix("saml")
+	resName := "vault_saml_auth_backend.test"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccSAMLAuthBackendCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSAMLAuthBackendConfig(path, "https://sp.example.com/saml", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "path", path),
+					resource.TestCheckResourceAttr(resName, "idp_sso_url", "https://idp.example.com/sso"),
+					resource.TestCheckResourceAttr(resName, "idp_entity_id", "https://idp.example.com"),
+					resource.TestCheckResourceAttr(resName, "entity_id", "https://sp.example.com/saml"),
+					resource.TestCheckResourceAttr(resName, "acs_urls.#", "1"),
+					resource.TestCheckResourceAttr(resName, "default_role", ""),
+					resource.TestCheckResourceAttrSet(resName, "accessor"),
+				),
+			},
+			{
+				Config: testAccSAMLAuthBackendConfig(path, "https://sp.example.com/saml2", "admin"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "entity_id", "https://sp.example.com/saml2"),
+					resource.TestCheckResourceAttr(resName, "default_role", "admin"),
+				),
+			},
+			{
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSAMLAuthBackendCheckDestroy(s *terraform.State) error {
+	return testAccCheckAuthMountDestroy(s, "vault_saml_auth_backend")
+}
+
+func testAccSAMLAuthBackendConfig(path, entityID, defaultRole string) string {
+	return fmt.Sprintf(`
+resource "vault_saml_auth_backend" "test" {
+  path          = "%s"
+  idp_sso_url   = "https://idp.example.com/sso"
+  idp_entity_id = "https://idp.example.com"
+  idp_cert      = <<EOT
+%s
+EOT
+  entity_id     = "%s"
+  acs_urls      = ["https://vault.example.com/v1/auth/%s/callback"]
+  default_role  = "%s"
+}
+`, path, testCertificate, entityID, path, defaultRole)
+}
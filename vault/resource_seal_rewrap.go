@@ -0,0 +1,102 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+const sealRewrapPath = "sys/sealwrap/rewrap"
+
+func sealRewrapResource() *schema.Resource {
+	return &schema.Resource{
+		Create: sealRewrapCreate,
+		Read:   sealRewrapRead,
+		Delete: sealRewrapDelete,
+
+		Schema: map[string]*schema.Schema{
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary map of values that, when changed, starts a new rewrap.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"is_running": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the rewrap is still running.",
+			},
+			"entries_processed": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of entries processed by the rewrap.",
+			},
+			"entries_succeeded": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of entries successfully rewrapped.",
+			},
+			"entries_failed": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of entries that failed to be rewrapped.",
+			},
+			"entries_skipped": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of entries skipped by the rewrap.",
+			},
+		},
+	}
+}
+
+func sealRewrapCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	log.Printf("[DEBUG] Starting seal rewrap")
+	if _, err := client.Logical().Write(sealRewrapPath, nil); err != nil {
+		return fmt.Errorf("error starting seal rewrap: %s", err)
+	}
+	log.Printf("[DEBUG] Started seal rewrap")
+	d.SetId(sealRewrapPath)
+
+	return sealRewrapRead(d, meta)
+}
+
+func sealRewrapRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	log.Printf("[DEBUG] Reading seal rewrap status")
+	resp, err := client.Logical().Read(sealRewrapPath)
+	if err != nil {
+		return fmt.Errorf("error reading seal rewrap status: %s", err)
+	}
+	log.Printf("[DEBUG] Read seal rewrap status")
+
+	if resp == nil {
+		return fmt.Errorf("no seal rewrap status returned")
+	}
+
+	d.Set("is_running", resp.Data["is_running"])
+
+	entries, _ := resp.Data["entries"].(map[string]interface{})
+	for _, k := range []string{"processed", "succeeded", "failed", "skipped"} {
+		if err := d.Set("entries_"+k, entries[k]); err != nil {
+			return fmt.Errorf("error setting state key %q: %s", "entries_"+k, err)
+		}
+	}
+
+	return nil
+}
+
+func sealRewrapDelete(d *schema.ResourceData, meta interface{}) error {
+	// A rewrap can't be undone, so removing the resource only removes it
+	// from state.
+	log.Printf("[DEBUG] Removing seal rewrap from state")
+	return nil
+}
@@ -0,0 +1,48 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestResourceSealRewrap(t *testing.T) {
+	isEnterprise := os.Getenv("TF_ACC_ENTERPRISE")
+	if isEnterprise == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+
+	resourceName := "vault_seal_rewrap.test"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceSealRewrap_config("1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "triggers.rotation", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "is_running"),
+					resource.TestCheckResourceAttrSet(resourceName, "entries_processed"),
+				),
+			},
+			{
+				Config: testResourceSealRewrap_config("2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "triggers.rotation", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testResourceSealRewrap_config(rotation string) string {
+	return fmt.Sprintf(`
+resource "vault_seal_rewrap" "test" {
+  triggers = {
+    rotation = "%s"
+  }
+}
+`, rotation)
+}
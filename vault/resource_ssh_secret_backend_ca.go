@@ -6,9 +6,21 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/vault/api"
 )
 
+var sshSecretBackendCAKeyTypes = []string{
+	"ssh-rsa",
+	"rsa",
+	"ecdsa-sha2-nistp256",
+	"ecdsa-sha2-nistp384",
+	"ecdsa-sha2-nistp521",
+	"ec",
+	"ssh-ed25519",
+	"ed25519",
+}
+
 func sshSecretBackendCAResource() *schema.Resource {
 	return &schema.Resource{
 		Create: sshSecretBackendCACreate,
@@ -51,6 +63,33 @@ func sshSecretBackendCAResource() *schema.Resource {
 				Computed:    true,
 				Description: "Public key part the SSH CA key pair; required if generate_signing_key is false.",
 			},
+			"key_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "Specifies the desired key type for the generated SSH CA key. Requires Vault 1.12+.",
+				ValidateFunc: validation.StringInSlice(sshSecretBackendCAKeyTypes, false),
+			},
+			"key_bits": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Specifies the desired key bits for the generated SSH CA key. Requires Vault 1.12+.",
+			},
+			"managed_key_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "The name of the managed key to use as the SSH CA key. Vault Enterprise only, requires Vault 1.14+.",
+				ConflictsWith: []string{"managed_key_id", "private_key"},
+			},
+			"managed_key_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "The ID of the managed key to use as the SSH CA key. Vault Enterprise only, requires Vault 1.14+.",
+				ConflictsWith: []string{"managed_key_name", "private_key"},
+			},
 		},
 	}
 }
@@ -69,6 +108,11 @@ func sshSecretBackendCACreate(d *schema.ResourceData, meta interface{}) error {
 	if publicKey, ok := d.Get("public_key").(string); ok {
 		data["public_key"] = publicKey
 	}
+	for _, k := range []string{"key_type", "key_bits", "managed_key_name", "managed_key_id"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
 
 	log.Printf("[DEBUG] Writing CA information on SSH backend %q", backend)
 	_, err := client.Logical().Write(backend+"/config/ca", data)
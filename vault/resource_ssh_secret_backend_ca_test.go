@@ -2,6 +2,7 @@ package vault
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
@@ -42,6 +43,28 @@ func TestAccSSHSecretBackendCA_provided(t *testing.T) {
 	})
 }
 
+func TestAccSSHSecretBackendCA_keyType(t *testing.T) {
+	backend := "ssh-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckSSHSecretBackendCADestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSSHSecretBackendCAConfigKeyType(backend),
+				Check: resource.ComposeTestCheckFunc(
+					testAccSSHSecretBackendCACheck(backend),
+					resource.TestCheckResourceAttr("vault_ssh_secret_backend_ca.test", "key_type", "ec"),
+					resource.TestCheckResourceAttr("vault_ssh_secret_backend_ca.test", "key_bits", "384"),
+					resource.TestMatchResourceAttr("vault_ssh_secret_backend_ca.test", "public_key", regexp.MustCompile("^ecdsa-sha2-nistp384 ")),
+					resource.TestCheckResourceAttrPair("data.vault_ssh_secret_backend_ca.test", "public_key", "vault_ssh_secret_backend_ca.test", "public_key"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSSHSecretBackend_import(t *testing.T) {
 	backend := "ssh-" + acctest.RandString(10)
 	resource.Test(t, resource.TestCase{
@@ -95,6 +118,26 @@ resource "vault_ssh_secret_backend_ca" "test" {
 }`, backend)
 }
 
+func testAccSSHSecretBackendCAConfigKeyType(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  type = "ssh"
+  path = "%s"
+  description = "SSH Secret backend"
+}
+
+resource "vault_ssh_secret_backend_ca" "test" {
+  backend              = vault_mount.test.path
+  generate_signing_key = true
+  key_type             = "ec"
+  key_bits             = 384
+}
+
+data "vault_ssh_secret_backend_ca" "test" {
+  backend = vault_ssh_secret_backend_ca.test.backend
+}`, backend)
+}
+
 func testAccSSHSecretBackendCAConfigProvided(backend string) string {
 	return fmt.Sprintf(`
 resource "vault_mount" "test" {
@@ -72,8 +72,9 @@ func sshSecretBackendRoleResource() *schema.Resource {
 				Optional: true,
 			},
 			"cidr_list": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateCIDRList,
 			},
 			"allowed_extensions": {
 				Type:     schema.TypeString,
@@ -118,14 +119,16 @@ func sshSecretBackendRoleResource() *schema.Resource {
 				Computed: true,
 			},
 			"max_ttl": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateVaultDuration,
 			},
 			"ttl": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateVaultDuration,
 			},
 		},
 	}
@@ -0,0 +1,160 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func sshSecretBackendSignResource() *schema.Resource {
+	return &schema.Resource{
+		Create: sshSecretBackendSignCreate,
+		Read:   sshSecretBackendSignRead,
+		Delete: sshSecretBackendSignDelete,
+
+		Schema: sshSecretBackendSignSchema(true),
+	}
+}
+
+// sshSecretBackendSignSchema returns the fields shared by the sign resource
+// and data source.
+func sshSecretBackendSignSchema(forceNew bool) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"backend": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    forceNew,
+			Description: "The path of the SSH Secret Backend the role belongs to.",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    forceNew,
+			Description: "Name of the role to sign the public key against.",
+		},
+		"public_key": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    forceNew,
+			Description: "The SSH public key that should be signed.",
+		},
+		"cert_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     forceNew,
+			Default:      "user",
+			Description:  "Type of certificate to be created, either \"user\" or \"host\".",
+			ValidateFunc: validation.StringInSlice([]string{"user", "host"}, false),
+		},
+		"valid_principals": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			ForceNew:    forceNew,
+			Description: "List of usernames or hostnames that the certificate should be signed for.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"ttl": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     forceNew,
+			Description:  "Requested Time To Live. Cannot be greater than the role's max_ttl.",
+			ValidateFunc: validateVaultDuration,
+		},
+		"key_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    forceNew,
+			Description: "Key ID that the created certificate should have.",
+		},
+		"critical_options": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			ForceNew:    forceNew,
+			Description: "Critical options that the certificate should be signed for.",
+		},
+		"extensions": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			ForceNew:    forceNew,
+			Description: "Extensions that the certificate should be signed for.",
+		},
+		"serial_number": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The serial number of the signed certificate.",
+		},
+		"signed_key": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The signed SSH certificate.",
+		},
+	}
+}
+
+func sshSecretBackendSignCreate(d *schema.ResourceData, meta interface{}) error {
+	if err := sshSecretBackendSign(d, meta); err != nil {
+		return err
+	}
+	return sshSecretBackendSignRead(d, meta)
+}
+
+func sshSecretBackendSignRead(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func sshSecretBackendSignDelete(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+// sshSecretBackendSign signs the configured public key and stores the
+// signed certificate, the ID is set to the certificate's serial number
+// prefixed with the signing path.
+func sshSecretBackendSign(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+	path := sshSecretBackendSignPath(backend, name)
+
+	data := map[string]interface{}{
+		"public_key": d.Get("public_key").(string),
+		"cert_type":  d.Get("cert_type").(string),
+	}
+	if v, ok := d.GetOk("valid_principals"); ok {
+		principals := make([]string, 0)
+		for _, p := range v.([]interface{}) {
+			principals = append(principals, p.(string))
+		}
+		data["valid_principals"] = strings.Join(principals, ",")
+	}
+	for _, k := range []string{"ttl", "key_id", "critical_options", "extensions"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Signing SSH public key with role %q on SSH backend %q", name, backend)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error signing SSH public key with role %q on SSH backend %q: %s", name, backend, err)
+	}
+	if resp == nil {
+		return fmt.Errorf("no signed key returned by role %q on SSH backend %q", name, backend)
+	}
+	log.Printf("[DEBUG] Signed SSH public key with role %q on SSH backend %q", name, backend)
+
+	serial, _ := resp.Data["serial_number"].(string)
+	d.SetId(path + "/" + serial)
+	d.Set("serial_number", serial)
+	d.Set("signed_key", resp.Data["signed_key"])
+
+	return nil
+}
+
+func sshSecretBackendSignPath(backend string, name string) string {
+	return strings.Trim(backend, "/") + "/sign/" + strings.Trim(name, "/")
+}
@@ -0,0 +1,80 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+const testSSHSecretBackendSignPublicKey = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQC7/n+wNKpUxXpRKOA+QZwcz1fcQ22AxTgAWsoAwJXzmpsaGBHD3Mmu68jFPr3n/SQsftSp4R8zGVjhcG4eRZG5TgON3lwAt6UcnzOYb5mVpFytCNVEzQ++fYPcFCxNJYghZLMuYu5pg4YEyuuAGUYOtUtbzymSxiI9OvgF3Gor9PM7AspiPCVP5dXcdAvGvprv5IeTf/89apCGEhmz65o5KyDnFIG5THoQYkipJYFSIGEHo8nmd0ZUNFmSJKa6XqWn/hZy68CReIqocJEKc0BwEACEVQScvQmpD2DlCYjAQZz4vi2De/hCL4hTCWTwtGSStwSACPGLTgk7ZdcE/OUZ test@terraform-vault-provider.local"
+
+func TestAccSSHSecretBackendSign_basic(t *testing.T) {
+	backend := "ssh-" + acctest.RandString(10)
+	resourceName := "vault_ssh_secret_backend_sign.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSSHSecretBackendSignConfig(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "name", "test"),
+					resource.TestCheckResourceAttr(resourceName, "cert_type", "user"),
+					resource.TestCheckResourceAttr(resourceName, "valid_principals.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "valid_principals.0", "ubuntu"),
+					resource.TestCheckResourceAttrSet(resourceName, "serial_number"),
+					resource.TestMatchResourceAttr(resourceName, "signed_key", regexp.MustCompile("^ssh-rsa-cert-v01@openssh.com ")),
+					resource.TestMatchResourceAttr("data.vault_ssh_secret_backend_sign.test", "signed_key", regexp.MustCompile("^ssh-rsa-cert-v01@openssh.com ")),
+				),
+			},
+		},
+	})
+}
+
+func testAccSSHSecretBackendSignConfig(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  type = "ssh"
+  path = "%s"
+}
+
+resource "vault_ssh_secret_backend_ca" "test" {
+  backend              = vault_mount.test.path
+  generate_signing_key = true
+}
+
+resource "vault_ssh_secret_backend_role" "test" {
+  name                    = "test"
+  backend                 = vault_mount.test.path
+  key_type                = "ca"
+  allow_user_certificates = true
+  allowed_users           = "ubuntu"
+  default_extensions      = {
+    permit-pty = ""
+  }
+
+  depends_on = [vault_ssh_secret_backend_ca.test]
+}
+
+resource "vault_ssh_secret_backend_sign" "test" {
+  backend          = vault_mount.test.path
+  name             = vault_ssh_secret_backend_role.test.name
+  public_key       = "%s"
+  valid_principals = ["ubuntu"]
+  ttl              = "1h"
+}
+
+data "vault_ssh_secret_backend_sign" "test" {
+  backend          = vault_mount.test.path
+  name             = vault_ssh_secret_backend_role.test.name
+  public_key       = "%s"
+  valid_principals = ["ubuntu"]
+  ttl              = "5m"
+}
+`, backend, testSSHSecretBackendSignPublicKey, testSSHSecretBackendSignPublicKey)
+}
@@ -22,11 +22,12 @@ func terraformCloudSecretBackendResource() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"backend": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				ForceNew:    true,
-				Default:     "terraform",
-				Description: "Unique name of the Vault Terraform Cloud mount to configure",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "terraform",
+				Description:  "Unique name of the Vault Terraform Cloud mount to configure",
+				ValidateFunc: validateMountPath,
 				StateFunc: func(s interface{}) string {
 					return strings.Trim(s.(string), "/")
 				},
@@ -146,7 +146,9 @@ func terraformCloudSecretRoleRead(d *schema.ResourceData, meta interface{}) erro
 	log.Printf("[DEBUG] Read Terraform Cloud secrets backend role at %q", path)
 
 	if secret == nil {
-		return fmt.Errorf("resource not found")
+		log.Printf("[WARN] Terraform Cloud secrets backend role %q not found, removing from state", path)
+		d.SetId("")
+		return nil
 	}
 
 	data := secret.Data
@@ -1,6 +1,9 @@
 package vault
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"log"
 	"strings"
@@ -8,9 +11,13 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/encryption"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/vault/api"
 )
 
+// batch tokens have no accessor, so they are identified by a hash of the token.
+const tokenBatchIDPrefix = "batch-"
+
 func tokenResource() *schema.Resource {
 	return &schema.Resource{
 		Create: tokenCreate,
@@ -63,24 +70,27 @@ func tokenResource() *schema.Resource {
 				Description: "Flag to allow the token to be renewed",
 			},
 			"ttl": {
-				Type:        schema.TypeString,
-				Required:    false,
-				Optional:    true,
-				ForceNew:    true,
-				Description: "The TTL period of the token.",
+				Type:         schema.TypeString,
+				Required:     false,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "The TTL period of the token.",
+				ValidateFunc: validateVaultDuration,
 			},
 			"explicit_max_ttl": {
-				Type:        schema.TypeString,
-				Required:    false,
-				Optional:    true,
-				ForceNew:    true,
-				Description: "The explicit max TTL of the token.",
+				Type:         schema.TypeString,
+				Required:     false,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "The explicit max TTL of the token.",
+				ValidateFunc: validateVaultDuration,
 			},
 			"wrapping_ttl": {
-				Type:        schema.TypeString,
-				Required:    false,
-				Optional:    true,
-				Description: "The TTL period of the wrapped token.",
+				Type:         schema.TypeString,
+				Required:     false,
+				Optional:     true,
+				Description:  "The TTL period of the wrapped token.",
+				ValidateFunc: validateVaultDuration,
 			},
 			"display_name": {
 				Type:        schema.TypeString,
@@ -98,11 +108,20 @@ func tokenResource() *schema.Resource {
 				Description: "The number of allowed uses of the token.",
 			},
 			"period": {
-				Type:        schema.TypeString,
-				Required:    false,
-				Optional:    true,
-				ForceNew:    true,
-				Description: "The period of the token.",
+				Type:         schema.TypeString,
+				Required:     false,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "The period of the token.",
+				ValidateFunc: validateVaultDuration,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				Description:  "The type of token to create, either \"service\" or \"batch\".",
+				ValidateFunc: validation.StringInSlice([]string{"service", "batch"}, false),
 			},
 			"renew_min_lease": {
 				Type:        schema.TypeInt,
@@ -212,6 +231,17 @@ func tokenCreate(d *schema.ResourceData, meta interface{}) error {
 		createRequest.Renewable = &renewable
 	}
 
+	batch := d.Get("type").(string) == "batch"
+	if batch {
+		if _, ok := d.GetOk("wrapping_ttl"); ok {
+			return fmt.Errorf("wrapping_ttl can't be used with batch tokens")
+		}
+		if _, ok := d.GetOk("pgp_key"); ok {
+			return fmt.Errorf("pgp_key can't be used with batch tokens")
+		}
+		createRequest.Type = "batch"
+	}
+
 	if v, ok := d.GetOk("wrapping_ttl"); ok {
 		wrappingTTL := v.(string)
 		token := client.Token()
@@ -285,6 +315,10 @@ func tokenCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if batch {
+		accessor = tokenBatchID(resp.Auth.ClientToken)
+	}
+
 	d.SetId(accessor)
 
 	return tokenRead(d, meta)
@@ -297,8 +331,11 @@ func tokenRead(d *schema.ResourceData, meta interface{}) error {
 	accessor := d.Id()
 
 	log.Printf("[DEBUG] Reading token accessor %q", accessor)
-	resp, err := client.Auth().Token().LookupAccessor(accessor)
+	resp, err := tokenLookup(client, id, accessor)
 	if err != nil {
+		if !readErrorRemovesResource(meta, err) {
+			return fmt.Errorf("error reading token accessor %q: %s", accessor, err)
+		}
 		log.Printf("[WARN] Token not found, removing from state")
 		d.SetId("")
 		return nil
@@ -321,6 +358,7 @@ func tokenRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("renewable", resp.Data["renewable"])
 	d.Set("display_name", strings.TrimPrefix(resp.Data["display_name"].(string), "token-"))
 	d.Set("num_uses", resp.Data["num_uses"])
+	d.Set("type", resp.Data["type"])
 	if _, ok := d.GetOk("pgp_key"); !ok {
 		d.Set("pgp_key", "")
 	}
@@ -337,6 +375,17 @@ func tokenRead(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return fmt.Errorf("error parsing issue_time: %s, please format string like '2006-01-02T15:04:05.999999999Z07:00'", err)
 	}
+	// Periodic tokens are renewed in place, base the lease on the last
+	// renewal so that it doesn't keep growing from the issue time.
+	if v, ok := resp.Data["last_renewal_time"].(json.Number); ok {
+		lastRenewal, err := v.Int64()
+		if err != nil {
+			return fmt.Errorf("error parsing last_renewal_time: %s", err)
+		}
+		if lastRenewal > 0 {
+			issueTime = time.Unix(lastRenewal, 0).UTC()
+		}
+	}
 	d.Set("lease_started", issueTime.Format(time.RFC3339))
 
 	expireTimeStr, ok := resp.Data["expire_time"].(string)
@@ -366,6 +415,9 @@ func tokenRead(d *schema.ResourceData, meta interface{}) error {
 
 		renewed, err := client.Auth().Token().Renew(id, increment)
 		if err != nil {
+			if !readErrorRemovesResource(meta, err) {
+				return fmt.Errorf("error renewing token accessor %q: %s", accessor, err)
+			}
 			log.Printf("[DEBUG] Error renewing token, removing from state")
 			d.SetId("")
 			return nil
@@ -373,7 +425,7 @@ func tokenRead(d *schema.ResourceData, meta interface{}) error {
 
 		log.Printf("[DEBUG] Lease for token accessor %q renewed, new lease duration %d", id, renewed.Auth.LeaseDuration)
 
-		d.Set("lease_duration", renewed.Data["lease_duration"])
+		d.Set("lease_duration", renewed.Auth.LeaseDuration)
 		d.Set("lease_started", time.Now().Format(time.RFC3339))
 		d.Set("client_token", renewed.Auth.ClientToken)
 
@@ -392,6 +444,12 @@ func tokenDelete(d *schema.ResourceData, meta interface{}) error {
 
 	token := d.Id()
 
+	if strings.HasPrefix(token, tokenBatchIDPrefix) {
+		// batch tokens can't be revoked, they expire with their TTL or parent.
+		log.Printf("[DEBUG] Batch token %q can't be revoked, removing from state", token)
+		return nil
+	}
+
 	log.Printf("[DEBUG] Deleting token %q", token)
 	err := client.Auth().Token().RevokeAccessor(token)
 	if err != nil {
@@ -407,14 +465,34 @@ func tokenExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 	accessor := d.Id()
 
 	log.Printf("[DEBUG] Checking if token accessor %q exists", accessor)
-	resp, err := client.Auth().Token().LookupAccessor(accessor)
+	resp, err := tokenLookup(client, d.Get("client_token").(string), accessor)
 	if err != nil {
+		if !readErrorRemovesResource(meta, err) {
+			return true, fmt.Errorf("error checking if token accessor %q exists: %s", accessor, err)
+		}
 		log.Printf("[DEBUG] token accessor %q not found: %s", d.Id(), err)
 		return false, nil
 	}
 	return resp != nil, nil
 }
 
+// tokenLookup looks up a token by accessor, or by the token itself for batch
+// tokens which have no accessor.
+func tokenLookup(client *api.Client, token, accessor string) (*api.Secret, error) {
+	if strings.HasPrefix(accessor, tokenBatchIDPrefix) {
+		if token == "" {
+			return nil, fmt.Errorf("batch token %q can only be looked up by its client token", accessor)
+		}
+		return client.Auth().Token().Lookup(token)
+	}
+	return client.Auth().Token().LookupAccessor(accessor)
+}
+
+func tokenBatchID(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return tokenBatchIDPrefix + hex.EncodeToString(sum[:])
+}
+
 func tokenCheckLease(d *schema.ResourceData) bool {
 	accessor := d.Id()
 
@@ -55,6 +55,33 @@ func tokenAuthBackendRoleResource() *schema.Resource {
 			DefaultFunc: tokenAuthBackendRoleEmptyStringSet,
 			Description: "List of disallowed policies for given role.",
 		},
+		"allowed_policies_glob": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			DefaultFunc: tokenAuthBackendRoleEmptyStringSet,
+			Description: "List of allowed policy glob patterns for given role.",
+		},
+		"disallowed_policies_glob": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			DefaultFunc: tokenAuthBackendRoleEmptyStringSet,
+			Description: "List of disallowed policy glob patterns for given role.",
+		},
+		"allowed_entity_aliases": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			DefaultFunc: tokenAuthBackendRoleEmptyStringSet,
+			Description: "Set of allowed entity aliases for this role, glob patterns are supported.",
+		},
 		"orphan": {
 			Type:        schema.TypeBool,
 			Optional:    true,
@@ -80,6 +107,7 @@ func tokenAuthBackendRoleResource() *schema.Resource {
 			Type:          schema.TypeString,
 			Optional:      true,
 			Description:   "Number of seconds to set the TTL to for issued tokens upon renewal. Makes the token a periodic token, which will never expire as long as it is renewed before the TTL each period.",
+			ValidateFunc:  validateVaultDuration,
 			ConflictsWith: []string{"token_period", "token_ttl"},
 			Deprecated:    "use `token_period` instead if you are running Vault >= 1.2",
 		},
@@ -87,6 +115,7 @@ func tokenAuthBackendRoleResource() *schema.Resource {
 			Type:          schema.TypeString,
 			Optional:      true,
 			Description:   "Number of seconds after which issued tokens can no longer be renewed.",
+			ValidateFunc:  validateVaultDuration,
 			Deprecated:    "use `token_explicit_max_ttl` instead if you are running Vault >= 1.2",
 			ConflictsWith: []string{"token_explicit_max_ttl"},
 		},
@@ -95,7 +124,8 @@ func tokenAuthBackendRoleResource() *schema.Resource {
 			Optional:    true,
 			Description: "List of CIDRs valid as the source address for login requests. This value is also encoded into any resulting token.",
 			Elem: &schema.Schema{
-				Type: schema.TypeString,
+				Type:         schema.TypeString,
+				ValidateFunc: validateCIDR,
 			},
 			Deprecated:    "use `token_bound_cidrs` instead if you are running Vault >= 1.2",
 			ConflictsWith: []string{"token_bound_cidrs"},
@@ -122,6 +152,9 @@ func tokenAuthBackendRoleUpdateFields(d *schema.ResourceData, data map[string]in
 
 	data["allowed_policies"] = d.Get("allowed_policies").(*schema.Set).List()
 	data["disallowed_policies"] = d.Get("disallowed_policies").(*schema.Set).List()
+	data["allowed_policies_glob"] = d.Get("allowed_policies_glob").(*schema.Set).List()
+	data["disallowed_policies_glob"] = d.Get("disallowed_policies_glob").(*schema.Set).List()
+	data["allowed_entity_aliases"] = d.Get("allowed_entity_aliases").(*schema.Set).List()
 	data["orphan"] = d.Get("orphan").(bool)
 	data["renewable"] = d.Get("renewable").(bool)
 	data["path_suffix"] = d.Get("path_suffix").(string)
@@ -227,7 +260,7 @@ func tokenAuthBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	for _, k := range []string{"allowed_policies", "disallowed_policies", "orphan", "path_suffix", "renewable"} {
+	for _, k := range []string{"allowed_policies", "disallowed_policies", "allowed_policies_glob", "disallowed_policies_glob", "allowed_entity_aliases", "orphan", "path_suffix", "renewable"} {
 		if err := d.Set(k, resp.Data[k]); err != nil {
 			return fmt.Errorf("error reading %s for Token auth backend role %q: %q", k, path, err)
 		}
@@ -72,6 +72,8 @@ func TestAccTokenAuthBackendRoleUpdate(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_token_auth_backend_role.role", "allowed_policies.1785148924", "test"),
 					resource.TestCheckResourceAttr("vault_token_auth_backend_role.role", "disallowed_policies.#", "1"),
 					resource.TestCheckResourceAttr("vault_token_auth_backend_role.role", "disallowed_policies.1971754988", "default"),
+					resource.TestCheckResourceAttr("vault_token_auth_backend_role.role", "allowed_policies_glob.#", "1"),
+					resource.TestCheckResourceAttr("vault_token_auth_backend_role.role", "allowed_entity_aliases.#", "1"),
 					resource.TestCheckResourceAttr("vault_token_auth_backend_role.role", "orphan", "true"),
 					resource.TestCheckResourceAttr("vault_token_auth_backend_role.role", "token_period", "86400"),
 					resource.TestCheckResourceAttr("vault_token_auth_backend_role.role", "renewable", "false"),
@@ -93,6 +95,8 @@ func TestAccTokenAuthBackendRoleUpdate(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_token_auth_backend_role.role", "allowed_policies.1785148924", "test"),
 					resource.TestCheckResourceAttr("vault_token_auth_backend_role.role", "disallowed_policies.#", "1"),
 					resource.TestCheckResourceAttr("vault_token_auth_backend_role.role", "disallowed_policies.1971754988", "default"),
+					resource.TestCheckResourceAttr("vault_token_auth_backend_role.role", "allowed_policies_glob.#", "1"),
+					resource.TestCheckResourceAttr("vault_token_auth_backend_role.role", "allowed_entity_aliases.#", "1"),
 					resource.TestCheckResourceAttr("vault_token_auth_backend_role.role", "orphan", "true"),
 					resource.TestCheckResourceAttr("vault_token_auth_backend_role.role", "token_period", "86400"),
 					resource.TestCheckResourceAttr("vault_token_auth_backend_role.role", "renewable", "false"),
@@ -110,6 +114,8 @@ func TestAccTokenAuthBackendRoleUpdate(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_token_auth_backend_role.role", "role_name", roleUpdated),
 					resource.TestCheckResourceAttr("vault_token_auth_backend_role.role", "allowed_policies.#", "0"),
 					resource.TestCheckResourceAttr("vault_token_auth_backend_role.role", "disallowed_policies.#", "0"),
+					resource.TestCheckResourceAttr("vault_token_auth_backend_role.role", "allowed_policies_glob.#", "0"),
+					resource.TestCheckResourceAttr("vault_token_auth_backend_role.role", "allowed_entity_aliases.#", "0"),
 					resource.TestCheckResourceAttr("vault_token_auth_backend_role.role", "orphan", "false"),
 					resource.TestCheckResourceAttr("vault_token_auth_backend_role.role", "token_period", "0"),
 					resource.TestCheckResourceAttr("vault_token_auth_backend_role.role", "renewable", "true"),
@@ -242,6 +248,8 @@ func testAccTokenAuthBackendRoleCheck_attrs(role string) resource.TestCheckFunc
 			"role_name":              "name",
 			"allowed_policies":       "allowed_policies",
 			"disallowed_policies":    "disallowed_policies",
+			"allowed_policies_glob":  "allowed_policies_glob",
+			"allowed_entity_aliases": "allowed_entity_aliases",
 			"orphan":                 "orphan",
 			"token_period":           "token_period",
 			"token_explicit_max_ttl": "token_explicit_max_ttl",
@@ -333,6 +341,8 @@ resource "vault_token_auth_backend_role" "role" {
   role_name = "%s"
   allowed_policies = ["dev", "test"]
   disallowed_policies = ["default"]
+  allowed_policies_glob = ["dev-*"]
+  allowed_entity_aliases = ["test-*"]
   orphan = true
   token_period = "86400"
   renewable = false
@@ -46,6 +46,81 @@ func TestResourceToken_basic(t *testing.T) {
 	})
 }
 
+func TestResourceToken_batch(t *testing.T) {
+	// batch tokens can't be revoked, so there is no CheckDestroy.
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceTokenConfig_batch(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_token.test", "type", "batch"),
+					resource.TestCheckResourceAttr("vault_token.test", "renewable", "false"),
+					resource.TestCheckResourceAttrSet("vault_token.test", "lease_duration"),
+					resource.TestCheckResourceAttrSet("vault_token.test", "client_token"),
+				),
+			},
+		},
+	})
+}
+
+func testResourceTokenConfig_batch() string {
+	return `
+resource "vault_policy" "test" {
+	name = "test"
+	policy = <<EOT
+path "secret/*" { capabilities = [ "list" ] }
+EOT
+}
+
+resource "vault_token" "test" {
+	policies = [ "${vault_policy.test.name}" ]
+	type = "batch"
+	no_parent = true
+	ttl = "60s"
+}`
+}
+
+func TestResourceToken_periodic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testResourceTokenCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceTokenConfig_periodic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_token.test", "period", "1h"),
+					resource.TestCheckResourceAttr("vault_token.test", "type", "service"),
+					resource.TestCheckResourceAttr("vault_token.test", "lease_duration", "3600"),
+				),
+			},
+			{
+				Config:   testResourceTokenConfig_periodic(),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testResourceTokenConfig_periodic() string {
+	return `
+resource "vault_policy" "test" {
+	name = "test"
+	policy = <<EOT
+path "secret/*" { capabilities = [ "list" ] }
+EOT
+}
+
+resource "vault_token" "test" {
+	policies = [ "${vault_policy.test.name}" ]
+	period = "1h"
+	renewable = true
+	renew_min_lease = 60
+}`
+}
+
 func TestResourceToken_import(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		Providers:    testProviders,
@@ -0,0 +1,294 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	totpSecretBackendFromKeyPathRegex = regexp.MustCompile("^(.+)/keys/.+$")
+	totpSecretBackendKeyFromPathRegex = regexp.MustCompile("^.+/keys/(.+$)")
+)
+
+// totpSecretBackendKeyGenerateFields are only meaningful when Vault
+// generates the key.
+var totpSecretBackendKeyGenerateFields = []string{
+	"exported",
+	"key_size",
+	"skew",
+	"qr_size",
+}
+
+// totpSecretBackendKeyImportedDiffSuppress suppresses changes to the fields
+// only used when creating a key while they are missing from state, as they
+// are for an imported key since Vault never returns them.
+func totpSecretBackendKeyImportedDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	return d.Id() != "" && old == ""
+}
+
+func totpSecretBackendKeyResource() *schema.Resource {
+	return &schema.Resource{
+		Create: totpSecretBackendKeyCreate,
+		Read:   totpSecretBackendKeyRead,
+		Delete: totpSecretBackendKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "totp",
+				Description: "The path of the TOTP secret backend the key belongs to.",
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the key.",
+			},
+			"generate": {
+				Type:             schema.TypeBool,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          false,
+				Description:      "Whether Vault should generate the key. If false, one of key or url must be set.",
+				ConflictsWith:    []string{"key"},
+				DiffSuppressFunc: totpSecretBackendKeyImportedDiffSuppress,
+			},
+			"exported": {
+				Type:             schema.TypeBool,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          true,
+				Description:      "Whether the barcode and url of a generated key are returned. Only used when generate is true.",
+				DiffSuppressFunc: totpSecretBackendKeyImportedDiffSuppress,
+			},
+			"key_size": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          20,
+				Description:      "Size in bytes of the generated key. Only used when generate is true.",
+				DiffSuppressFunc: totpSecretBackendKeyImportedDiffSuppress,
+			},
+			"skew": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          1,
+				Description:      "Number of delay periods allowed when validating a code. Only used when generate is true.",
+				ValidateFunc:     validation.IntBetween(0, 1),
+				DiffSuppressFunc: totpSecretBackendKeyImportedDiffSuppress,
+			},
+			"qr_size": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          200,
+				Description:      "Pixel size of the square QR code of a generated key. A value of 0 disables the QR code. Only used when generate is true.",
+				DiffSuppressFunc: totpSecretBackendKeyImportedDiffSuppress,
+			},
+			"key": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Sensitive:        true,
+				Description:      "Base32 encoded secret key of an existing key to import.",
+				DiffSuppressFunc: totpSecretBackendKeyImportedDiffSuppress,
+			},
+			"url": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				Sensitive:        true,
+				Description:      "TOTP key url of an existing key to import, or the url of a generated and exported key.",
+				DiffSuppressFunc: totpSecretBackendKeyImportedDiffSuppress,
+			},
+			"barcode": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Base64 encoded PNG QR code of a generated and exported key.",
+			},
+			"issuer": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Name of the key's issuing organization. Required when generate is true.",
+			},
+			"account_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Name of the account associated with the key. Required when generate is true.",
+			},
+			"period": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Length of time in seconds used to generate a counter for the code calculation.",
+			},
+			"algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "Hashing algorithm used to generate the code. Valid values are SHA1, SHA256 and SHA512.",
+				ValidateFunc: validation.StringInSlice([]string{"SHA1", "SHA256", "SHA512"}, false),
+			},
+			"digits": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "Number of digits in the generated code. Valid values are 6 and 8.",
+				ValidateFunc: validation.IntInSlice([]int{6, 8}),
+			},
+		},
+	}
+}
+
+func totpSecretBackendKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+	path := totpSecretBackendKeyPath(backend, name)
+
+	generate := d.Get("generate").(bool)
+	data := map[string]interface{}{
+		"generate": generate,
+	}
+
+	if generate {
+		for _, k := range totpSecretBackendKeyGenerateFields {
+			data[k] = d.Get(k)
+		}
+	} else {
+		_, hasKey := d.GetOk("key")
+		_, hasURL := d.GetOk("url")
+		if !hasKey && !hasURL {
+			return fmt.Errorf("one of key or url must be set when generate is false for TOTP key %q", name)
+		}
+	}
+
+	for _, k := range []string{"key", "url", "issuer", "account_name", "period", "algorithm", "digits"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Writing %q", path)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing %q: %s", path, err)
+	}
+	d.SetId(path)
+	log.Printf("[DEBUG] Wrote %q", path)
+
+	// the barcode and url of a generated key are only ever returned on creation
+	if resp != nil {
+		if v, ok := resp.Data["barcode"]; ok {
+			d.Set("barcode", v)
+		}
+		if v, ok := resp.Data["url"]; ok {
+			d.Set("url", v)
+		}
+	}
+
+	return totpSecretBackendKeyRead(d, meta)
+}
+
+func totpSecretBackendKeyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	backend, err := totpSecretBackendFromKeyPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid key ID %q: %s", path, err)
+	}
+	name, err := totpSecretBackendKeyFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid key ID %q: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read %q", path)
+	if resp == nil {
+		log.Printf("[WARN] %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("name", name)
+	for _, k := range []string{"issuer", "account_name", "period", "algorithm", "digits"} {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting state key %q: %s", k, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func totpSecretBackendKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting %q", path)
+	if _, err := client.Logical().Delete(path); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error deleting %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted %q", path)
+
+	return nil
+}
+
+func totpSecretBackendKeyPath(backend, name string) string {
+	return strings.Trim(backend, "/") + "/keys/" + strings.Trim(name, "/")
+}
+
+func totpSecretBackendFromKeyPath(path string) (string, error) {
+	if !totpSecretBackendFromKeyPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := totpSecretBackendFromKeyPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
+
+func totpSecretBackendKeyFromPath(path string) (string, error) {
+	if !totpSecretBackendKeyFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no name found")
+	}
+	res := totpSecretBackendKeyFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for name", len(res))
+	}
+	return res[1], nil
+}
@@ -0,0 +1,172 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccTOTPSecretBackendKey_generate(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-totp")
+	name := acctest.RandomWithPrefix("tf-test-key")
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { util.TestAccPreCheck(t) },
+		CheckDestroy: testAccTOTPSecretBackendKeyCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTOTPSecretBackendKeyConfig_generate(backend, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_totp_secret_backend_key.test", "backend", backend),
+					resource.TestCheckResourceAttr("vault_totp_secret_backend_key.test", "name", name),
+					resource.TestCheckResourceAttr("vault_totp_secret_backend_key.test", "issuer", "Vault"),
+					resource.TestCheckResourceAttr("vault_totp_secret_backend_key.test", "account_name", "test@example.com"),
+					resource.TestCheckResourceAttr("vault_totp_secret_backend_key.test", "period", "30"),
+					resource.TestCheckResourceAttr("vault_totp_secret_backend_key.test", "algorithm", "SHA1"),
+					resource.TestCheckResourceAttr("vault_totp_secret_backend_key.test", "digits", "6"),
+					resource.TestCheckResourceAttrSet("vault_totp_secret_backend_key.test", "barcode"),
+					resource.TestCheckResourceAttrSet("vault_totp_secret_backend_key.test", "url"),
+				),
+			},
+			{
+				ResourceName:            "vault_totp_secret_backend_key.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"generate", "exported", "key_size", "skew", "qr_size", "barcode", "url"},
+			},
+		},
+	})
+}
+
+func TestAccTOTPSecretBackendKey_import(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-totp")
+	name := acctest.RandomWithPrefix("tf-test-key")
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { util.TestAccPreCheck(t) },
+		CheckDestroy: testAccTOTPSecretBackendKeyCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTOTPSecretBackendKeyConfig_import(backend, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_totp_secret_backend_key.test", "name", name),
+					resource.TestCheckResourceAttr("vault_totp_secret_backend_key.test", "issuer", "Vault"),
+					resource.TestCheckResourceAttr("vault_totp_secret_backend_key.test", "account_name", "test@example.com"),
+					resource.TestCheckResourceAttr("vault_totp_secret_backend_key.test", "period", "60"),
+					resource.TestCheckResourceAttr("vault_totp_secret_backend_key.test", "algorithm", "SHA256"),
+					resource.TestCheckResourceAttr("vault_totp_secret_backend_key.test", "digits", "8"),
+					resource.TestCheckResourceAttr("vault_totp_secret_backend_key.test", "barcode", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestTOTPSecretBackendKeyDiff_imported(t *testing.T) {
+	// imported keys only have the fields returned by Vault in state
+	imported := map[string]string{
+		"backend":      "totp",
+		"name":         "test",
+		"issuer":       "Vault",
+		"account_name": "test@example.com",
+		"period":       "30",
+		"algorithm":    "SHA1",
+		"digits":       "6",
+	}
+	created := map[string]string{
+		"generate": "false",
+		"exported": "true",
+		"key_size": "20",
+		"skew":     "1",
+		"qr_size":  "200",
+	}
+	for k, v := range imported {
+		created[k] = v
+	}
+
+	tests := []struct {
+		attributes  map[string]string
+		requiresNew bool
+	}{
+		{imported, false},
+		{created, true},
+	}
+
+	for i, test := range tests {
+		state := &terraform.InstanceState{
+			ID:         "totp/keys/test",
+			Attributes: test.attributes,
+		}
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name":         "test",
+			"generate":     true,
+			"issuer":       "Vault",
+			"account_name": "test@example.com",
+		})
+
+		diff, err := totpSecretBackendKeyResource().Diff(state, config, nil)
+		if err != nil {
+			t.Fatalf("test %d: %s", i, err)
+		}
+		if actual := diff != nil && diff.RequiresNew(); actual != test.requiresNew {
+			t.Errorf("test %d: expected RequiresNew %t, got %t", i, test.requiresNew, actual)
+		}
+	}
+}
+
+func testAccTOTPSecretBackendKeyCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_totp_secret_backend_key" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("key %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccTOTPSecretBackendKeyConfig_generate(backend, name string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "totp" {
+  path = "%s"
+  type = "totp"
+}
+
+resource "vault_totp_secret_backend_key" "test" {
+  backend      = vault_mount.totp.path
+  name         = "%s"
+  generate     = true
+  issuer       = "Vault"
+  account_name = "test@example.com"
+}
+`, backend, name)
+}
+
+func testAccTOTPSecretBackendKeyConfig_import(backend, name string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "totp" {
+  path = "%s"
+  type = "totp"
+}
+
+resource "vault_totp_secret_backend_key" "test" {
+  backend = vault_mount.totp.path
+  name    = "%s"
+  url     = "otpauth://totp/Vault:test@example.com?secret=Y64VEVMBTSXCYIWRSHRNDZW62MPGVU2G&issuer=Vault&algorithm=SHA256&digits=8&period=60"
+}
+`, backend, name)
+}
@@ -0,0 +1,94 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+func transformAlphabetResource() *schema.Resource {
+	fields := transformBackendSchema("The name of the alphabet.")
+	fields["alphabet"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "A string of characters that contains the alphabet set.",
+	}
+
+	return &schema.Resource{
+		Create: transformAlphabetWrite,
+		Read:   transformAlphabetRead,
+		Update: transformAlphabetWrite,
+		Delete: transformAlphabetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: fields,
+	}
+}
+
+func transformAlphabetWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := transformPath(d.Get("path").(string), "alphabet", d.Get("name").(string))
+
+	data := map[string]interface{}{}
+	if v, ok := d.GetOk("alphabet"); ok {
+		data["alphabet"] = v
+	}
+
+	log.Printf("[DEBUG] Writing transform alphabet %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing transform alphabet %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote transform alphabet %q", path)
+
+	d.SetId(path)
+	return transformAlphabetRead(d, meta)
+}
+
+func transformAlphabetRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading transform alphabet %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading transform alphabet %q: %s", path, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] Transform alphabet %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+	log.Printf("[DEBUG] Read transform alphabet %q", path)
+
+	if err := transformSetPathAndName(d); err != nil {
+		return err
+	}
+	if v, ok := resp.Data["alphabet"]; ok {
+		if err := d.Set("alphabet", v); err != nil {
+			return fmt.Errorf("error setting %q for transform alphabet %q: %s", "alphabet", path, err)
+		}
+	}
+
+	return nil
+}
+
+func transformAlphabetDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting transform alphabet %q", path)
+	if _, err := client.Logical().Delete(path); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error deleting transform alphabet %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted transform alphabet %q", path)
+
+	return nil
+}
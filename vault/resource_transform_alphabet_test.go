@@ -0,0 +1,57 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+func TestTransformAlphabet(t *testing.T) {
+	path := acctest.RandomWithPrefix("transform")
+	resourceName := "vault_transform_alphabet.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { util.TestEntPreCheck(t) },
+		CheckDestroy: testTransformCheckDestroy("vault_transform_alphabet"),
+		Steps: []resource.TestStep{
+			{
+				Config: testTransformAlphabetConfig(path, "0123456789"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "path", path),
+					resource.TestCheckResourceAttr(resourceName, "name", "numerics"),
+					resource.TestCheckResourceAttr(resourceName, "alphabet", "0123456789"),
+				),
+			},
+			{
+				Config: testTransformAlphabetConfig(path, "abc"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "alphabet", "abc"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testTransformAlphabetConfig(path, alphabet string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "transform" {
+  path = "%s"
+  type = "transform"
+}
+
+resource "vault_transform_alphabet" "test" {
+  path     = vault_mount.transform.path
+  name     = "numerics"
+  alphabet = "%s"
+}
+`, path, alphabet)
+}
@@ -0,0 +1,92 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+func transformRoleResource() *schema.Resource {
+	fields := transformBackendSchema("The name of the role.")
+	fields["transformations"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "The transformations that can be used with this role.",
+	}
+
+	return &schema.Resource{
+		Create: transformRoleWrite,
+		Read:   transformRoleRead,
+		Update: transformRoleWrite,
+		Delete: transformRoleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: fields,
+	}
+}
+
+func transformRoleWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := transformPath(d.Get("path").(string), "role", d.Get("name").(string))
+
+	data := map[string]interface{}{
+		"transformations": d.Get("transformations").([]interface{}),
+	}
+
+	log.Printf("[DEBUG] Writing transform role %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing transform role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote transform role %q", path)
+
+	d.SetId(path)
+	return transformRoleRead(d, meta)
+}
+
+func transformRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading transform role %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading transform role %q: %s", path, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] Transform role %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+	log.Printf("[DEBUG] Read transform role %q", path)
+
+	if err := transformSetPathAndName(d); err != nil {
+		return err
+	}
+	if err := d.Set("transformations", resp.Data["transformations"]); err != nil {
+		return fmt.Errorf("error setting %q for transform role %q: %s", "transformations", path, err)
+	}
+
+	return nil
+}
+
+func transformRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting transform role %q", path)
+	if _, err := client.Logical().Delete(path); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error deleting transform role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted transform role %q", path)
+
+	return nil
+}
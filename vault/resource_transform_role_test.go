@@ -0,0 +1,59 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+func TestTransformRole(t *testing.T) {
+	path := acctest.RandomWithPrefix("transform")
+	resourceName := "vault_transform_role.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { util.TestEntPreCheck(t) },
+		CheckDestroy: testTransformCheckDestroy("vault_transform_role"),
+		Steps: []resource.TestStep{
+			{
+				Config: testTransformRoleConfig(path, "ccn-fpe"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "path", path),
+					resource.TestCheckResourceAttr(resourceName, "name", "payments"),
+					resource.TestCheckResourceAttr(resourceName, "transformations.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "transformations.0", "ccn-fpe"),
+				),
+			},
+			{
+				Config: testTransformRoleConfig(path, "ccn-masking"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "transformations.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "transformations.0", "ccn-masking"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testTransformRoleConfig(path, transformation string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "transform" {
+  path = "%s"
+  type = "transform"
+}
+
+resource "vault_transform_role" "test" {
+  path            = vault_mount.transform.path
+  name            = "payments"
+  transformations = ["%s"]
+}
+`, path, transformation)
+}
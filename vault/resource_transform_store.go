@@ -0,0 +1,179 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	transformStoreFields = []string{
+		"driver",
+		"supported_transformations",
+		"schema",
+		"max_open_connections",
+		"max_idle_connections",
+		"max_connection_lifetime",
+	}
+	transformStoreCredentialFields = []string{
+		"connection_string",
+		"username",
+		"password",
+	}
+)
+
+func transformStoreResource() *schema.Resource {
+	fields := transformBackendSchema("The name of the store.")
+	fields["type"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Default:      "sql",
+		Description:  "The type of store. Currently, only sql is supported.",
+		ValidateFunc: validation.StringInSlice([]string{"sql"}, false),
+	}
+	fields["driver"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "The database driver to use.",
+		ValidateFunc: validation.StringInSlice([]string{"postgres", "mysql", "mssql"}, false),
+	}
+	fields["connection_string"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Sensitive:   true,
+		Description: "Database connection string with {{username}} and {{password}} templates.",
+	}
+	fields["username"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The username used to connect to the database.",
+	}
+	fields["password"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Sensitive:   true,
+		Description: "The password used to connect to the database.",
+	}
+	fields["supported_transformations"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Computed:    true,
+		Description: "The types of transformations this store can host.",
+	}
+	fields["schema"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		Description: "The schema within the database to use.",
+	}
+	fields["max_open_connections"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Computed:    true,
+		Description: "The maximum number of connections to the database at any given time.",
+	}
+	fields["max_idle_connections"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Computed:    true,
+		Description: "The maximum number of idle connections to the database at any given time.",
+	}
+	fields["max_connection_lifetime"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Computed:    true,
+		Description: "The maximum amount of time in seconds a connection can be open before closing it.",
+	}
+
+	return &schema.Resource{
+		Create: transformStoreWrite,
+		Read:   transformStoreRead,
+		Update: transformStoreWrite,
+		Delete: transformStoreDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: fields,
+	}
+}
+
+func transformStoreWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := transformPath(d.Get("path").(string), "stores", d.Get("name").(string))
+
+	data := map[string]interface{}{
+		"type": d.Get("type").(string),
+	}
+	for _, k := range transformStoreCredentialFields {
+		data[k] = d.Get(k)
+	}
+	for _, k := range transformStoreFields {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Writing transform store %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing transform store %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote transform store %q", path)
+
+	d.SetId(path)
+	return transformStoreRead(d, meta)
+}
+
+func transformStoreRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading transform store %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading transform store %q: %s", path, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] Transform store %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+	log.Printf("[DEBUG] Read transform store %q", path)
+
+	if err := transformSetPathAndName(d); err != nil {
+		return err
+	}
+	// The connection credentials are never returned by Vault, so they are
+	// left as configured.
+	for _, k := range append([]string{"type"}, transformStoreFields...) {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting %q for transform store %q: %s", k, path, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func transformStoreDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting transform store %q", path)
+	if _, err := client.Logical().Delete(path); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error deleting transform store %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted transform store %q", path)
+
+	return nil
+}
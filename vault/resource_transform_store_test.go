@@ -0,0 +1,78 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+func TestTransformStore(t *testing.T) {
+	connURL := os.Getenv("POSTGRES_URL")
+	if connURL == "" {
+		t.Skip("POSTGRES_URL not set")
+	}
+	username := os.Getenv("POSTGRES_USERNAME")
+	password := os.Getenv("POSTGRES_PASSWORD")
+	if username == "" || password == "" {
+		t.Skip("POSTGRES_USERNAME and POSTGRES_PASSWORD must be set")
+	}
+
+	path := acctest.RandomWithPrefix("transform")
+	resourceName := "vault_transform_store.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { util.TestEntPreCheck(t) },
+		CheckDestroy: testTransformCheckDestroy("vault_transform_store"),
+		Steps: []resource.TestStep{
+			{
+				Config: testTransformStoreConfig(path, connURL, username, password, 4),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "path", path),
+					resource.TestCheckResourceAttr(resourceName, "name", "postgres"),
+					resource.TestCheckResourceAttr(resourceName, "type", "sql"),
+					resource.TestCheckResourceAttr(resourceName, "driver", "postgres"),
+					resource.TestCheckResourceAttr(resourceName, "supported_transformations.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "supported_transformations.0", "tokenization"),
+					resource.TestCheckResourceAttr(resourceName, "max_open_connections", "4"),
+				),
+			},
+			{
+				Config: testTransformStoreConfig(path, connURL, username, password, 8),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "max_open_connections", "8"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: transformStoreCredentialFields,
+			},
+		},
+	})
+}
+
+func testTransformStoreConfig(path, connURL, username, password string, maxOpenConnections int) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "transform" {
+  path = "%s"
+  type = "transform"
+}
+
+resource "vault_transform_store" "test" {
+  path                      = vault_mount.transform.path
+  name                      = "postgres"
+  driver                    = "postgres"
+  connection_string         = "%s"
+  username                  = "%s"
+  password                  = "%s"
+  supported_transformations = ["tokenization"]
+  max_open_connections      = %d
+}
+`, path, connURL, username, password, maxOpenConnections)
+}
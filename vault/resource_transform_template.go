@@ -0,0 +1,122 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+func transformTemplateResource() *schema.Resource {
+	fields := transformBackendSchema("The name of the template.")
+	fields["type"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "regex",
+		Description:  "The pattern type to use for match detection. Currently, only regex is supported.",
+		ValidateFunc: validation.StringInSlice([]string{"regex"}, false),
+	}
+	fields["pattern"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The pattern used for matching. Currently, only regular expression pattern is supported.",
+	}
+	fields["alphabet"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The alphabet to use for this template. This is only used during FPE transformations.",
+	}
+	fields["encode_format"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The regular expression template used to format encoded values. Requires Vault 1.9+.",
+	}
+	fields["decode_formats"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "Map of named regular expression templates used to format decoded values. Requires Vault 1.9+.",
+	}
+
+	return &schema.Resource{
+		Create: transformTemplateWrite,
+		Read:   transformTemplateRead,
+		Update: transformTemplateWrite,
+		Delete: transformTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: fields,
+	}
+}
+
+func transformTemplateWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := transformPath(d.Get("path").(string), "template", d.Get("name").(string))
+
+	data := map[string]interface{}{}
+	for _, k := range []string{"type", "pattern", "alphabet", "encode_format", "decode_formats"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Writing transform template %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing transform template %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote transform template %q", path)
+
+	d.SetId(path)
+	return transformTemplateRead(d, meta)
+}
+
+func transformTemplateRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading transform template %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading transform template %q: %s", path, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] Transform template %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+	log.Printf("[DEBUG] Read transform template %q", path)
+
+	if err := transformSetPathAndName(d); err != nil {
+		return err
+	}
+	for _, k := range []string{"type", "pattern", "alphabet", "encode_format", "decode_formats"} {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting %q for transform template %q: %s", k, path, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func transformTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting transform template %q", path)
+	if _, err := client.Logical().Delete(path); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error deleting transform template %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted transform template %q", path)
+
+	return nil
+}
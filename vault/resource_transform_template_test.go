@@ -0,0 +1,68 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+func TestTransformTemplate(t *testing.T) {
+	path := acctest.RandomWithPrefix("transform")
+	resourceName := "vault_transform_template.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { util.TestEntPreCheck(t) },
+		CheckDestroy: testTransformCheckDestroy("vault_transform_template"),
+		Steps: []resource.TestStep{
+			{
+				Config: testTransformTemplateConfig(path, `(\\d{4})-(\\d{4})-(\\d{4})-(\\d{4})`, "vault_transform_alphabet.numerics.name"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "path", path),
+					resource.TestCheckResourceAttr(resourceName, "name", "ccn"),
+					resource.TestCheckResourceAttr(resourceName, "type", "regex"),
+					resource.TestCheckResourceAttr(resourceName, "pattern", `(\d{4})-(\d{4})-(\d{4})-(\d{4})`),
+					resource.TestCheckResourceAttr(resourceName, "alphabet", "numerics"),
+				),
+			},
+			{
+				Config: testTransformTemplateConfig(path, `(\\d{9})`, `"builtin/numeric"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "pattern", `(\d{9})`),
+					resource.TestCheckResourceAttr(resourceName, "alphabet", "builtin/numeric"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testTransformTemplateConfig(path, pattern, alphabet string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "transform" {
+  path = "%s"
+  type = "transform"
+}
+
+resource "vault_transform_alphabet" "numerics" {
+  path     = vault_mount.transform.path
+  name     = "numerics"
+  alphabet = "0123456789"
+}
+
+resource "vault_transform_template" "test" {
+  path     = vault_transform_alphabet.numerics.path
+  name     = "ccn"
+  type     = "regex"
+  pattern  = "%s"
+  alphabet = %s
+}
+`, path, pattern, alphabet)
+}
@@ -0,0 +1,199 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+var transformTransformationFields = []string{
+	"tweak_source",
+	"masking_character",
+	"allowed_roles",
+	"stores",
+	"mapping_mode",
+	"convergent",
+	"deletion_allowed",
+}
+
+func transformTransformationResource() *schema.Resource {
+	fields := transformBackendSchema("The name of the transformation.")
+	fields["type"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     true,
+		Description:  "The type of transformation to perform.",
+		ValidateFunc: validation.StringInSlice([]string{"fpe", "masking", "tokenization"}, false),
+	}
+	fields["template"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "The name of the template to use. Only valid for fpe and masking transformations.",
+	}
+	fields["templates"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Computed:    true,
+		Description: "Templates configured for the transformation.",
+	}
+	fields["tweak_source"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		Description:  "The source of where the tweak value comes from. Only valid for fpe transformations.",
+		ValidateFunc: validation.StringInSlice([]string{"supplied", "generated", "internal"}, false),
+	}
+	fields["masking_character"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		Description: "The character used to replace data. Only valid for masking transformations.",
+	}
+	fields["allowed_roles"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Description: "The set of roles allowed to perform this transformation.",
+	}
+	fields["stores"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    true,
+		Description: "The list of stores tokens are kept in. Only valid for tokenization transformations.",
+	}
+	fields["mapping_mode"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     true,
+		Description:  "The mapping mode of tokens. Only valid for tokenization transformations.",
+		ValidateFunc: validation.StringInSlice([]string{"default", "exportable"}, false),
+	}
+	fields["convergent"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    true,
+		Description: "Whether tokenization is convergent. Only valid for tokenization transformations. Requires Vault 1.11+.",
+	}
+	fields["deletion_allowed"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Computed:    true,
+		Description: "Whether the transformation can be deleted. Only valid for tokenization transformations. Requires Vault 1.12+.",
+	}
+
+	return &schema.Resource{
+		Create: transformTransformationWrite,
+		Read:   transformTransformationRead,
+		Update: transformTransformationWrite,
+		Delete: transformTransformationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: fields,
+	}
+}
+
+func transformTransformationWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := transformPath(d.Get("path").(string), "transformation", d.Get("name").(string))
+
+	data := map[string]interface{}{}
+	if v, ok := d.GetOk("type"); ok {
+		data["type"] = v
+	}
+	if v, ok := d.GetOk("template"); ok {
+		data["template"] = v
+	}
+	for _, k := range transformTransformationFields {
+		if v, ok := d.GetOkExists(k); ok {
+			data[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Writing transform transformation %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing transform transformation %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote transform transformation %q", path)
+
+	d.SetId(path)
+	return transformTransformationRead(d, meta)
+}
+
+func transformTransformationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading transform transformation %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading transform transformation %q: %s", path, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] Transform transformation %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+	log.Printf("[DEBUG] Read transform transformation %q", path)
+
+	if err := transformSetPathAndName(d); err != nil {
+		return err
+	}
+	if err := d.Set("type", resp.Data["type"]); err != nil {
+		return fmt.Errorf("error setting %q for transform transformation %q: %s", "type", path, err)
+	}
+
+	// Vault only reports the list of templates, of which at most one can
+	// currently be configured.
+	templates, _ := resp.Data["templates"].([]interface{})
+	if err := d.Set("templates", templates); err != nil {
+		return fmt.Errorf("error setting %q for transform transformation %q: %s", "templates", path, err)
+	}
+	if len(templates) > 0 {
+		d.Set("template", templates[0])
+	}
+
+	// Older versions of Vault report the masking character as a code point.
+	if v, ok := resp.Data["masking_character"].(json.Number); ok {
+		if i, err := v.Int64(); err == nil {
+			resp.Data["masking_character"] = string(rune(i))
+		}
+	}
+
+	for _, k := range transformTransformationFields {
+		if v, ok := resp.Data[k]; ok {
+			if err := d.Set(k, v); err != nil {
+				return fmt.Errorf("error setting %q for transform transformation %q: %s", k, path, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func transformTransformationDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting transform transformation %q", path)
+	if _, err := client.Logical().Delete(path); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error deleting transform transformation %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted transform transformation %q", path)
+
+	return nil
+}
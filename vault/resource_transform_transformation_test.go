@@ -0,0 +1,152 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+func TestTransformTransformation_fpe(t *testing.T) {
+	path := acctest.RandomWithPrefix("transform")
+	resourceName := "vault_transform_transformation.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { util.TestEntPreCheck(t) },
+		CheckDestroy: testTransformCheckDestroy("vault_transform_transformation"),
+		Steps: []resource.TestStep{
+			{
+				Config: testTransformTransformationConfig_fpe(path, "internal", "payments"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "path", path),
+					resource.TestCheckResourceAttr(resourceName, "name", "ccn-fpe"),
+					resource.TestCheckResourceAttr(resourceName, "type", "fpe"),
+					resource.TestCheckResourceAttr(resourceName, "template", "builtin/creditcardnumber"),
+					resource.TestCheckResourceAttr(resourceName, "templates.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tweak_source", "internal"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_roles.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_roles.0", "payments"),
+				),
+			},
+			{
+				Config: testTransformTransformationConfig_fpe(path, "generated", "payments-1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tweak_source", "generated"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_roles.0", "payments-1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestTransformTransformation_masking(t *testing.T) {
+	path := acctest.RandomWithPrefix("transform")
+	resourceName := "vault_transform_transformation.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { util.TestEntPreCheck(t) },
+		CheckDestroy: testTransformCheckDestroy("vault_transform_transformation"),
+		Steps: []resource.TestStep{
+			{
+				Config: testTransformTransformationConfig_masking(path, "#"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", "masking"),
+					resource.TestCheckResourceAttr(resourceName, "masking_character", "#"),
+				),
+			},
+			{
+				Config: testTransformTransformationConfig_masking(path, "-"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "masking_character", "-"),
+				),
+			},
+		},
+	})
+}
+
+func TestTransformTransformation_tokenization(t *testing.T) {
+	path := acctest.RandomWithPrefix("transform")
+	resourceName := "vault_transform_transformation.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { util.TestEntPreCheck(t) },
+		CheckDestroy: testTransformCheckDestroy("vault_transform_transformation"),
+		Steps: []resource.TestStep{
+			{
+				Config: testTransformTransformationConfig_tokenization(path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", "tokenization"),
+					resource.TestCheckResourceAttr(resourceName, "mapping_mode", "exportable"),
+					resource.TestCheckResourceAttr(resourceName, "stores.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stores.0", "builtin/internal"),
+					resource.TestCheckResourceAttr(resourceName, "deletion_allowed", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testTransformTransformationConfig_fpe(path, tweakSource, allowedRole string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "transform" {
+  path = "%s"
+  type = "transform"
+}
+
+resource "vault_transform_transformation" "test" {
+  path          = vault_mount.transform.path
+  name          = "ccn-fpe"
+  type          = "fpe"
+  template      = "builtin/creditcardnumber"
+  tweak_source  = "%s"
+  allowed_roles = ["%s"]
+}
+`, path, tweakSource, allowedRole)
+}
+
+func testTransformTransformationConfig_masking(path, maskingCharacter string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "transform" {
+  path = "%s"
+  type = "transform"
+}
+
+resource "vault_transform_transformation" "test" {
+  path              = vault_mount.transform.path
+  name              = "ccn-masking"
+  type              = "masking"
+  template          = "builtin/creditcardnumber"
+  masking_character = "%s"
+  allowed_roles     = ["payments"]
+}
+`, path, maskingCharacter)
+}
+
+func testTransformTransformationConfig_tokenization(path string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "transform" {
+  path = "%s"
+  type = "transform"
+}
+
+resource "vault_transform_transformation" "test" {
+  path             = vault_mount.transform.path
+  name             = "ccn-tokenization"
+  type             = "tokenization"
+  mapping_mode     = "exportable"
+  stores           = ["builtin/internal"]
+  deletion_allowed = true
+  allowed_roles    = ["payments"]
+}
+`, path)
+}
@@ -28,6 +28,11 @@ func TestAccTransitCacheConfig(t *testing.T) {
 					testAccTransitCacheConfigCheckApi(600),
 				),
 			},
+			{
+				ResourceName:      "vault_transit_secret_cache_config.cfg",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccTransitCacheConfig(name, 700),
 				Check:  resource.TestCheckResourceAttr("vault_transit_secret_cache_config.cfg", "size", "700"),
@@ -97,8 +97,15 @@ func transitSecretBackendKeyResource() *schema.Resource {
 			},
 			"min_available_version": {
 				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Minimum key version available for use. Increasing it trims all key versions below it from the keyring, which cannot be undone.",
+			},
+			"auto_rotate_period": {
+				Type:        schema.TypeInt,
+				Optional:    true,
 				Computed:    true,
-				Description: "Minimum key version available for use.",
+				Description: "Amount of seconds the key should live before being automatically rotated. A value of 0 disables automatic rotation for the key. Requires Vault 1.10+.",
 			},
 			"min_decryption_version": {
 				Type:        schema.TypeInt,
@@ -171,6 +178,17 @@ func transitSecretBackendKeyResource() *schema.Resource {
 			customdiff.ForceNewIfChange("allow_plaintext_backup", func(old, new, meta interface{}) bool {
 				return !new.(bool) && old.(bool)
 			}),
+			customdiff.ValidateChange("min_available_version", func(old, new, meta interface{}) error {
+				// Trimmed key versions are gone for good.
+				if new.(int) < old.(int) {
+					return fmt.Errorf("'min_available_version' cannot be decreased once key versions have been trimmed")
+				}
+				return nil
+			}),
+			// Trimming removes key versions from the keyring.
+			customdiff.ComputedIf("keys", func(d *schema.ResourceDiff, meta interface{}) bool {
+				return d.HasChange("min_available_version")
+			}),
 		),
 	}
 }
@@ -185,11 +203,14 @@ func transitSecretBackendKeyCreate(d *schema.ResourceData, meta interface{}) err
 
 	configData := map[string]interface{}{
 		"min_decryption_version": d.Get("min_decryption_version").(int),
-		"min_encryption_versoin": d.Get("min_encryption_version").(int),
+		"min_encryption_version": d.Get("min_encryption_version").(int),
 		"deletion_allowed":       d.Get("deletion_allowed").(bool),
 		"exportable":             d.Get("exportable").(bool),
 		"allow_plaintext_backup": d.Get("allow_plaintext_backup").(bool),
 	}
+	if v, ok := d.GetOk("auto_rotate_period"); ok {
+		configData["auto_rotate_period"] = v
+	}
 
 	data := map[string]interface{}{
 		"convergent_encryption": d.Get("convergent_encryption").(bool),
@@ -299,6 +320,11 @@ func transitSecretBackendKeyRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("min_available_version", minAvailableVersion)
 	d.Set("min_decryption_version", minDecryptionVersion)
 	d.Set("min_encryption_version", minEncryptionVersion)
+	if v, ok := secret.Data["auto_rotate_period"]; ok {
+		if err := d.Set("auto_rotate_period", v); err != nil {
+			return fmt.Errorf("error setting auto_rotate_period for key %q: %s", path, err)
+		}
+	}
 	d.Set("supports_decryption", secret.Data["supports_decryption"].(bool))
 	d.Set("supports_derivation", secret.Data["supports_derivation"].(bool))
 	d.Set("supports_encryption", secret.Data["supports_encryption"].(bool))
@@ -321,6 +347,9 @@ func transitSecretBackendKeyUpdate(d *schema.ResourceData, meta interface{}) err
 		"exportable":             d.Get("exportable"),
 		"allow_plaintext_backup": d.Get("allow_plaintext_backup"),
 	}
+	if d.HasChange("auto_rotate_period") {
+		data["auto_rotate_period"] = d.Get("auto_rotate_period")
+	}
 
 	_, err := client.Logical().Write(path+"/config", data)
 	if err != nil {
@@ -328,6 +357,21 @@ func transitSecretBackendKeyUpdate(d *schema.ResourceData, meta interface{}) err
 	}
 	log.Printf("[DEBUG] Updated transit secret backend key %q", path)
 
+	// Trimming has to happen after the config update, since Vault requires
+	// min_decryption_version and min_encryption_version to be at least
+	// min_available_version.
+	if d.HasChange("min_available_version") {
+		trimData := map[string]interface{}{
+			"min_available_version": d.Get("min_available_version"),
+		}
+
+		log.Printf("[DEBUG] Trimming transit secret backend key %q", path)
+		if _, err := client.Logical().Write(path+"/trim", trimData); err != nil {
+			return fmt.Errorf("error trimming transit secret backend key %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Trimmed transit secret backend key %q", path)
+	}
+
 	return transitSecretBackendKeyRead(d, meta)
 }
 
@@ -0,0 +1,379 @@
+package vault
+
+import (
+	"crypto/aes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"hash"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+var transitSecretBackendKeyImportHashFunctions = map[string]func() hash.Hash{
+	"SHA1":   sha1.New,
+	"SHA224": sha256.New224,
+	"SHA256": sha256.New,
+	"SHA384": sha512.New384,
+	"SHA512": sha512.New,
+}
+
+func transitSecretBackendKeyImportResource() *schema.Resource {
+	return &schema.Resource{
+		Create: transitSecretBackendKeyImportCreate,
+		Read:   transitSecretBackendKeyImportRead,
+		Update: transitSecretBackendKeyImportUpdate,
+		Delete: transitSecretBackendKeyDelete,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The Transit secret backend the resource belongs to.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the encryption key to import.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Specifies the type of key being imported.",
+				ValidateFunc: validation.StringInSlice([]string{"aes128-gcm96", "aes256-gcm96", "chacha20-poly1305", "ed25519", "ecdsa-p256", "ecdsa-p384", "ecdsa-p521", "rsa-2048", "rsa-3072", "rsa-4096", "hmac"}, false),
+			},
+			"key": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				Description:   "Base64 encoded key material to import. Raw bytes for symmetric keys, PKCS#8 DER for asymmetric keys. Wrapped with the backend's wrapping key before being sent to Vault.",
+				ConflictsWith: []string{"ciphertext"},
+			},
+			"ciphertext": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				Description:   "Base64 encoded key material that has already been wrapped with the backend's wrapping key, e.g. by an HSM.",
+				ConflictsWith: []string{"key"},
+			},
+			"hash_function": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "SHA256",
+				Description:  "The hash function used for the RSA-OAEP step of wrapping the key.",
+				ValidateFunc: validation.StringInSlice([]string{"SHA1", "SHA224", "SHA256", "SHA384", "SHA512"}, false),
+			},
+			"allow_rotation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Whether Vault is allowed to rotate the imported key. When false, changing the key material replaces the key instead of importing a new version.",
+			},
+			"derived": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Specifies if key derivation is to be used.",
+			},
+			"exportable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Enables the key to be exportable.",
+			},
+			"allow_plaintext_backup": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Enables taking a backup of the named key in plaintext format.",
+			},
+			"auto_rotate_period": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Amount of seconds the key should live before being automatically rotated. Requires allow_rotation.",
+			},
+			"deletion_allowed": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Specifies if the key is allowed to be deleted.",
+			},
+			"latest_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Latest key version in the keyring.",
+			},
+		},
+		CustomizeDiff: customdiff.All(
+			customdiff.ForceNewIf("key", transitSecretBackendKeyImportForceNew),
+			customdiff.ForceNewIf("ciphertext", transitSecretBackendKeyImportForceNew),
+		),
+	}
+}
+
+// transitSecretBackendKeyImportForceNew only allows new key material to be
+// imported as a new version when the key may be rotated.
+func transitSecretBackendKeyImportForceNew(d *schema.ResourceDiff, meta interface{}) bool {
+	return !d.Get("allow_rotation").(bool)
+}
+
+func transitSecretBackendKeyImportCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	name := d.Get("name").(string)
+	path := transitSecretBackendKeyPath(backend, name)
+
+	ciphertext, err := transitSecretBackendKeyImportCiphertext(client, d)
+	if err != nil {
+		return err
+	}
+
+	data := map[string]interface{}{
+		"ciphertext":    ciphertext,
+		"hash_function": d.Get("hash_function").(string),
+		"type":          d.Get("type").(string),
+	}
+	for _, k := range []string{"allow_rotation", "derived", "exportable", "allow_plaintext_backup", "auto_rotate_period"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Importing key %q into transit secret backend %q", name, backend)
+	if _, err := client.Logical().Write(path+"/import", data); err != nil {
+		return fmt.Errorf("error importing key %q into transit secret backend %q: %s", name, backend, err)
+	}
+	log.Printf("[DEBUG] Imported key %q into transit secret backend %q", name, backend)
+
+	d.SetId(path)
+
+	if err := transitSecretBackendKeyImportWriteConfig(client, d); err != nil {
+		return err
+	}
+
+	return transitSecretBackendKeyImportRead(d, meta)
+}
+
+func transitSecretBackendKeyImportRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	backend, err := transitSecretBackendKeyBackendFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid key ID %q: %s", path, err)
+	}
+	name, err := transitSecretBackendKeyNameFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid key ID %q: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading key from %q", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading key %q: %s", path, err)
+	}
+	if secret == nil {
+		log.Printf("[WARN] Key %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+	log.Printf("[DEBUG] Read key from %q", path)
+
+	latestVersion, err := secret.Data["latest_version"].(json.Number).Int64()
+	if err != nil {
+		return fmt.Errorf("expected latest_version %q to be a number, and it isn't", secret.Data["latest_version"])
+	}
+
+	d.Set("backend", backend)
+	d.Set("name", name)
+	d.Set("type", secret.Data["type"])
+	d.Set("latest_version", latestVersion)
+	for _, k := range []string{"derived", "exportable", "allow_plaintext_backup", "deletion_allowed"} {
+		d.Set(k, secret.Data[k])
+	}
+	if v, ok := secret.Data["imported_key_allow_rotation"]; ok {
+		d.Set("allow_rotation", v)
+	}
+
+	return nil
+}
+
+func transitSecretBackendKeyImportUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	if d.HasChange("key") || d.HasChange("ciphertext") {
+		ciphertext, err := transitSecretBackendKeyImportCiphertext(client, d)
+		if err != nil {
+			return err
+		}
+
+		data := map[string]interface{}{
+			"ciphertext":    ciphertext,
+			"hash_function": d.Get("hash_function").(string),
+		}
+
+		log.Printf("[DEBUG] Importing new version of key %q", path)
+		if _, err := client.Logical().Write(path+"/import_version", data); err != nil {
+			return fmt.Errorf("error importing new version of key %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Imported new version of key %q", path)
+	}
+
+	if d.HasChange("deletion_allowed") {
+		if err := transitSecretBackendKeyImportWriteConfig(client, d); err != nil {
+			return err
+		}
+	}
+
+	return transitSecretBackendKeyImportRead(d, meta)
+}
+
+func transitSecretBackendKeyImportWriteConfig(client *api.Client, d *schema.ResourceData) error {
+	path := d.Id()
+
+	data := map[string]interface{}{
+		"deletion_allowed": d.Get("deletion_allowed").(bool),
+	}
+
+	log.Printf("[DEBUG] Setting configuration for key %q", path)
+	if _, err := client.Logical().Write(path+"/config", data); err != nil {
+		return fmt.Errorf("error setting configuration for transit secret backend key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Set configuration for key %q", path)
+
+	return nil
+}
+
+// transitSecretBackendKeyImportCiphertext returns the wrapped key material
+// to import, wrapping the configured key with the backend's wrapping key
+// if it hasn't been wrapped already.
+func transitSecretBackendKeyImportCiphertext(client *api.Client, d *schema.ResourceData) (string, error) {
+	if v, ok := d.GetOk("ciphertext"); ok {
+		return v.(string), nil
+	}
+
+	v, ok := d.GetOk("key")
+	if !ok {
+		return "", fmt.Errorf("one of key or ciphertext must be set")
+	}
+	key, err := base64.StdEncoding.DecodeString(v.(string))
+	if err != nil {
+		return "", fmt.Errorf("error decoding key: %s", err)
+	}
+
+	backend := d.Get("backend").(string)
+	path := strings.Trim(backend, "/") + "/wrapping_key"
+
+	log.Printf("[DEBUG] Reading wrapping key from transit secret backend %q", backend)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return "", fmt.Errorf("error reading wrapping key from transit secret backend %q: %s", backend, err)
+	}
+	if resp == nil {
+		return "", fmt.Errorf("no wrapping key found in transit secret backend %q", backend)
+	}
+	log.Printf("[DEBUG] Read wrapping key from transit secret backend %q", backend)
+
+	wrappingKey, _ := resp.Data["public_key"].(string)
+	newHash := transitSecretBackendKeyImportHashFunctions[d.Get("hash_function").(string)]
+
+	return transitWrapKeyForImport(wrappingKey, key, newHash())
+}
+
+// transitWrapKeyForImport wraps key as expected by Vault's BYOK import:
+// the key is wrapped with an ephemeral AES-256 key using AES-KWP, and the
+// ephemeral key is encrypted with the wrapping key using RSA-OAEP.
+func transitWrapKeyForImport(wrappingKeyPEM string, key []byte, oaepHash hash.Hash) (string, error) {
+	block, _ := pem.Decode([]byte(wrappingKeyPEM))
+	if block == nil {
+		return "", fmt.Errorf("no PEM data found in wrapping key")
+	}
+	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return "", fmt.Errorf("error parsing wrapping key: %s", err)
+	}
+	rsaPub, ok := pub.(*rsa.PublicKey)
+	if !ok {
+		return "", fmt.Errorf("expected an RSA wrapping key, got %T", pub)
+	}
+
+	ephemeralKey := make([]byte, 32)
+	if _, err := rand.Read(ephemeralKey); err != nil {
+		return "", fmt.Errorf("error generating ephemeral key: %s", err)
+	}
+
+	wrappedKey, err := transitAESKeyWrapWithPadding(ephemeralKey, key)
+	if err != nil {
+		return "", fmt.Errorf("error wrapping key: %s", err)
+	}
+
+	wrappedEphemeralKey, err := rsa.EncryptOAEP(oaepHash, rand.Reader, rsaPub, ephemeralKey, nil)
+	if err != nil {
+		return "", fmt.Errorf("error wrapping ephemeral key: %s", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(append(wrappedEphemeralKey, wrappedKey...)), nil
+}
+
+// transitAESKeyWrapWithPadding implements AES key wrap with padding as
+// described in RFC 5649.
+func transitAESKeyWrapWithPadding(kek, plaintext []byte) ([]byte, error) {
+	if len(plaintext) == 0 {
+		return nil, fmt.Errorf("plaintext must not be empty")
+	}
+
+	cipher, err := aes.NewCipher(kek)
+	if err != nil {
+		return nil, err
+	}
+
+	// Alternative initial value: a constant followed by the length of
+	// the unpadded plaintext.
+	a := []byte{0xa6, 0x59, 0x59, 0xa6, 0, 0, 0, 0}
+	binary.BigEndian.PutUint32(a[4:], uint32(len(plaintext)))
+
+	r := make([]byte, (len(plaintext)+7)/8*8)
+	copy(r, plaintext)
+
+	if len(r) == 8 {
+		out := make([]byte, 16)
+		cipher.Encrypt(out, append(a, r...))
+		return out, nil
+	}
+
+	n := len(r) / 8
+	b := make([]byte, 16)
+	for j := 0; j < 6; j++ {
+		for i := 1; i <= n; i++ {
+			copy(b[:8], a)
+			copy(b[8:], r[(i-1)*8:i*8])
+			cipher.Encrypt(b, b)
+
+			t := uint64(n*j + i)
+			binary.BigEndian.PutUint64(a, binary.BigEndian.Uint64(b[:8])^t)
+			copy(r[(i-1)*8:i*8], b[8:])
+		}
+	}
+
+	return append(a, r...), nil
+}
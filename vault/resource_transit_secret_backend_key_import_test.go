@@ -0,0 +1,102 @@
+package vault
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestTransitSecretBackendKeyImport_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("transit")
+	name := acctest.RandomWithPrefix("key")
+	resourceName := "vault_transit_secret_backend_key_import.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testTransitSecretBackendKeyCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testTransitSecretBackendKeyImportConfig(name, backend, testTransitSecretBackendKeyImportRandomKey(t)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "type", "aes256-gcm96"),
+					resource.TestCheckResourceAttr(resourceName, "allow_rotation", "true"),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "1"),
+				),
+			},
+			{
+				// New key material is imported as a new version.
+				Config: testTransitSecretBackendKeyImportConfig(name, backend, testTransitSecretBackendKeyImportRandomKey(t)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestTransitAESKeyWrapWithPadding(t *testing.T) {
+	// Test vectors from RFC 5649, section 6.
+	kek, _ := hex.DecodeString("5840df6e29b02af1ab493b705bf16ea1ae8338f4dcc176a8")
+
+	tests := []struct {
+		plaintext string
+		expected  string
+	}{
+		{
+			plaintext: "c37b7e6492584340bed12207808941155068f738",
+			expected:  "138bdeaa9b8fa7fc61f97742e72248ee5ae6ae5360d1ae6a5f54f373fa543b6a",
+		},
+		{
+			plaintext: "466f7250617369",
+			expected:  "afbeb0f07dfbf5419200f2ccb50bb24f",
+		},
+	}
+
+	for _, test := range tests {
+		plaintext, _ := hex.DecodeString(test.plaintext)
+		expected, _ := hex.DecodeString(test.expected)
+
+		actual, err := transitAESKeyWrapWithPadding(kek, plaintext)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(actual, expected) {
+			t.Errorf("expected %x, got %x", expected, actual)
+		}
+	}
+}
+
+func testTransitSecretBackendKeyImportRandomKey(t *testing.T) string {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		t.Fatal(err)
+	}
+	return base64.StdEncoding.EncodeToString(key)
+}
+
+func testTransitSecretBackendKeyImportConfig(name, path, key string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "transit" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key_import" "test" {
+  backend          = vault_mount.transit.path
+  name             = "%s"
+  type             = "aes256-gcm96"
+  key              = "%s"
+  allow_rotation   = true
+  deletion_allowed = true
+}
+`, path, name, key)
+}
@@ -66,6 +66,50 @@ func TestTransitSecretBackendKey_basic(t *testing.T) {
 	})
 }
 
+func TestTransitSecretBackendKey_rotateAndTrim(t *testing.T) {
+	backend := acctest.RandomWithPrefix("transit")
+	name := acctest.RandomWithPrefix("key")
+	resourceName := "vault_transit_secret_backend_key.test"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testTransitSecretBackendKeyCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testTransitSecretBackendKeyConfig_rotateAndTrim(name, backend, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "auto_rotate_period", "86400"),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "min_available_version", "0"),
+				),
+			},
+			{
+				// Rotations made outside of Terraform are picked up on refresh.
+				PreConfig: func() {
+					client := testProvider.Meta().(*api.Client)
+					for i := 0; i < 2; i++ {
+						if _, err := client.Logical().Write(transitSecretBackendKeyPath(backend, name)+"/rotate", nil); err != nil {
+							t.Fatal(err)
+						}
+					}
+				},
+				Config: testTransitSecretBackendKeyConfig_rotateAndTrim(name, backend, 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "3"),
+					resource.TestCheckResourceAttr(resourceName, "min_decryption_version", "3"),
+					resource.TestCheckResourceAttr(resourceName, "min_encryption_version", "3"),
+					resource.TestCheckResourceAttr(resourceName, "min_available_version", "3"),
+					resource.TestCheckResourceAttr(resourceName, "keys.#", "1"),
+				),
+			},
+			{
+				Config:      testTransitSecretBackendKeyConfig_rotateAndTrim(name, backend, 2),
+				ExpectError: regexp.MustCompile("cannot be decreased once key versions have been trimmed"),
+			},
+		},
+	})
+}
+
 func TestTransitSecretBackendKey_rsa4096(t *testing.T) {
 	backend := acctest.RandomWithPrefix("transit")
 	name := acctest.RandomWithPrefix("key")
@@ -213,6 +257,29 @@ resource "vault_transit_secret_backend_key" "test" {
 `, path, name)
 }
 
+func testTransitSecretBackendKeyConfig_rotateAndTrim(name, path string, minVersion int) string {
+	minAvailable := ""
+	if minVersion > 1 {
+		minAvailable = fmt.Sprintf("min_available_version  = %d", minVersion)
+	}
+	return fmt.Sprintf(`
+resource "vault_mount" "transit" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  backend                = vault_mount.transit.path
+  name                   = "%s"
+  deletion_allowed       = true
+  auto_rotate_period     = 86400
+  min_decryption_version = %d
+  min_encryption_version = %d
+  %s
+}
+`, path, name, minVersion, minVersion, minAvailable)
+}
+
 func testTransitSecretBackendKeyConfig_invalidUpdates(name, path string) string {
 	return fmt.Sprintf(`
 resource "vault_mount" "transit" {
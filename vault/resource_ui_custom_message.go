@@ -0,0 +1,226 @@
+package vault
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+const uiCustomMessagesPath = "sys/config/ui/custom-messages"
+
+func uiCustomMessageResource() *schema.Resource {
+	return &schema.Resource{
+		Create: uiCustomMessageCreate,
+		Read:   uiCustomMessageRead,
+		Update: uiCustomMessageUpdate,
+		Delete: uiCustomMessageDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"title": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Title of the message.",
+			},
+			"message": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Text of the message.",
+			},
+			"authenticated": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Show the message after users log in, rather than on the login page.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "banner",
+				Description:  "How the message is shown. Valid values are banner and modal.",
+				ValidateFunc: validation.StringInSlice([]string{"banner", "modal"}, false),
+			},
+			"start_time": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Time the message is first shown, in RFC3339 format.",
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
+			"end_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Time the message is no longer shown, in RFC3339 format. The message is shown indefinitely if not set.",
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
+			"link": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Link shown with the message.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"title": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Text of the link.",
+						},
+						"href": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "URL of the link.",
+						},
+					},
+				},
+			},
+			"options": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Additional options of the message.",
+			},
+		},
+	}
+}
+
+func uiCustomMessageRequestData(d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"title":         d.Get("title"),
+		"message":       base64.StdEncoding.EncodeToString([]byte(d.Get("message").(string))),
+		"authenticated": d.Get("authenticated"),
+		"type":          d.Get("type"),
+		"start_time":    d.Get("start_time"),
+		"options":       d.Get("options"),
+	}
+	if v, ok := d.GetOk("end_time"); ok {
+		data["end_time"] = v
+	}
+	if v, ok := d.GetOk("link"); ok {
+		link := v.([]interface{})[0].(map[string]interface{})
+		data["link"] = map[string]interface{}{
+			link["title"].(string): link["href"],
+		}
+	}
+
+	return data
+}
+
+func uiCustomMessageCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	title := d.Get("title").(string)
+
+	log.Printf("[DEBUG] Creating UI custom message %q", title)
+	resp, err := client.Logical().Write(uiCustomMessagesPath, uiCustomMessageRequestData(d))
+	if err != nil {
+		return fmt.Errorf("error creating UI custom message %q: %s", title, err)
+	}
+	if resp == nil || resp.Data["id"] == nil {
+		return fmt.Errorf("no ID returned for UI custom message %q", title)
+	}
+	d.SetId(resp.Data["id"].(string))
+	log.Printf("[DEBUG] Created UI custom message %q with ID %q", title, d.Id())
+
+	return uiCustomMessageRead(d, meta)
+}
+
+func uiCustomMessageRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	id := d.Id()
+
+	log.Printf("[DEBUG] Reading UI custom message %q", id)
+	resp, err := client.Logical().Read(uiCustomMessagesPath + "/" + id)
+	if err != nil {
+		return fmt.Errorf("error reading UI custom message %q: %s", id, err)
+	}
+	log.Printf("[DEBUG] Read UI custom message %q", id)
+	if resp == nil {
+		log.Printf("[WARN] UI custom message %q not found, removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	message, err := base64.StdEncoding.DecodeString(resp.Data["message"].(string))
+	if err != nil {
+		return fmt.Errorf("error decoding UI custom message %q: %s", id, err)
+	}
+	d.Set("message", string(message))
+
+	for _, k := range []string{"title", "authenticated", "type", "options"} {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return fmt.Errorf("error setting state key %q: %s", k, err)
+		}
+	}
+
+	// Vault normalizes the times to UTC, so they are only set when they
+	// differ from the configured times.
+	for _, k := range []string{"start_time", "end_time"} {
+		v, _ := resp.Data[k].(string)
+		if !uiCustomMessageTimesEqual(d.Get(k).(string), v) {
+			d.Set(k, v)
+		}
+	}
+
+	var links []map[string]interface{}
+	if link, ok := resp.Data["link"].(map[string]interface{}); ok {
+		for title, href := range link {
+			links = append(links, map[string]interface{}{
+				"title": title,
+				"href":  href,
+			})
+		}
+	}
+	if err := d.Set("link", links); err != nil {
+		return fmt.Errorf("error setting state key \"link\": %s", err)
+	}
+
+	return nil
+}
+
+func uiCustomMessageUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	id := d.Id()
+
+	log.Printf("[DEBUG] Updating UI custom message %q", id)
+	if _, err := client.Logical().Write(uiCustomMessagesPath+"/"+id, uiCustomMessageRequestData(d)); err != nil {
+		return fmt.Errorf("error updating UI custom message %q: %s", id, err)
+	}
+	log.Printf("[DEBUG] Updated UI custom message %q", id)
+
+	return uiCustomMessageRead(d, meta)
+}
+
+func uiCustomMessageDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	id := d.Id()
+
+	log.Printf("[DEBUG] Deleting UI custom message %q", id)
+	if _, err := client.Logical().Delete(uiCustomMessagesPath + "/" + id); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error deleting UI custom message %q: %s", id, err)
+	}
+	log.Printf("[DEBUG] Deleted UI custom message %q", id)
+
+	return nil
+}
+
+func uiCustomMessageTimesEqual(a, b string) bool {
+	ta, err := time.Parse(time.RFC3339, a)
+	if err != nil {
+		return a == b
+	}
+	tb, err := time.Parse(time.RFC3339, b)
+	if err != nil {
+		return false
+	}
+	return ta.Equal(tb)
+}
@@ -0,0 +1,85 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestResourceUICustomMessage(t *testing.T) {
+	isEnterprise := os.Getenv("TF_ACC_ENTERPRISE")
+	if isEnterprise == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+
+	resourceName := "vault_ui_custom_message.test"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testResourceUICustomMessage_destroyCheck,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceUICustomMessage_config("Maintenance", "banner"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "title", "Maintenance"),
+					resource.TestCheckResourceAttr(resourceName, "message", "Vault will be upgraded on Saturday."),
+					resource.TestCheckResourceAttr(resourceName, "type", "banner"),
+					resource.TestCheckResourceAttr(resourceName, "authenticated", "true"),
+					resource.TestCheckResourceAttr(resourceName, "start_time", "2024-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "link.0.title", "Details"),
+					resource.TestCheckResourceAttr(resourceName, "link.0.href", "https://example.com/maintenance"),
+				),
+			},
+			{
+				Config: testResourceUICustomMessage_config("Planned maintenance", "modal"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "title", "Planned maintenance"),
+					resource.TestCheckResourceAttr(resourceName, "type", "modal"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testResourceUICustomMessage_destroyCheck(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_ui_custom_message" {
+			continue
+		}
+		resp, err := client.Logical().Read(uiCustomMessagesPath + "/" + rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error checking for UI custom message %q: %s", rs.Primary.ID, err)
+		}
+		if resp != nil {
+			return fmt.Errorf("UI custom message %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testResourceUICustomMessage_config(title, messageType string) string {
+	return fmt.Sprintf(`
+resource "vault_ui_custom_message" "test" {
+  title      = "%s"
+  message    = "Vault will be upgraded on Saturday."
+  type       = "%s"
+  start_time = "2024-01-01T00:00:00Z"
+
+  link {
+    title = "Details"
+    href  = "https://example.com/maintenance"
+  }
+}
+`, title, messageType)
+}
@@ -0,0 +1,96 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+const uiHeadersPath = "sys/config/ui/headers"
+
+func uiHeaderResource() *schema.Resource {
+	return &schema.Resource{
+		Create: uiHeaderWrite,
+		Read:   uiHeaderRead,
+		Update: uiHeaderWrite,
+		Delete: uiHeaderDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the response header.",
+			},
+			"values": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Values of the response header.",
+			},
+		},
+	}
+}
+
+func uiHeaderWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Get("name").(string)
+
+	log.Printf("[DEBUG] Writing UI header %q", name)
+	if _, err := client.Logical().Write(uiHeadersPath+"/"+name, map[string]interface{}{
+		"values": d.Get("values"),
+	}); err != nil {
+		return fmt.Errorf("error writing UI header %q: %s", name, err)
+	}
+	d.SetId(name)
+	log.Printf("[DEBUG] Wrote UI header %q", name)
+
+	return uiHeaderRead(d, meta)
+}
+
+func uiHeaderRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Id()
+
+	log.Printf("[DEBUG] Reading UI header %q", name)
+	resp, err := client.Logical().Read(uiHeadersPath + "/" + name)
+	if err != nil {
+		return fmt.Errorf("error reading UI header %q: %s", name, err)
+	}
+	log.Printf("[DEBUG] Read UI header %q", name)
+	if resp == nil {
+		log.Printf("[WARN] UI header %q not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	if err := d.Set("values", resp.Data["values"]); err != nil {
+		return fmt.Errorf("error setting state key \"values\": %s", err)
+	}
+
+	return nil
+}
+
+func uiHeaderDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Id()
+
+	log.Printf("[DEBUG] Deleting UI header %q", name)
+	if _, err := client.Logical().Delete(uiHeadersPath + "/" + name); err != nil && !util.Is404(err) {
+		return fmt.Errorf("error deleting UI header %q: %s", name, err)
+	}
+	log.Printf("[DEBUG] Deleted UI header %q", name)
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestResourceUIHeader(t *testing.T) {
+	name := acctest.RandomWithPrefix("X-Test")
+	resourceName := "vault_ui_header.test"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testResourceUIHeader_destroyCheck,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceUIHeader_config(name, `["foo"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "values.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "values.0", "foo"),
+				),
+			},
+			{
+				Config: testResourceUIHeader_config(name, `["foo", "bar"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "values.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "values.1", "bar"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testResourceUIHeader_destroyCheck(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_ui_header" {
+			continue
+		}
+		resp, err := client.Logical().Read(uiHeadersPath + "/" + rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("error checking for UI header %q: %s", rs.Primary.ID, err)
+		}
+		if resp != nil {
+			return fmt.Errorf("UI header %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testResourceUIHeader_config(name, values string) string {
+	return fmt.Sprintf(`
+resource "vault_ui_header" "test" {
+  name   = "%s"
+  values = %s
+}
+`, name, values)
+}
@@ -4,11 +4,62 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/vault/api"
-	"log"
 )
 
+// sentinelPolicyNamespaceSchema places a Sentinel policy in a child namespace
+// of the provider's namespace.
+func sentinelPolicyNamespaceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Namespace, relative to the provider's namespace, to create the policy in.",
+		StateFunc: func(v interface{}) string {
+			return strings.Trim(v.(string), "/")
+		},
+	}
+}
+
+// sentinelPolicyImport accepts IDs of the form <namespace>/<name> for
+// policies that are placed in a namespace.
+func sentinelPolicyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	id := d.Id()
+	if i := strings.LastIndex(id, "/"); i != -1 {
+		d.Set("namespace", id[:i])
+		d.SetId(id[i+1:])
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// sentinelPolicyClient returns a client for the namespace of the policy.
+func sentinelPolicyClient(d *schema.ResourceData, meta interface{}) (*api.Client, error) {
+	client := meta.(*api.Client)
+
+	namespace := d.Get("namespace").(string)
+	if namespace == "" {
+		return client, nil
+	}
+
+	if parent := client.Headers().Get("X-Vault-Namespace"); parent != "" {
+		namespace = strings.Trim(parent, "/") + "/" + namespace
+	}
+
+	token := client.Token()
+	client, err := client.Clone()
+	if err != nil {
+		return nil, fmt.Errorf("error cloning client: %s", err)
+	}
+	client.SetToken(token)
+	client.SetNamespace(namespace)
+
+	return client, nil
+}
+
 func readSentinelPolicy(client *api.Client, policyType string, name string) (map[string]interface{}, error) {
 	r := client.NewRequest("GET", fmt.Sprintf("/v1/sys/policies/%s/%s", policyType, name))
 
@@ -73,13 +124,16 @@ func ValidateSentinelEnforcementLevel(v interface{}, k string) (ws []string, err
 }
 
 func sentinelPolicyDelete(policyType string, d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*api.Client)
+	client, err := sentinelPolicyClient(d, meta)
+	if err != nil {
+		return err
+	}
 
 	name := d.Id()
 
 	log.Printf("[DEBUG] Deleting %s policy %s from Vault", policyType, name)
 
-	err := DeleteSentinelPolicy(client, policyType, name)
+	err = DeleteSentinelPolicy(client, policyType, name)
 	if err != nil {
 		return fmt.Errorf("error deleting from Vault: %s", err)
 	}
@@ -88,7 +142,10 @@ func sentinelPolicyDelete(policyType string, d *schema.ResourceData, meta interf
 }
 
 func sentinelPolicyRead(policyType string, attributes []string, d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*api.Client)
+	client, err := sentinelPolicyClient(d, meta)
+	if err != nil {
+		return err
+	}
 
 	name := d.Id()
 
@@ -97,6 +154,11 @@ func sentinelPolicyRead(policyType string, attributes []string, d *schema.Resour
 	if err != nil {
 		return fmt.Errorf("error reading from Vault: %s", err)
 	}
+	if policy == nil {
+		log.Printf("[WARN] %s policy %s not found, removing from state", policyType, name)
+		d.SetId("")
+		return nil
+	}
 
 	for _, value := range attributes {
 		d.Set(value, policy[value])
@@ -107,7 +169,10 @@ func sentinelPolicyRead(policyType string, attributes []string, d *schema.Resour
 }
 
 func sentinelPolicyWrite(policyType string, attributes []string, d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*api.Client)
+	client, err := sentinelPolicyClient(d, meta)
+	if err != nil {
+		return err
+	}
 
 	name := d.Get("name").(string)
 
@@ -117,7 +182,7 @@ func sentinelPolicyWrite(policyType string, attributes []string, d *schema.Resou
 		body[value] = d.Get(value)
 	}
 
-	err := PutSentinelPolicy(client, policyType, name, body)
+	err = PutSentinelPolicy(client, policyType, name, body)
 
 	if err != nil {
 		return fmt.Errorf("error writing to Vault: %s", err)
@@ -0,0 +1,66 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+var transformPathRegex = regexp.MustCompile("^(.+)/(alphabet|role|stores|template|transformation)/([^/]+)$")
+
+// transformPath returns the path of a named object of the given kind, for
+// example "alphabet" or "role", within a transform secrets engine.
+func transformPath(backend, kind, name string) string {
+	return strings.Trim(backend, "/") + "/" + kind + "/" + strings.Trim(name, "/")
+}
+
+// transformPathParts splits a path built by transformPath back into the
+// backend, the kind of object and its name. IDs written by the generated
+// resources these replaced start with a slash, so it is trimmed from the
+// backend.
+func transformPathParts(path string) (string, string, string, error) {
+	res := transformPathRegex.FindStringSubmatch(path)
+	if len(res) != 4 {
+		return "", "", "", fmt.Errorf("unexpected transform path %q", path)
+	}
+	return strings.Trim(res[1], "/"), res[2], res[3], nil
+}
+
+// transformBackendSchema returns the fields common to all the transform
+// secrets engine resources.
+func transformBackendSchema(nameDescription string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"path": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Path to where the transform secrets engine is mounted.",
+			// standardise on no beginning or trailing slashes
+			StateFunc: func(v interface{}) string {
+				return strings.Trim(v.(string), "/")
+			},
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: nameDescription,
+		},
+	}
+}
+
+// transformSetPathAndName sets the path and name fields from the resource ID,
+// rewriting legacy IDs into the form built by transformPath.
+func transformSetPathAndName(d *schema.ResourceData) error {
+	backend, kind, name, err := transformPathParts(d.Id())
+	if err != nil {
+		return err
+	}
+	d.SetId(transformPath(backend, kind, name))
+	if err := d.Set("path", backend); err != nil {
+		return err
+	}
+	return d.Set("name", name)
+}
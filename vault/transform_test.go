@@ -0,0 +1,92 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestTransformPathParts(t *testing.T) {
+	tests := []struct {
+		path    string
+		backend string
+		kind    string
+		name    string
+		wantErr bool
+	}{
+		{path: "transform/alphabet/numerics", backend: "transform", kind: "alphabet", name: "numerics"},
+		{path: "nested/transform/role/payments", backend: "nested/transform", kind: "role", name: "payments"},
+		{path: "transform/stores/postgres", backend: "transform", kind: "stores", name: "postgres"},
+		{path: "transform/transformation/ccn-fpe", backend: "transform", kind: "transformation", name: "ccn-fpe"},
+		{path: "/transform/template/ccn", backend: "transform", kind: "template", name: "ccn"},
+		{path: "transform/unknown/foo", wantErr: true},
+		{path: "transform/role/", wantErr: true},
+	}
+
+	for _, test := range tests {
+		backend, kind, name, err := transformPathParts(test.path)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("expected an error for %q", test.path)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unexpected error for %q: %s", test.path, err)
+			continue
+		}
+		if backend != test.backend || kind != test.kind || name != test.name {
+			t.Errorf("expected %q, %q and %q for %q, got %q, %q and %q", test.backend, test.kind, test.name, test.path, backend, kind, name)
+		}
+	}
+}
+
+func TestTransformSetPathAndName_legacyID(t *testing.T) {
+	raw := map[string]interface{}{
+		"path":            "transform",
+		"name":            "payments",
+		"transformations": []interface{}{"ccn-fpe"},
+	}
+
+	r := transformRoleResource()
+	d := schema.TestResourceDataRaw(t, r.Schema, raw)
+	d.SetId("/transform/role/payments")
+	if err := transformSetPathAndName(d); err != nil {
+		t.Fatal(err)
+	}
+	if expected := "transform/role/payments"; d.Id() != expected {
+		t.Errorf("expected ID %q, got %q", expected, d.Id())
+	}
+
+	diff, err := r.Diff(d.State(), terraform.NewResourceConfigRaw(raw), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff != nil && !diff.Empty() {
+		t.Errorf("expected an empty plan, got %#v", diff.Attributes)
+	}
+}
+
+func testTransformCheckDestroy(resourceType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testProvider.Meta().(*api.Client)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+			secret, err := client.Logical().Read(rs.Primary.ID)
+			if err != nil {
+				return fmt.Errorf("error checking for %q: %s", rs.Primary.ID, err)
+			}
+			if secret != nil {
+				return fmt.Errorf("%q still exists", rs.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
@@ -3,10 +3,15 @@ package vault
 import (
 	"errors"
 	"fmt"
+	"net"
+	"net/url"
 	"strings"
 	"time"
 
 	"github.com/gosimple/slug"
+	"github.com/hashicorp/go-secure-stdlib/parseutil"
+	"github.com/hashicorp/hcl"
+	"github.com/hashicorp/hcl/hcl/ast"
 )
 
 func validateStringSlug(i interface{}, k string) (s []string, es []error) {
@@ -22,6 +27,9 @@ func validateStringSlug(i interface{}, k string) (s []string, es []error) {
 	return
 }
 
+// validateDuration checks that the value can be parsed by time.ParseDuration.
+// Use it for values the provider parses itself, e.g. to suppress diffs; use
+// validateVaultDuration for values that are only passed on to Vault.
 func validateDuration(i interface{}, k string) (s []string, es []error) {
 	v, ok := i.(string)
 	if !ok {
@@ -47,3 +55,145 @@ func validateNoTrailingSlash(i interface{}, k string) (s []string, es []error) {
 	}
 	return
 }
+
+// validateVaultDuration checks that the value is a duration as accepted by
+// Vault, either a number of seconds or a string with a unit like "768h".
+// Unlike validateDuration, it accepts numbers without a unit.
+func validateVaultDuration(i interface{}, k string) (s []string, es []error) {
+	v, ok := i.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if _, err := parseutil.ParseDurationSecond(v); err != nil {
+		es = append(es, fmt.Errorf("expected %s to be a duration like \"768h\", \"30m\" or a number of seconds, got %q", k, v))
+	}
+	return
+}
+
+// validateMountPath checks that the value can be used as the path of a
+// mount, leading and trailing slashes are allowed as they are trimmed.
+func validateMountPath(i interface{}, k string) (s []string, es []error) {
+	v, ok := i.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	path := strings.Trim(v, "/")
+	if path == "" {
+		es = append(es, fmt.Errorf("expected %s to be a non-empty mount path", k))
+		return
+	}
+	if strings.IndexFunc(path, func(r rune) bool { return r <= ' ' || r == 0x7f }) != -1 {
+		es = append(es, fmt.Errorf("expected %s to not contain whitespace or control characters, got %q", k, v))
+	}
+	if strings.ContainsAny(path, "*+") {
+		es = append(es, fmt.Errorf("expected %s to not contain the wildcards '*' or '+', got %q", k, v))
+	}
+	for _, segment := range strings.Split(path, "/") {
+		if segment == "" {
+			es = append(es, fmt.Errorf("expected %s to not contain empty path segments, got %q", k, v))
+			break
+		}
+		if segment == "." || segment == ".." {
+			es = append(es, fmt.Errorf("expected %s to not contain relative path segments, got %q", k, v))
+			break
+		}
+	}
+	return
+}
+
+// validatePolicyHCL checks that the value is syntactically valid HCL made of
+// path blocks, the rules themselves are checked by Vault.
+func validatePolicyHCL(i interface{}, k string) (s []string, es []error) {
+	v, ok := i.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	root, err := hcl.Parse(v)
+	if err != nil {
+		es = append(es, fmt.Errorf("expected %s to be a valid HCL policy: %s", k, err))
+		return
+	}
+
+	list, ok := root.Node.(*ast.ObjectList)
+	if !ok {
+		es = append(es, fmt.Errorf("expected %s to be a valid HCL policy: the root must be an object", k))
+		return
+	}
+	for _, item := range list.Items {
+		if len(item.Keys) == 0 {
+			continue
+		}
+		if key := item.Keys[0].Token.Value(); key != "path" && key != "name" {
+			es = append(es, fmt.Errorf("expected %s to only contain path blocks, got %q at %s", k, key, item.Keys[0].Pos()))
+		}
+	}
+	return
+}
+
+// validateCIDR checks that the value is a CIDR block or a single IP address.
+func validateCIDR(i interface{}, k string) (s []string, es []error) {
+	v, ok := i.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if _, _, err := net.ParseCIDR(v); err == nil {
+		return
+	}
+	if net.ParseIP(v) == nil {
+		es = append(es, fmt.Errorf("expected %s to be a CIDR block or an IP address, got %q", k, v))
+	}
+	return
+}
+
+// validateCIDRList checks that the value is a comma separated list of CIDR
+// blocks or IP addresses.
+func validateCIDRList(i interface{}, k string) (s []string, es []error) {
+	v, ok := i.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	for _, cidr := range strings.Split(v, ",") {
+		if cidr = strings.TrimSpace(cidr); cidr == "" {
+			continue
+		}
+		_, errs := validateCIDR(cidr, k)
+		es = append(es, errs...)
+	}
+	return
+}
+
+// validateProxyURL checks that the value is the absolute URL of an HTTP,
+// HTTPS or SOCKS5 proxy.
+func validateProxyURL(i interface{}, k string) (s []string, es []error) {
+	v, ok := i.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	u, err := url.Parse(v)
+	if err != nil {
+		es = append(es, fmt.Errorf("expected %s to be a URL: %s", k, err))
+		return
+	}
+	switch u.Scheme {
+	case "http", "https", "socks5":
+	default:
+		es = append(es, fmt.Errorf("expected the scheme of %s to be http, https or socks5, got %q", k, v))
+		return
+	}
+	if u.Host == "" {
+		es = append(es, fmt.Errorf("expected %s to have a host, got %q", k, v))
+	}
+	return
+}
@@ -0,0 +1,138 @@
+package vault
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+// tokenFileTransport sets the token of the requests made to Vault to the
+// content of a file, e.g. the file sink of a Vault Agent, re-reading it when
+// it changes so that the token the agent renewed or re-authenticated with is
+// picked up in the middle of a run.
+type tokenFileTransport struct {
+	transport http.RoundTripper
+	path      string
+
+	mu      sync.Mutex
+	modTime time.Time
+	size    int64
+	token   string
+	// read are all the tokens read from the file, only the requests made
+	// with one of those, or none, have their token replaced.
+	read map[string]bool
+}
+
+func newTokenFileTransport(transport http.RoundTripper, path string) (*tokenFileTransport, error) {
+	t := &tokenFileTransport{
+		transport: transport,
+		path:      path,
+		read:      map[string]bool{},
+	}
+	token, err := t.Token()
+	if err != nil {
+		return nil, err
+	}
+	if token == "" {
+		return nil, fmt.Errorf("token file %q is empty", path)
+	}
+	return t, nil
+}
+
+// Token returns the token in the file, re-reading it if it changed since it
+// was last read.
+func (t *tokenFileTransport) Token() (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	info, err := os.Stat(t.path)
+	if err != nil {
+		return "", fmt.Errorf("error reading token file: %s", err)
+	}
+	if info.ModTime().Equal(t.modTime) && info.Size() == t.size {
+		return t.token, nil
+	}
+
+	b, err := ioutil.ReadFile(t.path)
+	if err != nil {
+		return "", fmt.Errorf("error reading token file: %s", err)
+	}
+	token := strings.TrimSpace(string(b))
+	if token == "" && t.token != "" {
+		// the file is being written, keep the previous token until the
+		// next request
+		log.Printf("[DEBUG] Token file %q is empty, using the previous token", t.path)
+		return t.token, nil
+	}
+
+	if t.token != "" && token != t.token {
+		log.Printf("[INFO] Token file %q changed, using the new token", t.path)
+	}
+	t.modTime, t.size, t.token = info.ModTime(), info.Size(), token
+	t.read[token] = true
+	return token, nil
+}
+
+func (t *tokenFileTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	current := req.Header.Get("X-Vault-Token")
+
+	t.mu.Lock()
+	replace := current == "" || t.read[current]
+	t.mu.Unlock()
+	if !replace {
+		return t.transport.RoundTrip(req)
+	}
+
+	token, err := t.Token()
+	if err != nil {
+		return nil, err
+	}
+	if token != current {
+		req = req.Clone(req.Context())
+		req.Header.Set("X-Vault-Token", token)
+	}
+	return t.transport.RoundTrip(req)
+}
+
+// configureAgentClient finishes the configuration of a client authenticating
+// through a Vault Agent, either through its API proxy or the token file it
+// writes. The client uses the agent's token as is rather than a limited child
+// token, as the child token would be revoked along with the agent's token
+// when it re-authenticates.
+func configureAgentClient(d *schema.ResourceData, client *api.Client, tokenFile *tokenFileTransport) (*api.Client, error) {
+	if tokenFile != nil {
+		token, err := tokenFile.Token()
+		if err != nil {
+			return nil, err
+		}
+		client.SetToken(token)
+	} else {
+		// The agent's API proxy adds its auto-auth token to the requests
+		// made without one.
+		client.ClearToken()
+	}
+
+	tokenInfo, err := client.Auth().Token().LookupSelf()
+	if err != nil {
+		return nil, err
+	}
+	if policies, ok := tokenInfo.Data["policies"].([]interface{}); ok {
+		log.Printf("[INFO] Using the Vault Agent token with the following policies: %v", policies)
+	}
+	if tokenNamespace, ok := tokenInfo.Data["namespace_path"].(string); ok && tokenNamespace != "" {
+		client.SetNamespace(tokenNamespace)
+	}
+
+	if namespace := d.Get("namespace").(string); namespace != "" {
+		client.SetNamespace(namespace)
+	}
+	return client, nil
+}
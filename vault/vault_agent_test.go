@@ -0,0 +1,87 @@
+package vault
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestTokenFileTransport(t *testing.T) {
+	tokens := make(chan string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokens <- r.Header.Get("X-Vault-Token")
+	}))
+	defer server.Close()
+
+	dir, err := ioutil.TempDir("", "vault-agent")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "token")
+
+	writeToken := func(token string, modTime time.Time) {
+		t.Helper()
+
+		if err := ioutil.WriteFile(path, []byte(token+"\n"), 0600); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(path, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+	expectToken := func(client *http.Client, requestToken, expected string) {
+		t.Helper()
+
+		req, err := http.NewRequest("GET", server.URL+"/v1/sys/mounts", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if requestToken != "" {
+			req.Header.Set("X-Vault-Token", requestToken)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+
+		if actual := <-tokens; actual != expected {
+			t.Fatalf("expected the request to be made with %q, got %q", expected, actual)
+		}
+	}
+
+	writeToken("", time.Now())
+	if _, err := newTokenFileTransport(http.DefaultTransport, path); err == nil {
+		t.Fatal("expected an error for an empty token file")
+	}
+	if _, err := newTokenFileTransport(http.DefaultTransport, filepath.Join(dir, "missing")); err == nil {
+		t.Fatal("expected an error for a missing token file")
+	}
+
+	now := time.Now()
+	writeToken("s.first", now.Add(-time.Minute))
+	transport, err := newTokenFileTransport(http.DefaultTransport, path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &http.Client{Transport: transport}
+
+	expectToken(client, "", "s.first")
+	expectToken(client, "s.first", "s.first")
+
+	// the agent re-authenticated
+	writeToken("s.second", now)
+	expectToken(client, "s.first", "s.second")
+
+	// tokens not read from the file are left alone
+	expectToken(client, "s.other", "s.other")
+
+	// the token is kept while the file is being written
+	writeToken("", now.Add(time.Minute))
+	expectToken(client, "s.second", "s.second")
+}
@@ -0,0 +1,144 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+// vaultFeature describes the Vault server an attribute requires.
+type vaultFeature struct {
+	// MinVersion is the first Vault version supporting the attribute.
+	MinVersion string
+	// Enterprise is set when the attribute is only supported by Vault
+	// Enterprise.
+	Enterprise bool
+}
+
+// vaultServerInfo is the version of a Vault server, as reported by its
+// sys/health endpoint.
+type vaultServerInfo struct {
+	version    *version.Version
+	enterprise bool
+}
+
+// vaultServerInfos caches the version of the Vault servers per address, so
+// that sys/health is only read once per run rather than once per resource.
+var vaultServerInfos = struct {
+	sync.Mutex
+	m map[string]*vaultServerInfo
+}{m: map[string]*vaultServerInfo{}}
+
+// getVaultServerInfo returns the version of the Vault server client talks
+// to, reading it from sys/health on the first call.
+func getVaultServerInfo(client *api.Client) (*vaultServerInfo, error) {
+	vaultServerInfos.Lock()
+	defer vaultServerInfos.Unlock()
+
+	addr := client.Address()
+	if info, ok := vaultServerInfos.m[addr]; ok {
+		return info, nil
+	}
+
+	log.Printf("[DEBUG] Reading the version of the Vault server at %q", addr)
+	health, err := client.Sys().Health()
+	if err != nil {
+		return nil, fmt.Errorf("error reading the version of the Vault server: %s", err)
+	}
+
+	info, err := parseVaultServerVersion(health.Version)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[DEBUG] Vault server at %q is running Vault %s", addr, health.Version)
+
+	vaultServerInfos.m[addr] = info
+	return info, nil
+}
+
+func parseVaultServerVersion(v string) (*vaultServerInfo, error) {
+	parsed, err := version.NewVersion(v)
+	if err != nil {
+		return nil, fmt.Errorf("unexpected Vault server version %q: %s", v, err)
+	}
+
+	return &vaultServerInfo{
+		version:    parsed,
+		enterprise: strings.HasPrefix(parsed.Metadata(), "ent") || strings.HasPrefix(parsed.Metadata(), "prem"),
+	}, nil
+}
+
+// supports returns an error describing why the server doesn't support
+// feature, or nil if it does. Pre-releases are considered to support the
+// features of their release.
+func (i *vaultServerInfo) supports(feature vaultFeature) error {
+	if feature.Enterprise && !i.enterprise {
+		return fmt.Errorf("requires Vault Enterprise, the server is running Vault %s", i.version.Original())
+	}
+
+	if feature.MinVersion == "" {
+		return nil
+	}
+	min, err := version.NewVersion(feature.MinVersion)
+	if err != nil {
+		return err
+	}
+
+	current := i.version.Segments()
+	for n, s := range min.Segments() {
+		if current[n] != s {
+			if current[n] < s {
+				return fmt.Errorf("requires Vault %s or later, the server is running Vault %s", feature.MinVersion, i.version.Original())
+			}
+			break
+		}
+	}
+
+	return nil
+}
+
+// vaultVersionCustomizeDiff returns a CustomizeDiffFunc checking at plan time
+// that the Vault server supports the attributes of features that are set and
+// changed. The check is skipped when the version of the server can't be read,
+// e.g. when it isn't reachable yet, leaving the error to Vault.
+func vaultVersionCustomizeDiff(features map[string]vaultFeature) schema.CustomizeDiffFunc {
+	return func(d *schema.ResourceDiff, meta interface{}) error {
+		var changed []string
+		for k := range features {
+			if !d.NewValueKnown(k) || !d.HasChange(k) {
+				continue
+			}
+			if _, ok := d.GetOk(k); ok {
+				changed = append(changed, k)
+			}
+		}
+		if len(changed) == 0 {
+			return nil
+		}
+		sort.Strings(changed)
+
+		client, ok := meta.(*api.Client)
+		if !ok {
+			return nil
+		}
+		info, err := getVaultServerInfo(client)
+		if err != nil {
+			log.Printf("[WARN] Skipping the Vault version check: %s", err)
+			return nil
+		}
+
+		for _, k := range changed {
+			if err := info.supports(features[k]); err != nil {
+				return fmt.Errorf("%q %s", k, err)
+			}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,83 @@
+package vault
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestVaultServerInfoSupports(t *testing.T) {
+	testCases := []struct {
+		version     string
+		feature     vaultFeature
+		expectedErr *regexp.Regexp
+	}{
+		{
+			version: "1.13.1",
+			feature: vaultFeature{MinVersion: "1.13"},
+		},
+		{
+			version: "1.14.0",
+			feature: vaultFeature{MinVersion: "1.13"},
+		},
+		{
+			version: "2.0.0",
+			feature: vaultFeature{MinVersion: "1.13"},
+		},
+		{
+			version: "1.13.0-rc1",
+			feature: vaultFeature{MinVersion: "1.13"},
+		},
+		{
+			version:     "1.12.9",
+			feature:     vaultFeature{MinVersion: "1.13"},
+			expectedErr: regexp.MustCompile(`requires Vault 1.13 or later, the server is running Vault 1.12.9`),
+		},
+		{
+			version:     "0.14.0",
+			feature:     vaultFeature{MinVersion: "1.13"},
+			expectedErr: regexp.MustCompile(`requires Vault 1.13 or later`),
+		},
+		{
+			version: "1.13.2+ent",
+			feature: vaultFeature{MinVersion: "1.13", Enterprise: true},
+		},
+		{
+			version: "1.13.2+prem.hsm",
+			feature: vaultFeature{Enterprise: true},
+		},
+		{
+			version:     "1.13.2",
+			feature:     vaultFeature{MinVersion: "1.13", Enterprise: true},
+			expectedErr: regexp.MustCompile(`requires Vault Enterprise, the server is running Vault 1.13.2`),
+		},
+		{
+			version:     "1.12.0+ent",
+			feature:     vaultFeature{MinVersion: "1.13", Enterprise: true},
+			expectedErr: regexp.MustCompile(`requires Vault 1.13 or later`),
+		},
+	}
+
+	for _, tc := range testCases {
+		info, err := parseVaultServerVersion(tc.version)
+		if err != nil {
+			t.Fatalf("unexpected error parsing version %q: %s", tc.version, err)
+		}
+
+		err = info.supports(tc.feature)
+		if tc.expectedErr == nil {
+			if err != nil {
+				t.Errorf("expected Vault %s to support %+v, got %s", tc.version, tc.feature, err)
+			}
+			continue
+		}
+		if err == nil || !tc.expectedErr.MatchString(err.Error()) {
+			t.Errorf("expected an error matching %q for Vault %s and %+v, got %v", tc.expectedErr, tc.version, tc.feature, err)
+		}
+	}
+}
+
+func TestParseVaultServerVersion_invalid(t *testing.T) {
+	if _, err := parseVaultServerVersion("not-a-version"); err == nil {
+		t.Fatal("expected an error parsing an invalid version")
+	}
+}